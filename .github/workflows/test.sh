@@ -5,3 +5,4 @@ set -ex
 go env
 export CGO_LDFLAGS="-L/usr/lib -lopenblas"
 go test -a -v ./...
+go test -v -tags threadstub -run NumThreads ./blas/netlib
@@ -0,0 +1,142 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#include <stdint.h>
+#include "suffix64.h"
+#include "cblas.h"
+#include "weak.h"
+
+typedef CBLAS_INT blas_int;
+
+// Introspection entry points of the optimized BLAS implementations and of
+// LAPACK. They are declared weak so that the package links against any CBLAS
+// implementation; WEAK evaluates to NULL for a function that is not provided
+// by the libraries.
+
+// OpenBLAS
+extern char *openblas_get_config(void) __attribute__((weak));
+extern char *openblas_get_corename(void) __attribute__((weak));
+
+// Intel MKL
+extern void MKL_Get_Version_String(char *buf, int len) __attribute__((weak));
+
+// BLIS
+extern const char *bli_info_get_version_str(void) __attribute__((weak));
+extern int64_t bli_info_get_blas_int_type_size(void) __attribute__((weak));
+
+// ATLAS
+extern void ATL_buildinfo(void) __attribute__((weak));
+
+// LAPACK
+extern void ilaver_(blas_int *major, blas_int *minor, blas_int *patch) __attribute__((weak));
+
+// The order must match the Vendor constants.
+enum {
+	vendor_generic,
+	vendor_openblas,
+	vendor_mkl,
+	vendor_blis,
+	vendor_atlas,
+};
+
+static int backend_vendor(void) {
+	if (WEAK(openblas_get_config)) {
+		return vendor_openblas;
+	}
+	if (WEAK(MKL_Get_Version_String)) {
+		return vendor_mkl;
+	}
+	if (WEAK(bli_info_get_version_str)) {
+		return vendor_blis;
+	}
+	if (WEAK(ATL_buildinfo)) {
+		return vendor_atlas;
+	}
+	return vendor_generic;
+}
+
+static const char *openblas_config(void) {
+	return WEAK(openblas_get_config) ? WEAK(openblas_get_config)() : NULL;
+}
+
+static const char *openblas_corename(void) {
+	return WEAK(openblas_get_corename) ? WEAK(openblas_get_corename)() : NULL;
+}
+
+static void mkl_version(char *buf, int len) {
+	buf[0] = '\0';
+	if (WEAK(MKL_Get_Version_String)) {
+		WEAK(MKL_Get_Version_String)(buf, len);
+		buf[len-1] = '\0';
+	}
+}
+
+static const char *blis_version(void) {
+	return WEAK(bli_info_get_version_str) ? WEAK(bli_info_get_version_str)() : NULL;
+}
+
+static int blis_int_size(void) {
+	return WEAK(bli_info_get_blas_int_type_size) ? (int)WEAK(bli_info_get_blas_int_type_size)() : 0;
+}
+
+static int lapack_version(blas_int *major, blas_int *minor, blas_int *patch) {
+	if (!WEAK(ilaver_)) {
+		return 0;
+	}
+	WEAK(ilaver_)(major, minor, patch);
+	return 1;
+}
+*/
+import "C"
+
+import "strings"
+
+// Native reports whether the package calls a C BLAS library. It is false when
+// the package is built without cgo.
+const Native = true
+
+// Backend returns a description of the BLAS and LAPACK libraries that the
+// program is linked against. The libraries are identified by the presence of
+// vendor-specific symbols, so Backend works with any CBLAS implementation.
+func Backend() BackendInfo {
+	b := BackendInfo{
+		Vendor:  Vendor(C.backend_vendor()),
+		IntSize: 8 * C.sizeof_blas_int,
+	}
+
+	switch b.Vendor {
+	case OpenBLAS:
+		b.Config = C.GoString(C.openblas_config())
+		b.CoreName = strings.TrimSpace(C.GoString(C.openblas_corename()))
+		// The configuration string starts with the library name and
+		// version, for example "OpenBLAS 0.3.21 DYNAMIC_ARCH ...".
+		if f := strings.Fields(b.Config); len(f) > 1 && f[0] == "OpenBLAS" {
+			b.Version = f[1]
+		}
+		for _, opt := range strings.Fields(b.Config) {
+			if opt == "USE64BITINT" {
+				b.IntSize = 64
+			}
+		}
+	case MKL:
+		var buf [256]C.char
+		C.mkl_version(&buf[0], C.int(len(buf)))
+		b.Version = strings.TrimSpace(C.GoString(&buf[0]))
+	case BLIS:
+		b.Version = C.GoString(C.blis_version())
+		if n := int(C.blis_int_size()); n == 32 || n == 64 {
+			b.IntSize = n
+		}
+	}
+
+	var major, minor, patch C.blas_int
+	if C.lapack_version(&major, &minor, &patch) != 0 {
+		b.LAPACK = LAPACKVersion{Major: int(major), Minor: int(minor), Patch: int(patch)}
+	}
+
+	return b
+}
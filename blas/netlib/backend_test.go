@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "testing"
+
+func TestBackend(t *testing.T) {
+	b := Backend()
+	t.Logf("backend: %v", b)
+
+	if b.IntSize != 32 && b.IntSize != 64 {
+		t.Errorf("unexpected integer size: %d", b.IntSize)
+	}
+	switch b.Vendor {
+	case Gonum:
+		if Native {
+			t.Error("unexpected Gonum vendor with native backend")
+		}
+	case Generic, ATLAS:
+		if b.Config != "" || b.CoreName != "" {
+			t.Errorf("unexpected build configuration for %v: config=%q corename=%q", b.Vendor, b.Config, b.CoreName)
+		}
+	case OpenBLAS:
+		if b.Config == "" {
+			t.Error("missing build configuration for OpenBLAS")
+		}
+	case MKL, BLIS:
+		if b.Version == "" {
+			t.Errorf("missing version for %v", b.Vendor)
+		}
+	default:
+		t.Errorf("unknown vendor: %v", b.Vendor)
+	}
+	if b.LAPACK != (LAPACKVersion{}) && b.LAPACK.Major < 3 {
+		t.Errorf("unexpected LAPACK version: %v", b.LAPACK)
+	}
+}
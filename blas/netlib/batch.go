@@ -0,0 +1,379 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#include "suffix64.h"
+#include "cblas.h"
+#include "weak.h"
+
+// Batched matrix multiplication entry points with the Intel MKL interface.
+// They are declared weak so that the package links against any CBLAS
+// implementation; when the linked library does not provide them the batch
+// is computed by a loop over the corresponding cblas_?gemm function.
+
+extern void cblas_sgemm_batch(CBLAS_LAYOUT layout, const CBLAS_TRANSPOSE *transA, const CBLAS_TRANSPOSE *transB,
+	const CBLAS_INT *m, const CBLAS_INT *n, const CBLAS_INT *k, const float *alpha, const float **a, const CBLAS_INT *lda,
+	const float **b, const CBLAS_INT *ldb, const float *beta, float **c, const CBLAS_INT *ldc,
+	CBLAS_INT groups, const CBLAS_INT *size) __attribute__((weak));
+extern void cblas_dgemm_batch(CBLAS_LAYOUT layout, const CBLAS_TRANSPOSE *transA, const CBLAS_TRANSPOSE *transB,
+	const CBLAS_INT *m, const CBLAS_INT *n, const CBLAS_INT *k, const double *alpha, const double **a, const CBLAS_INT *lda,
+	const double **b, const CBLAS_INT *ldb, const double *beta, double **c, const CBLAS_INT *ldc,
+	CBLAS_INT groups, const CBLAS_INT *size) __attribute__((weak));
+extern void cblas_cgemm_batch(CBLAS_LAYOUT layout, const CBLAS_TRANSPOSE *transA, const CBLAS_TRANSPOSE *transB,
+	const CBLAS_INT *m, const CBLAS_INT *n, const CBLAS_INT *k, const void *alpha, const void **a, const CBLAS_INT *lda,
+	const void **b, const CBLAS_INT *ldb, const void *beta, void **c, const CBLAS_INT *ldc,
+	CBLAS_INT groups, const CBLAS_INT *size) __attribute__((weak));
+extern void cblas_zgemm_batch(CBLAS_LAYOUT layout, const CBLAS_TRANSPOSE *transA, const CBLAS_TRANSPOSE *transB,
+	const CBLAS_INT *m, const CBLAS_INT *n, const CBLAS_INT *k, const void *alpha, const void **a, const CBLAS_INT *lda,
+	const void **b, const CBLAS_INT *ldb, const void *beta, void **c, const CBLAS_INT *ldc,
+	CBLAS_INT groups, const CBLAS_INT *size) __attribute__((weak));
+
+extern void cblas_sgemm_batch_strided(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE transA, CBLAS_TRANSPOSE transB,
+	CBLAS_INT m, CBLAS_INT n, CBLAS_INT k, float alpha, const float *a, CBLAS_INT lda, CBLAS_INT strideA,
+	const float *b, CBLAS_INT ldb, CBLAS_INT strideB, float beta, float *c, CBLAS_INT ldc, CBLAS_INT strideC,
+	CBLAS_INT count) __attribute__((weak));
+extern void cblas_dgemm_batch_strided(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE transA, CBLAS_TRANSPOSE transB,
+	CBLAS_INT m, CBLAS_INT n, CBLAS_INT k, double alpha, const double *a, CBLAS_INT lda, CBLAS_INT strideA,
+	const double *b, CBLAS_INT ldb, CBLAS_INT strideB, double beta, double *c, CBLAS_INT ldc, CBLAS_INT strideC,
+	CBLAS_INT count) __attribute__((weak));
+extern void cblas_cgemm_batch_strided(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE transA, CBLAS_TRANSPOSE transB,
+	CBLAS_INT m, CBLAS_INT n, CBLAS_INT k, const void *alpha, const void *a, CBLAS_INT lda, CBLAS_INT strideA,
+	const void *b, CBLAS_INT ldb, CBLAS_INT strideB, const void *beta, void *c, CBLAS_INT ldc, CBLAS_INT strideC,
+	CBLAS_INT count) __attribute__((weak));
+extern void cblas_zgemm_batch_strided(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE transA, CBLAS_TRANSPOSE transB,
+	CBLAS_INT m, CBLAS_INT n, CBLAS_INT k, const void *alpha, const void *a, CBLAS_INT lda, CBLAS_INT strideA,
+	const void *b, CBLAS_INT ldb, CBLAS_INT strideB, const void *beta, void *c, CBLAS_INT ldc, CBLAS_INT strideC,
+	CBLAS_INT count) __attribute__((weak));
+
+// Scalars are passed by value to the real and by reference to the complex
+// cblas_?gemm functions.
+#define BY_VALUE(x) (*(x))
+#define BY_REFERENCE(x) (x)
+
+// T is the type of the scalars and the batch entry point's element type, E
+// is the element type of the matrices.
+#define GEMM_BATCH(p, T, E, SCALAR) \
+static void p##gemm_batch(CBLAS_TRANSPOSE tA, CBLAS_TRANSPOSE tB, CBLAS_INT m, CBLAS_INT n, CBLAS_INT k, \
+	const T *alpha, const E **a, CBLAS_INT lda, const E **b, CBLAS_INT ldb, \
+	const T *beta, E **c, CBLAS_INT ldc, CBLAS_INT count) { \
+	if (WEAK(cblas_##p##gemm_batch)) { \
+		WEAK(cblas_##p##gemm_batch)(CblasRowMajor, &tA, &tB, &m, &n, &k, alpha, \
+			(const T **)a, &lda, (const T **)b, &ldb, beta, (T **)c, &ldc, 1, &count); \
+		return; \
+	} \
+	for (CBLAS_INT i = 0; i < count; i++) { \
+		cblas_##p##gemm(CblasRowMajor, tA, tB, m, n, k, SCALAR(alpha), a[i], lda, b[i], ldb, SCALAR(beta), c[i], ldc); \
+	} \
+} \
+\
+static void p##gemm_batch_strided(CBLAS_TRANSPOSE tA, CBLAS_TRANSPOSE tB, CBLAS_INT m, CBLAS_INT n, CBLAS_INT k, \
+	const T *alpha, const E *a, CBLAS_INT lda, CBLAS_INT strideA, const E *b, CBLAS_INT ldb, CBLAS_INT strideB, \
+	const T *beta, E *c, CBLAS_INT ldc, CBLAS_INT strideC, CBLAS_INT count) { \
+	if (WEAK(cblas_##p##gemm_batch_strided)) { \
+		WEAK(cblas_##p##gemm_batch_strided)(CblasRowMajor, tA, tB, m, n, k, SCALAR(alpha), a, lda, strideA, \
+			b, ldb, strideB, SCALAR(beta), c, ldc, strideC, count); \
+		return; \
+	} \
+	for (CBLAS_INT i = 0; i < count; i++) { \
+		cblas_##p##gemm(CblasRowMajor, tA, tB, m, n, k, SCALAR(alpha), a+i*strideA, lda, b+i*strideB, ldb, \
+			SCALAR(beta), c+i*strideC, ldc); \
+	} \
+}
+
+typedef struct { float re, im; } complex_float;
+typedef struct { double re, im; } complex_double;
+
+GEMM_BATCH(s, float, float, BY_VALUE)
+GEMM_BATCH(d, double, double, BY_VALUE)
+GEMM_BATCH(c, void, complex_float, BY_REFERENCE)
+GEMM_BATCH(z, void, complex_double, BY_REFERENCE)
+*/
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// cblasTranspose returns the CBLAS value of a transpose argument checked by
+// gemmShape.
+func cblasTranspose(t blas.Transpose) C.CBLAS_TRANSPOSE {
+	switch t {
+	case blas.NoTrans:
+		return C.CblasNoTrans
+	case blas.Trans:
+		return C.CblasTrans
+	}
+	return C.CblasConjTrans
+}
+
+// batchPointers returns the addresses of the first elements of the slices in
+// x after pinning them so that they can be passed to C in Go memory. The
+// address of an empty slice is nil.
+func batchPointers[T any](pin *runtime.Pinner, x [][]T) []unsafe.Pointer {
+	p := make([]unsafe.Pointer, len(x))
+	for i, s := range x {
+		if len(s) > 0 {
+			pin.Pin(&s[0])
+			p[i] = unsafe.Pointer(&s[0])
+		}
+	}
+	return p
+}
+
+// SgemmBatch performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., len(c)-1,
+//
+// where op(X) is X or Xᵀ as specified by tA and tB, A_i is an m×k or k×m dense
+// matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and alpha
+// and beta are scalars. The dimensions, leading dimensions and scalars are
+// shared by all the operations in the batch, and the matrices of the i-th
+// operation are stored in a[i], b[i] and c[i]. a, b and c must have the same
+// length and the matrices in c must not overlap, otherwise SgemmBatch will
+// panic or the results are undefined.
+//
+// The whole batch is computed in a single call to C, using the batched entry
+// point of the BLAS library if it provides one.
+func (Implementation) SgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha float32, a [][]float32, lda int, b [][]float32, ldb int, beta float32, c [][]float32, ldc int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatch(m, n, a, rowA, colA, lda, b, rowB, colB, ldb, c, ldc) {
+		return
+	}
+
+	var pin runtime.Pinner
+	defer pin.Unpin()
+	_a := batchPointers(&pin, a)
+	_b := batchPointers(&pin, b)
+	_c := batchPointers(&pin, c)
+	C.sgemm_batch(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		(*C.float)(&alpha), (**C.float)(unsafe.Pointer(&_a[0])), C.CBLAS_INT(lda), (**C.float)(unsafe.Pointer(&_b[0])), C.CBLAS_INT(ldb),
+		(*C.float)(&beta), (**C.float)(unsafe.Pointer(&_c[0])), C.CBLAS_INT(ldc), C.CBLAS_INT(len(c)))
+}
+
+// DgemmBatch performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., len(c)-1,
+//
+// where op(X) is X or Xᵀ as specified by tA and tB, A_i is an m×k or k×m dense
+// matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and alpha
+// and beta are scalars. The dimensions, leading dimensions and scalars are
+// shared by all the operations in the batch, and the matrices of the i-th
+// operation are stored in a[i], b[i] and c[i]. a, b and c must have the same
+// length and the matrices in c must not overlap, otherwise DgemmBatch will
+// panic or the results are undefined.
+//
+// The whole batch is computed in a single call to C, using the batched entry
+// point of the BLAS library if it provides one.
+func (Implementation) DgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha float64, a [][]float64, lda int, b [][]float64, ldb int, beta float64, c [][]float64, ldc int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatch(m, n, a, rowA, colA, lda, b, rowB, colB, ldb, c, ldc) {
+		return
+	}
+
+	var pin runtime.Pinner
+	defer pin.Unpin()
+	_a := batchPointers(&pin, a)
+	_b := batchPointers(&pin, b)
+	_c := batchPointers(&pin, c)
+	C.dgemm_batch(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		(*C.double)(&alpha), (**C.double)(unsafe.Pointer(&_a[0])), C.CBLAS_INT(lda), (**C.double)(unsafe.Pointer(&_b[0])), C.CBLAS_INT(ldb),
+		(*C.double)(&beta), (**C.double)(unsafe.Pointer(&_c[0])), C.CBLAS_INT(ldc), C.CBLAS_INT(len(c)))
+}
+
+// CgemmBatch performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., len(c)-1,
+//
+// where op(X) is X, Xᵀ or Xᴴ as specified by tA and tB, A_i is an m×k or k×m
+// dense matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and
+// alpha and beta are scalars. The dimensions, leading dimensions and scalars
+// are shared by all the operations in the batch, and the matrices of the i-th
+// operation are stored in a[i], b[i] and c[i]. a, b and c must have the same
+// length and the matrices in c must not overlap, otherwise CgemmBatch will
+// panic or the results are undefined.
+//
+// The whole batch is computed in a single call to C, using the batched entry
+// point of the BLAS library if it provides one.
+func (Implementation) CgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha complex64, a [][]complex64, lda int, b [][]complex64, ldb int, beta complex64, c [][]complex64, ldc int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatch(m, n, a, rowA, colA, lda, b, rowB, colB, ldb, c, ldc) {
+		return
+	}
+
+	var pin runtime.Pinner
+	defer pin.Unpin()
+	_a := batchPointers(&pin, a)
+	_b := batchPointers(&pin, b)
+	_c := batchPointers(&pin, c)
+	C.cgemm_batch(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		unsafe.Pointer(&alpha), (**C.complex_float)(unsafe.Pointer(&_a[0])), C.CBLAS_INT(lda), (**C.complex_float)(unsafe.Pointer(&_b[0])), C.CBLAS_INT(ldb),
+		unsafe.Pointer(&beta), (**C.complex_float)(unsafe.Pointer(&_c[0])), C.CBLAS_INT(ldc), C.CBLAS_INT(len(c)))
+}
+
+// ZgemmBatch performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., len(c)-1,
+//
+// where op(X) is X, Xᵀ or Xᴴ as specified by tA and tB, A_i is an m×k or k×m
+// dense matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and
+// alpha and beta are scalars. The dimensions, leading dimensions and scalars
+// are shared by all the operations in the batch, and the matrices of the i-th
+// operation are stored in a[i], b[i] and c[i]. a, b and c must have the same
+// length and the matrices in c must not overlap, otherwise ZgemmBatch will
+// panic or the results are undefined.
+//
+// The whole batch is computed in a single call to C, using the batched entry
+// point of the BLAS library if it provides one.
+func (Implementation) ZgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha complex128, a [][]complex128, lda int, b [][]complex128, ldb int, beta complex128, c [][]complex128, ldc int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatch(m, n, a, rowA, colA, lda, b, rowB, colB, ldb, c, ldc) {
+		return
+	}
+
+	var pin runtime.Pinner
+	defer pin.Unpin()
+	_a := batchPointers(&pin, a)
+	_b := batchPointers(&pin, b)
+	_c := batchPointers(&pin, c)
+	C.zgemm_batch(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		unsafe.Pointer(&alpha), (**C.complex_double)(unsafe.Pointer(&_a[0])), C.CBLAS_INT(lda), (**C.complex_double)(unsafe.Pointer(&_b[0])), C.CBLAS_INT(ldb),
+		unsafe.Pointer(&beta), (**C.complex_double)(unsafe.Pointer(&_c[0])), C.CBLAS_INT(ldc), C.CBLAS_INT(len(c)))
+}
+
+// SgemmBatchStrided performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., count-1,
+//
+// where op(X) is X or Xᵀ as specified by tA and tB, A_i is an m×k or k×m dense
+// matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and alpha
+// and beta are scalars. The matrices of the i-th operation start at
+// a[i*strideA], b[i*strideB] and c[i*strideC]. strideA and strideB must not be
+// negative and strideC must be at least ldc*m, otherwise SgemmBatchStrided
+// will panic. A zero strideA or strideB uses the same A or B matrix for all
+// operations in the batch.
+//
+// The whole batch is computed in a single call to C, using the strided batched
+// entry point of the BLAS library if it provides one.
+func (Implementation) SgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda, strideA int, b []float32, ldb, strideB int, beta float32, c []float32, ldc, strideC int, count int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatchStrided(m, n, count, rowA, colA, lda, strideA, len(a), rowB, colB, ldb, strideB, len(b), ldc, strideC, len(c)) {
+		return
+	}
+
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.sgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		(*C.float)(&alpha), (*C.float)(_a), C.CBLAS_INT(lda), C.CBLAS_INT(strideA), (*C.float)(_b), C.CBLAS_INT(ldb), C.CBLAS_INT(strideB),
+		(*C.float)(&beta), (*C.float)(&c[0]), C.CBLAS_INT(ldc), C.CBLAS_INT(strideC), C.CBLAS_INT(count))
+}
+
+// DgemmBatchStrided performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., count-1,
+//
+// where op(X) is X or Xᵀ as specified by tA and tB, A_i is an m×k or k×m dense
+// matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and alpha
+// and beta are scalars. The matrices of the i-th operation start at
+// a[i*strideA], b[i*strideB] and c[i*strideC]. strideA and strideB must not be
+// negative and strideC must be at least ldc*m, otherwise DgemmBatchStrided
+// will panic. A zero strideA or strideB uses the same A or B matrix for all
+// operations in the batch.
+//
+// The whole batch is computed in a single call to C, using the strided batched
+// entry point of the BLAS library if it provides one.
+func (Implementation) DgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda, strideA int, b []float64, ldb, strideB int, beta float64, c []float64, ldc, strideC int, count int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatchStrided(m, n, count, rowA, colA, lda, strideA, len(a), rowB, colB, ldb, strideB, len(b), ldc, strideC, len(c)) {
+		return
+	}
+
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.dgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		(*C.double)(&alpha), (*C.double)(_a), C.CBLAS_INT(lda), C.CBLAS_INT(strideA), (*C.double)(_b), C.CBLAS_INT(ldb), C.CBLAS_INT(strideB),
+		(*C.double)(&beta), (*C.double)(&c[0]), C.CBLAS_INT(ldc), C.CBLAS_INT(strideC), C.CBLAS_INT(count))
+}
+
+// CgemmBatchStrided performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., count-1,
+//
+// where op(X) is X, Xᵀ or Xᴴ as specified by tA and tB, A_i is an m×k or k×m
+// dense matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and
+// alpha and beta are scalars. The matrices of the i-th operation start at
+// a[i*strideA], b[i*strideB] and c[i*strideC]. strideA and strideB must not be
+// negative and strideC must be at least ldc*m, otherwise CgemmBatchStrided
+// will panic. A zero strideA or strideB uses the same A or B matrix for all
+// operations in the batch.
+//
+// The whole batch is computed in a single call to C, using the strided batched
+// entry point of the BLAS library if it provides one.
+func (Implementation) CgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda, strideA int, b []complex64, ldb, strideB int, beta complex64, c []complex64, ldc, strideC int, count int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatchStrided(m, n, count, rowA, colA, lda, strideA, len(a), rowB, colB, ldb, strideB, len(b), ldc, strideC, len(c)) {
+		return
+	}
+
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		unsafe.Pointer(&alpha), (*C.complex_float)(unsafe.Pointer(_a)), C.CBLAS_INT(lda), C.CBLAS_INT(strideA), (*C.complex_float)(unsafe.Pointer(_b)), C.CBLAS_INT(ldb), C.CBLAS_INT(strideB),
+		unsafe.Pointer(&beta), (*C.complex_float)(unsafe.Pointer(&c[0])), C.CBLAS_INT(ldc), C.CBLAS_INT(strideC), C.CBLAS_INT(count))
+}
+
+// ZgemmBatchStrided performs the batch of matrix-matrix operations
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i,  i = 0, ..., count-1,
+//
+// where op(X) is X, Xᵀ or Xᴴ as specified by tA and tB, A_i is an m×k or k×m
+// dense matrix, B_i is an n×k or k×n dense matrix, C_i is an m×n matrix, and
+// alpha and beta are scalars. The matrices of the i-th operation start at
+// a[i*strideA], b[i*strideB] and c[i*strideC]. strideA and strideB must not be
+// negative and strideC must be at least ldc*m, otherwise ZgemmBatchStrided
+// will panic. A zero strideA or strideB uses the same A or B matrix for all
+// operations in the batch.
+//
+// The whole batch is computed in a single call to C, using the strided batched
+// entry point of the BLAS library if it provides one.
+func (Implementation) ZgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda, strideA int, b []complex128, ldb, strideB int, beta complex128, c []complex128, ldc, strideC int, count int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatchStrided(m, n, count, rowA, colA, lda, strideA, len(a), rowB, colB, ldb, strideB, len(b), ldc, strideC, len(c)) {
+		return
+	}
+
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.zgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k),
+		unsafe.Pointer(&alpha), (*C.complex_double)(unsafe.Pointer(_a)), C.CBLAS_INT(lda), C.CBLAS_INT(strideA), (*C.complex_double)(unsafe.Pointer(_b)), C.CBLAS_INT(ldb), C.CBLAS_INT(strideB),
+		unsafe.Pointer(&beta), (*C.complex_double)(unsafe.Pointer(&c[0])), C.CBLAS_INT(ldc), C.CBLAS_INT(strideC), C.CBLAS_INT(count))
+}
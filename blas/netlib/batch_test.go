@@ -0,0 +1,384 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/cmplxs"
+	"gonum.org/v1/gonum/cmplxs/cscalar"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var batchTests = []struct {
+	m, n, k int
+	count   int
+}{
+	{0, 3, 2, 2},
+	{3, 0, 2, 2},
+	{3, 4, 0, 2},
+	{3, 4, 5, 0},
+	{1, 1, 1, 1},
+	{3, 4, 5, 1},
+	{5, 3, 4, 3},
+	{7, 6, 8, 4},
+}
+
+func TestSgemmBatch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range batchTests {
+		m, n, k, count := test.m, test.n, test.k, test.count
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				rowA, colA := m, k
+				if tA != blas.NoTrans {
+					rowA, colA = k, m
+				}
+				rowB, colB := k, n
+				if tB != blas.NoTrans {
+					rowB, colB = n, k
+				}
+				lda, ldb, ldc := max(1, colA)+2, max(1, colB)+1, max(1, n)+3
+				strideA, strideB, strideC := lda*rowA+1, ldb*rowB, ldc*m+2
+
+				a := make([]float32, strideA*count)
+				b := make([]float32, strideB*count)
+				c := make([]float32, strideC*count)
+				for i := range a {
+					a[i] = float32(rnd.NormFloat64())
+				}
+				for i := range b {
+					b[i] = float32(rnd.NormFloat64())
+				}
+				for i := range c {
+					c[i] = float32(rnd.NormFloat64())
+				}
+				var alpha, beta float32 = 1.5, -0.5
+
+				want := make([]float32, len(c))
+				copy(want, c)
+				for i := 0; i < count; i++ {
+					if m == 0 || n == 0 {
+						break
+					}
+					gonum.Implementation{}.Sgemm(tA, tB, m, n, k, alpha, a[i*strideA:], lda, b[i*strideB:], ldb, beta, want[i*strideC:], ldc)
+				}
+
+				name := fmt.Sprintf("m=%d,n=%d,k=%d,count=%d,tA=%v,tB=%v", m, n, k, count, tA, tB)
+
+				batchA := make([][]float32, count)
+				batchB := make([][]float32, count)
+				batchC := make([][]float32, count)
+				got := make([]float32, len(c))
+				copy(got, c)
+				for i := 0; i < count; i++ {
+					batchA[i] = a[i*strideA : (i+1)*strideA]
+					batchB[i] = b[i*strideB : (i+1)*strideB]
+					batchC[i] = got[i*strideC : (i+1)*strideC]
+				}
+				impl.SgemmBatch(tA, tB, m, n, k, alpha, batchA, lda, batchB, ldb, beta, batchC, ldc)
+				if !equalApproxFloat32(got, want, 1e-4) {
+					t.Errorf("%s: unexpected SgemmBatch result", name)
+				}
+
+				copy(got, c)
+				impl.SgemmBatchStrided(tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, got, ldc, strideC, count)
+				if !equalApproxFloat32(got, want, 1e-4) {
+					t.Errorf("%s: unexpected SgemmBatchStrided result", name)
+				}
+			}
+		}
+	}
+}
+
+func TestDgemmBatch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range batchTests {
+		m, n, k, count := test.m, test.n, test.k, test.count
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				rowA, colA := m, k
+				if tA != blas.NoTrans {
+					rowA, colA = k, m
+				}
+				rowB, colB := k, n
+				if tB != blas.NoTrans {
+					rowB, colB = n, k
+				}
+				lda, ldb, ldc := max(1, colA)+2, max(1, colB)+1, max(1, n)+3
+				strideA, strideB, strideC := lda*rowA+1, ldb*rowB, ldc*m+2
+
+				a := make([]float64, strideA*count)
+				b := make([]float64, strideB*count)
+				c := make([]float64, strideC*count)
+				for i := range a {
+					a[i] = rnd.NormFloat64()
+				}
+				for i := range b {
+					b[i] = rnd.NormFloat64()
+				}
+				for i := range c {
+					c[i] = rnd.NormFloat64()
+				}
+				alpha, beta := 1.5, -0.5
+
+				want := make([]float64, len(c))
+				copy(want, c)
+				for i := 0; i < count; i++ {
+					if m == 0 || n == 0 {
+						break
+					}
+					gonum.Implementation{}.Dgemm(tA, tB, m, n, k, alpha, a[i*strideA:], lda, b[i*strideB:], ldb, beta, want[i*strideC:], ldc)
+				}
+
+				name := fmt.Sprintf("m=%d,n=%d,k=%d,count=%d,tA=%v,tB=%v", m, n, k, count, tA, tB)
+
+				batchA := make([][]float64, count)
+				batchB := make([][]float64, count)
+				batchC := make([][]float64, count)
+				got := make([]float64, len(c))
+				copy(got, c)
+				for i := 0; i < count; i++ {
+					batchA[i] = a[i*strideA : (i+1)*strideA]
+					batchB[i] = b[i*strideB : (i+1)*strideB]
+					batchC[i] = got[i*strideC : (i+1)*strideC]
+				}
+				impl.DgemmBatch(tA, tB, m, n, k, alpha, batchA, lda, batchB, ldb, beta, batchC, ldc)
+				if !floats.EqualApprox(got, want, 1e-13) {
+					t.Errorf("%s: unexpected DgemmBatch result", name)
+				}
+
+				copy(got, c)
+				impl.DgemmBatchStrided(tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, got, ldc, strideC, count)
+				if !floats.EqualApprox(got, want, 1e-13) {
+					t.Errorf("%s: unexpected DgemmBatchStrided result", name)
+				}
+			}
+		}
+	}
+}
+
+func TestCgemmBatch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range batchTests {
+		m, n, k, count := test.m, test.n, test.k, test.count
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+				rowA, colA := m, k
+				if tA != blas.NoTrans {
+					rowA, colA = k, m
+				}
+				rowB, colB := k, n
+				if tB != blas.NoTrans {
+					rowB, colB = n, k
+				}
+				lda, ldb, ldc := max(1, colA)+2, max(1, colB)+1, max(1, n)+3
+				strideA, strideB, strideC := lda*rowA+1, ldb*rowB, ldc*m+2
+
+				a := make([]complex64, strideA*count)
+				b := make([]complex64, strideB*count)
+				c := make([]complex64, strideC*count)
+				for i := range a {
+					a[i] = complex(float32(rnd.NormFloat64()), float32(rnd.NormFloat64()))
+				}
+				for i := range b {
+					b[i] = complex(float32(rnd.NormFloat64()), float32(rnd.NormFloat64()))
+				}
+				for i := range c {
+					c[i] = complex(float32(rnd.NormFloat64()), float32(rnd.NormFloat64()))
+				}
+				var alpha, beta complex64 = complex(1.5, -0.5), complex(-0.5, 0.25)
+
+				want := make([]complex64, len(c))
+				copy(want, c)
+				for i := 0; i < count; i++ {
+					if m == 0 || n == 0 {
+						break
+					}
+					gonum.Implementation{}.Cgemm(tA, tB, m, n, k, alpha, a[i*strideA:], lda, b[i*strideB:], ldb, beta, want[i*strideC:], ldc)
+				}
+
+				name := fmt.Sprintf("m=%d,n=%d,k=%d,count=%d,tA=%v,tB=%v", m, n, k, count, tA, tB)
+
+				batchA := make([][]complex64, count)
+				batchB := make([][]complex64, count)
+				batchC := make([][]complex64, count)
+				got := make([]complex64, len(c))
+				copy(got, c)
+				for i := 0; i < count; i++ {
+					batchA[i] = a[i*strideA : (i+1)*strideA]
+					batchB[i] = b[i*strideB : (i+1)*strideB]
+					batchC[i] = got[i*strideC : (i+1)*strideC]
+				}
+				impl.CgemmBatch(tA, tB, m, n, k, alpha, batchA, lda, batchB, ldb, beta, batchC, ldc)
+				if !equalApproxComplex64(got, want, 1e-4) {
+					t.Errorf("%s: unexpected CgemmBatch result", name)
+				}
+
+				copy(got, c)
+				impl.CgemmBatchStrided(tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, got, ldc, strideC, count)
+				if !equalApproxComplex64(got, want, 1e-4) {
+					t.Errorf("%s: unexpected CgemmBatchStrided result", name)
+				}
+			}
+		}
+	}
+}
+
+func TestZgemmBatch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range batchTests {
+		m, n, k, count := test.m, test.n, test.k, test.count
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+				rowA, colA := m, k
+				if tA != blas.NoTrans {
+					rowA, colA = k, m
+				}
+				rowB, colB := k, n
+				if tB != blas.NoTrans {
+					rowB, colB = n, k
+				}
+				lda, ldb, ldc := max(1, colA)+2, max(1, colB)+1, max(1, n)+3
+				strideA, strideB, strideC := lda*rowA+1, ldb*rowB, ldc*m+2
+
+				a := make([]complex128, strideA*count)
+				b := make([]complex128, strideB*count)
+				c := make([]complex128, strideC*count)
+				for i := range a {
+					a[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+				}
+				for i := range b {
+					b[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+				}
+				for i := range c {
+					c[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+				}
+				alpha, beta := complex(1.5, -0.5), complex(-0.5, 0.25)
+
+				want := make([]complex128, len(c))
+				copy(want, c)
+				for i := 0; i < count; i++ {
+					if m == 0 || n == 0 {
+						break
+					}
+					gonum.Implementation{}.Zgemm(tA, tB, m, n, k, alpha, a[i*strideA:], lda, b[i*strideB:], ldb, beta, want[i*strideC:], ldc)
+				}
+
+				name := fmt.Sprintf("m=%d,n=%d,k=%d,count=%d,tA=%v,tB=%v", m, n, k, count, tA, tB)
+
+				batchA := make([][]complex128, count)
+				batchB := make([][]complex128, count)
+				batchC := make([][]complex128, count)
+				got := make([]complex128, len(c))
+				copy(got, c)
+				for i := 0; i < count; i++ {
+					batchA[i] = a[i*strideA : (i+1)*strideA]
+					batchB[i] = b[i*strideB : (i+1)*strideB]
+					batchC[i] = got[i*strideC : (i+1)*strideC]
+				}
+				impl.ZgemmBatch(tA, tB, m, n, k, alpha, batchA, lda, batchB, ldb, beta, batchC, ldc)
+				if !cmplxs.EqualApprox(got, want, 1e-13) {
+					t.Errorf("%s: unexpected ZgemmBatch result", name)
+				}
+
+				copy(got, c)
+				impl.ZgemmBatchStrided(tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, got, ldc, strideC, count)
+				if !cmplxs.EqualApprox(got, want, 1e-13) {
+					t.Errorf("%s: unexpected ZgemmBatchStrided result", name)
+				}
+			}
+		}
+	}
+}
+
+func TestGemmBatchPanics(t *testing.T) {
+	a := make([]float64, 4)
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{
+			name: "mismatched a",
+			fn: func() {
+				impl.DgemmBatch(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, [][]float64{a}, 2, [][]float64{a, a}, 2, 0, [][]float64{a, a}, 2)
+			},
+			want: badLenBatchA,
+		},
+		{
+			name: "mismatched b",
+			fn: func() {
+				impl.DgemmBatch(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, [][]float64{a, a}, 2, [][]float64{a}, 2, 0, [][]float64{a, a}, 2)
+			},
+			want: badLenBatchB,
+		},
+		{
+			name: "short c",
+			fn: func() {
+				impl.DgemmBatch(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, [][]float64{a, a}, 2, [][]float64{a, a}, 2, 0, [][]float64{a, a[:3]}, 2)
+			},
+			want: shortBatchC,
+		},
+		{
+			name: "negative count",
+			fn: func() {
+				impl.DgemmBatchStrided(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 2, 4, a, 2, 4, 0, a, 2, 4, -1)
+			},
+			want: countLT0,
+		},
+		{
+			name: "overlapping c",
+			fn: func() {
+				impl.DgemmBatchStrided(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 2, 0, a, 2, 0, 0, a, 2, 3, 1)
+			},
+			want: badStrideC,
+		},
+	} {
+		func() {
+			defer func() {
+				r := recover()
+				if r != test.want {
+					t.Errorf("%s: unexpected panic: got %v, want %q", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+// equalApproxFloat32 returns whether the elements of a and b are equal
+// within the absolute or relative tolerance tol.
+func equalApproxFloat32(a, b []float32, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if !scalar.EqualWithinAbsOrRel(float64(v), float64(b[i]), tol, tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalApproxComplex64 returns whether the elements of a and b are equal
+// within the absolute or relative tolerance tol.
+func equalApproxComplex64(a, b []complex64, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if !cscalar.EqualWithinAbsOrRel(complex128(v), complex128(b[i]), tol, tol) {
+			return false
+		}
+	}
+	return true
+}
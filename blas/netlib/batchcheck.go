@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// Panic strings for batched routines.
+const (
+	badLenBatchA = "blas: bad length of batch of A"
+	badLenBatchB = "blas: bad length of batch of B"
+	countLT0     = "blas: batch count < 0"
+	badStrideA   = "blas: bad stride of A"
+	badStrideB   = "blas: bad stride of B"
+	badStrideC   = "blas: bad stride of C"
+	shortBatchA  = "blas: insufficient length of a in batch"
+	shortBatchB  = "blas: insufficient length of b in batch"
+	shortBatchC  = "blas: insufficient length of c in batch"
+)
+
+// gemmShape checks the transpose arguments, the dimensions and the leading
+// dimensions of a batched gemm call and returns the shapes of op(A) and op(B)
+// as stored.
+func gemmShape(tA, tB blas.Transpose, m, n, k, lda, ldb, ldc int) (rowA, colA, rowB, colB int) {
+	switch tA {
+	case blas.NoTrans, blas.Trans, blas.ConjTrans:
+	default:
+		panic(badTranspose)
+	}
+	switch tB {
+	case blas.NoTrans, blas.Trans, blas.ConjTrans:
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if tA == blas.NoTrans {
+		rowA, colA = m, k
+	} else {
+		rowA, colA = k, m
+	}
+	if tB == blas.NoTrans {
+		rowB, colB = k, n
+	} else {
+		rowB, colB = n, k
+	}
+	if lda < max(1, colA) {
+		panic(badLdA)
+	}
+	if ldb < max(1, colB) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+	return rowA, colA, rowB, colB
+}
+
+// checkBatch checks the batches of matrices of a batched gemm call and
+// reports whether there is any work to do.
+func checkBatch[T any](m, n int, a [][]T, rowA, colA, lda int, b [][]T, rowB, colB, ldb int, c [][]T, ldc int) bool {
+	if len(a) != len(c) {
+		panic(badLenBatchA)
+	}
+	if len(b) != len(c) {
+		panic(badLenBatchB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || len(c) == 0 {
+		return false
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	for i := range c {
+		if len(a[i]) < lda*(rowA-1)+colA {
+			panic(shortBatchA)
+		}
+		if len(b[i]) < ldb*(rowB-1)+colB {
+			panic(shortBatchB)
+		}
+		if len(c[i]) < ldc*(m-1)+n {
+			panic(shortBatchC)
+		}
+	}
+	return true
+}
+
+// checkBatchStrided checks the strides and the slice lengths of a strided
+// batched gemm call and reports whether there is any work to do.
+func checkBatchStrided(m, n, count, rowA, colA, lda, strideA, lenA, rowB, colB, ldb, strideB, lenB, ldc, strideC, lenC int) bool {
+	if count < 0 {
+		panic(countLT0)
+	}
+	if strideA < 0 {
+		panic(badStrideA)
+	}
+	if strideB < 0 {
+		panic(badStrideB)
+	}
+	if strideC < ldc*m {
+		panic(badStrideC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || count == 0 {
+		return false
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if lenA < strideA*(count-1)+lda*(rowA-1)+colA {
+		panic(shortA)
+	}
+	if lenB < strideB*(count-1)+ldb*(rowB-1)+colB {
+		panic(shortB)
+	}
+	if lenC < strideC*(count-1)+ldc*(m-1)+n {
+		panic(shortC)
+	}
+	return true
+}
@@ -8,6 +8,7 @@ package netlib
 
 /*
 #cgo CFLAGS: -g -O2
+#include "suffix64.h"
 #include "cblas.h"
 */
 import "C"
@@ -107,7 +108,7 @@ func (Implementation) Srotm(n int, x []float32, incX int, y []float32, incY int,
 		flag: float32(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_srotm(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(unsafe.Pointer(&pi)))
+	C.cblas_srotm(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Drotg(a float64, b float64) (c float64, s float64, r float64, z float64) {
 	C.cblas_drotg((*C.double)(&a), (*C.double)(&b), (*C.double)(&c), (*C.double)(&s))
@@ -156,7 +157,7 @@ func (Implementation) Drotm(n int, x []float64, incX int, y []float64, incY int,
 		flag: float64(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_drotm(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(unsafe.Pointer(&pi)))
+	C.cblas_drotm(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY int) (dotu complex64) {
 	if n < 0 {
@@ -189,7 +190,7 @@ func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	C.cblas_cdotu_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY int) (dotc complex64) {
@@ -223,7 +224,7 @@ func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	C.cblas_cdotc_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, incY int) (dotu complex128) {
@@ -257,7 +258,7 @@ func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	C.cblas_zdotu_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, incY int) (dotc complex128) {
@@ -291,7 +292,7 @@ func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	C.cblas_zdotc_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 
@@ -333,7 +334,7 @@ func (Implementation) Sdsdot(n int, alpha float32, x []float32, incX int, y []fl
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float32(C.cblas_sdsdot(C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+	return float32(C.cblas_sdsdot(C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY)))
 }
 
 // Dsdot computes the dot product of the two vectors
@@ -372,7 +373,7 @@ func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float64(C.cblas_dsdot(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+	return float64(C.cblas_dsdot(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY)))
 }
 
 // Sdot computes the dot product of the two vectors
@@ -411,7 +412,7 @@ func (Implementation) Sdot(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float32(C.cblas_sdot(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+	return float32(C.cblas_sdot(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY)))
 }
 
 // Ddot computes the dot product of the two vectors
@@ -450,7 +451,7 @@ func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float64(C.cblas_ddot(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY)))
+	return float64(C.cblas_ddot(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY)))
 }
 
 // Snrm2 computes the Euclidean norm of a vector,
@@ -481,7 +482,7 @@ func (Implementation) Snrm2(n int, x []float32, incX int) float32 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_snrm2(C.int(n), (*C.float)(_x), C.int(incX)))
+	return float32(C.cblas_snrm2(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX)))
 }
 
 // Sasum computes the sum of the absolute values of the elements of x.
@@ -512,7 +513,7 @@ func (Implementation) Sasum(n int, x []float32, incX int) float32 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_sasum(C.int(n), (*C.float)(_x), C.int(incX)))
+	return float32(C.cblas_sasum(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX)))
 }
 
 // Dnrm2 computes the Euclidean norm of a vector,
@@ -543,7 +544,7 @@ func (Implementation) Dnrm2(n int, x []float64, incX int) float64 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dnrm2(C.int(n), (*C.double)(_x), C.int(incX)))
+	return float64(C.cblas_dnrm2(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX)))
 }
 
 // Dasum computes the sum of the absolute values of the elements of x.
@@ -574,7 +575,7 @@ func (Implementation) Dasum(n int, x []float64, incX int) float64 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dasum(C.int(n), (*C.double)(_x), C.int(incX)))
+	return float64(C.cblas_dasum(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX)))
 }
 
 // Scnrm2 computes the Euclidean norm of the complex vector x,
@@ -607,7 +608,7 @@ func (Implementation) Scnrm2(n int, x []complex64, incX int) float32 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_scnrm2(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	return float32(C.cblas_scnrm2(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX)))
 }
 
 // Scasum returns the sum of the absolute values of the elements of x
@@ -640,7 +641,7 @@ func (Implementation) Scasum(n int, x []complex64, incX int) float32 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_scasum(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	return float32(C.cblas_scasum(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX)))
 }
 
 // Dznrm2 computes the Euclidean norm of the complex vector x,
@@ -671,7 +672,7 @@ func (Implementation) Dznrm2(n int, x []complex128, incX int) float64 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dznrm2(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	return float64(C.cblas_dznrm2(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX)))
 }
 
 // Dzasum returns the sum of the absolute values of the elements of x
@@ -702,7 +703,7 @@ func (Implementation) Dzasum(n int, x []complex128, incX int) float64 {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dzasum(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	return float64(C.cblas_dzasum(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX)))
 }
 
 // Isamax returns the index of an element of x with the largest absolute value.
@@ -731,7 +732,7 @@ func (Implementation) Isamax(n int, x []float32, incX int) int {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_isamax(C.int(n), (*C.float)(_x), C.int(incX)))
+	return int(C.cblas_isamax(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX)))
 }
 
 // Idamax returns the index of an element of x with the largest absolute value.
@@ -760,7 +761,7 @@ func (Implementation) Idamax(n int, x []float64, incX int) int {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_idamax(C.int(n), (*C.double)(_x), C.int(incX)))
+	return int(C.cblas_idamax(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX)))
 }
 
 // Icamax returns the index of the first element of x having largest |Re(·)|+|Im(·)|.
@@ -790,7 +791,7 @@ func (Implementation) Icamax(n int, x []complex64, incX int) int {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_icamax(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	return int(C.cblas_icamax(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX)))
 }
 
 // Izamax returns the index of the first element of x having largest |Re(·)|+|Im(·)|.
@@ -818,7 +819,7 @@ func (Implementation) Izamax(n int, x []complex128, incX int) int {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_izamax(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	return int(C.cblas_izamax(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX)))
 }
 
 // Sswap exchanges the elements of two vectors.
@@ -857,7 +858,7 @@ func (Implementation) Sswap(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sswap(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+	C.cblas_sswap(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Scopy copies the elements of x into the elements of y.
@@ -896,7 +897,7 @@ func (Implementation) Scopy(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_scopy(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+	C.cblas_scopy(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Saxpy adds alpha times x to y
@@ -935,7 +936,7 @@ func (Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []flo
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_saxpy(C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+	C.cblas_saxpy(C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Dswap exchanges the elements of two vectors.
@@ -974,7 +975,7 @@ func (Implementation) Dswap(n int, x []float64, incX int, y []float64, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dswap(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY))
+	C.cblas_dswap(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Dcopy copies the elements of x into the elements of y.
@@ -1013,7 +1014,7 @@ func (Implementation) Dcopy(n int, x []float64, incX int, y []float64, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dcopy(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY))
+	C.cblas_dcopy(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Daxpy adds alpha times x to y
@@ -1052,7 +1053,7 @@ func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []flo
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_daxpy(C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY))
+	C.cblas_daxpy(C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Cswap exchanges the elements of two complex vectors x and y.
@@ -1091,7 +1092,7 @@ func (Implementation) Cswap(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cswap(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_cswap(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Ccopy copies the vector x to vector y.
@@ -1130,7 +1131,7 @@ func (Implementation) Ccopy(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_ccopy(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_ccopy(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Caxpy adds alpha times x to y:
@@ -1171,7 +1172,7 @@ func (Implementation) Caxpy(n int, alpha complex64, x []complex64, incX int, y [
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_caxpy(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_caxpy(C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Zswap exchanges the elements of two complex vectors x and y.
@@ -1208,7 +1209,7 @@ func (Implementation) Zswap(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zswap(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zswap(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Zcopy copies the vector x to vector y.
@@ -1245,7 +1246,7 @@ func (Implementation) Zcopy(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zcopy(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zcopy(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Zaxpy adds alpha times x to y:
@@ -1284,7 +1285,7 @@ func (Implementation) Zaxpy(n int, alpha complex128, x []complex128, incX int, y
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zaxpy(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zaxpy(C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Srot applies a plane transformation.
@@ -1324,7 +1325,7 @@ func (Implementation) Srot(n int, x []float32, incX int, y []float32, incY int,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_srot(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), C.float(c), C.float(s))
+	C.cblas_srot(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), C.float(c), C.float(s))
 }
 
 // Drot applies a plane transformation.
@@ -1364,7 +1365,7 @@ func (Implementation) Drot(n int, x []float64, incX int, y []float64, incY int,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_drot(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), C.double(c), C.double(s))
+	C.cblas_drot(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), C.double(c), C.double(s))
 }
 
 // Sscal scales x by alpha.
@@ -1395,7 +1396,7 @@ func (Implementation) Sscal(n int, alpha float32, x []float32, incX int) {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_sscal(C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX))
+	C.cblas_sscal(C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX))
 }
 
 // Dscal scales x by alpha.
@@ -1426,7 +1427,7 @@ func (Implementation) Dscal(n int, alpha float64, x []float64, incX int) {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dscal(C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX))
+	C.cblas_dscal(C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX))
 }
 
 // Cscal scales the vector x by a complex scalar alpha.
@@ -1456,7 +1457,7 @@ func (Implementation) Cscal(n int, alpha complex64, x []complex64, incX int) {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_cscal(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_cscal(C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Zscal scales the vector x by a complex scalar alpha.
@@ -1484,7 +1485,7 @@ func (Implementation) Zscal(n int, alpha complex128, x []complex128, incX int) {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_zscal(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_zscal(C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Csscal scales the vector x by a real scalar alpha.
@@ -1514,7 +1515,7 @@ func (Implementation) Csscal(n int, alpha float32, x []complex64, incX int) {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_csscal(C.int(n), C.float(alpha), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_csscal(C.CBLAS_INT(n), C.float(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Zdscal scales the vector x by a real scalar alpha.
@@ -1542,7 +1543,7 @@ func (Implementation) Zdscal(n int, alpha float64, x []complex128, incX int) {
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_zdscal(C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_zdscal(C.CBLAS_INT(n), C.double(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Sgemv computes
@@ -1613,7 +1614,7 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	C.cblas_sgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Sgbmv performs one of the matrix-vector operations
@@ -1691,7 +1692,7 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	C.cblas_sgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Strmv performs one of the matrix-vector operations
@@ -1759,7 +1760,7 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_strmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	C.cblas_strmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
 }
 
 // Stbmv performs one of the matrix-vector operations
@@ -1830,7 +1831,7 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	C.cblas_stbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
 }
 
 // Stpmv performs one of the matrix-vector operations
@@ -1895,7 +1896,7 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.float)(_ap), (*C.float)(_x), C.int(incX))
+	C.cblas_stpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_ap), (*C.float)(_x), C.CBLAS_INT(incX))
 }
 
 // Strsv solves one of the systems of equations
@@ -1969,7 +1970,7 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_strsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	C.cblas_strsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
 }
 
 // Stbsv solves one of the systems of equations
@@ -2047,7 +2048,7 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	C.cblas_stbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
 }
 
 // Stpsv solves one of the systems of equations
@@ -2118,7 +2119,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.float)(_ap), (*C.float)(_x), C.int(incX))
+	C.cblas_stpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_ap), (*C.float)(_x), C.CBLAS_INT(incX))
 }
 
 // Dgemv computes
@@ -2189,7 +2190,7 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	C.cblas_dgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Dgbmv performs one of the matrix-vector operations
@@ -2267,7 +2268,7 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	C.cblas_dgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Dtrmv performs one of the matrix-vector operations
@@ -2335,7 +2336,7 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtrmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	C.cblas_dtrmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
 }
 
 // Dtbmv performs one of the matrix-vector operations
@@ -2406,7 +2407,7 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	C.cblas_dtbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
 }
 
 // Dtpmv performs one of the matrix-vector operations
@@ -2471,7 +2472,7 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.double)(_ap), (*C.double)(_x), C.int(incX))
+	C.cblas_dtpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_ap), (*C.double)(_x), C.CBLAS_INT(incX))
 }
 
 // Dtrsv solves one of the systems of equations
@@ -2545,7 +2546,7 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtrsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	C.cblas_dtrsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
 }
 
 // Dtbsv solves one of the systems of equations
@@ -2623,7 +2624,7 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	C.cblas_dtbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
 }
 
 // Dtpsv solves one of the systems of equations
@@ -2694,7 +2695,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), (*C.double)(_ap), (*C.double)(_x), C.int(incX))
+	C.cblas_dtpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_ap), (*C.double)(_x), C.CBLAS_INT(incX))
 }
 
 // Cgemv performs one of the matrix-vector operations
@@ -2768,7 +2769,7 @@ func (Implementation) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []co
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_cgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Cgbmv performs one of the matrix-vector operations
@@ -2849,7 +2850,7 @@ func (Implementation) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_cgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Ctrmv performs one of the matrix-vector operations
@@ -2920,7 +2921,7 @@ func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctrmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ctrmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ctbmv performs one of the matrix-vector operations
@@ -2995,7 +2996,7 @@ func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ctbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ctpmv performs one of the matrix-vector operations
@@ -3064,7 +3065,7 @@ func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ctpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ctrsv solves one of the systems of equations
@@ -3141,7 +3142,7 @@ func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctrsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ctrsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ctbsv solves one of the systems of equations
@@ -3222,7 +3223,7 @@ func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ctbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ctpsv solves one of the systems of equations
@@ -3297,7 +3298,7 @@ func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ctpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Zgemv performs one of the matrix-vector operations
@@ -3369,7 +3370,7 @@ func (Implementation) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []c
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zgemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Zgbmv performs one of the matrix-vector operations
@@ -3448,7 +3449,7 @@ func (Implementation) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex12
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zgbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Ztrmv performs one of the matrix-vector operations
@@ -3517,7 +3518,7 @@ func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztrmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ztrmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ztbmv performs one of the matrix-vector operations
@@ -3590,7 +3591,7 @@ func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ztbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ztpmv performs one of the matrix-vector operations
@@ -3657,7 +3658,7 @@ func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ztpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ztrsv solves one of the systems of equations
@@ -3732,7 +3733,7 @@ func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztrsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ztrsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ztbsv solves one of the systems of equations
@@ -3811,7 +3812,7 @@ func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ztbsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ztpsv solves one of the systems of equations
@@ -3884,7 +3885,7 @@ func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	C.cblas_ztpsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
 }
 
 // Ssymv performs the matrix-vector operation
@@ -3944,7 +3945,7 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_ssymv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	C.cblas_ssymv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Ssbmv performs the matrix-vector operation
@@ -4007,7 +4008,7 @@ func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_ssbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	C.cblas_ssbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Sspmv performs the matrix-vector operation
@@ -4064,7 +4065,7 @@ func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap, x []float32,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sspmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_ap), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	C.cblas_sspmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_ap), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
 }
 
 // Sger performs the rank-one operation
@@ -4118,7 +4119,7 @@ func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []f
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_sger(C.CBLAS_LAYOUT(rowMajor), C.int(m), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(_a), C.int(lda))
+	C.cblas_sger(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(_a), C.CBLAS_INT(lda))
 }
 
 // Ssyr performs the symmetric rank-one update
@@ -4167,7 +4168,7 @@ func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_ssyr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_a), C.int(lda))
+	C.cblas_ssyr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_a), C.CBLAS_INT(lda))
 }
 
 // Sspr performs the symmetric rank-one operation
@@ -4214,7 +4215,7 @@ func (Implementation) Sspr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_sspr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_ap))
+	C.cblas_sspr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_ap))
 }
 
 // Ssyr2 performs the symmetric rank-two update
@@ -4273,7 +4274,7 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_ssyr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(_a), C.int(lda))
+	C.cblas_ssyr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(_a), C.CBLAS_INT(lda))
 }
 
 // Sspr2 performs the symmetric rank-2 update
@@ -4330,7 +4331,7 @@ func (Implementation) Sspr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_sspr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(_a))
+	C.cblas_sspr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(_a))
 }
 
 // Dsymv performs the matrix-vector operation
@@ -4390,7 +4391,7 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dsymv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	C.cblas_dsymv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Dsbmv performs the matrix-vector operation
@@ -4453,7 +4454,7 @@ func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dsbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	C.cblas_dsbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Dspmv performs the matrix-vector operation
@@ -4510,7 +4511,7 @@ func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap, x []float64,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dspmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_ap), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	C.cblas_dspmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_ap), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
 }
 
 // Dger performs the rank-one operation
@@ -4564,7 +4565,7 @@ func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []f
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_dger(C.CBLAS_LAYOUT(rowMajor), C.int(m), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(_a), C.int(lda))
+	C.cblas_dger(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(_a), C.CBLAS_INT(lda))
 }
 
 // Dsyr performs the symmetric rank-one update
@@ -4613,7 +4614,7 @@ func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_dsyr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_a), C.int(lda))
+	C.cblas_dsyr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_a), C.CBLAS_INT(lda))
 }
 
 // Dspr performs the symmetric rank-one operation
@@ -4660,7 +4661,7 @@ func (Implementation) Dspr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_dspr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_ap))
+	C.cblas_dspr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_ap))
 }
 
 // Dsyr2 performs the symmetric rank-two update
@@ -4719,7 +4720,7 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_dsyr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(_a), C.int(lda))
+	C.cblas_dsyr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(_a), C.CBLAS_INT(lda))
 }
 
 // Dspr2 performs the symmetric rank-2 update
@@ -4776,7 +4777,7 @@ func (Implementation) Dspr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_dspr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(_a))
+	C.cblas_dspr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(_a))
 }
 
 // Chemv performs the matrix-vector operation
@@ -4839,7 +4840,7 @@ func (Implementation) Chemv(ul blas.Uplo, n int, alpha complex64, a []complex64,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_chemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_chemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Chbmv performs the matrix-vector operation
@@ -4905,7 +4906,7 @@ func (Implementation) Chbmv(ul blas.Uplo, n, k int, alpha complex64, a []complex
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_chbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_chbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Chpmv performs the matrix-vector operation
@@ -4965,7 +4966,7 @@ func (Implementation) Chpmv(ul blas.Uplo, n int, alpha complex64, ap, x []comple
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_chpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_chpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Cgeru performs the rank-one operation
@@ -5022,7 +5023,7 @@ func (Implementation) Cgeru(m, n int, alpha complex64, x []complex64, incX int,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cgeru(C.CBLAS_LAYOUT(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_cgeru(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Cgerc performs the rank-one operation
@@ -5079,7 +5080,7 @@ func (Implementation) Cgerc(m, n int, alpha complex64, x []complex64, incX int,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cgerc(C.CBLAS_LAYOUT(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_cgerc(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Cher performs the Hermitian rank-one operation
@@ -5132,7 +5133,7 @@ func (Implementation) Cher(ul blas.Uplo, n int, alpha float32, x []complex64, in
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cher(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_cher(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Chpr performs the Hermitian rank-1 operation
@@ -5182,7 +5183,7 @@ func (Implementation) Chpr(ul blas.Uplo, n int, alpha float32, x []complex64, in
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_chpr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.float(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_a))
+	C.cblas_chpr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a))
 }
 
 // Cher2 performs the Hermitian rank-two operation
@@ -5245,7 +5246,7 @@ func (Implementation) Cher2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cher2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_cher2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Chpr2 performs the Hermitian rank-2 operation
@@ -5305,7 +5306,7 @@ func (Implementation) Chpr2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_chpr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_ap))
+	C.cblas_chpr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_ap))
 }
 
 // Zhemv performs the matrix-vector operation
@@ -5366,7 +5367,7 @@ func (Implementation) Zhemv(ul blas.Uplo, n int, alpha complex128, a []complex12
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zhemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zhemv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Zhbmv performs the matrix-vector operation
@@ -5430,7 +5431,7 @@ func (Implementation) Zhbmv(ul blas.Uplo, n, k int, alpha complex128, a []comple
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zhbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zhbmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Zhpmv performs the matrix-vector operation
@@ -5488,7 +5489,7 @@ func (Implementation) Zhpmv(ul blas.Uplo, n int, alpha complex128, ap, x []compl
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zhpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_zhpmv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
 }
 
 // Zgeru performs the rank-one operation
@@ -5543,7 +5544,7 @@ func (Implementation) Zgeru(m, n int, alpha complex128, x []complex128, incX int
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zgeru(C.CBLAS_LAYOUT(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_zgeru(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Zgerc performs the rank-one operation
@@ -5598,7 +5599,7 @@ func (Implementation) Zgerc(m, n int, alpha complex128, x []complex128, incX int
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zgerc(C.CBLAS_LAYOUT(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_zgerc(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Zher performs the Hermitian rank-one operation
@@ -5649,7 +5650,7 @@ func (Implementation) Zher(ul blas.Uplo, n int, alpha float64, x []complex128, i
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zher(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_zher(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Zhpr performs the Hermitian rank-1 operation
@@ -5697,7 +5698,7 @@ func (Implementation) Zhpr(ul blas.Uplo, n int, alpha float64, x []complex128, i
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zhpr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_a))
+	C.cblas_zhpr(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a))
 }
 
 // Zher2 performs the Hermitian rank-two operation
@@ -5758,7 +5759,7 @@ func (Implementation) Zher2(ul blas.Uplo, n int, alpha complex128, x []complex12
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zher2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	C.cblas_zher2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
 }
 
 // Zhpr2 performs the Hermitian rank-2 operation
@@ -5816,7 +5817,7 @@ func (Implementation) Zhpr2(ul blas.Uplo, n int, alpha complex128, x []complex12
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_zhpr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_ap))
+	C.cblas_zhpr2(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_ap))
 }
 
 // Sgemm performs one of the matrix-matrix operations
@@ -5909,7 +5910,7 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_sgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb), C.float(beta), (*C.float)(_c), C.int(ldc))
+	C.cblas_sgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
 }
 
 // Ssymm performs one of the matrix-matrix operations
@@ -5987,7 +5988,7 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_ssymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb), C.float(beta), (*C.float)(_c), C.int(ldc))
+	C.cblas_ssymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
 }
 
 // Ssyrk performs one of the symmetric rank-k operations
@@ -6057,7 +6058,7 @@ func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_ssyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), C.float(beta), (*C.float)(_c), C.int(ldc))
+	C.cblas_ssyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
 }
 
 // Ssyr2k performs one of the symmetric rank 2k operations
@@ -6137,7 +6138,7 @@ func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_ssyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb), C.float(beta), (*C.float)(_c), C.int(ldc))
+	C.cblas_ssyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
 }
 
 // Strmm performs one of the matrix-matrix operations
@@ -6224,7 +6225,7 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_strmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb))
+	C.cblas_strmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb))
 }
 
 // Strsm solves one of the matrix equations
@@ -6317,7 +6318,7 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_strsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb))
+	C.cblas_strsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb))
 }
 
 // Dgemm performs one of the matrix-matrix operations
@@ -6410,7 +6411,7 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb), C.double(beta), (*C.double)(_c), C.int(ldc))
+	C.cblas_dgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
 }
 
 // Dsymm performs one of the matrix-matrix operations
@@ -6488,7 +6489,7 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dsymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb), C.double(beta), (*C.double)(_c), C.int(ldc))
+	C.cblas_dsymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
 }
 
 // Dsyrk performs one of the symmetric rank-k operations
@@ -6558,7 +6559,7 @@ func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dsyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), C.double(beta), (*C.double)(_c), C.int(ldc))
+	C.cblas_dsyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
 }
 
 // Dsyr2k performs one of the symmetric rank 2k operations
@@ -6638,7 +6639,7 @@ func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dsyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb), C.double(beta), (*C.double)(_c), C.int(ldc))
+	C.cblas_dsyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
 }
 
 // Dtrmm performs one of the matrix-matrix operations
@@ -6725,7 +6726,7 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_dtrmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb))
+	C.cblas_dtrmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb))
 }
 
 // Dtrsm solves one of the matrix equations
@@ -6818,7 +6819,7 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_dtrsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb))
+	C.cblas_dtrsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb))
 }
 
 // Cgemm performs one of the matrix-matrix operations
@@ -6913,7 +6914,7 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_cgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_cgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Csymm performs one of the matrix-matrix operations
@@ -6993,7 +6994,7 @@ func (Implementation) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_csymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_csymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Csyrk performs one of the symmetric rank-k operations
@@ -7063,7 +7064,7 @@ func (Implementation) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_csyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_csyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Csyr2k performs one of the symmetric rank-2k operations
@@ -7143,7 +7144,7 @@ func (Implementation) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_csyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_csyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Ctrmm performs one of the matrix-matrix operations
@@ -7235,7 +7236,7 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ctrmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	C.cblas_ctrmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
 }
 
 // Ctrsm solves one of the matrix equations
@@ -7329,7 +7330,7 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ctrsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	C.cblas_ctrsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
 }
 
 // Zgemm performs one of the matrix-matrix operations
@@ -7422,7 +7423,7 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_zgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Zsymm performs one of the matrix-matrix operations
@@ -7500,7 +7501,7 @@ func (Implementation) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zsymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_zsymm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Zsyrk performs one of the symmetric rank-k operations
@@ -7568,7 +7569,7 @@ func (Implementation) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zsyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_zsyrk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Zsyr2k performs one of the symmetric rank-2k operations
@@ -7646,7 +7647,7 @@ func (Implementation) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zsyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_zsyr2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Ztrmm performs one of the matrix-matrix operations
@@ -7736,7 +7737,7 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ztrmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	C.cblas_ztrmm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
 }
 
 // Ztrsm solves one of the matrix equations
@@ -7828,7 +7829,7 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ztrsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	C.cblas_ztrsm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
 }
 
 // Chemm performs one of the matrix-matrix operations
@@ -7909,7 +7910,7 @@ func (Implementation) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_chemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_chemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Cherk performs one of the hermitian rank-k operations
@@ -7982,7 +7983,7 @@ func (Implementation) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_cherk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.float(alpha), unsafe.Pointer(_a), C.int(lda), C.float(beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_cherk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), C.float(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Cher2k performs one of the hermitian rank-2k operations
@@ -8065,7 +8066,7 @@ func (Implementation) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_cher2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), C.float(beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_cher2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), C.float(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Zhemm performs one of the matrix-matrix operations
@@ -8144,7 +8145,7 @@ func (Implementation) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zhemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_zhemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Zherk performs one of the hermitian rank-k operations
@@ -8215,7 +8216,7 @@ func (Implementation) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zherk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.double(alpha), unsafe.Pointer(_a), C.int(lda), C.double(beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_zherk(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), C.double(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
 
 // Zher2k performs one of the hermitian rank-2k operations
@@ -8296,5 +8297,5 @@ func (Implementation) Zher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zher2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), C.double(beta), unsafe.Pointer(_c), C.int(ldc))
+	C.cblas_zher2k(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), C.double(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
 }
@@ -0,0 +1,727 @@
+// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from cblas.h; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && dlopen
+
+#include "cblas.h"
+#include "weak.h"
+
+const int netlib_nsymbols = 142;
+
+const char *const netlib_symbols[142] = {
+	"cblas_sdsdot",
+	"cblas_dsdot",
+	"cblas_sdot",
+	"cblas_ddot",
+	"cblas_cdotu_sub",
+	"cblas_cdotc_sub",
+	"cblas_zdotu_sub",
+	"cblas_zdotc_sub",
+	"cblas_snrm2",
+	"cblas_sasum",
+	"cblas_dnrm2",
+	"cblas_dasum",
+	"cblas_scnrm2",
+	"cblas_scasum",
+	"cblas_dznrm2",
+	"cblas_dzasum",
+	"cblas_isamax",
+	"cblas_idamax",
+	"cblas_icamax",
+	"cblas_izamax",
+	"cblas_sswap",
+	"cblas_scopy",
+	"cblas_saxpy",
+	"cblas_dswap",
+	"cblas_dcopy",
+	"cblas_daxpy",
+	"cblas_cswap",
+	"cblas_ccopy",
+	"cblas_caxpy",
+	"cblas_zswap",
+	"cblas_zcopy",
+	"cblas_zaxpy",
+	"cblas_srotg",
+	"cblas_srotmg",
+	"cblas_srot",
+	"cblas_srotm",
+	"cblas_drotg",
+	"cblas_drotmg",
+	"cblas_drot",
+	"cblas_drotm",
+	"cblas_sscal",
+	"cblas_dscal",
+	"cblas_cscal",
+	"cblas_zscal",
+	"cblas_csscal",
+	"cblas_zdscal",
+	"cblas_sgemv",
+	"cblas_sgbmv",
+	"cblas_strmv",
+	"cblas_stbmv",
+	"cblas_stpmv",
+	"cblas_strsv",
+	"cblas_stbsv",
+	"cblas_stpsv",
+	"cblas_dgemv",
+	"cblas_dgbmv",
+	"cblas_dtrmv",
+	"cblas_dtbmv",
+	"cblas_dtpmv",
+	"cblas_dtrsv",
+	"cblas_dtbsv",
+	"cblas_dtpsv",
+	"cblas_cgemv",
+	"cblas_cgbmv",
+	"cblas_ctrmv",
+	"cblas_ctbmv",
+	"cblas_ctpmv",
+	"cblas_ctrsv",
+	"cblas_ctbsv",
+	"cblas_ctpsv",
+	"cblas_zgemv",
+	"cblas_zgbmv",
+	"cblas_ztrmv",
+	"cblas_ztbmv",
+	"cblas_ztpmv",
+	"cblas_ztrsv",
+	"cblas_ztbsv",
+	"cblas_ztpsv",
+	"cblas_ssymv",
+	"cblas_ssbmv",
+	"cblas_sspmv",
+	"cblas_sger",
+	"cblas_ssyr",
+	"cblas_sspr",
+	"cblas_ssyr2",
+	"cblas_sspr2",
+	"cblas_dsymv",
+	"cblas_dsbmv",
+	"cblas_dspmv",
+	"cblas_dger",
+	"cblas_dsyr",
+	"cblas_dspr",
+	"cblas_dsyr2",
+	"cblas_dspr2",
+	"cblas_chemv",
+	"cblas_chbmv",
+	"cblas_chpmv",
+	"cblas_cgeru",
+	"cblas_cgerc",
+	"cblas_cher",
+	"cblas_chpr",
+	"cblas_cher2",
+	"cblas_chpr2",
+	"cblas_zhemv",
+	"cblas_zhbmv",
+	"cblas_zhpmv",
+	"cblas_zgeru",
+	"cblas_zgerc",
+	"cblas_zher",
+	"cblas_zhpr",
+	"cblas_zher2",
+	"cblas_zhpr2",
+	"cblas_sgemm",
+	"cblas_ssymm",
+	"cblas_ssyrk",
+	"cblas_ssyr2k",
+	"cblas_strmm",
+	"cblas_strsm",
+	"cblas_dgemm",
+	"cblas_dsymm",
+	"cblas_dsyrk",
+	"cblas_dsyr2k",
+	"cblas_dtrmm",
+	"cblas_dtrsm",
+	"cblas_cgemm",
+	"cblas_csymm",
+	"cblas_csyrk",
+	"cblas_csyr2k",
+	"cblas_ctrmm",
+	"cblas_ctrsm",
+	"cblas_zgemm",
+	"cblas_zsymm",
+	"cblas_zsyrk",
+	"cblas_zsyr2k",
+	"cblas_ztrmm",
+	"cblas_ztrsm",
+	"cblas_chemm",
+	"cblas_cherk",
+	"cblas_cher2k",
+	"cblas_zhemm",
+	"cblas_zherk",
+	"cblas_zher2k",
+};
+
+void *netlib_functions[142];
+
+float cblas_sdsdot(const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY) {
+	return ((__typeof__(&cblas_sdsdot))netlib_function(0))(N, alpha, X, incX, Y, incY);
+}
+
+double cblas_dsdot(const CBLAS_INT N, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY) {
+	return ((__typeof__(&cblas_dsdot))netlib_function(1))(N, X, incX, Y, incY);
+}
+
+float cblas_sdot(const CBLAS_INT N, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY) {
+	return ((__typeof__(&cblas_sdot))netlib_function(2))(N, X, incX, Y, incY);
+}
+
+double cblas_ddot(const CBLAS_INT N, const double *X, const CBLAS_INT incX, const double *Y, const CBLAS_INT incY) {
+	return ((__typeof__(&cblas_ddot))netlib_function(3))(N, X, incX, Y, incY);
+}
+
+void cblas_cdotu_sub(const CBLAS_INT N, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *dotu) {
+	((__typeof__(&cblas_cdotu_sub))netlib_function(4))(N, X, incX, Y, incY, dotu);
+}
+
+void cblas_cdotc_sub(const CBLAS_INT N, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *dotc) {
+	((__typeof__(&cblas_cdotc_sub))netlib_function(5))(N, X, incX, Y, incY, dotc);
+}
+
+void cblas_zdotu_sub(const CBLAS_INT N, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *dotu) {
+	((__typeof__(&cblas_zdotu_sub))netlib_function(6))(N, X, incX, Y, incY, dotu);
+}
+
+void cblas_zdotc_sub(const CBLAS_INT N, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *dotc) {
+	((__typeof__(&cblas_zdotc_sub))netlib_function(7))(N, X, incX, Y, incY, dotc);
+}
+
+float cblas_snrm2(const CBLAS_INT N, const float *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_snrm2))netlib_function(8))(N, X, incX);
+}
+
+float cblas_sasum(const CBLAS_INT N, const float *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_sasum))netlib_function(9))(N, X, incX);
+}
+
+double cblas_dnrm2(const CBLAS_INT N, const double *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_dnrm2))netlib_function(10))(N, X, incX);
+}
+
+double cblas_dasum(const CBLAS_INT N, const double *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_dasum))netlib_function(11))(N, X, incX);
+}
+
+float cblas_scnrm2(const CBLAS_INT N, const void *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_scnrm2))netlib_function(12))(N, X, incX);
+}
+
+float cblas_scasum(const CBLAS_INT N, const void *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_scasum))netlib_function(13))(N, X, incX);
+}
+
+double cblas_dznrm2(const CBLAS_INT N, const void *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_dznrm2))netlib_function(14))(N, X, incX);
+}
+
+double cblas_dzasum(const CBLAS_INT N, const void *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_dzasum))netlib_function(15))(N, X, incX);
+}
+
+CBLAS_INDEX cblas_isamax(const CBLAS_INT N, const float *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_isamax))netlib_function(16))(N, X, incX);
+}
+
+CBLAS_INDEX cblas_idamax(const CBLAS_INT N, const double *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_idamax))netlib_function(17))(N, X, incX);
+}
+
+CBLAS_INDEX cblas_icamax(const CBLAS_INT N, const void *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_icamax))netlib_function(18))(N, X, incX);
+}
+
+CBLAS_INDEX cblas_izamax(const CBLAS_INT N, const void *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_izamax))netlib_function(19))(N, X, incX);
+}
+
+void cblas_sswap(const CBLAS_INT N, float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_sswap))netlib_function(20))(N, X, incX, Y, incY);
+}
+
+void cblas_scopy(const CBLAS_INT N, const float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_scopy))netlib_function(21))(N, X, incX, Y, incY);
+}
+
+void cblas_saxpy(const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_saxpy))netlib_function(22))(N, alpha, X, incX, Y, incY);
+}
+
+void cblas_dswap(const CBLAS_INT N, double *X, const CBLAS_INT incX, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_dswap))netlib_function(23))(N, X, incX, Y, incY);
+}
+
+void cblas_dcopy(const CBLAS_INT N, const double *X, const CBLAS_INT incX, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_dcopy))netlib_function(24))(N, X, incX, Y, incY);
+}
+
+void cblas_daxpy(const CBLAS_INT N, const double alpha, const double *X, const CBLAS_INT incX, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_daxpy))netlib_function(25))(N, alpha, X, incX, Y, incY);
+}
+
+void cblas_cswap(const CBLAS_INT N, void *X, const CBLAS_INT incX, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_cswap))netlib_function(26))(N, X, incX, Y, incY);
+}
+
+void cblas_ccopy(const CBLAS_INT N, const void *X, const CBLAS_INT incX, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_ccopy))netlib_function(27))(N, X, incX, Y, incY);
+}
+
+void cblas_caxpy(const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_caxpy))netlib_function(28))(N, alpha, X, incX, Y, incY);
+}
+
+void cblas_zswap(const CBLAS_INT N, void *X, const CBLAS_INT incX, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zswap))netlib_function(29))(N, X, incX, Y, incY);
+}
+
+void cblas_zcopy(const CBLAS_INT N, const void *X, const CBLAS_INT incX, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zcopy))netlib_function(30))(N, X, incX, Y, incY);
+}
+
+void cblas_zaxpy(const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zaxpy))netlib_function(31))(N, alpha, X, incX, Y, incY);
+}
+
+void cblas_srotg(float *a, float *b, float *c, float *s) {
+	((__typeof__(&cblas_srotg))netlib_function(32))(a, b, c, s);
+}
+
+void cblas_srotmg(float *d1, float *d2, float *b1, const float b2, float *P) {
+	((__typeof__(&cblas_srotmg))netlib_function(33))(d1, d2, b1, b2, P);
+}
+
+void cblas_srot(const CBLAS_INT N, float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY, const float c, const float s) {
+	((__typeof__(&cblas_srot))netlib_function(34))(N, X, incX, Y, incY, c, s);
+}
+
+void cblas_srotm(const CBLAS_INT N, float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY, const float *P) {
+	((__typeof__(&cblas_srotm))netlib_function(35))(N, X, incX, Y, incY, P);
+}
+
+void cblas_drotg(double *a, double *b, double *c, double *s) {
+	((__typeof__(&cblas_drotg))netlib_function(36))(a, b, c, s);
+}
+
+void cblas_drotmg(double *d1, double *d2, double *b1, const double b2, double *P) {
+	((__typeof__(&cblas_drotmg))netlib_function(37))(d1, d2, b1, b2, P);
+}
+
+void cblas_drot(const CBLAS_INT N, double *X, const CBLAS_INT incX, double *Y, const CBLAS_INT incY, const double c, const double s) {
+	((__typeof__(&cblas_drot))netlib_function(38))(N, X, incX, Y, incY, c, s);
+}
+
+void cblas_drotm(const CBLAS_INT N, double *X, const CBLAS_INT incX, double *Y, const CBLAS_INT incY, const double *P) {
+	((__typeof__(&cblas_drotm))netlib_function(39))(N, X, incX, Y, incY, P);
+}
+
+void cblas_sscal(const CBLAS_INT N, const float alpha, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_sscal))netlib_function(40))(N, alpha, X, incX);
+}
+
+void cblas_dscal(const CBLAS_INT N, const double alpha, double *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_dscal))netlib_function(41))(N, alpha, X, incX);
+}
+
+void cblas_cscal(const CBLAS_INT N, const void *alpha, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_cscal))netlib_function(42))(N, alpha, X, incX);
+}
+
+void cblas_zscal(const CBLAS_INT N, const void *alpha, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_zscal))netlib_function(43))(N, alpha, X, incX);
+}
+
+void cblas_csscal(const CBLAS_INT N, const float alpha, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_csscal))netlib_function(44))(N, alpha, X, incX);
+}
+
+void cblas_zdscal(const CBLAS_INT N, const double alpha, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_zdscal))netlib_function(45))(N, alpha, X, incX);
+}
+
+void cblas_sgemv(const CBLAS_LAYOUT layout, const CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const float alpha, const float *A, const CBLAS_INT lda, const float *X, const CBLAS_INT incX, const float beta, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_sgemv))netlib_function(46))(layout, TransA, M, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_sgbmv(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT KL, const CBLAS_INT KU, const float alpha, const float *A, const CBLAS_INT lda, const float *X, const CBLAS_INT incX, const float beta, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_sgbmv))netlib_function(47))(layout, TransA, M, N, KL, KU, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_strmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const float *A, const CBLAS_INT lda, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_strmv))netlib_function(48))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_stbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const float *A, const CBLAS_INT lda, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_stbmv))netlib_function(49))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_stpmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const float *Ap, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_stpmv))netlib_function(50))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_strsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const float *A, const CBLAS_INT lda, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_strsv))netlib_function(51))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_stbsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const float *A, const CBLAS_INT lda, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_stbsv))netlib_function(52))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_stpsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const float *Ap, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_stpsv))netlib_function(53))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_dgemv(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const double alpha, const double *A, const CBLAS_INT lda, const double *X, const CBLAS_INT incX, const double beta, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_dgemv))netlib_function(54))(layout, TransA, M, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_dgbmv(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT KL, const CBLAS_INT KU, const double alpha, const double *A, const CBLAS_INT lda, const double *X, const CBLAS_INT incX, const double beta, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_dgbmv))netlib_function(55))(layout, TransA, M, N, KL, KU, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_dtrmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const double *A, const CBLAS_INT lda, double *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_dtrmv))netlib_function(56))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_dtbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const double *A, const CBLAS_INT lda, double *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_dtbmv))netlib_function(57))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_dtpmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const double *Ap, double *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_dtpmv))netlib_function(58))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_dtrsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const double *A, const CBLAS_INT lda, double *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_dtrsv))netlib_function(59))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_dtbsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const double *A, const CBLAS_INT lda, double *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_dtbsv))netlib_function(60))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_dtpsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const double *Ap, double *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_dtpsv))netlib_function(61))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_cgemv(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_cgemv))netlib_function(62))(layout, TransA, M, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_cgbmv(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT KL, const CBLAS_INT KU, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_cgbmv))netlib_function(63))(layout, TransA, M, N, KL, KU, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_ctrmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ctrmv))netlib_function(64))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_ctbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ctbmv))netlib_function(65))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_ctpmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *Ap, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ctpmv))netlib_function(66))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_ctrsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ctrsv))netlib_function(67))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_ctbsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ctbsv))netlib_function(68))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_ctpsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *Ap, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ctpsv))netlib_function(69))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_zgemv(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zgemv))netlib_function(70))(layout, TransA, M, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_zgbmv(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT KL, const CBLAS_INT KU, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zgbmv))netlib_function(71))(layout, TransA, M, N, KL, KU, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_ztrmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ztrmv))netlib_function(72))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_ztbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ztbmv))netlib_function(73))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_ztpmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *Ap, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ztpmv))netlib_function(74))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_ztrsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ztrsv))netlib_function(75))(layout, Uplo, TransA, Diag, N, A, lda, X, incX);
+}
+
+void cblas_ztbsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const CBLAS_INT K, const void *A, const CBLAS_INT lda, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ztbsv))netlib_function(76))(layout, Uplo, TransA, Diag, N, K, A, lda, X, incX);
+}
+
+void cblas_ztpsv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT N, const void *Ap, void *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_ztpsv))netlib_function(77))(layout, Uplo, TransA, Diag, N, Ap, X, incX);
+}
+
+void cblas_ssymv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const float *A, const CBLAS_INT lda, const float *X, const CBLAS_INT incX, const float beta, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_ssymv))netlib_function(78))(layout, Uplo, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_ssbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const CBLAS_INT K, const float alpha, const float *A, const CBLAS_INT lda, const float *X, const CBLAS_INT incX, const float beta, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_ssbmv))netlib_function(79))(layout, Uplo, N, K, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_sspmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const float *Ap, const float *X, const CBLAS_INT incX, const float beta, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_sspmv))netlib_function(80))(layout, Uplo, N, alpha, Ap, X, incX, beta, Y, incY);
+}
+
+void cblas_sger(CBLAS_LAYOUT layout, const CBLAS_INT M, const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY, float *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_sger))netlib_function(81))(layout, M, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_ssyr(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, float *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_ssyr))netlib_function(82))(layout, Uplo, N, alpha, X, incX, A, lda);
+}
+
+void cblas_sspr(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, float *Ap) {
+	((__typeof__(&cblas_sspr))netlib_function(83))(layout, Uplo, N, alpha, X, incX, Ap);
+}
+
+void cblas_ssyr2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY, float *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_ssyr2))netlib_function(84))(layout, Uplo, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_sspr2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY, float *A) {
+	((__typeof__(&cblas_sspr2))netlib_function(85))(layout, Uplo, N, alpha, X, incX, Y, incY, A);
+}
+
+void cblas_dsymv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const double *A, const CBLAS_INT lda, const double *X, const CBLAS_INT incX, const double beta, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_dsymv))netlib_function(86))(layout, Uplo, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_dsbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const CBLAS_INT K, const double alpha, const double *A, const CBLAS_INT lda, const double *X, const CBLAS_INT incX, const double beta, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_dsbmv))netlib_function(87))(layout, Uplo, N, K, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_dspmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const double *Ap, const double *X, const CBLAS_INT incX, const double beta, double *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_dspmv))netlib_function(88))(layout, Uplo, N, alpha, Ap, X, incX, beta, Y, incY);
+}
+
+void cblas_dger(CBLAS_LAYOUT layout, const CBLAS_INT M, const CBLAS_INT N, const double alpha, const double *X, const CBLAS_INT incX, const double *Y, const CBLAS_INT incY, double *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_dger))netlib_function(89))(layout, M, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_dsyr(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const double *X, const CBLAS_INT incX, double *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_dsyr))netlib_function(90))(layout, Uplo, N, alpha, X, incX, A, lda);
+}
+
+void cblas_dspr(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const double *X, const CBLAS_INT incX, double *Ap) {
+	((__typeof__(&cblas_dspr))netlib_function(91))(layout, Uplo, N, alpha, X, incX, Ap);
+}
+
+void cblas_dsyr2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const double *X, const CBLAS_INT incX, const double *Y, const CBLAS_INT incY, double *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_dsyr2))netlib_function(92))(layout, Uplo, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_dspr2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const double *X, const CBLAS_INT incX, const double *Y, const CBLAS_INT incY, double *A) {
+	((__typeof__(&cblas_dspr2))netlib_function(93))(layout, Uplo, N, alpha, X, incX, Y, incY, A);
+}
+
+void cblas_chemv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_chemv))netlib_function(94))(layout, Uplo, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_chbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_chbmv))netlib_function(95))(layout, Uplo, N, K, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_chpmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *Ap, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_chpmv))netlib_function(96))(layout, Uplo, N, alpha, Ap, X, incX, beta, Y, incY);
+}
+
+void cblas_cgeru(CBLAS_LAYOUT layout, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_cgeru))netlib_function(97))(layout, M, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_cgerc(CBLAS_LAYOUT layout, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_cgerc))netlib_function(98))(layout, M, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_cher(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const void *X, const CBLAS_INT incX, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_cher))netlib_function(99))(layout, Uplo, N, alpha, X, incX, A, lda);
+}
+
+void cblas_chpr(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const float alpha, const void *X, const CBLAS_INT incX, void *A) {
+	((__typeof__(&cblas_chpr))netlib_function(100))(layout, Uplo, N, alpha, X, incX, A);
+}
+
+void cblas_cher2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_cher2))netlib_function(101))(layout, Uplo, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_chpr2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *Ap) {
+	((__typeof__(&cblas_chpr2))netlib_function(102))(layout, Uplo, N, alpha, X, incX, Y, incY, Ap);
+}
+
+void cblas_zhemv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zhemv))netlib_function(103))(layout, Uplo, N, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_zhbmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zhbmv))netlib_function(104))(layout, Uplo, N, K, alpha, A, lda, X, incX, beta, Y, incY);
+}
+
+void cblas_zhpmv(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *Ap, const void *X, const CBLAS_INT incX, const void *beta, void *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_zhpmv))netlib_function(105))(layout, Uplo, N, alpha, Ap, X, incX, beta, Y, incY);
+}
+
+void cblas_zgeru(CBLAS_LAYOUT layout, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_zgeru))netlib_function(106))(layout, M, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_zgerc(CBLAS_LAYOUT layout, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_zgerc))netlib_function(107))(layout, M, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_zher(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const void *X, const CBLAS_INT incX, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_zher))netlib_function(108))(layout, Uplo, N, alpha, X, incX, A, lda);
+}
+
+void cblas_zhpr(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const double alpha, const void *X, const CBLAS_INT incX, void *A) {
+	((__typeof__(&cblas_zhpr))netlib_function(109))(layout, Uplo, N, alpha, X, incX, A);
+}
+
+void cblas_zher2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *A, const CBLAS_INT lda) {
+	((__typeof__(&cblas_zher2))netlib_function(110))(layout, Uplo, N, alpha, X, incX, Y, incY, A, lda);
+}
+
+void cblas_zhpr2(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, const CBLAS_INT N, const void *alpha, const void *X, const CBLAS_INT incX, const void *Y, const CBLAS_INT incY, void *Ap) {
+	((__typeof__(&cblas_zhpr2))netlib_function(111))(layout, Uplo, N, alpha, X, incX, Y, incY, Ap);
+}
+
+void cblas_sgemm(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, CBLAS_TRANSPOSE TransB, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT K, const float alpha, const float *A, const CBLAS_INT lda, const float *B, const CBLAS_INT ldb, const float beta, float *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_sgemm))netlib_function(112))(layout, TransA, TransB, M, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_ssymm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, const CBLAS_INT M, const CBLAS_INT N, const float alpha, const float *A, const CBLAS_INT lda, const float *B, const CBLAS_INT ldb, const float beta, float *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_ssymm))netlib_function(113))(layout, Side, Uplo, M, N, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_ssyrk(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const float alpha, const float *A, const CBLAS_INT lda, const float beta, float *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_ssyrk))netlib_function(114))(layout, Uplo, Trans, N, K, alpha, A, lda, beta, C, ldc);
+}
+
+void cblas_ssyr2k(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const float alpha, const float *A, const CBLAS_INT lda, const float *B, const CBLAS_INT ldb, const float beta, float *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_ssyr2k))netlib_function(115))(layout, Uplo, Trans, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_strmm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const float alpha, const float *A, const CBLAS_INT lda, float *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_strmm))netlib_function(116))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_strsm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const float alpha, const float *A, const CBLAS_INT lda, float *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_strsm))netlib_function(117))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_dgemm(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, CBLAS_TRANSPOSE TransB, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT K, const double alpha, const double *A, const CBLAS_INT lda, const double *B, const CBLAS_INT ldb, const double beta, double *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_dgemm))netlib_function(118))(layout, TransA, TransB, M, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_dsymm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, const CBLAS_INT M, const CBLAS_INT N, const double alpha, const double *A, const CBLAS_INT lda, const double *B, const CBLAS_INT ldb, const double beta, double *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_dsymm))netlib_function(119))(layout, Side, Uplo, M, N, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_dsyrk(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const double alpha, const double *A, const CBLAS_INT lda, const double beta, double *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_dsyrk))netlib_function(120))(layout, Uplo, Trans, N, K, alpha, A, lda, beta, C, ldc);
+}
+
+void cblas_dsyr2k(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const double alpha, const double *A, const CBLAS_INT lda, const double *B, const CBLAS_INT ldb, const double beta, double *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_dsyr2k))netlib_function(121))(layout, Uplo, Trans, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_dtrmm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const double alpha, const double *A, const CBLAS_INT lda, double *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_dtrmm))netlib_function(122))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_dtrsm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const double alpha, const double *A, const CBLAS_INT lda, double *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_dtrsm))netlib_function(123))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_cgemm(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, CBLAS_TRANSPOSE TransB, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_cgemm))netlib_function(124))(layout, TransA, TransB, M, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_csymm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_csymm))netlib_function(125))(layout, Side, Uplo, M, N, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_csyrk(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_csyrk))netlib_function(126))(layout, Uplo, Trans, N, K, alpha, A, lda, beta, C, ldc);
+}
+
+void cblas_csyr2k(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_csyr2k))netlib_function(127))(layout, Uplo, Trans, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_ctrmm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, void *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_ctrmm))netlib_function(128))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_ctrsm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, void *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_ctrsm))netlib_function(129))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_zgemm(CBLAS_LAYOUT layout, CBLAS_TRANSPOSE TransA, CBLAS_TRANSPOSE TransB, const CBLAS_INT M, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_zgemm))netlib_function(130))(layout, TransA, TransB, M, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_zsymm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_zsymm))netlib_function(131))(layout, Side, Uplo, M, N, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_zsyrk(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_zsyrk))netlib_function(132))(layout, Uplo, Trans, N, K, alpha, A, lda, beta, C, ldc);
+}
+
+void cblas_zsyr2k(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_zsyr2k))netlib_function(133))(layout, Uplo, Trans, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_ztrmm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, void *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_ztrmm))netlib_function(134))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_ztrsm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE TransA, CBLAS_DIAG Diag, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, void *B, const CBLAS_INT ldb) {
+	((__typeof__(&cblas_ztrsm))netlib_function(135))(layout, Side, Uplo, TransA, Diag, M, N, alpha, A, lda, B, ldb);
+}
+
+void cblas_chemm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_chemm))netlib_function(136))(layout, Side, Uplo, M, N, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_cherk(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const float alpha, const void *A, const CBLAS_INT lda, const float beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_cherk))netlib_function(137))(layout, Uplo, Trans, N, K, alpha, A, lda, beta, C, ldc);
+}
+
+void cblas_cher2k(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const float beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_cher2k))netlib_function(138))(layout, Uplo, Trans, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_zhemm(CBLAS_LAYOUT layout, CBLAS_SIDE Side, CBLAS_UPLO Uplo, const CBLAS_INT M, const CBLAS_INT N, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const void *beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_zhemm))netlib_function(139))(layout, Side, Uplo, M, N, alpha, A, lda, B, ldb, beta, C, ldc);
+}
+
+void cblas_zherk(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const double alpha, const void *A, const CBLAS_INT lda, const double beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_zherk))netlib_function(140))(layout, Uplo, Trans, N, K, alpha, A, lda, beta, C, ldc);
+}
+
+void cblas_zher2k(CBLAS_LAYOUT layout, CBLAS_UPLO Uplo, CBLAS_TRANSPOSE Trans, const CBLAS_INT N, const CBLAS_INT K, const void *alpha, const void *A, const CBLAS_INT lda, const void *B, const CBLAS_INT ldb, const double beta, void *C, const CBLAS_INT ldc) {
+	((__typeof__(&cblas_zher2k))netlib_function(141))(layout, Uplo, Trans, N, K, alpha, A, lda, B, ldb, beta, C, ldc);
+}
@@ -0,0 +1,6792 @@
+// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from cblas.h; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#include "suffix64.h"
+#include "cblas.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+const colMajor layout = C.CblasColMajor
+
+// ColMajor provides the Level 2 and Level 3 BLAS routines of the C BLAS
+// library for matrices stored in column-major order, as used by Fortran and
+// by the 'F' order arrays of NumPy and Julia. The element (i, j) of a dense
+// matrix a with leading dimension lda is a[i+j*lda], and the leading
+// dimension must be at least the number of rows of the matrix. Band matrices
+// use the Fortran band storage where a[ku+i-j+j*lda] holds the element (i, j),
+// and packed matrices store the columns of the referenced triangle
+// consecutively.
+//
+// ColMajor does not implement the blas interfaces of Gonum, which assume
+// row-major storage. The Level 1 routines do not depend on the storage order
+// and are provided by Implementation. ColMajor is only available when the
+// package is built with cgo.
+type ColMajor struct{}
+
+// Generated cases ...
+
+// Sgemv computes
+//
+//	y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+//
+// where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
+func (ColMajor) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	// declared at cblas.h:167:6 void cblas_sgemv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_sgemv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
+}
+
+// Sgbmv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if tA == blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals,
+// x and y are vectors, and alpha and beta are scalars.
+func (ColMajor) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	// declared at cblas.h:172:6 void cblas_sgbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if kL < 0 {
+		panic(kLLT0)
+	}
+	if kU < 0 {
+		panic(kULT0)
+	}
+	if lda < kL+kU+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(min(n, m+kU)-1)+kL+kU+1 {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_sgbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
+}
+
+// Strmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA == blas.NoTrans
+//	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix, and x is a vector.
+func (ColMajor) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
+	// declared at cblas.h:177:6 void cblas_strmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_strmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
+}
+
+// Stbmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA == blas.NoTrans
+//	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular band matrix with k+1 diagonals, and x is a vector.
+func (ColMajor) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float32, lda int, x []float32, incX int) {
+	// declared at cblas.h:181:6 void cblas_stbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_stbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
+}
+
+// Stpmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA == blas.NoTrans
+//	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix in packed format, and x is a vector.
+func (ColMajor) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float32, incX int) {
+	// declared at cblas.h:185:6 void cblas_stpmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_stpmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_ap), (*C.float)(_x), C.CBLAS_INT(incX))
+}
+
+// Strsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix, and x and b are vectors.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
+	// declared at cblas.h:188:6 void cblas_strsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_strsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
+}
+
+// Stbsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans or tA == blas.ConjTrans
+//
+// where A is an n×n triangular band matrix with k+1 diagonals,
+// and x and b are vectors.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float32, lda int, x []float32, incX int) {
+	// declared at cblas.h:192:6 void cblas_stbsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_stbsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX))
+}
+
+// Stpsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix in packed format, and x and b are vectors.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float32, incX int) {
+	// declared at cblas.h:196:6 void cblas_stpsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_stpsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.float)(_ap), (*C.float)(_x), C.CBLAS_INT(incX))
+}
+
+// Dgemv computes
+//
+//	y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
+//
+// where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
+func (ColMajor) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	// declared at cblas.h:200:6 void cblas_dgemv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_dgemv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
+}
+
+// Dgbmv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if tA == blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals,
+// x and y are vectors, and alpha and beta are scalars.
+func (ColMajor) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	// declared at cblas.h:205:6 void cblas_dgbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if kL < 0 {
+		panic(kLLT0)
+	}
+	if kU < 0 {
+		panic(kULT0)
+	}
+	if lda < kL+kU+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(min(n, m+kU)-1)+kL+kU+1 {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_dgbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
+}
+
+// Dtrmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA == blas.NoTrans
+//	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix, and x is a vector.
+func (ColMajor) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
+	// declared at cblas.h:210:6 void cblas_dtrmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_dtrmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
+}
+
+// Dtbmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA == blas.NoTrans
+//	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular band matrix with k+1 diagonals, and x is a vector.
+func (ColMajor) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
+	// declared at cblas.h:214:6 void cblas_dtbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_dtbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
+}
+
+// Dtpmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA == blas.NoTrans
+//	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix in packed format, and x is a vector.
+func (ColMajor) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float64, incX int) {
+	// declared at cblas.h:218:6 void cblas_dtpmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_dtpmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_ap), (*C.double)(_x), C.CBLAS_INT(incX))
+}
+
+// Dtrsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix, and x and b are vectors.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
+	// declared at cblas.h:221:6 void cblas_dtrsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_dtrsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
+}
+
+// Dtbsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans or tA == blas.ConjTrans
+//
+// where A is an n×n triangular band matrix with k+1 diagonals,
+// and x and b are vectors.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
+	// declared at cblas.h:225:6 void cblas_dtbsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_dtbsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX))
+}
+
+// Dtpsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular matrix in packed format, and x and b are vectors.
+//
+// At entry to the function, x contains the values of b, and the result is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float64, incX int) {
+	// declared at cblas.h:229:6 void cblas_dtpsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_dtpsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(_ap), (*C.double)(_x), C.CBLAS_INT(incX))
+}
+
+// Cgemv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if trans = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if trans = blas.Trans
+//	y = alpha * Aᴴ * x + beta * y  if trans = blas.ConjTrans
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an m×n dense matrix.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	// declared at cblas.h:233:6 void cblas_cgemv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_cgemv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Cgbmv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if trans = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if trans = blas.Trans
+//	y = alpha * Aᴴ * x + beta * y  if trans = blas.ConjTrans
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an m×n band matrix
+// with kL sub-diagonals and kU super-diagonals.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	// declared at cblas.h:238:6 void cblas_cgbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if kL < 0 {
+		panic(kLLT0)
+	}
+	if kU < 0 {
+		panic(kULT0)
+	}
+	if lda < kL+kU+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(min(n, m+kU)-1)+kL+kU+1 {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_cgbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Ctrmv performs one of the matrix-vector operations
+//
+//	x = A * x   if trans = blas.NoTrans
+//	x = Aᵀ * x  if trans = blas.Trans
+//	x = Aᴴ * x  if trans = blas.ConjTrans
+//
+// where x is a vector, and A is an n×n triangular matrix.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex64, lda int, x []complex64, incX int) {
+	// declared at cblas.h:243:6 void cblas_ctrmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ctrmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ctbmv performs one of the matrix-vector operations
+//
+//	x = A * x   if trans = blas.NoTrans
+//	x = Aᵀ * x  if trans = blas.Trans
+//	x = Aᴴ * x  if trans = blas.ConjTrans
+//
+// where x is an n element vector and A is an n×n triangular band matrix, with
+// (k+1) diagonals.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex64, lda int, x []complex64, incX int) {
+	// declared at cblas.h:247:6 void cblas_ctbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ctbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ctpmv performs one of the matrix-vector operations
+//
+//	x = A * x   if trans = blas.NoTrans
+//	x = Aᵀ * x  if trans = blas.Trans
+//	x = Aᴴ * x  if trans = blas.ConjTrans
+//
+// where x is an n element vector and A is an n×n triangular matrix, supplied in
+// packed form.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex64, incX int) {
+	// declared at cblas.h:251:6 void cblas_ctpmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ctpmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ctrsv solves one of the systems of equations
+//
+//	A * x = b   if trans == blas.NoTrans
+//	Aᵀ * x = b  if trans == blas.Trans
+//	Aᴴ * x = b  if trans == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular matrix.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex64, lda int, x []complex64, incX int) {
+	// declared at cblas.h:254:6 void cblas_ctrsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ctrsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ctbsv solves one of the systems of equations
+//
+//	A * x = b   if trans == blas.NoTrans
+//	Aᵀ * x = b  if trans == blas.Trans
+//	Aᴴ * x = b  if trans == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular band matrix
+// with (k+1) diagonals.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex64, lda int, x []complex64, incX int) {
+	// declared at cblas.h:258:6 void cblas_ctbsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ctbsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ctpsv solves one of the systems of equations
+//
+//	A * x = b   if trans == blas.NoTrans
+//	Aᵀ * x = b  if trans == blas.Trans
+//	Aᴴ * x = b  if trans == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular matrix in
+// packed form.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex64, incX int) {
+	// declared at cblas.h:262:6 void cblas_ctpsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ctpsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Zgemv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if trans = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if trans = blas.Trans
+//	y = alpha * Aᴴ * x + beta * y  if trans = blas.ConjTrans
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an m×n dense matrix.
+func (ColMajor) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:266:6 void cblas_zgemv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zgemv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Zgbmv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if trans = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if trans = blas.Trans
+//	y = alpha * Aᴴ * x + beta * y  if trans = blas.ConjTrans
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an m×n band matrix
+// with kL sub-diagonals and kU super-diagonals.
+func (ColMajor) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:271:6 void cblas_zgbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if kL < 0 {
+		panic(kLLT0)
+	}
+	if kU < 0 {
+		panic(kULT0)
+	}
+	if lda < kL+kU+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(min(n, m+kU)-1)+kL+kU+1 {
+		panic(shortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(shortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zgbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(kL), C.CBLAS_INT(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Ztrmv performs one of the matrix-vector operations
+//
+//	x = A * x   if trans = blas.NoTrans
+//	x = Aᵀ * x  if trans = blas.Trans
+//	x = Aᴴ * x  if trans = blas.ConjTrans
+//
+// where x is a vector, and A is an n×n triangular matrix.
+func (ColMajor) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:276:6 void cblas_ztrmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztrmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ztbmv performs one of the matrix-vector operations
+//
+//	x = A * x   if trans = blas.NoTrans
+//	x = Aᵀ * x  if trans = blas.Trans
+//	x = Aᴴ * x  if trans = blas.ConjTrans
+//
+// where x is an n element vector and A is an n×n triangular band matrix, with
+// (k+1) diagonals.
+func (ColMajor) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:280:6 void cblas_ztbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ztpmv performs one of the matrix-vector operations
+//
+//	x = A * x   if trans = blas.NoTrans
+//	x = Aᵀ * x  if trans = blas.Trans
+//	x = Aᴴ * x  if trans = blas.ConjTrans
+//
+// where x is an n element vector and A is an n×n triangular matrix, supplied in
+// packed form.
+func (ColMajor) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex128, incX int) {
+	// declared at cblas.h:284:6 void cblas_ztpmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztpmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ztrsv solves one of the systems of equations
+//
+//	A * x = b   if trans == blas.NoTrans
+//	Aᵀ * x = b  if trans == blas.Trans
+//	Aᴴ * x = b  if trans == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular matrix.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:287:6 void cblas_ztrsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztrsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ztbsv solves one of the systems of equations
+//
+//	A * x = b   if trans == blas.NoTrans
+//	Aᵀ * x = b  if trans == blas.Trans
+//	Aᴴ * x = b  if trans == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular band matrix
+// with (k+1) diagonals.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:291:6 void cblas_ztbsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztbsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ztpsv solves one of the systems of equations
+//
+//	A * x = b   if trans == blas.NoTrans
+//	Aᵀ * x = b  if trans == blas.Trans
+//	Aᴴ * x = b  if trans == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular matrix in
+// packed form.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func (ColMajor) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex128, incX int) {
+	// declared at cblas.h:295:6 void cblas_ztpsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztpsv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX))
+}
+
+// Ssymv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where A is an n×n symmetric matrix, x and y are vectors, and alpha and
+// beta are scalars.
+func (ColMajor) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	// declared at cblas.h:303:6 void cblas_ssymv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_ssymv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
+}
+
+// Ssbmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where A is an n×n symmetric band matrix with k super-diagonals, x and y are
+// vectors, and alpha and beta are scalars.
+func (ColMajor) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	// declared at cblas.h:307:6 void cblas_ssbmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_ssbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
+}
+
+// Sspmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where A is an n×n symmetric matrix in packed format, x and y are vectors,
+// and alpha and beta are scalars.
+func (ColMajor) Sspmv(ul blas.Uplo, n int, alpha float32, ap, x []float32, incX int, beta float32, y []float32, incY int) {
+	// declared at cblas.h:311:6 void cblas_sspmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_sspmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_ap), (*C.float)(_x), C.CBLAS_INT(incX), C.float(beta), (*C.float)(_y), C.CBLAS_INT(incY))
+}
+
+// Sger performs the rank-one operation
+//
+//	A += alpha * x * yᵀ
+//
+// where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
+func (ColMajor) Sger(m, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
+	// declared at cblas.h:315:6 void cblas_sger ...
+
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_sger(C.CBLAS_LAYOUT(colMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(_a), C.CBLAS_INT(lda))
+}
+
+// Ssyr performs the symmetric rank-one update
+//
+//	A += alpha * x * xᵀ
+//
+// where A is an n×n symmetric matrix, and x is a vector.
+func (ColMajor) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, a []float32, lda int) {
+	// declared at cblas.h:318:6 void cblas_ssyr ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_ssyr(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_a), C.CBLAS_INT(lda))
+}
+
+// Sspr performs the symmetric rank-one operation
+//
+//	A += alpha * x * xᵀ
+//
+// where A is an n×n symmetric matrix in packed format, x is a vector, and
+// alpha is a scalar.
+func (ColMajor) Sspr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, ap []float32) {
+	// declared at cblas.h:321:6 void cblas_sspr ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	C.cblas_sspr(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_ap))
+}
+
+// Ssyr2 performs the symmetric rank-two update
+//
+//	A += alpha * x * yᵀ + alpha * y * xᵀ
+//
+// where A is an n×n symmetric matrix, x and y are vectors, and alpha is a scalar.
+func (ColMajor) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
+	// declared at cblas.h:324:6 void cblas_ssyr2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_ssyr2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(_a), C.CBLAS_INT(lda))
+}
+
+// Sspr2 performs the symmetric rank-2 update
+//
+//	A += alpha * x * yᵀ + alpha * y * xᵀ
+//
+// where A is an n×n symmetric matrix in packed format, x and y are vectors,
+// and alpha is a scalar.
+func (ColMajor) Sspr2(ul blas.Uplo, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32) {
+	// declared at cblas.h:328:6 void cblas_sspr2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < n*(n+1)/2 {
+		panic(shortA)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_sspr2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(_a))
+}
+
+// Dsymv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where A is an n×n symmetric matrix, x and y are vectors, and alpha and
+// beta are scalars.
+func (ColMajor) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	// declared at cblas.h:332:6 void cblas_dsymv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_dsymv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
+}
+
+// Dsbmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where A is an n×n symmetric band matrix with k super-diagonals, x and y are
+// vectors, and alpha and beta are scalars.
+func (ColMajor) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	// declared at cblas.h:336:6 void cblas_dsbmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_dsbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
+}
+
+// Dspmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where A is an n×n symmetric matrix in packed format, x and y are vectors,
+// and alpha and beta are scalars.
+func (ColMajor) Dspmv(ul blas.Uplo, n int, alpha float64, ap, x []float64, incX int, beta float64, y []float64, incY int) {
+	// declared at cblas.h:340:6 void cblas_dspmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_dspmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_ap), (*C.double)(_x), C.CBLAS_INT(incX), C.double(beta), (*C.double)(_y), C.CBLAS_INT(incY))
+}
+
+// Dger performs the rank-one operation
+//
+//	A += alpha * x * yᵀ
+//
+// where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
+func (ColMajor) Dger(m, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
+	// declared at cblas.h:344:6 void cblas_dger ...
+
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_dger(C.CBLAS_LAYOUT(colMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(_a), C.CBLAS_INT(lda))
+}
+
+// Dsyr performs the symmetric rank-one update
+//
+//	A += alpha * x * xᵀ
+//
+// where A is an n×n symmetric matrix, and x is a vector.
+func (ColMajor) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64, lda int) {
+	// declared at cblas.h:347:6 void cblas_dsyr ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_dsyr(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_a), C.CBLAS_INT(lda))
+}
+
+// Dspr performs the symmetric rank-one operation
+//
+//	A += alpha * x * xᵀ
+//
+// where A is an n×n symmetric matrix in packed format, x is a vector, and
+// alpha is a scalar.
+func (ColMajor) Dspr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, ap []float64) {
+	// declared at cblas.h:350:6 void cblas_dspr ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	C.cblas_dspr(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_ap))
+}
+
+// Dsyr2 performs the symmetric rank-two update
+//
+//	A += alpha * x * yᵀ + alpha * y * xᵀ
+//
+// where A is an n×n symmetric matrix, x and y are vectors, and alpha is a scalar.
+func (ColMajor) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
+	// declared at cblas.h:353:6 void cblas_dsyr2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_dsyr2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(_a), C.CBLAS_INT(lda))
+}
+
+// Dspr2 performs the symmetric rank-2 update
+//
+//	A += alpha * x * yᵀ + alpha * y * xᵀ
+//
+// where A is an n×n symmetric matrix in packed format, x and y are vectors,
+// and alpha is a scalar.
+func (ColMajor) Dspr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64) {
+	// declared at cblas.h:357:6 void cblas_dspr2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < n*(n+1)/2 {
+		panic(shortA)
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_dspr2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(_a))
+}
+
+// Chemv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian matrix. The imaginary parts of the diagonal elements of A are
+// ignored and assumed to be zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Chemv(ul blas.Uplo, n int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	// declared at cblas.h:365:6 void cblas_chemv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_chemv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Chbmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian band matrix with k super-diagonals. The imaginary parts of
+// the diagonal elements of A are ignored and assumed to be zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Chbmv(ul blas.Uplo, n, k int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	// declared at cblas.h:369:6 void cblas_chbmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_chbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Chpmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian matrix in packed form. The imaginary parts of the diagonal
+// elements of A are ignored and assumed to be zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Chpmv(ul blas.Uplo, n int, alpha complex64, ap, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	// declared at cblas.h:373:6 void cblas_chpmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_chpmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Cgeru performs the rank-one operation
+//
+//	A += alpha * x * yᵀ
+//
+// where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
+// and y is an n element vector.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cgeru(m, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
+	// declared at cblas.h:377:6 void cblas_cgeru ...
+
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_cgeru(C.CBLAS_LAYOUT(colMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Cgerc performs the rank-one operation
+//
+//	A += alpha * x * yᴴ
+//
+// where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
+// and y is an n element vector.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cgerc(m, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
+	// declared at cblas.h:380:6 void cblas_cgerc ...
+
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_cgerc(C.CBLAS_LAYOUT(colMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Cher performs the Hermitian rank-one operation
+//
+//	A += alpha * x * xᴴ
+//
+// where A is an n×n Hermitian matrix, alpha is a real scalar, and x is an n
+// element vector. On entry, the imaginary parts of the diagonal elements of A
+// are ignored and assumed to be zero, on return they will be set to zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cher(ul blas.Uplo, n int, alpha float32, x []complex64, incX int, a []complex64, lda int) {
+	// declared at cblas.h:383:6 void cblas_cher ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_cher(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Chpr performs the Hermitian rank-1 operation
+//
+//	A += alpha * x * xᴴ
+//
+// where alpha is a real scalar, x is a vector, and A is an n×n hermitian matrix
+// in packed form. On entry, the imaginary parts of the diagonal elements are
+// assumed to be zero, and on return they are set to zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Chpr(ul blas.Uplo, n int, alpha float32, x []complex64, incX int, a []complex64) {
+	// declared at cblas.h:386:6 void cblas_chpr ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < n*(n+1)/2 {
+		panic(shortA)
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_chpr(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.float(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a))
+}
+
+// Cher2 performs the Hermitian rank-two operation
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ
+//
+// where alpha is a scalar, x and y are n element vectors and A is an n×n
+// Hermitian matrix. On entry, the imaginary parts of the diagonal elements are
+// ignored and assumed to be zero. On return they will be set to zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cher2(ul blas.Uplo, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
+	// declared at cblas.h:389:6 void cblas_cher2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_cher2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Chpr2 performs the Hermitian rank-2 operation
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ
+//
+// where alpha is a complex scalar, x and y are n element vectors, and A is an
+// n×n Hermitian matrix, supplied in packed form. On entry, the imaginary parts
+// of the diagonal elements are assumed to be zero, and on return they are set to zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Chpr2(ul blas.Uplo, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, ap []complex64) {
+	// declared at cblas.h:392:6 void cblas_chpr2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	C.cblas_chpr2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_ap))
+}
+
+// Zhemv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian matrix. The imaginary parts of the diagonal elements of A are
+// ignored and assumed to be zero.
+func (ColMajor) Zhemv(ul blas.Uplo, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:396:6 void cblas_zhemv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zhemv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Zhbmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian band matrix with k super-diagonals. The imaginary parts of
+// the diagonal elements of A are ignored and assumed to be zero.
+func (ColMajor) Zhbmv(ul blas.Uplo, n, k int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:400:6 void cblas_zhbmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	if lda < k+1 {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(shortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zhbmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Zhpmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian matrix in packed form. The imaginary parts of the diagonal
+// elements of A are ignored and assumed to be zero.
+func (ColMajor) Zhpmv(ul blas.Uplo, n int, alpha complex128, ap, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:404:6 void cblas_zhpmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zhpmv(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.CBLAS_INT(incY))
+}
+
+// Zgeru performs the rank-one operation
+//
+//	A += alpha * x * yᵀ
+//
+// where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
+// and y is an n element vector.
+func (ColMajor) Zgeru(m, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
+	// declared at cblas.h:408:6 void cblas_zgeru ...
+
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zgeru(C.CBLAS_LAYOUT(colMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Zgerc performs the rank-one operation
+//
+//	A += alpha * x * yᴴ
+//
+// where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
+// and y is an n element vector.
+func (ColMajor) Zgerc(m, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
+	// declared at cblas.h:411:6 void cblas_zgerc ...
+
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, m) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+m {
+		panic(shortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zgerc(C.CBLAS_LAYOUT(colMajor), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Zher performs the Hermitian rank-one operation
+//
+//	A += alpha * x * xᴴ
+//
+// where A is an n×n Hermitian matrix, alpha is a real scalar, and x is an n
+// element vector. On entry, the imaginary parts of the diagonal elements of A
+// are ignored and assumed to be zero, on return they will be set to zero.
+func (ColMajor) Zher(ul blas.Uplo, n int, alpha float64, x []complex128, incX int, a []complex128, lda int) {
+	// declared at cblas.h:414:6 void cblas_zher ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zher(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Zhpr performs the Hermitian rank-1 operation
+//
+//	A += alpha * x * xᴴ
+//
+// where alpha is a real scalar, x is a vector, and A is an n×n hermitian matrix
+// in packed form. On entry, the imaginary parts of the diagonal elements are
+// assumed to be zero, and on return they are set to zero.
+func (ColMajor) Zhpr(ul blas.Uplo, n int, alpha float64, x []complex128, incX int, a []complex128) {
+	// declared at cblas.h:417:6 void cblas_zhpr ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if len(a) < n*(n+1)/2 {
+		panic(shortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zhpr(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), C.double(alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_a))
+}
+
+// Zher2 performs the Hermitian rank-two operation
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ
+//
+// where alpha is a scalar, x and y are n element vectors and A is an n×n
+// Hermitian matrix. On entry, the imaginary parts of the diagonal elements are
+// ignored and assumed to be zero. On return they will be set to zero.
+func (ColMajor) Zher2(ul blas.Uplo, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
+	// declared at cblas.h:420:6 void cblas_zher2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if lda < max(1, n) {
+		panic(badLdA)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(shortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zher2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_a), C.CBLAS_INT(lda))
+}
+
+// Zhpr2 performs the Hermitian rank-2 operation
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ
+//
+// where alpha is a complex scalar, x and y are n element vectors, and A is an
+// n×n Hermitian matrix, supplied in packed form. On entry, the imaginary parts
+// of the diagonal elements are assumed to be zero, and on return they are set to zero.
+func (ColMajor) Zhpr2(ul blas.Uplo, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, ap []complex128) {
+	// declared at cblas.h:423:6 void cblas_zhpr2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if incX == 0 {
+		panic(zeroIncX)
+	}
+	if incY == 0 {
+		panic(zeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(shortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(shortY)
+	}
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	C.cblas_zhpr2(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(_ap))
+}
+
+// Sgemm performs one of the matrix-matrix operations
+//
+//	C = alpha * A * B + beta * C
+//	C = alpha * Aᵀ * B + beta * C
+//	C = alpha * A * Bᵀ + beta * C
+//	C = alpha * Aᵀ * Bᵀ + beta * C
+//
+// where A is an m×k or k×m dense matrix, B is an n×k or k×n dense matrix, C is
+// an m×n matrix, and alpha and beta are scalars. tA and tB specify whether A or
+// B are transposed.
+func (ColMajor) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	// declared at cblas.h:436:6 void cblas_sgemm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch tB {
+	case blas.NoTrans:
+		tB = C.CblasNoTrans
+	case blas.Trans:
+		tB = C.CblasTrans
+	case blas.ConjTrans:
+		tB = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var rowA, colA, rowB, colB int
+	if tA == C.CblasNoTrans {
+		rowA, colA = m, k
+	} else {
+		rowA, colA = k, m
+	}
+	if tB == C.CblasNoTrans {
+		rowB, colB = k, n
+	} else {
+		rowB, colB = n, k
+	}
+	if lda < max(1, rowA) {
+		panic(badLdA)
+	}
+	if ldb < max(1, rowB) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(colA-1)+rowA {
+		panic(shortA)
+	}
+	if len(b) < ldb*(colB-1)+rowB {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_sgemm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
+}
+
+// Ssymm performs one of the matrix-matrix operations
+//
+//	C = alpha * A * B + beta * C  if side == blas.Left
+//	C = alpha * B * A + beta * C  if side == blas.Right
+//
+// where A is an n×n or m×m symmetric matrix, B and C are m×n matrices, and alpha
+// is a scalar.
+func (ColMajor) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	// declared at cblas.h:441:6 void cblas_ssymm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_ssymm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
+}
+
+// Ssyrk performs one of the symmetric rank-k operations
+//
+//	C = alpha * A * Aᵀ + beta * C  if tA == blas.NoTrans
+//	C = alpha * Aᵀ * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
+//
+// where A is an n×k or k×n matrix, C is an n×n symmetric matrix, and alpha and
+// beta are scalars.
+func (ColMajor) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
+	// declared at cblas.h:446:6 void cblas_ssyrk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_ssyrk(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
+}
+
+// Ssyr2k performs one of the symmetric rank 2k operations
+//
+//	C = alpha * A * Bᵀ + alpha * B * Aᵀ + beta * C  if tA == blas.NoTrans
+//	C = alpha * Aᵀ * B + alpha * Bᵀ * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
+//
+// where A and B are n×k or k×n matrices, C is an n×n symmetric matrix, and
+// alpha and beta are scalars.
+func (ColMajor) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	// declared at cblas.h:450:6 void cblas_ssyr2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldb < max(1, row) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(b) < ldb*(col-1)+row {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_ssyr2k(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb), C.float(beta), (*C.float)(_c), C.CBLAS_INT(ldc))
+}
+
+// Strmm performs one of the matrix-matrix operations
+//
+//	B = alpha * A * B   if tA == blas.NoTrans and side == blas.Left
+//	B = alpha * Aᵀ * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Left
+//	B = alpha * B * A   if tA == blas.NoTrans and side == blas.Right
+//	B = alpha * B * Aᵀ  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
+//
+// where A is an n×n or m×m triangular matrix, B is an m×n matrix, and alpha is a scalar.
+func (ColMajor) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+	// declared at cblas.h:455:6 void cblas_strmm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_strmm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb))
+}
+
+// Strsm solves one of the matrix equations
+//
+//	A * X = alpha * B   if tA == blas.NoTrans and side == blas.Left
+//	Aᵀ * X = alpha * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Left
+//	X * A = alpha * B   if tA == blas.NoTrans and side == blas.Right
+//	X * Aᵀ = alpha * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
+//
+// where A is an n×n or m×m triangular matrix, X and B are m×n matrices, and alpha is a
+// scalar.
+//
+// At entry to the function, X contains the values of B, and the result is
+// stored in-place into X.
+//
+// No check is made that A is invertible.
+func (ColMajor) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+	// declared at cblas.h:460:6 void cblas_strsm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_strsm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.float(alpha), (*C.float)(_a), C.CBLAS_INT(lda), (*C.float)(_b), C.CBLAS_INT(ldb))
+}
+
+// Dgemm performs one of the matrix-matrix operations
+//
+//	C = alpha * A * B + beta * C
+//	C = alpha * Aᵀ * B + beta * C
+//	C = alpha * A * Bᵀ + beta * C
+//	C = alpha * Aᵀ * Bᵀ + beta * C
+//
+// where A is an m×k or k×m dense matrix, B is an n×k or k×n dense matrix, C is
+// an m×n matrix, and alpha and beta are scalars. tA and tB specify whether A or
+// B are transposed.
+func (ColMajor) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	// declared at cblas.h:466:6 void cblas_dgemm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch tB {
+	case blas.NoTrans:
+		tB = C.CblasNoTrans
+	case blas.Trans:
+		tB = C.CblasTrans
+	case blas.ConjTrans:
+		tB = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var rowA, colA, rowB, colB int
+	if tA == C.CblasNoTrans {
+		rowA, colA = m, k
+	} else {
+		rowA, colA = k, m
+	}
+	if tB == C.CblasNoTrans {
+		rowB, colB = k, n
+	} else {
+		rowB, colB = n, k
+	}
+	if lda < max(1, rowA) {
+		panic(badLdA)
+	}
+	if ldb < max(1, rowB) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(colA-1)+rowA {
+		panic(shortA)
+	}
+	if len(b) < ldb*(colB-1)+rowB {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_dgemm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
+}
+
+// Dsymm performs one of the matrix-matrix operations
+//
+//	C = alpha * A * B + beta * C  if side == blas.Left
+//	C = alpha * B * A + beta * C  if side == blas.Right
+//
+// where A is an n×n or m×m symmetric matrix, B and C are m×n matrices, and alpha
+// is a scalar.
+func (ColMajor) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	// declared at cblas.h:471:6 void cblas_dsymm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_dsymm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
+}
+
+// Dsyrk performs one of the symmetric rank-k operations
+//
+//	C = alpha * A * Aᵀ + beta * C  if tA == blas.NoTrans
+//	C = alpha * Aᵀ * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
+//
+// where A is an n×k or k×n matrix, C is an n×n symmetric matrix, and alpha and
+// beta are scalars.
+func (ColMajor) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
+	// declared at cblas.h:476:6 void cblas_dsyrk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_dsyrk(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
+}
+
+// Dsyr2k performs one of the symmetric rank 2k operations
+//
+//	C = alpha * A * Bᵀ + alpha * B * Aᵀ + beta * C  if tA == blas.NoTrans
+//	C = alpha * Aᵀ * B + alpha * Bᵀ * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
+//
+// where A and B are n×k or k×n matrices, C is an n×n symmetric matrix, and
+// alpha and beta are scalars.
+func (ColMajor) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	// declared at cblas.h:480:6 void cblas_dsyr2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldb < max(1, row) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(b) < ldb*(col-1)+row {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_dsyr2k(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb), C.double(beta), (*C.double)(_c), C.CBLAS_INT(ldc))
+}
+
+// Dtrmm performs one of the matrix-matrix operations
+//
+//	B = alpha * A * B   if tA == blas.NoTrans and side == blas.Left
+//	B = alpha * Aᵀ * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Left
+//	B = alpha * B * A   if tA == blas.NoTrans and side == blas.Right
+//	B = alpha * B * Aᵀ  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
+//
+// where A is an n×n or m×m triangular matrix, B is an m×n matrix, and alpha is a scalar.
+func (ColMajor) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	// declared at cblas.h:485:6 void cblas_dtrmm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_dtrmm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb))
+}
+
+// Dtrsm solves one of the matrix equations
+//
+//	A * X = alpha * B   if tA == blas.NoTrans and side == blas.Left
+//	Aᵀ * X = alpha * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Left
+//	X * A = alpha * B   if tA == blas.NoTrans and side == blas.Right
+//	X * Aᵀ = alpha * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
+//
+// where A is an n×n or m×m triangular matrix, X and B are m×n matrices, and alpha is a
+// scalar.
+//
+// At entry to the function, X contains the values of B, and the result is
+// stored in-place into X.
+//
+// No check is made that A is invertible.
+func (ColMajor) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	// declared at cblas.h:490:6 void cblas_dtrsm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_dtrsm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), C.double(alpha), (*C.double)(_a), C.CBLAS_INT(lda), (*C.double)(_b), C.CBLAS_INT(ldb))
+}
+
+// Cgemm performs one of the matrix-matrix operations
+//
+//	C = alpha * op(A) * op(B) + beta * C
+//
+// where op(X) is one of
+//
+//	op(X) = X  or  op(X) = Xᵀ  or  op(X) = Xᴴ,
+//
+// alpha and beta are scalars, and A, B and C are matrices, with op(A) an m×k matrix,
+// op(B) a k×n matrix and C an m×n matrix.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	// declared at cblas.h:496:6 void cblas_cgemm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch tB {
+	case blas.NoTrans:
+		tB = C.CblasNoTrans
+	case blas.Trans:
+		tB = C.CblasTrans
+	case blas.ConjTrans:
+		tB = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var rowA, colA, rowB, colB int
+	if tA == C.CblasNoTrans {
+		rowA, colA = m, k
+	} else {
+		rowA, colA = k, m
+	}
+	if tB == C.CblasNoTrans {
+		rowB, colB = k, n
+	} else {
+		rowB, colB = n, k
+	}
+	if lda < max(1, rowA) {
+		panic(badLdA)
+	}
+	if ldb < max(1, rowB) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(colA-1)+rowA {
+		panic(shortA)
+	}
+	if len(b) < ldb*(colB-1)+rowB {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_cgemm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Csymm performs one of the matrix-matrix operations
+//
+//	C = alpha*A*B + beta*C  if side == blas.Left
+//	C = alpha*B*A + beta*C  if side == blas.Right
+//
+// where alpha and beta are scalars, A is an m×m or n×n symmetric matrix and B
+// and C are m×n matrices.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	// declared at cblas.h:501:6 void cblas_csymm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_csymm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Csyrk performs one of the symmetric rank-k operations
+//
+//	C = alpha*A*Aᵀ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᵀ*A + beta*C  if trans == blas.Trans
+//
+// where alpha and beta are scalars, C is an n×n symmetric matrix and A is
+// an n×k matrix in the first case and a k×n matrix in the second case.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, beta complex64, c []complex64, ldc int) {
+	// declared at cblas.h:506:6 void cblas_csyrk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_csyrk(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Csyr2k performs one of the symmetric rank-2k operations
+//
+//	C = alpha*A*Bᵀ + alpha*B*Aᵀ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᵀ*B + alpha*Bᵀ*A + beta*C  if trans == blas.Trans
+//
+// where alpha and beta are scalars, C is an n×n symmetric matrix and A and B
+// are n×k matrices in the first case and k×n matrices in the second case.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	// declared at cblas.h:510:6 void cblas_csyr2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldb < max(1, row) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(b) < ldb*(col-1)+row {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_csyr2k(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Ctrmm performs one of the matrix-matrix operations
+//
+//	B = alpha * op(A) * B  if side == blas.Left,
+//	B = alpha * B * op(A)  if side == blas.Right,
+//
+// where alpha is a scalar, B is an m×n matrix, A is a unit, or non-unit,
+// upper or lower triangular matrix and op(A) is one of
+//
+//	op(A) = A   if trans == blas.NoTrans,
+//	op(A) = Aᵀ  if trans == blas.Trans,
+//	op(A) = Aᴴ  if trans == blas.ConjTrans.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
+	// declared at cblas.h:515:6 void cblas_ctrmm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_ctrmm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
+}
+
+// Ctrsm solves one of the matrix equations
+//
+//	op(A) * X = alpha * B  if side == blas.Left,
+//	X * op(A) = alpha * B  if side == blas.Right,
+//
+// where alpha is a scalar, X and B are m×n matrices, A is a unit or
+// non-unit, upper or lower triangular matrix and op(A) is one of
+//
+//	op(A) = A   if transA == blas.NoTrans,
+//	op(A) = Aᵀ  if transA == blas.Trans,
+//	op(A) = Aᴴ  if transA == blas.ConjTrans.
+//
+// On return the matrix X is overwritten on B.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
+	// declared at cblas.h:520:6 void cblas_ctrsm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_ctrsm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
+}
+
+// Zgemm performs one of the matrix-matrix operations
+//
+//	C = alpha * op(A) * op(B) + beta * C
+//
+// where op(X) is one of
+//
+//	op(X) = X  or  op(X) = Xᵀ  or  op(X) = Xᴴ,
+//
+// alpha and beta are scalars, and A, B and C are matrices, with op(A) an m×k matrix,
+// op(B) a k×n matrix and C an m×n matrix.
+func (ColMajor) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:526:6 void cblas_zgemm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch tB {
+	case blas.NoTrans:
+		tB = C.CblasNoTrans
+	case blas.Trans:
+		tB = C.CblasTrans
+	case blas.ConjTrans:
+		tB = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var rowA, colA, rowB, colB int
+	if tA == C.CblasNoTrans {
+		rowA, colA = m, k
+	} else {
+		rowA, colA = k, m
+	}
+	if tB == C.CblasNoTrans {
+		rowB, colB = k, n
+	} else {
+		rowB, colB = n, k
+	}
+	if lda < max(1, rowA) {
+		panic(badLdA)
+	}
+	if ldb < max(1, rowB) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(colA-1)+rowA {
+		panic(shortA)
+	}
+	if len(b) < ldb*(colB-1)+rowB {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zgemm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Zsymm performs one of the matrix-matrix operations
+//
+//	C = alpha*A*B + beta*C  if side == blas.Left
+//	C = alpha*B*A + beta*C  if side == blas.Right
+//
+// where alpha and beta are scalars, A is an m×m or n×n symmetric matrix and B
+// and C are m×n matrices.
+func (ColMajor) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:531:6 void cblas_zsymm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zsymm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Zsyrk performs one of the symmetric rank-k operations
+//
+//	C = alpha*A*Aᵀ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᵀ*A + beta*C  if trans == blas.Trans
+//
+// where alpha and beta are scalars, C is an n×n symmetric matrix and A is
+// an n×k matrix in the first case and a k×n matrix in the second case.
+func (ColMajor) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:536:6 void cblas_zsyrk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zsyrk(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Zsyr2k performs one of the symmetric rank-2k operations
+//
+//	C = alpha*A*Bᵀ + alpha*B*Aᵀ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᵀ*B + alpha*Bᵀ*A + beta*C  if trans == blas.Trans
+//
+// where alpha and beta are scalars, C is an n×n symmetric matrix and A and B
+// are n×k matrices in the first case and k×n matrices in the second case.
+func (ColMajor) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:540:6 void cblas_zsyr2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldb < max(1, row) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(b) < ldb*(col-1)+row {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zsyr2k(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Ztrmm performs one of the matrix-matrix operations
+//
+//	B = alpha * op(A) * B  if side == blas.Left,
+//	B = alpha * B * op(A)  if side == blas.Right,
+//
+// where alpha is a scalar, B is an m×n matrix, A is a unit, or non-unit,
+// upper or lower triangular matrix and op(A) is one of
+//
+//	op(A) = A   if trans == blas.NoTrans,
+//	op(A) = Aᵀ  if trans == blas.Trans,
+//	op(A) = Aᴴ  if trans == blas.ConjTrans.
+func (ColMajor) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+	// declared at cblas.h:545:6 void cblas_ztrmm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_ztrmm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
+}
+
+// Ztrsm solves one of the matrix equations
+//
+//	op(A) * X = alpha * B  if side == blas.Left,
+//	X * op(A) = alpha * B  if side == blas.Right,
+//
+// where alpha is a scalar, X and B are m×n matrices, A is a unit or
+// non-unit, upper or lower triangular matrix and op(A) is one of
+//
+//	op(A) = A   if transA == blas.NoTrans,
+//	op(A) = Aᵀ  if transA == blas.Trans,
+//	op(A) = Aᴴ  if transA == blas.ConjTrans.
+//
+// On return the matrix X is overwritten on B.
+func (ColMajor) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+	// declared at cblas.h:550:6 void cblas_ztrsm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(badDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_ztrsm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb))
+}
+
+// Chemm performs one of the matrix-matrix operations
+//
+//	C = alpha*A*B + beta*C  if side == blas.Left
+//	C = alpha*B*A + beta*C  if side == blas.Right
+//
+// where alpha and beta are scalars, A is an m×m or n×n hermitian matrix and B
+// and C are m×n matrices. The imaginary parts of the diagonal elements of A are
+// assumed to be zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	// declared at cblas.h:560:6 void cblas_chemm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_chemm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Cherk performs one of the hermitian rank-k operations
+//
+//	C = alpha*A*Aᴴ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᴴ*A + beta*C  if trans == blas.ConjTrans
+//
+// where alpha and beta are real scalars, C is an n×n hermitian matrix and A is
+// an n×k matrix in the first case and a k×n matrix in the second case.
+//
+// The imaginary parts of the diagonal elements of C are assumed to be zero, and
+// on return they will be set to zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []complex64, lda int, beta float32, c []complex64, ldc int) {
+	// declared at cblas.h:565:6 void cblas_cherk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_cherk(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.float(alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), C.float(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Cher2k performs one of the hermitian rank-2k operations
+//
+//	C = alpha*A*Bᴴ + conj(alpha)*B*Aᴴ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᴴ*B + conj(alpha)*Bᴴ*A + beta*C  if trans == blas.ConjTrans
+//
+// where alpha and beta are scalars with beta real, C is an n×n hermitian matrix
+// and A and B are n×k matrices in the first case and k×n matrices in the second case.
+//
+// The imaginary parts of the diagonal elements of C are assumed to be zero, and
+// on return they will be set to zero.
+//
+// Complex64 implementations are autogenerated and not directly tested.
+func (ColMajor) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta float32, c []complex64, ldc int) {
+	// declared at cblas.h:569:6 void cblas_cher2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldb < max(1, row) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(b) < ldb*(col-1)+row {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_cher2k(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), C.float(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Zhemm performs one of the matrix-matrix operations
+//
+//	C = alpha*A*B + beta*C  if side == blas.Left
+//	C = alpha*B*A + beta*C  if side == blas.Right
+//
+// where alpha and beta are scalars, A is an m×m or n×n hermitian matrix and B
+// and C are m×n matrices. The imaginary parts of the diagonal elements of A are
+// assumed to be zero.
+func (ColMajor) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:575:6 void cblas_zhemm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(badSide)
+	}
+	if m < 0 {
+		panic(mLT0)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(badLdA)
+	}
+	if ldb < max(1, m) {
+		panic(badLdB)
+	}
+	if ldc < max(1, m) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(shortA)
+	}
+	if len(b) < ldb*(n-1)+m {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+m {
+		panic(shortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zhemm(C.CBLAS_LAYOUT(colMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_INT(m), C.CBLAS_INT(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Zherk performs one of the hermitian rank-k operations
+//
+//	C = alpha*A*Aᴴ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᴴ*A + beta*C  if trans == blas.ConjTrans
+//
+// where alpha and beta are real scalars, C is an n×n hermitian matrix and A is
+// an n×k matrix in the first case and a k×n matrix in the second case.
+//
+// The imaginary parts of the diagonal elements of C are assumed to be zero, and
+// on return they will be set to zero.
+func (ColMajor) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) {
+	// declared at cblas.h:580:6 void cblas_zherk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zherk(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), C.double(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
+
+// Zher2k performs one of the hermitian rank-2k operations
+//
+//	C = alpha*A*Bᴴ + conj(alpha)*B*Aᴴ + beta*C  if trans == blas.NoTrans
+//	C = alpha*Aᴴ*B + conj(alpha)*Bᴴ*A + beta*C  if trans == blas.ConjTrans
+//
+// where alpha and beta are scalars with beta real, C is an n×n hermitian matrix
+// and A and B are n×k matrices in the first case and k×n matrices in the second case.
+//
+// The imaginary parts of the diagonal elements of C are assumed to be zero, and
+// on return they will be set to zero.
+func (ColMajor) Zher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) {
+	// declared at cblas.h:584:6 void cblas_zher2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(badTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(badUplo)
+	}
+	if n < 0 {
+		panic(nLT0)
+	}
+	if k < 0 {
+		panic(kLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, row) {
+		panic(badLdA)
+	}
+	if ldb < max(1, row) {
+		panic(badLdB)
+	}
+	if ldc < max(1, n) {
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(col-1)+row {
+		panic(shortA)
+	}
+	if len(b) < ldb*(col-1)+row {
+		panic(shortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(shortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zher2k(C.CBLAS_LAYOUT(colMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.CBLAS_INT(n), C.CBLAS_INT(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.CBLAS_INT(lda), unsafe.Pointer(_b), C.CBLAS_INT(ldb), C.double(beta), unsafe.Pointer(_c), C.CBLAS_INT(ldc))
+}
@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/floats"
+)
+
+// A column-major matrix is the transpose of the row-major matrix with the
+// same elements, so the column-major routines are tested against the Gonum
+// row-major routines applied to the transposed problem.
+
+func randomNormal(n int, rnd *rand.Rand) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+func TestColMajorDgemm(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, k int }{
+		{1, 1, 1},
+		{3, 4, 5},
+		{5, 3, 4},
+		{7, 6, 2},
+	} {
+		m, n, k := test.m, test.n, test.k
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				rowA, colA := m, k
+				if tA != blas.NoTrans {
+					rowA, colA = k, m
+				}
+				rowB, colB := k, n
+				if tB != blas.NoTrans {
+					rowB, colB = n, k
+				}
+				lda, ldb, ldc := rowA+2, rowB+1, m+3
+				a := randomNormal(lda*colA, rnd)
+				b := randomNormal(ldb*colB, rnd)
+				c := randomNormal(ldc*n, rnd)
+				alpha, beta := 1.5, -0.5
+
+				// Cᵀ = alpha * op(B)ᵀ * op(A)ᵀ + beta * Cᵀ
+				want := make([]float64, len(c))
+				copy(want, c)
+				gonum.Implementation{}.Dgemm(tB, tA, n, m, k, alpha, b, ldb, a, lda, beta, want, ldc)
+
+				got := make([]float64, len(c))
+				copy(got, c)
+				ColMajor{}.Dgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, got, ldc)
+
+				if !floats.EqualApprox(got, want, tol) {
+					t.Errorf("unexpected result for m=%d,n=%d,k=%d,tA=%v,tB=%v", m, n, k, tA, tB)
+				}
+			}
+		}
+	}
+}
+
+func TestColMajorDgemv(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{1, 1},
+		{3, 4},
+		{5, 3},
+	} {
+		m, n := test.m, test.n
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			lenX, lenY := n, m
+			tT := blas.Trans
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+				tT = blas.NoTrans
+			}
+			lda := m + 2
+			a := randomNormal(lda*n, rnd)
+			x := randomNormal(lenX, rnd)
+			y := randomNormal(lenY, rnd)
+
+			want := make([]float64, len(y))
+			copy(want, y)
+			gonum.Implementation{}.Dgemv(tT, n, m, 2, a, lda, x, 1, 0.5, want, 1)
+
+			got := make([]float64, len(y))
+			copy(got, y)
+			ColMajor{}.Dgemv(tA, m, n, 2, a, lda, x, 1, 0.5, got, 1)
+
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("unexpected result for m=%d,n=%d,tA=%v", m, n, tA)
+			}
+		}
+	}
+}
+
+func TestColMajorDgbmv(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, kl, ku int }{
+		{4, 4, 1, 2},
+		{6, 4, 2, 1},
+		{4, 6, 0, 3},
+	} {
+		m, n, kl, ku := test.m, test.n, test.kl, test.ku
+		lda := kl + ku + 2
+		// The column-major band storage of A is the row-major band storage
+		// of Aᵀ, which has kl and ku swapped.
+		a := randomNormal(lda*n, rnd)
+		x := randomNormal(n, rnd)
+		y := randomNormal(m, rnd)
+
+		want := make([]float64, len(y))
+		copy(want, y)
+		gonum.Implementation{}.Dgbmv(blas.Trans, n, m, ku, kl, 1.5, a, lda, x, 1, -1, want, 1)
+
+		got := make([]float64, len(y))
+		copy(got, y)
+		ColMajor{}.Dgbmv(blas.NoTrans, m, n, kl, ku, 1.5, a, lda, x, 1, -1, got, 1)
+
+		if !floats.EqualApprox(got, want, tol) {
+			t.Errorf("unexpected result for m=%d,n=%d,kl=%d,ku=%d", m, n, kl, ku)
+		}
+	}
+}
+
+func TestColMajorDtrsm(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	m, n := 4, 3
+	for _, s := range []blas.Side{blas.Left, blas.Right} {
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			k := m
+			sT := blas.Right
+			if s == blas.Right {
+				k = n
+				sT = blas.Left
+			}
+			ulT := blas.Lower
+			if ul == blas.Lower {
+				ulT = blas.Upper
+			}
+			lda, ldb := k+1, m+2
+			a := randomNormal(lda*k, rnd)
+			for i := 0; i < k; i++ {
+				a[i+i*lda] += 4
+			}
+			b := randomNormal(ldb*n, rnd)
+
+			want := make([]float64, len(b))
+			copy(want, b)
+			gonum.Implementation{}.Dtrsm(sT, ulT, blas.NoTrans, blas.NonUnit, n, m, 2, a, lda, want, ldb)
+
+			got := make([]float64, len(b))
+			copy(got, b)
+			ColMajor{}.Dtrsm(s, ul, blas.NoTrans, blas.NonUnit, m, n, 2, a, lda, got, ldb)
+
+			name := fmt.Sprintf("side=%v,uplo=%v", s, ul)
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("unexpected result for %s", name)
+			}
+		}
+	}
+}
+
+func TestColMajorDsyrk(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	n, k := 4, 3
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			row, col := n, k
+			ulT, tT := blas.Lower, blas.Trans
+			if ul == blas.Lower {
+				ulT = blas.Upper
+			}
+			if tA != blas.NoTrans {
+				row, col = k, n
+				tT = blas.NoTrans
+			}
+			lda, ldc := row+1, n+2
+			a := randomNormal(lda*col, rnd)
+			c := randomNormal(ldc*n, rnd)
+
+			want := make([]float64, len(c))
+			copy(want, c)
+			gonum.Implementation{}.Dsyrk(ulT, tT, n, k, 0.5, a, lda, 2, want, ldc)
+
+			got := make([]float64, len(c))
+			copy(got, c)
+			ColMajor{}.Dsyrk(ul, tA, n, k, 0.5, a, lda, 2, got, ldc)
+
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("unexpected result for uplo=%v,tA=%v", ul, tA)
+			}
+		}
+	}
+}
+
+func TestColMajorDtpmv(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	n := 5
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			// The column-major packed storage of the upper triangle of A is
+			// the row-major packed storage of the lower triangle of Aᵀ.
+			ulT, tT := blas.Lower, blas.Trans
+			if ul == blas.Lower {
+				ulT = blas.Upper
+			}
+			if tA != blas.NoTrans {
+				tT = blas.NoTrans
+			}
+			ap := randomNormal(n*(n+1)/2, rnd)
+			x := randomNormal(n, rnd)
+
+			want := make([]float64, len(x))
+			copy(want, x)
+			gonum.Implementation{}.Dtpmv(ulT, tT, blas.NonUnit, n, ap, want, 1)
+
+			got := make([]float64, len(x))
+			copy(got, x)
+			ColMajor{}.Dtpmv(ul, tA, blas.NonUnit, n, ap, got, 1)
+
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("unexpected result for uplo=%v,tA=%v", ul, tA)
+			}
+		}
+	}
+}
+
+func TestColMajorPanics(t *testing.T) {
+	panics := func(f func()) (msg interface{}) {
+		defer func() { msg = recover() }()
+		f()
+		return nil
+	}
+	a := make([]float64, 12)
+	x := make([]float64, 4)
+	y := make([]float64, 4)
+	for _, test := range []struct {
+		name string
+		f    func()
+		want interface{}
+	}{
+		{
+			// A row-major 3×2 matrix may have lda == 2.
+			name: "Dgemv lda < m",
+			f:    func() { ColMajor{}.Dgemv(blas.NoTrans, 3, 2, 1, a, 2, x, 1, 0, y, 1) },
+			want: badLdA,
+		},
+		{
+			name: "Dgemv lda == m",
+			f:    func() { ColMajor{}.Dgemv(blas.NoTrans, 3, 4, 1, a, 3, x, 1, 0, y, 1) },
+			want: nil,
+		},
+		{
+			name: "Dgemm ldc < m",
+			f:    func() { ColMajor{}.Dgemm(blas.NoTrans, blas.NoTrans, 3, 2, 2, 1, a, 3, a, 2, 0, a, 2) },
+			want: badLdC,
+		},
+		{
+			name: "Dtrsm ldb < m",
+			f:    func() { ColMajor{}.Dtrsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, 3, 2, 1, a, 3, y, 2) },
+			want: badLdB,
+		},
+		{
+			name: "Dger short a",
+			f:    func() { ColMajor{}.Dger(3, 4, 1, x, 1, y, 1, a[:11], 3) },
+			want: shortA,
+		},
+	} {
+		got := panics(test.f)
+		if got != test.want {
+			t.Errorf("unexpected panic for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && dlopen
+
+#include <dlfcn.h>
+#include <stdlib.h>
+
+// Defined in cblas_dlopen.c.
+extern const int netlib_nsymbols;
+extern const char *const netlib_symbols[];
+extern void *netlib_functions[];
+
+// Defined in dlopen.go.
+extern void netlibNotLoaded(char *name);
+
+// netlib_handle is the handle of the loaded BLAS library, or NULL if no
+// library has been loaded.
+void *netlib_handle;
+
+void *netlib_dlsym(const char *name) {
+	return netlib_handle ? dlsym(netlib_handle, name) : NULL;
+}
+
+// netlib_symbol returns the name of the i-th CBLAS function.
+const char *netlib_symbol(int i) {
+	return netlib_symbols[i];
+}
+
+// netlib_set_library replaces the loaded library by handle and functions
+// and returns the handle of the previously loaded library.
+void *netlib_set_library(void *handle, void **functions) {
+	for (int i = 0; i < netlib_nsymbols; i++) {
+		netlib_functions[i] = functions[i];
+	}
+	void *old = netlib_handle;
+	netlib_handle = handle;
+	return old;
+}
+
+// netlib_function returns the address of the i-th CBLAS function of the
+// loaded library. A library is only loaded if it provides all the functions,
+// so netlib_function panics only if no library has been loaded.
+void *netlib_function(int i) {
+	void *f = netlib_functions[i];
+	if (f == NULL) {
+		netlibNotLoaded((char *)netlib_symbols[i]);
+	}
+	return f;
+}
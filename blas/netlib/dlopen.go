@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && dlopen
+
+package netlib
+
+/*
+#cgo CFLAGS: -DNETLIB_DLOPEN
+#cgo LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdlib.h>
+
+// Defined in cblas_dlopen.c and dlopen.c.
+extern const int netlib_nsymbols;
+extern const char *netlib_symbol(int i);
+extern void *netlib_set_library(void *handle, void **functions);
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unsafe"
+)
+
+// libraryEnv is the environment variable holding the path of the BLAS
+// library to load when the program starts.
+const libraryEnv = "NETLIB_BLAS_LIBRARY"
+
+// libraries are the BLAS libraries that are tried in order when libraryEnv
+// is not set.
+var libraries = []string{
+	"libopenblas.so.0",
+	"libopenblas.so",
+	"libmkl_rt.so.2",
+	"libmkl_rt.so",
+	"libblis.so.4",
+	"libcblas.so.3",
+	"libblas.so.3",
+	"libopenblas.dylib",
+}
+
+// loadErr is the error returned by Loaded.
+var loadErr = errors.New("netlib: no BLAS library loaded")
+
+func init() {
+	if path := os.Getenv(libraryEnv); path != "" {
+		err := Load(path)
+		if err != nil {
+			loadErr = errors.Join(loadErr, err)
+		}
+		return
+	}
+	errs := []error{loadErr}
+	for _, path := range libraries {
+		err := Load(path)
+		if err == nil {
+			return
+		}
+		errs = append(errs, err)
+	}
+	loadErr = errors.Join(errs...)
+}
+
+// Loaded returns nil if a BLAS library has been loaded. Otherwise it returns
+// an error holding the reasons the libraries tried when the program started
+// could not be loaded. BLAS calls panic with this error while no library is
+// loaded.
+func Loaded() error {
+	return loadErr
+}
+
+//export netlibNotLoaded
+func netlibNotLoaded(name *C.char) {
+	panic(fmt.Sprintf("netlib: %s called: %v", C.GoString(name), loadErr))
+}
+
+// Load loads the CBLAS library at path and uses it for all subsequent BLAS
+// calls. path is passed to dlopen, so a file name without a slash is searched
+// for in the library search path of the dynamic linker.
+//
+// Load returns an error if the library cannot be opened or if it does not
+// provide all the CBLAS functions used by the package. The error lists the
+// missing functions. In both cases the previously loaded library, if any,
+// remains in use. Otherwise the previously loaded library is closed.
+//
+// Load must not be called concurrently with any BLAS call.
+func Load(path string) error {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	handle := C.dlopen(cpath, C.RTLD_NOW|C.RTLD_LOCAL)
+	if handle == nil {
+		return fmt.Errorf("netlib: %s", C.GoString(C.dlerror()))
+	}
+
+	functions := make([]unsafe.Pointer, C.netlib_nsymbols)
+	var missing []string
+	for i := range functions {
+		name := C.netlib_symbol(C.int(i))
+		functions[i] = C.dlsym(handle, name)
+		if functions[i] == nil {
+			missing = append(missing, C.GoString(name))
+		}
+	}
+	if missing != nil {
+		C.dlclose(handle)
+		return fmt.Errorf("netlib: %s does not provide %s", path, strings.Join(missing, ", "))
+	}
+
+	old := C.netlib_set_library(handle, &functions[0])
+	if old != nil {
+		C.dlclose(old)
+	}
+	loadErr = nil
+	return nil
+}
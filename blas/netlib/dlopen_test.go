@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && dlopen
+
+package netlib
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestLoad(t *testing.T) {
+	err := Load("libnetlib-does-not-exist.so")
+	if err == nil {
+		t.Error("expected error loading missing library")
+	}
+
+	// The C library can be opened but does not provide CBLAS.
+	err = Load("libc.so.6")
+	if err == nil {
+		t.Fatal("expected error loading library without CBLAS functions")
+	}
+	if !strings.Contains(err.Error(), "cblas_dgemm") {
+		t.Errorf("missing function not reported: %v", err)
+	}
+
+	if Loaded() != nil {
+		return
+	}
+	// The library loaded at start up must still be in use.
+	a, b, c := []float64{2}, []float64{3}, []float64{1}
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, 1, 1, 1, 1, a, 1, b, 1, 1, c, 1)
+	if c[0] != 7 {
+		t.Errorf("unexpected result after failed load: got %v, want 7", c[0])
+	}
+}
+
+func TestNotLoaded(t *testing.T) {
+	err := Loaded()
+	if err == nil {
+		t.Skip("BLAS library loaded")
+	}
+	if os.Getenv(libraryEnv) != "" {
+		t.Skip("default libraries not tried")
+	}
+	if !strings.Contains(err.Error(), libraries[0]) {
+		t.Errorf("failed library not reported: %v", err)
+	}
+	defer func() {
+		r := recover()
+		msg, ok := r.(string)
+		if !ok || !strings.Contains(msg, "cblas_dgemm") || !strings.Contains(msg, err.Error()) {
+			t.Errorf("unexpected panic: %v", r)
+		}
+	}()
+	a, b, c := []float64{2}, []float64{3}, []float64{1}
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, 1, 1, 1, 1, a, 1, b, 1, 1, c, 1)
+	t.Error("expected panic calling Dgemm without a loaded library")
+}
+
+// badLibrary is the value of libraryEnv used by TestLibraryEnv.
+const badLibrary = "/nonexistent/libnetlib-bad.so"
+
+func TestLibraryEnv(t *testing.T) {
+	if os.Getenv(libraryEnv) != badLibrary {
+		// The library is loaded during package initialization, so check
+		// the failure in a new process of the test binary.
+		cmd := exec.Command(os.Args[0], "-test.run=^TestLibraryEnv$")
+		cmd.Env = append(os.Environ(), libraryEnv+"="+badLibrary)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Errorf("unexpected failure with %s=%s: %v\n%s", libraryEnv, badLibrary, err, out)
+		}
+		return
+	}
+
+	err := Loaded()
+	if err == nil || !strings.Contains(err.Error(), badLibrary) {
+		t.Fatalf("failed library not reported: %v", err)
+	}
+	defer func() {
+		r := recover()
+		msg, ok := r.(string)
+		if !ok || !strings.Contains(msg, badLibrary) {
+			t.Errorf("unexpected panic: %v", r)
+		}
+	}()
+	a, b, c := []float64{2}, []float64{3}, []float64{1}
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, 1, 1, 1, 1, a, 1, b, 1, 1, c, 1)
+	t.Error("expected panic calling Dgemm without a loaded library")
+}
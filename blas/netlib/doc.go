@@ -4,6 +4,7 @@
 
 //go:generate go run generate_blas.go
 //go:generate go run generate_errors.go
+//go:generate go run generate_dlopen.go
 
 /*
 Package netlib provides bindings to a C BLAS library. This wrapper interface
@@ -13,6 +14,42 @@ is not specified, and differs among the BLAS implementations.
 gonum.org/v1/gonum/blas/blas64 provides helpful wrapper functions to the BLAS
 interface. The rest of this text describes the layout of the data for the input types.
 
+The C BLAS library is linked when the program is built, usually by setting
+CGO_LDFLAGS. When the package is built with the dlopen build tag, the library
+is instead loaded when the program starts, so that the same binary can use
+different libraries. The library is given by the NETLIB_BLAS_LIBRARY
+environment variable, or else is the first of a list of common BLAS libraries,
+including OpenBLAS, Intel MKL and BLIS, that can be loaded. A library can also
+be loaded explicitly by calling Load. While no library is loaded, Loaded
+returns the reasons the libraries could not be loaded, and BLAS calls panic.
+
+By default the library is expected to use 32-bit integers. The ilp64 build
+tag selects the ILP64 interface with 64-bit integers, provided for example by
+MKL ILP64 and by OpenBLAS built with INTERFACE64=1, so that vector lengths and
+matrix dimensions are not limited to 2^31-1. Libraries that export their
+functions with the 64_ symbol suffix, such as libopenblas64_, additionally
+require the suffix64 build tag when they are linked. The ilp64 build tag is
+only supported on 64-bit platforms where the C long type has 64 bits.
+
+The matrices passed to Implementation are stored in row-major order as
+described below. Matrices in column-major order, such as those shared with
+Fortran code or with NumPy and Julia, can be passed to the Level 2 and Level 3
+routines of ColMajor without transposing them first.
+
+The package replaces the cblas_xerbla and xerbla_ error handlers of the
+linked library, so that an illegal argument that is detected by the library
+but not by the checks of the package causes a panic with the same message as
+the package checks, instead of a message printed by the library followed by
+the termination of the process. Illegal arguments reported by LAPACK routines
+in the same library panic with a message prefixed by "lapack: ". In
+particular, the methods of gonum.org/v1/netlib/lapack/lapacke.Checked do not
+return an error with a negative Info for these arguments when this package is
+linked into the program; only the arguments checked by LAPACKE itself are
+reported as an error. Such a panic leaks the memory allocated by LAPACKE for
+the row-major copies of the operands, so it should not be recovered from
+repeatedly. The error handlers are not replaced when the library is loaded
+with the dlopen build tag.
+
 Note that in the function documentation, x[i] refers to the i^th element
 of the vector, which will be different from the i^th element of the slice if
 incX != 1.
@@ -31,8 +31,12 @@ const (
 	srcModule     = "gonum.org/v1/gonum"
 	documentation = "blas/gonum"
 	target        = "blas.go"
+	colTarget     = "colmajor.go"
+	suffixTarget  = "suffix64.h"
+	xerblaTarget  = "xerblaparams.go"
 
-	typ = "Implementation"
+	typ    = "Implementation"
+	colTyp = "ColMajor"
 
 	prefix = "cblas_"
 
@@ -91,7 +95,18 @@ var cgoEnums = map[string]*template.Template{
 	"CBLAS_SIDE":      template.Must(template.New("side").Parse("C.CBLAS_SIDE({{.}})")),
 }
 
+// colCgoEnums is cgoEnums for the column-major methods of ColMajor.
+var colCgoEnums = func() map[string]*template.Template {
+	m := make(map[string]*template.Template, len(cgoEnums))
+	for k, v := range cgoEnums {
+		m[k] = v
+	}
+	m["CBLAS_LAYOUT"] = template.Must(template.New("layout").Parse("C.CBLAS_LAYOUT(colMajor)"))
+	return m
+}()
+
 var cgoTypes = map[binding.TypeKey]*template.Template{
+	{Kind: cc.Int}: template.Must(template.New("int").Parse("C.CBLAS_INT({{.}})")),
 	{Kind: cc.Float, IsPointer: true}: template.Must(template.New("float*").Parse(
 		`(*C.float)({{if eq . "alpha" "beta"}}&{{else}}_{{end}}{{.}})`,
 	)),
@@ -165,13 +180,13 @@ func main() {
 			buf.WriteByte('\n')
 		}
 		n++
-		goSignature(&buf, d, docs[typ])
+		goSignature(&buf, d, docs[typ], typ)
 		if noteOrigin {
 			fmt.Fprintf(&buf, "\t// declared at %s %s %s ...\n\n", d.Position(), d.Return, d.Name)
 		}
 		parameterChecks(&buf, d, parameterCheckRules)
 		buf.WriteByte('\t')
-		cgoCall(&buf, d)
+		cgoCall(&buf, d, cgoEnums)
 		buf.WriteString("}\n")
 	}
 
@@ -183,9 +198,146 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	buf.Reset()
+	buf.WriteString(colHeader)
+	n = 0
+	for _, d := range decls {
+		if !strings.HasPrefix(d.Name, prefix) || skip[d.Name] || !hasLayout(d) {
+			continue
+		}
+		if n != 0 && (separateFuncs || cribDocs) {
+			buf.WriteByte('\n')
+		}
+		n++
+		goSignature(&buf, d, docs[typ], colTyp)
+		if noteOrigin {
+			fmt.Fprintf(&buf, "\t// declared at %s %s %s ...\n\n", d.Position(), d.Return, d.Name)
+		}
+		parameterChecks(&buf, d, colMajorCheckRules)
+		buf.WriteByte('\t')
+		cgoCall(&buf, d, colCgoEnums)
+		buf.WriteString("}\n")
+	}
+	b, err = format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(colTarget, b, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf.Reset()
+	suffixHeader(&buf, decls)
+	err = ioutil.WriteFile(suffixTarget, buf.Bytes(), 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf.Reset()
+	xerblaTable(&buf, decls)
+	b, err = format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(xerblaTarget, b, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// xerblaTable writes the table of CBLAS parameter names that is used to
+// translate the parameter positions reported to cblas_xerbla and xerbla_ into
+// panic messages.
+func xerblaTable(buf *bytes.Buffer, decls []binding.Declaration) {
+	fmt.Fprintf(buf, `// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from %s; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+// xerblaParams holds the parameter names of the CBLAS functions keyed by the
+// function name without the cblas_ prefix.
+var xerblaParams = map[string][]string{
+`, header)
+	for _, d := range decls {
+		if !strings.HasPrefix(d.Name, prefix) || d.Name == "cblas_xerbla" {
+			continue
+		}
+		fmt.Fprintf(buf, "\t%q: {", strings.TrimPrefix(d.Name, prefix))
+		for i, p := range d.Parameters() {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(buf, "%q", p.Name())
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+}
+
+// suffixExtras are the library functions called by the package that are not
+// declared in cblas.h.
+var suffixExtras = []string{
+	"cblas_sgemm_batch",
+	"cblas_dgemm_batch",
+	"cblas_cgemm_batch",
+	"cblas_zgemm_batch",
+	"cblas_sgemm_batch_strided",
+	"cblas_dgemm_batch_strided",
+	"cblas_cgemm_batch_strided",
+	"cblas_zgemm_batch_strided",
+	"openblas_get_config",
+	"openblas_get_corename",
+	"openblas_set_num_threads",
+	"openblas_get_num_threads",
+	"ilaver_",
+}
+
+// suffixHeader writes a C header that renames the library functions to the
+// names exported by OpenBLAS builds with the 64_ symbol suffix.
+func suffixHeader(buf *bytes.Buffer, decls []binding.Declaration) {
+	fmt.Fprintf(buf, `// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from %s; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#ifndef NETLIB_SUFFIX64_H
+#define NETLIB_SUFFIX64_H
+
+// When NETLIB_SUFFIX64 is defined, the functions called by the package are
+// renamed to the names exported by ILP64 builds of OpenBLAS with the 64_
+// symbol suffix, such as libopenblas64_.
+#ifdef NETLIB_SUFFIX64
+`, header)
+	for _, d := range decls {
+		if !strings.HasPrefix(d.Name, prefix) || d.Name == "cblas_xerbla" {
+			continue
+		}
+		fmt.Fprintf(buf, "#define %[1]s %[1]s64_\n", d.Name)
+	}
+	for _, name := range suffixExtras {
+		fmt.Fprintf(buf, "#define %[1]s %[1]s64_\n", name)
+	}
+	buf.WriteString("#endif\n\n#endif // NETLIB_SUFFIX64_H\n")
+}
+
+// hasLayout returns whether the CBLAS function takes a matrix layout
+// parameter, which is the case for the Level 2 and Level 3 functions.
+func hasLayout(d binding.Declaration) bool {
+	for _, p := range d.Parameters() {
+		if p.Kind() == cc.Enum && binding.GoTypeForEnum(p.Type(), "", blasEnums) == "layout" {
+			return true
+		}
+	}
+	return false
 }
 
-func goSignature(buf *bytes.Buffer, d binding.Declaration, docs map[string][]*ast.Comment) {
+func goSignature(buf *bytes.Buffer, d binding.Declaration, docs map[string][]*ast.Comment, recv string) {
 	blasName := strings.TrimPrefix(d.Name, prefix)
 	goName := binding.UpperCaseFirst(blasName)
 
@@ -216,7 +368,7 @@ func goSignature(buf *bytes.Buffer, d binding.Declaration, docs map[string][]*as
 		}
 	}
 
-	fmt.Fprintf(buf, "func (%s) %s(", typ, goName)
+	fmt.Fprintf(buf, "func (%s) %s(", recv, goName)
 	c := 0
 	for i, p := range parameters {
 		if p.Kind() == cc.Enum && binding.GoTypeForEnum(p.Type(), "", blasEnums) == "layout" {
@@ -270,7 +422,7 @@ func parameterChecks(buf *bytes.Buffer, d binding.Declaration, rules []func(*byt
 	}
 }
 
-func cgoCall(buf *bytes.Buffer, d binding.Declaration) {
+func cgoCall(buf *bytes.Buffer, d binding.Declaration, enums map[string]*template.Template) {
 	if d.Return.Kind() != cc.Void {
 		goType, ok := cToGoType[d.Return.String()]
 		if !ok {
@@ -284,7 +436,7 @@ func cgoCall(buf *bytes.Buffer, d binding.Declaration) {
 			buf.WriteString(", ")
 		}
 		if p.Type().Kind() == cc.Enum {
-			buf.WriteString(binding.CgoConversionForEnum(shorten(binding.LowerCaseFirst(p.Name())), p.Type(), cgoEnums))
+			buf.WriteString(binding.CgoConversionForEnum(shorten(binding.LowerCaseFirst(p.Name())), p.Type(), enums))
 		} else {
 			buf.WriteString(binding.CgoConversionFor(shorten(binding.LowerCaseFirst(p.Name())), p.Type(), cgoTypes))
 		}
@@ -308,6 +460,22 @@ var parameterCheckRules = []func(*bytes.Buffer, binding.Declaration, binding.Par
 	address,
 }
 
+// colMajorCheckRules are the parameter checks of the methods of ColMajor.
+// They differ from parameterCheckRules only in the checks of the leading
+// dimensions and lengths of the general matrices.
+var colMajorCheckRules = []func(*bytes.Buffer, binding.Declaration, binding.Parameter){
+	trans,
+	uplo,
+	diag,
+	side,
+	shape,
+	colLeadingDim,
+	zeroInc,
+	noWork,
+	colSliceLength,
+	address,
+}
+
 func trans(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) {
 	switch n := shorten(binding.LowerCaseFirst(p.Name())); n {
 	case "t", "tA", "tB":
@@ -508,6 +676,151 @@ func leadingDim(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) {
 	}
 }
 
+// colLeadingDim is leadingDim for column-major matrices, where the leading
+// dimension of a general matrix must not be less than its number of rows.
+func colLeadingDim(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) {
+	pname := binding.LowerCaseFirst(p.Name())
+	if !strings.HasPrefix(pname, "ld") {
+		return
+	}
+
+	has := make(map[string]bool)
+	for _, p := range d.Parameters() {
+		has[shorten(binding.LowerCaseFirst(p.Name()))] = true
+	}
+
+	switch d.Name {
+	case "cblas_sgemm", "cblas_dgemm", "cblas_cgemm", "cblas_zgemm":
+		switch pname {
+		case "lda":
+			fmt.Fprint(buf, `	var rowA, colA, rowB, colB int
+	if tA == C.CblasNoTrans {
+		rowA, colA = m, k
+	} else {
+		rowA, colA = k, m
+	}
+	if tB == C.CblasNoTrans {
+		rowB, colB = k, n
+	} else {
+		rowB, colB = n, k
+	}
+	if lda < max(1, rowA) {
+		panic(badLdA)
+	}
+`)
+			return
+		case "ldb":
+			fmt.Fprint(buf, `	if ldb < max(1, rowB) {
+		panic(badLdB)
+	}
+`)
+			return
+		}
+
+	case "cblas_ssyrk", "cblas_dsyrk", "cblas_csyrk", "cblas_zsyrk",
+		"cblas_ssyr2k", "cblas_dsyr2k", "cblas_csyr2k", "cblas_zsyr2k",
+		"cblas_cherk", "cblas_zherk", "cblas_cher2k", "cblas_zher2k":
+		if pname == "ldc" {
+			break
+		}
+		if pname == "lda" {
+			fmt.Fprint(buf, `	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+`)
+		}
+		fmt.Fprintf(buf, `	if %s < max(1, row) {
+		panic(bad%s)
+	}
+`, pname, ldToPanicString(pname))
+		return
+	}
+
+	// Band and square matrices have the same leading dimension
+	// requirements in both layouts.
+	if !has["m"] || has["kL"] || (has["s"] && pname == "lda") {
+		leadingDim(buf, d, p)
+		return
+	}
+	fmt.Fprintf(buf, `	if %s < max(1, m) {
+		panic(bad%s)
+	}
+`, pname, ldToPanicString(pname))
+}
+
+// colSliceLength is sliceLength for column-major matrices.
+func colSliceLength(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) {
+	pname := shorten(binding.LowerCaseFirst(p.Name()))
+	switch pname {
+	case "a", "b", "c":
+	default:
+		sliceLength(buf, d, p)
+		return
+	}
+	if p.Type().Kind() != cc.Ptr {
+		// srot or drot
+		return
+	}
+
+	has := make(map[string]bool)
+	for _, p := range d.Parameters() {
+		has[shorten(binding.LowerCaseFirst(p.Name()))] = true
+	}
+
+	switch d.Name {
+	case "cblas_sgemm", "cblas_dgemm", "cblas_cgemm", "cblas_zgemm":
+		switch pname {
+		case "a":
+			// rowA and colA have already been declared in colLeadingDim.
+			fmt.Fprint(buf, `	if len(a) < lda*(colA-1)+rowA {
+		panic(shortA)
+	}
+`)
+			return
+		case "b":
+			fmt.Fprint(buf, `	if len(b) < ldb*(colB-1)+rowB {
+		panic(shortB)
+	}
+`)
+			return
+		}
+
+	case "cblas_ssyrk", "cblas_dsyrk", "cblas_csyrk", "cblas_zsyrk",
+		"cblas_ssyr2k", "cblas_dsyr2k", "cblas_csyr2k", "cblas_zsyr2k",
+		"cblas_cherk", "cblas_zherk", "cblas_cher2k", "cblas_zher2k":
+		if pname == "c" {
+			break
+		}
+		// row and col have already been declared in colLeadingDim.
+		fmt.Fprintf(buf, `	if len(%[1]s) < ld%[1]s*(col-1)+row {
+		panic(short%[2]s)
+	}
+`, pname, strings.ToUpper(pname))
+		return
+
+	case "cblas_sgbmv", "cblas_dgbmv", "cblas_cgbmv", "cblas_zgbmv":
+		if pname == "a" {
+			fmt.Fprint(buf, `	if len(a) < lda*(min(n, m+kU)-1)+kL+kU+1 {
+		panic(shortA)
+	}
+`)
+			return
+		}
+	}
+
+	if !has["m"] || has["kL"] || (has["s"] && pname == "a") {
+		sliceLength(buf, d, p)
+		return
+	}
+	fmt.Fprintf(buf, `	if len(%[1]s) < ld%[1]s*(n-1)+m {
+		panic(short%[2]s)
+	}
+`, pname, strings.ToUpper(pname))
+}
+
 func zeroInc(buf *bytes.Buffer, _ binding.Declaration, p binding.Parameter) {
 	switch n := binding.LowerCaseFirst(p.Name()); n {
 	case "incX":
@@ -854,6 +1167,47 @@ func pathTo(module, pkg string) string {
 	return filepath.Join(gopath, "pkg", "mod", version, pkg)
 }
 
+const colHeader = `// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from cblas.h; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#include "suffix64.h"
+#include "cblas.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+const colMajor layout = C.CblasColMajor
+
+// ColMajor provides the Level 2 and Level 3 BLAS routines of the C BLAS
+// library for matrices stored in column-major order, as used by Fortran and
+// by the 'F' order arrays of NumPy and Julia. The element (i, j) of a dense
+// matrix a with leading dimension lda is a[i+j*lda], and the leading
+// dimension must be at least the number of rows of the matrix. Band matrices
+// use the Fortran band storage where a[ku+i-j+j*lda] holds the element (i, j),
+// and packed matrices store the columns of the referenced triangle
+// consecutively.
+//
+// ColMajor does not implement the blas interfaces of Gonum, which assume
+// row-major storage. The Level 1 routines do not depend on the storage order
+// and are provided by Implementation. ColMajor is only available when the
+// package is built with cgo.
+type ColMajor struct{}
+
+// Generated cases ...
+
+`
+
 const handwritten = `// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from {{.}}; DO NOT EDIT.
 
 // Copyright ©2014 The Gonum Authors. All rights reserved.
@@ -864,6 +1218,7 @@ package netlib
 
 /*
 #cgo CFLAGS: -g -O2
+#include "suffix64.h"
 #include "{{.}}"
 */
 import "C"
@@ -963,7 +1318,7 @@ func (Implementation) Srotm(n int, x []float32, incX int, y []float32, incY int,
 		flag: float32(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_srotm(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(unsafe.Pointer(&pi)))
+	C.cblas_srotm(C.CBLAS_INT(n), (*C.float)(_x), C.CBLAS_INT(incX), (*C.float)(_y), C.CBLAS_INT(incY), (*C.float)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Drotg(a float64, b float64) (c float64, s float64, r float64, z float64) {
 	C.cblas_drotg((*C.double)(&a), (*C.double)(&b), (*C.double)(&c), (*C.double)(&s))
@@ -1012,7 +1367,7 @@ func (Implementation) Drotm(n int, x []float64, incX int, y []float64, incY int,
 		flag: float64(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_drotm(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(unsafe.Pointer(&pi)))
+	C.cblas_drotm(C.CBLAS_INT(n), (*C.double)(_x), C.CBLAS_INT(incX), (*C.double)(_y), C.CBLAS_INT(incY), (*C.double)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY int) (dotu complex64) {
 	if n < 0 {
@@ -1045,7 +1400,7 @@ func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	C.cblas_cdotu_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY int) (dotc complex64) {
@@ -1079,7 +1434,7 @@ func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	C.cblas_cdotc_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, incY int) (dotu complex128) {
@@ -1113,7 +1468,7 @@ func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	C.cblas_zdotu_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, incY int) (dotc complex128) {
@@ -1147,7 +1502,7 @@ func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	C.cblas_zdotc_sub(C.CBLAS_INT(n), unsafe.Pointer(_x), C.CBLAS_INT(incX), unsafe.Pointer(_y), C.CBLAS_INT(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// generate_dlopen creates a cblas_dlopen.c file from the provided C header
+// file. The generated file defines every CBLAS function used by the package
+// as a forwarder to the function found in the library loaded at run time.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+const (
+	header = "cblas.h"
+	target = "cblas_dlopen.c"
+)
+
+// skip lists the functions that are not called by the package.
+var skip = map[string]bool{
+	"cblas_xerbla": true,
+	"cblas_scabs1": true,
+	"cblas_dcabs1": true,
+}
+
+var (
+	comment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	proto   = regexp.MustCompile(`(?m)^([A-Za-z_][A-Za-z0-9_ ]*?)\s+(cblas_[a-z0-9_]+)\s*\(([^)]*)\);`)
+	ident   = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+type function struct {
+	Return string
+	Name   string
+	Params string
+	Args   string
+}
+
+func main() {
+	b, err := os.ReadFile(header)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src := comment.ReplaceAllString(string(b), "")
+
+	var funcs []function
+	for _, m := range proto.FindAllStringSubmatch(src, -1) {
+		name := m[2]
+		if skip[name] {
+			continue
+		}
+		params := strings.Fields(m[3])
+		var args []string
+		for _, p := range strings.Split(strings.Join(params, " "), ",") {
+			p = strings.TrimSpace(p)
+			if p == "..." {
+				log.Fatalf("variadic function %s", name)
+			}
+			args = append(args, ident.FindString(p))
+		}
+		funcs = append(funcs, function{
+			Return: strings.Join(strings.Fields(m[1]), " "),
+			Name:   name,
+			Params: strings.Join(params, " "),
+			Args:   strings.Join(args, ", "),
+		})
+	}
+
+	var buf bytes.Buffer
+	err = dlopen.Execute(&buf, funcs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(target, buf.Bytes(), 0o664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+var dlopen = template.Must(template.New("dlopen").Parse(fmt.Sprintf(`// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from %s; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && dlopen
+
+#include "cblas.h"
+#include "weak.h"
+
+const int netlib_nsymbols = {{len .}};
+
+const char *const netlib_symbols[{{len .}}] = {
+{{range .}}	"{{.Name}}",
+{{end}}};
+
+void *netlib_functions[{{len .}}];
+{{range $i, $f := .}}
+{{$f.Return}} {{$f.Name}}({{$f.Params}}) {
+	{{if ne $f.Return "void"}}return {{end}}((__typeof__(&{{$f.Name}}))netlib_function({{$i}}))({{$f.Args}});
+}
+{{end}}`, header)))
@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !cgo
+
+package netlib
+
+import (
+	"strconv"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/gonum"
+)
+
+// Type check assertions:
+var (
+	_ blas.Float32    = Implementation{}
+	_ blas.Float64    = Implementation{}
+	_ blas.Complex64  = Implementation{}
+	_ blas.Complex128 = Implementation{}
+)
+
+// Native reports whether the package calls a C BLAS library. It is false when
+// the package is built without cgo.
+const Native = false
+
+// Implementation is the pure Go implementation of the BLAS routines that is
+// used when the package is built without cgo. All the routines are provided
+// by gonum.org/v1/gonum/blas/gonum.
+type Implementation struct {
+	gonum.Implementation
+}
+
+// SetNumThreads does nothing and returns false when the package is built
+// without cgo.
+//
+// SetNumThreads will panic if n is less than one.
+func SetNumThreads(n int) (ok bool) {
+	if n < 1 {
+		panic("blas: n < 1")
+	}
+	return false
+}
+
+// NumThreads returns 1 when the package is built without cgo.
+func NumThreads() int {
+	return 1
+}
+
+// Backend returns a description of the BLAS implementation. When the package
+// is built without cgo, the implementation is always Gonum.
+func Backend() BackendInfo {
+	return BackendInfo{
+		Vendor:  Gonum,
+		IntSize: strconv.IntSize,
+	}
+}
+
+// SgemmBatch performs a batch of matrix-matrix operations by calling Sgemm
+// for each operation in the batch.
+func (impl Implementation) SgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha float32, a [][]float32, lda int, b [][]float32, ldb int, beta float32, c [][]float32, ldc int) {
+	gemmBatch(impl.Sgemm, tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// DgemmBatch performs a batch of matrix-matrix operations by calling Dgemm
+// for each operation in the batch.
+func (impl Implementation) DgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha float64, a [][]float64, lda int, b [][]float64, ldb int, beta float64, c [][]float64, ldc int) {
+	gemmBatch(impl.Dgemm, tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// CgemmBatch performs a batch of matrix-matrix operations by calling Cgemm
+// for each operation in the batch.
+func (impl Implementation) CgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha complex64, a [][]complex64, lda int, b [][]complex64, ldb int, beta complex64, c [][]complex64, ldc int) {
+	gemmBatch(impl.Cgemm, tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// ZgemmBatch performs a batch of matrix-matrix operations by calling Zgemm
+// for each operation in the batch.
+func (impl Implementation) ZgemmBatch(tA, tB blas.Transpose, m, n, k int, alpha complex128, a [][]complex128, lda int, b [][]complex128, ldb int, beta complex128, c [][]complex128, ldc int) {
+	gemmBatch(impl.Zgemm, tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// SgemmBatchStrided performs a strided batch of matrix-matrix operations by
+// calling Sgemm for each operation in the batch.
+func (impl Implementation) SgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda, strideA int, b []float32, ldb, strideB int, beta float32, c []float32, ldc, strideC int, count int) {
+	gemmBatchStrided(impl.Sgemm, tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, c, ldc, strideC, count)
+}
+
+// DgemmBatchStrided performs a strided batch of matrix-matrix operations by
+// calling Dgemm for each operation in the batch.
+func (impl Implementation) DgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda, strideA int, b []float64, ldb, strideB int, beta float64, c []float64, ldc, strideC int, count int) {
+	gemmBatchStrided(impl.Dgemm, tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, c, ldc, strideC, count)
+}
+
+// CgemmBatchStrided performs a strided batch of matrix-matrix operations by
+// calling Cgemm for each operation in the batch.
+func (impl Implementation) CgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda, strideA int, b []complex64, ldb, strideB int, beta complex64, c []complex64, ldc, strideC int, count int) {
+	gemmBatchStrided(impl.Cgemm, tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, c, ldc, strideC, count)
+}
+
+// ZgemmBatchStrided performs a strided batch of matrix-matrix operations by
+// calling Zgemm for each operation in the batch.
+func (impl Implementation) ZgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda, strideA int, b []complex128, ldb, strideB int, beta complex128, c []complex128, ldc, strideC int, count int) {
+	gemmBatchStrided(impl.Zgemm, tA, tB, m, n, k, alpha, a, lda, strideA, b, ldb, strideB, beta, c, ldc, strideC, count)
+}
+
+type gemmFunc[T any] func(tA, tB blas.Transpose, m, n, k int, alpha T, a []T, lda int, b []T, ldb int, beta T, c []T, ldc int)
+
+func gemmBatch[T any](gemm gemmFunc[T], tA, tB blas.Transpose, m, n, k int, alpha T, a [][]T, lda int, b [][]T, ldb int, beta T, c [][]T, ldc int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatch(m, n, a, rowA, colA, lda, b, rowB, colB, ldb, c, ldc) {
+		return
+	}
+	for i := range c {
+		gemm(tA, tB, m, n, k, alpha, a[i], lda, b[i], ldb, beta, c[i], ldc)
+	}
+}
+
+func gemmBatchStrided[T any](gemm gemmFunc[T], tA, tB blas.Transpose, m, n, k int, alpha T, a []T, lda, strideA int, b []T, ldb, strideB int, beta T, c []T, ldc, strideC int, count int) {
+	rowA, colA, rowB, colB := gemmShape(tA, tB, m, n, k, lda, ldb, ldc)
+	if !checkBatchStrided(m, n, count, rowA, colA, lda, strideA, len(a), rowB, colB, ldb, strideB, len(b), ldc, strideC, len(c)) {
+		return
+	}
+	for i := 0; i < count; i++ {
+		gemm(tA, tB, m, n, k, alpha, tail(a, i*strideA), lda, tail(b, i*strideB), ldb, beta, c[i*strideC:], ldc)
+	}
+}
+
+// tail returns x[i:], or an empty slice if i is beyond the end of x. The
+// matrices of a strided batch may be empty when k is zero.
+func tail[T any](x []T, i int) []T {
+	if i > len(x) {
+		return nil
+	}
+	return x[i:]
+}
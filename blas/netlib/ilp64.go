@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && ilp64
+
+package netlib
+
+// The ilp64 build tag selects the CBLAS interface with 64-bit integers
+// provided by ILP64 builds of OpenBLAS and by MKL ILP64. cblas.h defines
+// CBLAS_INT as long, so the tag is only supported on LP64 platforms.
+
+/*
+#cgo CFLAGS: -DWeirdNEC
+*/
+import "C"
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Vendor identifies the implementation of the linked BLAS library.
+type Vendor int
+
+const (
+	Generic Vendor = iota // Reference CBLAS or an unrecognized implementation.
+	OpenBLAS
+	MKL // Intel oneAPI Math Kernel Library.
+	BLIS
+	ATLAS
+	Gonum // gonum.org/v1/gonum/blas/gonum, used when the package is built without cgo.
+)
+
+func (v Vendor) String() string {
+	switch v {
+	case Generic:
+		return "Generic"
+	case OpenBLAS:
+		return "OpenBLAS"
+	case MKL:
+		return "MKL"
+	case BLIS:
+		return "BLIS"
+	case ATLAS:
+		return "ATLAS"
+	case Gonum:
+		return "Gonum"
+	}
+	return fmt.Sprintf("Vendor(%d)", int(v))
+}
+
+// LAPACKVersion is a LAPACK version number.
+type LAPACKVersion struct {
+	Major, Minor, Patch int
+}
+
+func (v LAPACKVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// BackendInfo describes the BLAS and LAPACK libraries that the program is
+// linked against.
+type BackendInfo struct {
+	// Vendor is the implementation of the BLAS library.
+	Vendor Vendor
+
+	// Version is the version string reported by the BLAS library.
+	// It is empty if the library does not report its version.
+	Version string
+
+	// Config is the build configuration reported by the BLAS library,
+	// for example the output of openblas_get_config for OpenBLAS.
+	Config string
+
+	// CoreName is the name of the processor core the BLAS library has
+	// selected its kernels for. It is only reported by OpenBLAS.
+	CoreName string
+
+	// IntSize is the size in bits of the integer type used by the BLAS
+	// library. If the library does not report it, IntSize is the size of
+	// the integer type used by the bindings.
+	IntSize int
+
+	// LAPACK is the version of LAPACK as reported by ilaver. It is the
+	// zero LAPACKVersion if no LAPACK library is linked.
+	LAPACK LAPACKVersion
+}
+
+func (b BackendInfo) String() string {
+	var buf strings.Builder
+	buf.WriteString(b.Vendor.String())
+	if b.Version != "" {
+		fmt.Fprintf(&buf, " %s", b.Version)
+	}
+	if b.CoreName != "" {
+		fmt.Fprintf(&buf, " (%s)", b.CoreName)
+	}
+	fmt.Fprintf(&buf, " int%d", b.IntSize)
+	if b.LAPACK != (LAPACKVersion{}) {
+		fmt.Fprintf(&buf, " LAPACK %s", b.LAPACK)
+	}
+	return buf.String()
+}
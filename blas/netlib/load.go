@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !cgo || !dlopen
+
+package netlib
+
+import "errors"
+
+// Load always returns an error, since the BLAS library is linked when the
+// program is built. Build with cgo and the dlopen tag to load the library
+// when the program starts or by calling Load.
+func Load(path string) error {
+	return errors.New("netlib: Load requires cgo and the dlopen build tag")
+}
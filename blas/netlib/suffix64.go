@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && ilp64 && suffix64 && !dlopen
+
+package netlib
+
+// The suffix64 build tag, used together with ilp64, links against libraries
+// that export their functions with the 64_ symbol suffix, such as the
+// libopenblas64_ builds of OpenBLAS. The renaming is done by suffix64.h.
+
+/*
+#cgo CFLAGS: -DNETLIB_SUFFIX64
+*/
+import "C"
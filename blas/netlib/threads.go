@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#include <stdint.h>
+#include "suffix64.h"
+#include "weak.h"
+
+// Thread control entry points of the optimized BLAS implementations. They are
+// declared weak so that the package links against any CBLAS implementation;
+// WEAK evaluates to NULL for a function that is not provided by the library.
+
+// OpenBLAS
+extern void openblas_set_num_threads(int) __attribute__((weak));
+extern int openblas_get_num_threads(void) __attribute__((weak));
+
+// Intel MKL
+extern void MKL_Set_Num_Threads(int) __attribute__((weak));
+extern int MKL_Get_Max_Threads(void) __attribute__((weak));
+
+// BLIS
+extern void bli_thread_set_num_threads(int64_t) __attribute__((weak));
+extern int64_t bli_thread_get_num_threads(void) __attribute__((weak));
+
+static int set_num_threads(int n) {
+	if (WEAK(openblas_set_num_threads)) {
+		WEAK(openblas_set_num_threads)(n);
+		return 1;
+	}
+	if (WEAK(MKL_Set_Num_Threads)) {
+		WEAK(MKL_Set_Num_Threads)(n);
+		return 1;
+	}
+	if (WEAK(bli_thread_set_num_threads)) {
+		WEAK(bli_thread_set_num_threads)(n);
+		return 1;
+	}
+	return 0;
+}
+
+static int get_num_threads(void) {
+	if (WEAK(openblas_get_num_threads)) {
+		return WEAK(openblas_get_num_threads)();
+	}
+	if (WEAK(MKL_Get_Max_Threads)) {
+		return WEAK(MKL_Get_Max_Threads)();
+	}
+	if (WEAK(bli_thread_get_num_threads)) {
+		return (int)WEAK(bli_thread_get_num_threads)();
+	}
+	return 1;
+}
+*/
+import "C"
+
+import "math"
+
+// SetNumThreads sets the number of threads used by the underlying BLAS
+// library for subsequent calls and reports whether the library supports
+// runtime thread control. OpenBLAS, Intel MKL and BLIS are supported. For any
+// other library, including the reference CBLAS, SetNumThreads does nothing
+// and returns false.
+//
+// SetNumThreads will panic if n is less than one.
+func SetNumThreads(n int) (ok bool) {
+	if n < 1 {
+		panic("blas: n < 1")
+	}
+	if n > math.MaxInt32 {
+		n = math.MaxInt32
+	}
+	return C.set_num_threads(C.int(n)) != 0
+}
+
+// NumThreads returns the number of threads that the underlying BLAS library
+// will use. If the library does not support runtime thread control,
+// NumThreads returns 1.
+func NumThreads() int {
+	return int(C.get_num_threads())
+}
@@ -0,0 +1,25 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && threadstub && !dlopen
+
+#include "suffix64.h"
+
+// The threadstub build tag replaces the OpenBLAS thread control functions
+// with stubs so that the detection of the weak symbols in threads.go can be
+// tested with any CBLAS library. The stubs take precedence over the functions
+// of a linked OpenBLAS.
+
+int netlib_stub_threads = 1;
+int netlib_stub_calls = 0;
+
+void openblas_set_num_threads(int n) {
+	netlib_stub_threads = n;
+	netlib_stub_calls++;
+}
+
+int openblas_get_num_threads(void) {
+	netlib_stub_calls++;
+	return netlib_stub_threads;
+}
@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && threadstub && !dlopen
+
+package netlib
+
+/*
+// Defined in threads_stub.c.
+extern int netlib_stub_threads;
+extern int netlib_stub_calls;
+*/
+import "C"
+
+// stubThreads returns the number of threads recorded by the OpenBLAS thread
+// control stubs and the number of calls made to them.
+func stubThreads() (threads, calls int) {
+	return int(C.netlib_stub_threads), int(C.netlib_stub_calls)
+}
@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && threadstub && !dlopen
+
+package netlib
+
+import "testing"
+
+func TestNumThreadsStub(t *testing.T) {
+	_, calls := stubThreads()
+	for _, n := range []int{3, 1, 7} {
+		if !SetNumThreads(n) {
+			t.Fatalf("SetNumThreads(%d) did not find the OpenBLAS stub", n)
+		}
+		threads, c := stubThreads()
+		if threads != n {
+			t.Errorf("unexpected number of threads in stub after SetNumThreads(%d): got %d", n, threads)
+		}
+		if c != calls+1 {
+			t.Errorf("unexpected number of stub calls after SetNumThreads(%d): got %d want %d", n, c, calls+1)
+		}
+		calls = c
+
+		if got := NumThreads(); got != n {
+			t.Errorf("unexpected number of threads after SetNumThreads(%d): got %d", n, got)
+		}
+		_, c = stubThreads()
+		if c != calls+1 {
+			t.Errorf("NumThreads did not call the OpenBLAS stub")
+		}
+		calls = c
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "testing"
+
+func TestNumThreads(t *testing.T) {
+	orig := NumThreads()
+	if orig < 1 {
+		t.Fatalf("unexpected initial number of threads: got %d, want at least 1", orig)
+	}
+	defer SetNumThreads(orig)
+
+	// A single thread is available with every library, and restoring
+	// the initial number of threads must also succeed.
+	for _, n := range []int{1, orig} {
+		if !SetNumThreads(n) {
+			if got := NumThreads(); got != 1 {
+				t.Errorf("unexpected number of threads without thread control: got %d, want 1", got)
+			}
+			t.Skip("BLAS library does not support runtime thread control")
+		}
+		if got := NumThreads(); got != n {
+			t.Errorf("unexpected number of threads after SetNumThreads(%d): got %d", n, got)
+		}
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for zero threads")
+			}
+		}()
+		SetNumThreads(0)
+	}()
+}
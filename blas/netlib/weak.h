@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#ifndef NETLIB_WEAK_H
+#define NETLIB_WEAK_H
+
+// WEAK(f) evaluates to the address of the optional library function f, or to
+// NULL if the BLAS library does not provide it. f must be declared with the
+// weak attribute. When the library is loaded at run time, f is looked up in
+// the loaded library instead of being resolved by the linker.
+#ifdef NETLIB_DLOPEN
+void *netlib_dlsym(const char *name);
+void *netlib_function(int i);
+#define WEAK(f) ((__typeof__(&f))netlib_dlsym(#f))
+#else
+#define WEAK(f) (&f)
+#endif
+
+#endif // NETLIB_WEAK_H
@@ -0,0 +1,33 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && !dlopen
+
+#include <stddef.h>
+#include <string.h>
+#include "cblas.h"
+#include "_cgo_export.h"
+
+// cblas_xerbla replaces the error handler of the CBLAS library, which prints
+// a message and may terminate the process when a function is called with an
+// illegal argument. p is the position of the illegal argument in the CBLAS
+// function call.
+void cblas_xerbla(CBLAS_INT p, const char *rout, const char *form, ...) {
+	netlibXerbla((char *)rout, strlen(rout), p, 1);
+}
+
+// xerbla_ replaces the error handler of the Fortran BLAS and LAPACK routines.
+// info is the position of the illegal argument in the Fortran routine call
+// and len is the length of the routine name, which is not NUL-terminated.
+void xerbla_(const char *srname, const CBLAS_INT *info, size_t len) {
+	netlibXerbla((char *)srname, len, *info, 0);
+}
+
+#ifdef NETLIB_SUFFIX64
+// ILP64 builds of OpenBLAS with the 64_ symbol suffix call the suffixed error
+// handler.
+void xerbla_64_(const char *srname, const CBLAS_INT *info, size_t len) {
+	netlibXerbla((char *)srname, len, *info, 0);
+}
+#endif
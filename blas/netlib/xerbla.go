@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && !dlopen
+
+package netlib
+
+/*
+#include "suffix64.h"
+#include "cblas.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// netlibXerbla is called by the replacements of cblas_xerbla and xerbla_ in
+// xerbla.c when the BLAS or LAPACK library detects an illegal argument that
+// was not caught by the checks of the package. It panics instead of letting
+// the library print a message and terminate the process. The C frames of the
+// library routine are discarded by the panic. BLAS and LAPACK routines check
+// their arguments before they allocate any resources, but the row-major
+// LAPACKE_*_work wrappers allocate transposed copies of their operands before
+// they call the LAPACK routine, so a panic from such a call leaks the copies.
+//
+// The replacements are not built with the dlopen build tag since the symbols
+// of the executable are not visible to a library loaded at run time.
+//
+//export netlibXerbla
+func netlibXerbla(rout *C.char, n, param, cblas C.int) {
+	panic(xerblaMessage(C.GoStringN(rout, n), int(param), cblas != 0))
+}
+
+// xerblaMessage returns the panic message for the illegal argument at the
+// one-based position param of the call to the routine rout. If cblas is true,
+// param is the position in the call to the CBLAS function rout, otherwise it
+// is the position in the call to the Fortran routine rout. Fortran positions
+// of parameters that are swapped in row-major calls give a message naming
+// the position instead of the parameter.
+func xerblaMessage(rout string, param int, cblas bool) string {
+	name := strings.ToLower(strings.TrimRight(rout, " \x00"))
+	name = strings.TrimPrefix(name, "cblas_")
+	params, ok := xerblaParams[name]
+	if !ok {
+		// The routine is not a BLAS routine called by the package, so it
+		// is most likely a LAPACK routine.
+		return fmt.Sprintf("lapack: illegal value of parameter %d in %s", param, name)
+	}
+	generic := fmt.Sprintf("blas: illegal value of parameter %d in %s", param, name)
+	layout := len(params) != 0 && params[0] == "layout"
+	if !cblas && layout {
+		// The Fortran routines do not have the layout parameter.
+		params = params[1:]
+	}
+	if param < 1 || len(params) < param {
+		return generic
+	}
+	p := strings.ToLower(params[param-1])
+	if !cblas && layout && hasParam(params, rowMajorSwaps[p]) {
+		// A row-major CBLAS call is implemented by a Fortran call with
+		// the operands swapped, so the Fortran position does not
+		// identify the argument of the row-major call.
+		return generic
+	}
+	switch p {
+	case "transa", "transb", "trans":
+		return badTranspose
+	case "uplo":
+		return badUplo
+	case "diag":
+		return badDiag
+	case "side":
+		return badSide
+	case "m":
+		return mLT0
+	case "n":
+		return nLT0
+	case "k":
+		return kLT0
+	case "kl":
+		return kLLT0
+	case "ku":
+		return kULT0
+	case "lda":
+		return badLdA
+	case "ldb":
+		return badLdB
+	case "ldc":
+		return badLdC
+	case "incx":
+		return zeroIncX
+	case "incy":
+		return zeroIncY
+	default:
+		return fmt.Sprintf("blas: illegal value of parameter %s in %s", p, name)
+	}
+}
+
+// rowMajorSwaps holds the pairs of parameters of the Level 2 and Level 3
+// routines that are exchanged when a row-major CBLAS call is implemented by
+// a call to the column-major Fortran routine.
+var rowMajorSwaps = map[string]string{
+	"m":    "n",
+	"n":    "m",
+	"kl":   "ku",
+	"ku":   "kl",
+	"lda":  "ldb",
+	"ldb":  "lda",
+	"incx": "incy",
+	"incy": "incx",
+}
+
+// hasParam returns whether params holds the parameter name, ignoring case.
+func hasParam(params []string, name string) bool {
+	for _, p := range params {
+		if strings.EqualFold(p, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// dtrsvUnchecked calls cblas_dtrsv without the argument checks of Dtrsv. It
+// is used to test the handling of errors detected by the BLAS library.
+func dtrsvUnchecked(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
+	C.cblas_dtrsv(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.CBLAS_INT(n), (*C.double)(&a[0]), C.CBLAS_INT(lda), (*C.double)(&x[0]), C.CBLAS_INT(incX))
+}
+
+// dgemmUnchecked calls cblas_dgemm without the argument checks of Dgemm. It
+// is used to test the handling of errors detected by the BLAS library.
+func dgemmUnchecked(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	C.cblas_dgemm(C.CBLAS_LAYOUT(rowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.CBLAS_INT(m), C.CBLAS_INT(n), C.CBLAS_INT(k), C.double(alpha), (*C.double)(&a[0]), C.CBLAS_INT(lda), (*C.double)(&b[0]), C.CBLAS_INT(ldb), C.double(beta), (*C.double)(&c[0]), C.CBLAS_INT(ldc))
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && !dlopen
+
+package netlib
+
+import (
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestXerblaMessage(t *testing.T) {
+	for _, test := range []struct {
+		rout  string
+		param int
+		cblas bool
+		want  string
+	}{
+		{rout: "cblas_dgemm", param: 9, cblas: true, want: badLdA},
+		{rout: "DGEMM ", param: 1, cblas: false, want: badTranspose},
+		{rout: "DGEMM ", param: 8, cblas: false, want: "blas: illegal value of parameter 8 in dgemm"},
+		{rout: "DGEMM ", param: 10, cblas: false, want: "blas: illegal value of parameter 10 in dgemm"},
+		{rout: "DGEMM ", param: 3, cblas: false, want: "blas: illegal value of parameter 3 in dgemm"},
+		{rout: "DGEMV ", param: 6, cblas: false, want: badLdA},
+		{rout: "DGER  ", param: 5, cblas: false, want: "blas: illegal value of parameter 5 in dger"},
+		{rout: "cblas_dger", param: 6, cblas: true, want: zeroIncX},
+		{rout: "cblas_dgemm", param: 2, cblas: true, want: badTranspose},
+		{rout: "cblas_dtrsv", param: 2, cblas: true, want: badUplo},
+		{rout: "DTRSV ", param: 1, cblas: false, want: badUplo},
+		{rout: "cblas_dtrsv", param: 4, cblas: true, want: badDiag},
+		{rout: "cblas_dtrsm", param: 2, cblas: true, want: badSide},
+		{rout: "cblas_dgbmv", param: 5, cblas: true, want: kLLT0},
+		{rout: "cblas_daxpy", param: 4, cblas: true, want: zeroIncX},
+		{rout: "DAXPY\x00", param: 6, cblas: false, want: zeroIncY},
+	} {
+		got := xerblaMessage(test.rout, test.param, test.cblas)
+		if got != test.want {
+			t.Errorf("unexpected message for parameter %d of %q: got:%q want:%q", test.param, test.rout, got, test.want)
+		}
+	}
+
+	got := xerblaMessage("DGETRF", 4, false)
+	if !strings.HasPrefix(got, "lapack: ") || !strings.Contains(got, "dgetrf") {
+		t.Errorf("unexpected message for LAPACK routine: %q", got)
+	}
+}
+
+func TestXerbla(t *testing.T) {
+	a := []float64{1, 0, 0, 1}
+	x := []float64{1, 1}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic for illegal uplo")
+		}
+		if r != badUplo {
+			t.Errorf("unexpected panic: got:%v want:%v", r, badUplo)
+		}
+	}()
+	// The uplo argument is not checked before calling the library, which
+	// reports it through cblas_xerbla or xerbla_.
+	dtrsvUnchecked(0, blas.NoTrans, blas.NonUnit, 2, a, 2, x, 1)
+}
+
+func TestXerblaRowMajor(t *testing.T) {
+	a := make([]float64, 4)
+	b := make([]float64, 4)
+	c := make([]float64, 4)
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic for illegal lda")
+		}
+		// The library reports either the position of lda in the CBLAS
+		// call or the position of the swapped operand in the Fortran
+		// call, which must not be reported as ldb.
+		if r != badLdA && r != "blas: illegal value of parameter 10 in dgemm" {
+			t.Errorf("unexpected panic: got:%v want:%v", r, badLdA)
+		}
+	}()
+	// lda is less than k for the row-major A.
+	dgemmUnchecked(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 1, b, 2, 0, c, 2)
+}
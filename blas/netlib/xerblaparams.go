@@ -0,0 +1,156 @@
+// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from cblas.h; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+// xerblaParams holds the parameter names of the CBLAS functions keyed by the
+// function name without the cblas_ prefix.
+var xerblaParams = map[string][]string{
+	"dcabs1":    {"z"},
+	"scabs1":    {"c"},
+	"sdsdot":    {"N", "alpha", "X", "incX", "Y", "incY"},
+	"dsdot":     {"N", "X", "incX", "Y", "incY"},
+	"sdot":      {"N", "X", "incX", "Y", "incY"},
+	"ddot":      {"N", "X", "incX", "Y", "incY"},
+	"cdotu_sub": {"N", "X", "incX", "Y", "incY", "dotu"},
+	"cdotc_sub": {"N", "X", "incX", "Y", "incY", "dotc"},
+	"zdotu_sub": {"N", "X", "incX", "Y", "incY", "dotu"},
+	"zdotc_sub": {"N", "X", "incX", "Y", "incY", "dotc"},
+	"snrm2":     {"N", "X", "incX"},
+	"sasum":     {"N", "X", "incX"},
+	"dnrm2":     {"N", "X", "incX"},
+	"dasum":     {"N", "X", "incX"},
+	"scnrm2":    {"N", "X", "incX"},
+	"scasum":    {"N", "X", "incX"},
+	"dznrm2":    {"N", "X", "incX"},
+	"dzasum":    {"N", "X", "incX"},
+	"isamax":    {"N", "X", "incX"},
+	"idamax":    {"N", "X", "incX"},
+	"icamax":    {"N", "X", "incX"},
+	"izamax":    {"N", "X", "incX"},
+	"sswap":     {"N", "X", "incX", "Y", "incY"},
+	"scopy":     {"N", "X", "incX", "Y", "incY"},
+	"saxpy":     {"N", "alpha", "X", "incX", "Y", "incY"},
+	"dswap":     {"N", "X", "incX", "Y", "incY"},
+	"dcopy":     {"N", "X", "incX", "Y", "incY"},
+	"daxpy":     {"N", "alpha", "X", "incX", "Y", "incY"},
+	"cswap":     {"N", "X", "incX", "Y", "incY"},
+	"ccopy":     {"N", "X", "incX", "Y", "incY"},
+	"caxpy":     {"N", "alpha", "X", "incX", "Y", "incY"},
+	"zswap":     {"N", "X", "incX", "Y", "incY"},
+	"zcopy":     {"N", "X", "incX", "Y", "incY"},
+	"zaxpy":     {"N", "alpha", "X", "incX", "Y", "incY"},
+	"srotg":     {"a", "b", "c", "s"},
+	"srotmg":    {"d1", "d2", "b1", "b2", "P"},
+	"srot":      {"N", "X", "incX", "Y", "incY", "c", "s"},
+	"srotm":     {"N", "X", "incX", "Y", "incY", "P"},
+	"drotg":     {"a", "b", "c", "s"},
+	"drotmg":    {"d1", "d2", "b1", "b2", "P"},
+	"drot":      {"N", "X", "incX", "Y", "incY", "c", "s"},
+	"drotm":     {"N", "X", "incX", "Y", "incY", "P"},
+	"sscal":     {"N", "alpha", "X", "incX"},
+	"dscal":     {"N", "alpha", "X", "incX"},
+	"cscal":     {"N", "alpha", "X", "incX"},
+	"zscal":     {"N", "alpha", "X", "incX"},
+	"csscal":    {"N", "alpha", "X", "incX"},
+	"zdscal":    {"N", "alpha", "X", "incX"},
+	"sgemv":     {"layout", "TransA", "M", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"sgbmv":     {"layout", "TransA", "M", "N", "KL", "KU", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"strmv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"stbmv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"stpmv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"strsv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"stbsv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"stpsv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"dgemv":     {"layout", "TransA", "M", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"dgbmv":     {"layout", "TransA", "M", "N", "KL", "KU", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"dtrmv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"dtbmv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"dtpmv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"dtrsv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"dtbsv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"dtpsv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"cgemv":     {"layout", "TransA", "M", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"cgbmv":     {"layout", "TransA", "M", "N", "KL", "KU", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"ctrmv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"ctbmv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"ctpmv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"ctrsv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"ctbsv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"ctpsv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"zgemv":     {"layout", "TransA", "M", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"zgbmv":     {"layout", "TransA", "M", "N", "KL", "KU", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"ztrmv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"ztbmv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"ztpmv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"ztrsv":     {"layout", "Uplo", "TransA", "Diag", "N", "A", "lda", "X", "incX"},
+	"ztbsv":     {"layout", "Uplo", "TransA", "Diag", "N", "K", "A", "lda", "X", "incX"},
+	"ztpsv":     {"layout", "Uplo", "TransA", "Diag", "N", "Ap", "X", "incX"},
+	"ssymv":     {"layout", "Uplo", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"ssbmv":     {"layout", "Uplo", "N", "K", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"sspmv":     {"layout", "Uplo", "N", "alpha", "Ap", "X", "incX", "beta", "Y", "incY"},
+	"sger":      {"layout", "M", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"ssyr":      {"layout", "Uplo", "N", "alpha", "X", "incX", "A", "lda"},
+	"sspr":      {"layout", "Uplo", "N", "alpha", "X", "incX", "Ap"},
+	"ssyr2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"sspr2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "A"},
+	"dsymv":     {"layout", "Uplo", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"dsbmv":     {"layout", "Uplo", "N", "K", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"dspmv":     {"layout", "Uplo", "N", "alpha", "Ap", "X", "incX", "beta", "Y", "incY"},
+	"dger":      {"layout", "M", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"dsyr":      {"layout", "Uplo", "N", "alpha", "X", "incX", "A", "lda"},
+	"dspr":      {"layout", "Uplo", "N", "alpha", "X", "incX", "Ap"},
+	"dsyr2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"dspr2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "A"},
+	"chemv":     {"layout", "Uplo", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"chbmv":     {"layout", "Uplo", "N", "K", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"chpmv":     {"layout", "Uplo", "N", "alpha", "Ap", "X", "incX", "beta", "Y", "incY"},
+	"cgeru":     {"layout", "M", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"cgerc":     {"layout", "M", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"cher":      {"layout", "Uplo", "N", "alpha", "X", "incX", "A", "lda"},
+	"chpr":      {"layout", "Uplo", "N", "alpha", "X", "incX", "A"},
+	"cher2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"chpr2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "Ap"},
+	"zhemv":     {"layout", "Uplo", "N", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"zhbmv":     {"layout", "Uplo", "N", "K", "alpha", "A", "lda", "X", "incX", "beta", "Y", "incY"},
+	"zhpmv":     {"layout", "Uplo", "N", "alpha", "Ap", "X", "incX", "beta", "Y", "incY"},
+	"zgeru":     {"layout", "M", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"zgerc":     {"layout", "M", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"zher":      {"layout", "Uplo", "N", "alpha", "X", "incX", "A", "lda"},
+	"zhpr":      {"layout", "Uplo", "N", "alpha", "X", "incX", "A"},
+	"zher2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "A", "lda"},
+	"zhpr2":     {"layout", "Uplo", "N", "alpha", "X", "incX", "Y", "incY", "Ap"},
+	"sgemm":     {"layout", "TransA", "TransB", "M", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"ssymm":     {"layout", "Side", "Uplo", "M", "N", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"ssyrk":     {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "beta", "C", "ldc"},
+	"ssyr2k":    {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"strmm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"strsm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"dgemm":     {"layout", "TransA", "TransB", "M", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"dsymm":     {"layout", "Side", "Uplo", "M", "N", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"dsyrk":     {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "beta", "C", "ldc"},
+	"dsyr2k":    {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"dtrmm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"dtrsm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"cgemm":     {"layout", "TransA", "TransB", "M", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"csymm":     {"layout", "Side", "Uplo", "M", "N", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"csyrk":     {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "beta", "C", "ldc"},
+	"csyr2k":    {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"ctrmm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"ctrsm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"zgemm":     {"layout", "TransA", "TransB", "M", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"zsymm":     {"layout", "Side", "Uplo", "M", "N", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"zsyrk":     {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "beta", "C", "ldc"},
+	"zsyr2k":    {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"ztrmm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"ztrsm":     {"layout", "Side", "Uplo", "TransA", "Diag", "M", "N", "alpha", "A", "lda", "B", "ldb"},
+	"chemm":     {"layout", "Side", "Uplo", "M", "N", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"cherk":     {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "beta", "C", "ldc"},
+	"cher2k":    {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"zhemm":     {"layout", "Side", "Uplo", "M", "N", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+	"zherk":     {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "beta", "C", "ldc"},
+	"zher2k":    {"layout", "Uplo", "Trans", "N", "K", "alpha", "A", "lda", "B", "ldb", "beta", "C", "ldc"},
+}
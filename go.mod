@@ -1,6 +1,6 @@
 module gonum.org/v1/netlib
 
-go 1.17
+go 1.21
 
 require (
 	golang.org/x/exp v0.0.0-20230321023759-10a507213a29
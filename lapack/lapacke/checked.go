@@ -0,0 +1,29367 @@
+// Code generated by "go generate gonum.org/v1/netlib/lapack/lapacke" from lapacke.h; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include "suffix64.h"
+#include "lapacke.h"
+#include "select.h"
+*/
+import "C"
+
+import "unsafe"
+
+// Checked provides the LAPACKE bindings that return the info value reported
+// by LAPACKE as an error instead of a success boolean. A non-nil error
+// returned by a Checked method is always of type *Error.
+type Checked struct{}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sbdsdc.f.
+func (Checked) Sbdsdc(ul, compq byte, n int, d, e, u []float32, ldu int, vt []float32, ldvt int, q []float32, iq []Int, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float32
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _iq *Int
+	if len(iq) > 0 {
+		_iq = &iq[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sbdsdc", C.LAPACKE_sbdsdc_work((C.int)(rowMajor), (C.char)(ul), (C.char)(compq), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_vt), (C.lapack_int)(ldvt), (*C.float)(_q), (*C.lapack_int)(_iq), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dbdsdc.f.
+func (Checked) Dbdsdc(ul, compq byte, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, q []float64, iq []Int, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _iq *Int
+	if len(iq) > 0 {
+		_iq = &iq[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dbdsdc", C.LAPACKE_dbdsdc_work((C.int)(rowMajor), (C.char)(ul), (C.char)(compq), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_vt), (C.lapack_int)(ldvt), (*C.double)(_q), (*C.lapack_int)(_iq), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sbdsvdx.f.
+func (Checked) Sbdsvdx(ul, jobz, rng byte, n int, d, e []float32, vl, vu, il, iu, ns int, s, z []float32, ldz int, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sbdsvdx", C.LAPACKE_sbdsvdx_work((C.int)(rowMajor), (C.char)(ul), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.lapack_int)(vl), (C.lapack_int)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.lapack_int)(ns), (*C.float)(_s), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dbdsvdx.f.
+func (Checked) Dbdsvdx(ul, jobz, rng byte, n int, d, e []float64, vl, vu, il, iu, ns int, s, z []float64, ldz int, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dbdsvdx", C.LAPACKE_dbdsvdx_work((C.int)(rowMajor), (C.char)(ul), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.lapack_int)(vl), (C.lapack_int)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.lapack_int)(ns), (*C.double)(_s), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sbdsqr.f.
+func (Checked) Sbdsqr(ul byte, n, ncvt, nru, ncc int, d, e, vt []float32, ldvt int, u []float32, ldu int, c []float32, ldc int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _vt *float32
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sbdsqr", C.LAPACKE_sbdsqr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ncvt), (C.lapack_int)(nru), (C.lapack_int)(ncc), (*C.float)(_d), (*C.float)(_e), (*C.float)(_vt), (C.lapack_int)(ldvt), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dbdsqr.f.
+func (Checked) Dbdsqr(ul byte, n, ncvt, nru, ncc int, d, e, vt []float64, ldvt int, u []float64, ldu int, c []float64, ldc int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _vt *float64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dbdsqr", C.LAPACKE_dbdsqr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ncvt), (C.lapack_int)(nru), (C.lapack_int)(ncc), (*C.double)(_d), (*C.double)(_e), (*C.double)(_vt), (C.lapack_int)(ldvt), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cbdsqr.f.
+func (Checked) Cbdsqr(ul byte, n, ncvt, nru, ncc int, d, e []float32, vt []complex64, ldvt int, u []complex64, ldu int, c []complex64, ldc int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _vt *complex64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cbdsqr", C.LAPACKE_cbdsqr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ncvt), (C.lapack_int)(nru), (C.lapack_int)(ncc), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zbdsqr.f.
+func (Checked) Zbdsqr(ul byte, n, ncvt, nru, ncc int, d, e []float64, vt []complex128, ldvt int, u []complex128, ldu int, c []complex128, ldc int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _vt *complex128
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zbdsqr", C.LAPACKE_zbdsqr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ncvt), (C.lapack_int)(nru), (C.lapack_int)(ncc), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sdisna.f.
+func (Checked) Sdisna(job byte, m, n int, d, sep []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _sep *float32
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	return newError("sdisna", C.LAPACKE_sdisna_work((C.char)(job), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_sep)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ddisna.f.
+func (Checked) Ddisna(job byte, m, n int, d, sep []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _sep *float64
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	return newError("ddisna", C.LAPACKE_ddisna_work((C.char)(job), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_sep)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbbrd.f.
+func (Checked) Sgbbrd(vect byte, m, n, ncc, kl, ku int, ab []float32, ldab int, d, e, q []float32, ldq int, pt []float32, ldpt int, c []float32, ldc int, work []float32) error {
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _pt *float32
+	if len(pt) > 0 {
+		_pt = &pt[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgbbrd", C.LAPACKE_sgbbrd_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ncc), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_d), (*C.float)(_e), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_pt), (C.lapack_int)(ldpt), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbbrd.f.
+func (Checked) Dgbbrd(vect byte, m, n, ncc, kl, ku int, ab []float64, ldab int, d, e, q []float64, ldq int, pt []float64, ldpt int, c []float64, ldc int, work []float64) error {
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _pt *float64
+	if len(pt) > 0 {
+		_pt = &pt[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgbbrd", C.LAPACKE_dgbbrd_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ncc), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_d), (*C.double)(_e), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_pt), (C.lapack_int)(ldpt), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbbrd.f.
+func (Checked) Cgbbrd(vect byte, m, n, ncc, kl, ku int, ab []complex64, ldab int, d, e []float32, q []complex64, ldq int, pt []complex64, ldpt int, c []complex64, ldc int, work []complex64, rwork []float32) error {
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _pt *complex64
+	if len(pt) > 0 {
+		_pt = &pt[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgbbrd", C.LAPACKE_cgbbrd_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ncc), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_pt), (C.lapack_int)(ldpt), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbbrd.f.
+func (Checked) Zgbbrd(vect byte, m, n, ncc, kl, ku int, ab []complex128, ldab int, d, e []float64, q []complex128, ldq int, pt []complex128, ldpt int, c []complex128, ldc int, work []complex128, rwork []float64) error {
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _pt *complex128
+	if len(pt) > 0 {
+		_pt = &pt[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgbbrd", C.LAPACKE_zgbbrd_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ncc), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_pt), (C.lapack_int)(ldpt), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbcon.f.
+func (Checked) Sgbcon(norm byte, n, kl, ku int, ab []float32, ldab int, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) error {
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgbcon", C.LAPACKE_sgbcon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbcon.f.
+func (Checked) Dgbcon(norm byte, n, kl, ku int, ab []float64, ldab int, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) error {
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgbcon", C.LAPACKE_dgbcon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbcon.f.
+func (Checked) Cgbcon(norm byte, n, kl, ku int, ab []complex64, ldab int, ipiv []Int, anorm float32, rcond []float32, work []complex64, rwork []float32) error {
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgbcon", C.LAPACKE_cgbcon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbcon.f.
+func (Checked) Zgbcon(norm byte, n, kl, ku int, ab []complex128, ldab int, ipiv []Int, anorm float64, rcond []float64, work []complex128, rwork []float64) error {
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgbcon", C.LAPACKE_zgbcon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbequ.f.
+func (Checked) Sgbequ(m, n, kl, ku int, ab []float32, ldab int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("sgbequ", C.LAPACKE_sgbequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbequ.f.
+func (Checked) Dgbequ(m, n, kl, ku int, ab []float64, ldab int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dgbequ", C.LAPACKE_dgbequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbequ.f.
+func (Checked) Cgbequ(m, n, kl, ku int, ab []complex64, ldab int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cgbequ", C.LAPACKE_cgbequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbequ.f.
+func (Checked) Zgbequ(m, n, kl, ku int, ab []complex128, ldab int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zgbequ", C.LAPACKE_zgbequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbequb.f.
+func (Checked) Sgbequb(m, n, kl, ku int, ab []float32, ldab int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("sgbequb", C.LAPACKE_sgbequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbequb.f.
+func (Checked) Dgbequb(m, n, kl, ku int, ab []float64, ldab int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dgbequb", C.LAPACKE_dgbequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbequb.f.
+func (Checked) Cgbequb(m, n, kl, ku int, ab []complex64, ldab int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cgbequb", C.LAPACKE_cgbequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbequb.f.
+func (Checked) Zgbequb(m, n, kl, ku int, ab []complex128, ldab int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zgbequb", C.LAPACKE_zgbequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbrfs.f.
+func (Checked) Sgbrfs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float32
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgbrfs", C.LAPACKE_sgbrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbrfs.f.
+func (Checked) Dgbrfs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgbrfs", C.LAPACKE_dgbrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbrfs.f.
+func (Checked) Cgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgbrfs", C.LAPACKE_cgbrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbrfs.f.
+func (Checked) Zgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex128
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgbrfs", C.LAPACKE_zgbrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbsv.f.
+func (Checked) Sgbsv(n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []Int, b []float32, ldb int) error {
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sgbsv", C.LAPACKE_sgbsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbsv.f.
+func (Checked) Dgbsv(n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []Int, b []float64, ldb int) error {
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dgbsv", C.LAPACKE_dgbsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbsv.f.
+func (Checked) Cgbsv(n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []Int, b []complex64, ldb int) error {
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cgbsv", C.LAPACKE_cgbsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbsv.f.
+func (Checked) Zgbsv(n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []Int, b []complex128, ldb int) error {
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zgbsv", C.LAPACKE_zgbsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbsvx.f.
+func (Checked) Sgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, ipiv []Int, equed []byte, r, c, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float32
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgbsvx", C.LAPACKE_sgbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_r), (*C.float)(_c), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbsvx.f.
+func (Checked) Dgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, ipiv []Int, equed []byte, r, c, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgbsvx", C.LAPACKE_dgbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_r), (*C.double)(_c), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbsvx.f.
+func (Checked) Cgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, ipiv []Int, equed []byte, r, c []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgbsvx", C.LAPACKE_cgbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_r), (*C.float)(_c), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbsvx.f.
+func (Checked) Zgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, ipiv []Int, equed []byte, r, c []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex128
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgbsvx", C.LAPACKE_zgbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_afb), (C.lapack_int)(ldafb), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_r), (*C.double)(_c), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbtrf.f.
+func (Checked) Sgbtrf(m, n, kl, ku int, ab []float32, ldab int, ipiv []Int) error {
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("sgbtrf", C.LAPACKE_sgbtrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbtrf.f.
+func (Checked) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []Int) error {
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("dgbtrf", C.LAPACKE_dgbtrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbtrf.f.
+func (Checked) Cgbtrf(m, n, kl, ku int, ab []complex64, ldab int, ipiv []Int) error {
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("cgbtrf", C.LAPACKE_cgbtrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbtrf.f.
+func (Checked) Zgbtrf(m, n, kl, ku int, ab []complex128, ldab int, ipiv []Int) error {
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zgbtrf", C.LAPACKE_zgbtrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbtrs.f.
+func (Checked) Sgbtrs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []Int, b []float32, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sgbtrs", C.LAPACKE_sgbtrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbtrs.f.
+func (Checked) Dgbtrs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []Int, b []float64, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dgbtrs", C.LAPACKE_dgbtrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbtrs.f.
+func (Checked) Cgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []Int, b []complex64, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cgbtrs", C.LAPACKE_cgbtrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbtrs.f.
+func (Checked) Zgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []Int, b []complex128, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zgbtrs", C.LAPACKE_zgbtrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgebak.f.
+func (Checked) Sgebak(job, side byte, n, ilo, ihi int, scale []float32, m int, v []float32, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("sgebak", C.LAPACKE_sgebak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_scale), (C.lapack_int)(m), (*C.float)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgebak.f.
+func (Checked) Dgebak(job, side byte, n, ilo, ihi int, scale []float64, m int, v []float64, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("dgebak", C.LAPACKE_dgebak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_scale), (C.lapack_int)(m), (*C.double)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgebak.f.
+func (Checked) Cgebak(job, side byte, n, ilo, ihi int, scale []float32, m int, v []complex64, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("cgebak", C.LAPACKE_cgebak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_scale), (C.lapack_int)(m), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgebak.f.
+func (Checked) Zgebak(job, side byte, n, ilo, ihi int, scale []float64, m int, v []complex128, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("zgebak", C.LAPACKE_zgebak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_scale), (C.lapack_int)(m), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgebal.f.
+func (Checked) Sgebal(job byte, n int, a []float32, lda int, ilo, ihi []Int, scale []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("sgebal", C.LAPACKE_sgebal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgebal.f.
+func (Checked) Dgebal(job byte, n int, a []float64, lda int, ilo, ihi []Int, scale []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("dgebal", C.LAPACKE_dgebal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgebal.f.
+func (Checked) Cgebal(job byte, n int, a []complex64, lda int, ilo, ihi []Int, scale []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("cgebal", C.LAPACKE_cgebal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgebal.f.
+func (Checked) Zgebal(job byte, n int, a []complex128, lda int, ilo, ihi []Int, scale []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("zgebal", C.LAPACKE_zgebal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgebrd.f.
+func (Checked) Sgebrd(m, n int, a []float32, lda int, d, e, tauq, taup, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tauq *float32
+	if len(tauq) > 0 {
+		_tauq = &tauq[0]
+	}
+	var _taup *float32
+	if len(taup) > 0 {
+		_taup = &taup[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgebrd", C.LAPACKE_sgebrd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_d), (*C.float)(_e), (*C.float)(_tauq), (*C.float)(_taup), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgebrd.f.
+func (Checked) Dgebrd(m, n int, a []float64, lda int, d, e, tauq, taup, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tauq *float64
+	if len(tauq) > 0 {
+		_tauq = &tauq[0]
+	}
+	var _taup *float64
+	if len(taup) > 0 {
+		_taup = &taup[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgebrd", C.LAPACKE_dgebrd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_d), (*C.double)(_e), (*C.double)(_tauq), (*C.double)(_taup), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgebrd.f.
+func (Checked) Cgebrd(m, n int, a []complex64, lda int, d, e []float32, tauq, taup, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tauq *complex64
+	if len(tauq) > 0 {
+		_tauq = &tauq[0]
+	}
+	var _taup *complex64
+	if len(taup) > 0 {
+		_taup = &taup[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgebrd", C.LAPACKE_cgebrd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_tauq), (*C.lapack_complex_float)(_taup), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgebrd.f.
+func (Checked) Zgebrd(m, n int, a []complex128, lda int, d, e []float64, tauq, taup, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tauq *complex128
+	if len(tauq) > 0 {
+		_tauq = &tauq[0]
+	}
+	var _taup *complex128
+	if len(taup) > 0 {
+		_taup = &taup[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgebrd", C.LAPACKE_zgebrd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_tauq), (*C.lapack_complex_double)(_taup), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgecon.f.
+func (Checked) Sgecon(norm byte, n int, a []float32, lda int, anorm float32, rcond, work []float32, iwork []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgecon", C.LAPACKE_sgecon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgecon.f.
+func (Checked) Dgecon(norm byte, n int, a []float64, lda int, anorm float64, rcond, work []float64, iwork []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgecon", C.LAPACKE_dgecon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgecon.f.
+func (Checked) Cgecon(norm byte, n int, a []complex64, lda int, anorm float32, rcond []float32, work []complex64, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgecon", C.LAPACKE_cgecon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgecon.f.
+func (Checked) Zgecon(norm byte, n int, a []complex128, lda int, anorm float64, rcond []float64, work []complex128, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgecon", C.LAPACKE_zgecon_work((C.int)(rowMajor), (C.char)(norm), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeequ.f.
+func (Checked) Sgeequ(m, n int, a []float32, lda int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("sgeequ", C.LAPACKE_sgeequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeequ.f.
+func (Checked) Dgeequ(m, n int, a []float64, lda int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dgeequ", C.LAPACKE_dgeequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeequ.f.
+func (Checked) Cgeequ(m, n int, a []complex64, lda int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cgeequ", C.LAPACKE_cgeequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeequ.f.
+func (Checked) Zgeequ(m, n int, a []complex128, lda int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zgeequ", C.LAPACKE_zgeequ_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeequb.f.
+func (Checked) Sgeequb(m, n int, a []float32, lda int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("sgeequb", C.LAPACKE_sgeequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeequb.f.
+func (Checked) Dgeequb(m, n int, a []float64, lda int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dgeequb", C.LAPACKE_dgeequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeequb.f.
+func (Checked) Cgeequb(m, n int, a []complex64, lda int, r, c, rowcnd, colcnd, amax []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float32
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float32
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cgeequb", C.LAPACKE_cgeequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_r), (*C.float)(_c), (*C.float)(_rowcnd), (*C.float)(_colcnd), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeequb.f.
+func (Checked) Zgeequb(m, n int, a []complex128, lda int, r, c, rowcnd, colcnd, amax []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _rowcnd *float64
+	if len(rowcnd) > 0 {
+		_rowcnd = &rowcnd[0]
+	}
+	var _colcnd *float64
+	if len(colcnd) > 0 {
+		_colcnd = &colcnd[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zgeequb", C.LAPACKE_zgeequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgees.f.
+func (Checked) Sgees(jobvs, sort byte, sel func(wr, wi float32) bool, n int, a []float32, lda int, sdim []Int, wr, wi, vs []float32, ldvs int, work []float32, lwork int, bwork []Int) error {
+	var _sel C.LAPACK_S_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_S_SELECT2(C.netlib_s_select2)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float32
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("sgees", C.LAPACKE_sgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vs), (C.lapack_int)(ldvs), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgees.f.
+func (Checked) Dgees(jobvs, sort byte, sel func(wr, wi float64) bool, n int, a []float64, lda int, sdim []Int, wr, wi, vs []float64, ldvs int, work []float64, lwork int, bwork []Int) error {
+	var _sel C.LAPACK_D_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_D_SELECT2(C.netlib_d_select2)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("dgees", C.LAPACKE_dgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vs), (C.lapack_int)(ldvs), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgees.f.
+func (Checked) Cgees(jobvs, sort byte, sel func(w complex64) bool, n int, a []complex64, lda int, sdim []Int, w, vs []complex64, ldvs int, work []complex64, lwork int, rwork []float32, bwork []Int) error {
+	var _sel C.LAPACK_C_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_C_SELECT1(C.netlib_c_select1)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("cgees", C.LAPACKE_cgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vs), (C.lapack_int)(ldvs), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgees.f.
+func (Checked) Zgees(jobvs, sort byte, sel func(w complex128) bool, n int, a []complex128, lda int, sdim []Int, w, vs []complex128, ldvs int, work []complex128, lwork int, rwork []float64, bwork []Int) error {
+	var _sel C.LAPACK_Z_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_Z_SELECT1(C.netlib_z_select1)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex128
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("zgees", C.LAPACKE_zgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vs), (C.lapack_int)(ldvs), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeesx.f.
+func (Checked) Sgeesx(jobvs, sort byte, sel func(wr, wi float32) bool, sense byte, n int, a []float32, lda int, sdim []Int, wr, wi, vs []float32, ldvs int, rconde, rcondv, work []float32, lwork int, iwork []Int, liwork int, bwork []Int) error {
+	var _sel C.LAPACK_S_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_S_SELECT2(C.netlib_s_select2)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float32
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("sgeesx", C.LAPACKE_sgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vs), (C.lapack_int)(ldvs), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeesx.f.
+func (Checked) Dgeesx(jobvs, sort byte, sel func(wr, wi float64) bool, sense byte, n int, a []float64, lda int, sdim []Int, wr, wi, vs []float64, ldvs int, rconde, rcondv, work []float64, lwork int, iwork []Int, liwork int, bwork []Int) error {
+	var _sel C.LAPACK_D_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_D_SELECT2(C.netlib_d_select2)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("dgeesx", C.LAPACKE_dgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vs), (C.lapack_int)(ldvs), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeesx.f.
+func (Checked) Cgeesx(jobvs, sort byte, sel func(w complex64) bool, sense byte, n int, a []complex64, lda int, sdim []Int, w, vs []complex64, ldvs int, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32, bwork []Int) error {
+	var _sel C.LAPACK_C_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_C_SELECT1(C.netlib_c_select1)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("cgeesx", C.LAPACKE_cgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vs), (C.lapack_int)(ldvs), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeesx.f.
+func (Checked) Zgeesx(jobvs, sort byte, sel func(w complex128) bool, sense byte, n int, a []complex128, lda int, sdim []Int, w, vs []complex128, ldvs int, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64, bwork []Int) error {
+	var _sel C.LAPACK_Z_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_Z_SELECT1(C.netlib_z_select1)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex128
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("zgeesx", C.LAPACKE_zgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vs), (C.lapack_int)(ldvs), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeev.f.
+func (Checked) Sgeev(jobvl, jobvr byte, n int, a []float32, lda int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgeev", C.LAPACKE_sgeev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeev.f.
+func (Checked) Dgeev(jobvl, jobvr byte, n int, a []float64, lda int, wr, wi, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgeev", C.LAPACKE_dgeev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeev.f.
+func (Checked) Cgeev(jobvl, jobvr byte, n int, a []complex64, lda int, w, vl []complex64, ldvl int, vr []complex64, ldvr int, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgeev", C.LAPACKE_cgeev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeev.f.
+func (Checked) Zgeev(jobvl, jobvr byte, n int, a []complex128, lda int, w, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgeev", C.LAPACKE_zgeev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeevx.f.
+func (Checked) Sgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv, work []float32, lwork int, iwork []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _abnrm *float32
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgeevx", C.LAPACKE_sgeevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_scale), (*C.float)(_abnrm), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeevx.f.
+func (Checked) Dgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, wr, wi, vl []float64, ldvl int, vr []float64, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv, work []float64, lwork int, iwork []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _abnrm *float64
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgeevx", C.LAPACKE_dgeevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_scale), (*C.double)(_abnrm), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeevx.f.
+func (Checked) Cgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, w, vl []complex64, ldvl int, vr []complex64, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _abnrm *float32
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgeevx", C.LAPACKE_cgeevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_scale), (*C.float)(_abnrm), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeevx.f.
+func (Checked) Zgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, w, vl []complex128, ldvl int, vr []complex128, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _abnrm *float64
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgeevx", C.LAPACKE_zgeevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_scale), (*C.double)(_abnrm), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgehrd.f.
+func (Checked) Sgehrd(n, ilo, ihi int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgehrd", C.LAPACKE_sgehrd_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgehrd.f.
+func (Checked) Dgehrd(n, ilo, ihi int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgehrd", C.LAPACKE_dgehrd_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgehrd.f.
+func (Checked) Cgehrd(n, ilo, ihi int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgehrd", C.LAPACKE_cgehrd_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgehrd.f.
+func (Checked) Zgehrd(n, ilo, ihi int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgehrd", C.LAPACKE_zgehrd_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgejsv.f.
+func (Checked) Sgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float32, lda int, sva, u []float32, ldu int, v []float32, ldv int, work []float32, lwork int, iwork []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float32
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgejsv", C.LAPACKE_sgejsv_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.char)(jobr), (C.char)(jobt), (C.char)(jobp), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_sva), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgejsv.f.
+func (Checked) Dgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float64, lda int, sva, u []float64, ldu int, v []float64, ldv int, work []float64, lwork int, iwork []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float64
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgejsv", C.LAPACKE_dgejsv_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.char)(jobr), (C.char)(jobt), (C.char)(jobp), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_sva), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgejsv.f.
+func (Checked) Cgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex64, lda int, sva []float32, u []complex64, ldu int, v []complex64, ldv int, cwork []complex64, lwork int, work []float32, lrwork int, iwork []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float32
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _cwork *complex64
+	if len(cwork) > 0 {
+		_cwork = &cwork[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cgejsv", C.LAPACKE_cgejsv_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.char)(jobr), (C.char)(jobt), (C.char)(jobp), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_sva), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_cwork), (C.lapack_int)(lwork), (*C.float)(_work), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgejsv.f.
+func (Checked) Zgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex128, lda int, sva []float64, u []complex128, ldu int, v []complex128, ldv int, cwork []complex128, lwork int, work []float64, lrwork int, iwork []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float64
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _cwork *complex128
+	if len(cwork) > 0 {
+		_cwork = &cwork[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zgejsv", C.LAPACKE_zgejsv_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.char)(jobr), (C.char)(jobt), (C.char)(jobp), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_sva), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_cwork), (C.lapack_int)(lwork), (*C.double)(_work), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelq2.f.
+func (Checked) Sgelq2(m, n int, a []float32, lda int, tau, work []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgelq2", C.LAPACKE_sgelq2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelq2.f.
+func (Checked) Dgelq2(m, n int, a []float64, lda int, tau, work []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgelq2", C.LAPACKE_dgelq2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelq2.f.
+func (Checked) Cgelq2(m, n int, a []complex64, lda int, tau, work []complex64) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgelq2", C.LAPACKE_cgelq2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelq2.f.
+func (Checked) Zgelq2(m, n int, a []complex128, lda int, tau, work []complex128) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgelq2", C.LAPACKE_zgelq2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelqf.f.
+func (Checked) Sgelqf(m, n int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgelqf", C.LAPACKE_sgelqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelqf.f.
+func (Checked) Dgelqf(m, n int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgelqf", C.LAPACKE_dgelqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelqf.f.
+func (Checked) Cgelqf(m, n int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgelqf", C.LAPACKE_cgelqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelqf.f.
+func (Checked) Zgelqf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgelqf", C.LAPACKE_zgelqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgels.f.
+func (Checked) Sgels(trans byte, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgels", C.LAPACKE_sgels_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgels.f.
+func (Checked) Dgels(trans byte, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgels", C.LAPACKE_dgels_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgels.f.
+func (Checked) Cgels(trans byte, m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, work []complex64, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgels", C.LAPACKE_cgels_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgels.f.
+func (Checked) Zgels(trans byte, m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, work []complex128, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgels", C.LAPACKE_zgels_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelsd.f.
+func (Checked) Sgelsd(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []float32, rcond float32, rank []Int, work []float32, lwork int, iwork []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgelsd", C.LAPACKE_sgelsd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_s), (C.float)(rcond), (*C.lapack_int)(_rank), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelsd.f.
+func (Checked) Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, rank []Int, work []float64, lwork int, iwork []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgelsd", C.LAPACKE_dgelsd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_s), (C.double)(rcond), (*C.lapack_int)(_rank), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelsd.f.
+func (Checked) Cgelsd(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []float32, rcond float32, rank []Int, work []complex64, lwork int, rwork []float32, iwork []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cgelsd", C.LAPACKE_cgelsd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.float)(_s), (C.float)(rcond), (*C.lapack_int)(_rank), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelsd.f.
+func (Checked) Zgelsd(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s []float64, rcond float64, rank []Int, work []complex128, lwork int, rwork []float64, iwork []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zgelsd", C.LAPACKE_zgelsd_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.double)(_s), (C.double)(rcond), (*C.lapack_int)(_rank), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelss.f.
+func (Checked) Sgelss(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []float32, rcond float32, rank []Int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgelss", C.LAPACKE_sgelss_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_s), (C.float)(rcond), (*C.lapack_int)(_rank), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelss.f.
+func (Checked) Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, rank []Int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgelss", C.LAPACKE_dgelss_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_s), (C.double)(rcond), (*C.lapack_int)(_rank), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelss.f.
+func (Checked) Cgelss(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []float32, rcond float32, rank []Int, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgelss", C.LAPACKE_cgelss_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.float)(_s), (C.float)(rcond), (*C.lapack_int)(_rank), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelss.f.
+func (Checked) Zgelss(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s []float64, rcond float64, rank []Int, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgelss", C.LAPACKE_zgelss_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.double)(_s), (C.double)(rcond), (*C.lapack_int)(_rank), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelsy.f.
+func (Checked) Sgelsy(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, jpvt []Int, rcond float32, rank []Int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgelsy", C.LAPACKE_sgelsy_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_jpvt), (C.float)(rcond), (*C.lapack_int)(_rank), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelsy.f.
+func (Checked) Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []Int, rcond float64, rank []Int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgelsy", C.LAPACKE_dgelsy_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_jpvt), (C.double)(rcond), (*C.lapack_int)(_rank), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelsy.f.
+func (Checked) Cgelsy(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, jpvt []Int, rcond float32, rank []Int, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgelsy", C.LAPACKE_cgelsy_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_jpvt), (C.float)(rcond), (*C.lapack_int)(_rank), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelsy.f.
+func (Checked) Zgelsy(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, jpvt []Int, rcond float64, rank []Int, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgelsy", C.LAPACKE_zgelsy_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_jpvt), (C.double)(rcond), (*C.lapack_int)(_rank), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqlf.f.
+func (Checked) Sgeqlf(m, n int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgeqlf", C.LAPACKE_sgeqlf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqlf.f.
+func (Checked) Dgeqlf(m, n int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgeqlf", C.LAPACKE_dgeqlf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqlf.f.
+func (Checked) Cgeqlf(m, n int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgeqlf", C.LAPACKE_cgeqlf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqlf.f.
+func (Checked) Zgeqlf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgeqlf", C.LAPACKE_zgeqlf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqp3.f.
+func (Checked) Sgeqp3(m, n int, a []float32, lda int, jpvt []Int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgeqp3", C.LAPACKE_sgeqp3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_jpvt), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqp3.f.
+func (Checked) Dgeqp3(m, n int, a []float64, lda int, jpvt []Int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgeqp3", C.LAPACKE_dgeqp3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_jpvt), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqp3.f.
+func (Checked) Cgeqp3(m, n int, a []complex64, lda int, jpvt []Int, tau, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgeqp3", C.LAPACKE_cgeqp3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_jpvt), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqp3.f.
+func (Checked) Zgeqp3(m, n int, a []complex128, lda int, jpvt []Int, tau, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _jpvt *Int
+	if len(jpvt) > 0 {
+		_jpvt = &jpvt[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgeqp3", C.LAPACKE_zgeqp3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_jpvt), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqr2.f.
+func (Checked) Sgeqr2(m, n int, a []float32, lda int, tau, work []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgeqr2", C.LAPACKE_sgeqr2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqr2.f.
+func (Checked) Dgeqr2(m, n int, a []float64, lda int, tau, work []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgeqr2", C.LAPACKE_dgeqr2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqr2.f.
+func (Checked) Cgeqr2(m, n int, a []complex64, lda int, tau, work []complex64) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgeqr2", C.LAPACKE_cgeqr2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqr2.f.
+func (Checked) Zgeqr2(m, n int, a []complex128, lda int, tau, work []complex128) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgeqr2", C.LAPACKE_zgeqr2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqrf.f.
+func (Checked) Sgeqrf(m, n int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgeqrf", C.LAPACKE_sgeqrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqrf.f.
+func (Checked) Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgeqrf", C.LAPACKE_dgeqrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqrf.f.
+func (Checked) Cgeqrf(m, n int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgeqrf", C.LAPACKE_cgeqrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqrf.f.
+func (Checked) Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgeqrf", C.LAPACKE_zgeqrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqrfp.f.
+func (Checked) Sgeqrfp(m, n int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgeqrfp", C.LAPACKE_sgeqrfp_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqrfp.f.
+func (Checked) Dgeqrfp(m, n int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgeqrfp", C.LAPACKE_dgeqrfp_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqrfp.f.
+func (Checked) Cgeqrfp(m, n int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgeqrfp", C.LAPACKE_cgeqrfp_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqrfp.f.
+func (Checked) Zgeqrfp(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgeqrfp", C.LAPACKE_zgeqrfp_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgerfs.f.
+func (Checked) Sgerfs(trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float32
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgerfs", C.LAPACKE_sgerfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgerfs.f.
+func (Checked) Dgerfs(trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgerfs", C.LAPACKE_dgerfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgerfs.f.
+func (Checked) Cgerfs(trans byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgerfs", C.LAPACKE_cgerfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgerfs.f.
+func (Checked) Zgerfs(trans byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgerfs", C.LAPACKE_zgerfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgerqf.f.
+func (Checked) Sgerqf(m, n int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgerqf", C.LAPACKE_sgerqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgerqf.f.
+func (Checked) Dgerqf(m, n int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgerqf", C.LAPACKE_dgerqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgerqf.f.
+func (Checked) Cgerqf(m, n int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgerqf", C.LAPACKE_cgerqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgerqf.f.
+func (Checked) Zgerqf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgerqf", C.LAPACKE_zgerqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesdd.f.
+func (Checked) Sgesdd(jobz byte, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float32
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgesdd", C.LAPACKE_sgesdd_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_vt), (C.lapack_int)(ldvt), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesdd.f.
+func (Checked) Dgesdd(jobz byte, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgesdd", C.LAPACKE_dgesdd_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_vt), (C.lapack_int)(ldvt), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesdd.f.
+func (Checked) Cgesdd(jobz byte, m, n int, a []complex64, lda int, s []float32, u []complex64, ldu int, vt []complex64, ldvt int, work []complex64, lwork int, rwork []float32, iwork []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *complex64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cgesdd", C.LAPACKE_cgesdd_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesdd.f.
+func (Checked) Zgesdd(jobz byte, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64, iwork []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *complex128
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zgesdd", C.LAPACKE_zgesdd_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesv.f.
+func (Checked) Sgesv(n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sgesv", C.LAPACKE_sgesv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesv.f.
+func (Checked) Dgesv(n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dgesv", C.LAPACKE_dgesv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesv.f.
+func (Checked) Cgesv(n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cgesv", C.LAPACKE_cgesv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesv.f.
+func (Checked) Zgesv(n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zgesv", C.LAPACKE_zgesv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsgesv.f.
+func (Checked) Dsgesv(n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iter []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _swork *float32
+	if len(swork) > 0 {
+		_swork = &swork[0]
+	}
+	var _iter *Int
+	if len(iter) > 0 {
+		_iter = &iter[0]
+	}
+	return newError("dsgesv", C.LAPACKE_dsgesv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_work), (*C.float)(_swork), (*C.lapack_int)(_iter)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zcgesv.f.
+func (Checked) Zcgesv(n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, work []complex128, swork []complex64, rwork []float64, iter []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _swork *complex64
+	if len(swork) > 0 {
+		_swork = &swork[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iter *Int
+	if len(iter) > 0 {
+		_iter = &iter[0]
+	}
+	return newError("zcgesv", C.LAPACKE_zcgesv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.lapack_complex_double)(_work), (*C.lapack_complex_float)(_swork), (*C.double)(_rwork), (*C.lapack_int)(_iter)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesvd.f.
+func (Checked) Sgesvd(jobu, jobvt byte, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float32
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgesvd", C.LAPACKE_sgesvd_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_vt), (C.lapack_int)(ldvt), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesvd.f.
+func (Checked) Dgesvd(jobu, jobvt byte, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgesvd", C.LAPACKE_dgesvd_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_vt), (C.lapack_int)(ldvt), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesvd.f.
+func (Checked) Cgesvd(jobu, jobvt byte, m, n int, a []complex64, lda int, s []float32, u []complex64, ldu int, vt []complex64, ldvt int, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *complex64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgesvd", C.LAPACKE_cgesvd_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesvd.f.
+func (Checked) Zgesvd(jobu, jobvt byte, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *complex128
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgesvd", C.LAPACKE_zgesvd_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesvdx.f.
+func (Checked) Sgesvdx(jobu, jobvt, rng byte, m, n int, a []float32, lda, vl, vu, il, iu, ns int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float32
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgesvdx", C.LAPACKE_sgesvdx_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.char)(rng), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (C.lapack_int)(vl), (C.lapack_int)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.lapack_int)(ns), (*C.float)(_s), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_vt), (C.lapack_int)(ldvt), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesvdx.f.
+func (Checked) Dgesvdx(jobu, jobvt, rng byte, m, n int, a []float64, lda, vl, vu, il, iu, ns int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *float64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgesvdx", C.LAPACKE_dgesvdx_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.char)(rng), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (C.lapack_int)(vl), (C.lapack_int)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.lapack_int)(ns), (*C.double)(_s), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_vt), (C.lapack_int)(ldvt), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesvdx.f.
+func (Checked) Cgesvdx(jobu, jobvt, rng byte, m, n int, a []complex64, lda, vl, vu, il, iu, ns int, s []float32, u []complex64, ldu int, vt []complex64, ldvt int, work []complex64, lwork int, rwork []float32, iwork []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *complex64
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cgesvdx", C.LAPACKE_cgesvdx_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.char)(rng), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (C.lapack_int)(vl), (C.lapack_int)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.lapack_int)(ns), (*C.float)(_s), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesvdx.f.
+func (Checked) Zgesvdx(jobu, jobvt, rng byte, m, n int, a []complex128, lda, vl, vu, il, iu, ns int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64, iwork []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _vt *complex128
+	if len(vt) > 0 {
+		_vt = &vt[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zgesvdx", C.LAPACKE_zgesvdx_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobvt), (C.char)(rng), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (C.lapack_int)(vl), (C.lapack_int)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.lapack_int)(ns), (*C.double)(_s), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_vt), (C.lapack_int)(ldvt), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesvj.f.
+func (Checked) Sgesvj(joba, jobu, jobv byte, m, n int, a []float32, lda int, sva []float32, mv int, v []float32, ldv int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float32
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgesvj", C.LAPACKE_sgesvj_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_sva), (C.lapack_int)(mv), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesvj.f.
+func (Checked) Dgesvj(joba, jobu, jobv byte, m, n int, a []float64, lda int, sva []float64, mv int, v []float64, ldv int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float64
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgesvj", C.LAPACKE_dgesvj_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_sva), (C.lapack_int)(mv), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesvj.f.
+func (Checked) Cgesvj(joba, jobu, jobv byte, m, n int, a []complex64, lda int, sva []float32, mv int, v []complex64, ldv int, cwork []complex64, lwork int, rwork []float32, lrwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float32
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _cwork *complex64
+	if len(cwork) > 0 {
+		_cwork = &cwork[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgesvj", C.LAPACKE_cgesvj_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_sva), (C.lapack_int)(mv), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_cwork), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesvj.f.
+func (Checked) Zgesvj(joba, jobu, jobv byte, m, n int, a []complex128, lda int, sva []float64, mv int, v []complex128, ldv int, cwork []complex128, lwork int, rwork []float64, lrwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sva *float64
+	if len(sva) > 0 {
+		_sva = &sva[0]
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _cwork *complex128
+	if len(cwork) > 0 {
+		_cwork = &cwork[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgesvj", C.LAPACKE_zgesvj_work((C.int)(rowMajor), (C.char)(joba), (C.char)(jobu), (C.char)(jobv), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_sva), (C.lapack_int)(mv), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_cwork), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesvx.f.
+func (Checked) Sgesvx(fact, trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, equed []byte, r, c, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float32
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgesvx", C.LAPACKE_sgesvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_r), (*C.float)(_c), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesvx.f.
+func (Checked) Dgesvx(fact, trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, equed []byte, r, c, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgesvx", C.LAPACKE_dgesvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_r), (*C.double)(_c), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesvx.f.
+func (Checked) Cgesvx(fact, trans byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, equed []byte, r, c []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgesvx", C.LAPACKE_cgesvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_r), (*C.float)(_c), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesvx.f.
+func (Checked) Zgesvx(fact, trans byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, equed []byte, r, c []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgesvx", C.LAPACKE_zgesvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_r), (*C.double)(_c), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetf2.f.
+func (Checked) Sgetf2(m, n int, a []float32, lda int, ipiv []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("sgetf2", C.LAPACKE_sgetf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetf2.f.
+func (Checked) Dgetf2(m, n int, a []float64, lda int, ipiv []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("dgetf2", C.LAPACKE_dgetf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetf2.f.
+func (Checked) Cgetf2(m, n int, a []complex64, lda int, ipiv []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("cgetf2", C.LAPACKE_cgetf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetf2.f.
+func (Checked) Zgetf2(m, n int, a []complex128, lda int, ipiv []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zgetf2", C.LAPACKE_zgetf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetrf.f.
+func (Checked) Sgetrf(m, n int, a []float32, lda int, ipiv []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("sgetrf", C.LAPACKE_sgetrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetrf.f.
+func (Checked) Dgetrf(m, n int, a []float64, lda int, ipiv []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("dgetrf", C.LAPACKE_dgetrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetrf.f.
+func (Checked) Cgetrf(m, n int, a []complex64, lda int, ipiv []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("cgetrf", C.LAPACKE_cgetrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetrf.f.
+func (Checked) Zgetrf(m, n int, a []complex128, lda int, ipiv []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zgetrf", C.LAPACKE_zgetrf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetrf2.f.
+func (Checked) Sgetrf2(m, n int, a []float32, lda int, ipiv []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("sgetrf2", C.LAPACKE_sgetrf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetrf2.f.
+func (Checked) Dgetrf2(m, n int, a []float64, lda int, ipiv []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("dgetrf2", C.LAPACKE_dgetrf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetrf2.f.
+func (Checked) Cgetrf2(m, n int, a []complex64, lda int, ipiv []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("cgetrf2", C.LAPACKE_cgetrf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetrf2.f.
+func (Checked) Zgetrf2(m, n int, a []complex128, lda int, ipiv []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zgetrf2", C.LAPACKE_zgetrf2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetri.f.
+func (Checked) Sgetri(n int, a []float32, lda int, ipiv []Int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgetri", C.LAPACKE_sgetri_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetri.f.
+func (Checked) Dgetri(n int, a []float64, lda int, ipiv []Int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgetri", C.LAPACKE_dgetri_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetri.f.
+func (Checked) Cgetri(n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgetri", C.LAPACKE_cgetri_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetri.f.
+func (Checked) Zgetri(n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgetri", C.LAPACKE_zgetri_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetrs.f.
+func (Checked) Sgetrs(trans byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sgetrs", C.LAPACKE_sgetrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetrs.f.
+func (Checked) Dgetrs(trans byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dgetrs", C.LAPACKE_dgetrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetrs.f.
+func (Checked) Cgetrs(trans byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cgetrs", C.LAPACKE_cgetrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetrs.f.
+func (Checked) Zgetrs(trans byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zgetrs", C.LAPACKE_zgetrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggbak.f.
+func (Checked) Sggbak(job, side byte, n, ilo, ihi int, lscale, rscale []float32, m int, v []float32, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _lscale *float32
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float32
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("sggbak", C.LAPACKE_sggbak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_lscale), (*C.float)(_rscale), (C.lapack_int)(m), (*C.float)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggbak.f.
+func (Checked) Dggbak(job, side byte, n, ilo, ihi int, lscale, rscale []float64, m int, v []float64, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _lscale *float64
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float64
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("dggbak", C.LAPACKE_dggbak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_lscale), (*C.double)(_rscale), (C.lapack_int)(m), (*C.double)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggbak.f.
+func (Checked) Cggbak(job, side byte, n, ilo, ihi int, lscale, rscale []float32, m int, v []complex64, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _lscale *float32
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float32
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("cggbak", C.LAPACKE_cggbak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_lscale), (*C.float)(_rscale), (C.lapack_int)(m), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggbak.f.
+func (Checked) Zggbak(job, side byte, n, ilo, ihi int, lscale, rscale []float64, m int, v []complex128, ldv int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _lscale *float64
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float64
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	return newError("zggbak", C.LAPACKE_zggbak_work((C.int)(rowMajor), (C.char)(job), (C.char)(side), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_lscale), (*C.double)(_rscale), (C.lapack_int)(m), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggbal.f.
+func (Checked) Sggbal(job byte, n int, a []float32, lda int, b []float32, ldb int, ilo, ihi []Int, lscale, rscale, work []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float32
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float32
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sggbal", C.LAPACKE_sggbal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_lscale), (*C.float)(_rscale), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggbal.f.
+func (Checked) Dggbal(job byte, n int, a []float64, lda int, b []float64, ldb int, ilo, ihi []Int, lscale, rscale, work []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float64
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float64
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dggbal", C.LAPACKE_dggbal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_lscale), (*C.double)(_rscale), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggbal.f.
+func (Checked) Cggbal(job byte, n int, a []complex64, lda int, b []complex64, ldb int, ilo, ihi []Int, lscale, rscale, work []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float32
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float32
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cggbal", C.LAPACKE_cggbal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_lscale), (*C.float)(_rscale), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggbal.f.
+func (Checked) Zggbal(job byte, n int, a []complex128, lda int, b []complex128, ldb int, ilo, ihi []Int, lscale, rscale, work []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float64
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float64
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zggbal", C.LAPACKE_zggbal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_lscale), (*C.double)(_rscale), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgges.f.
+func (Checked) Sgges(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) error {
+	var _selctg C.LAPACK_S_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_S_SELECT3(C.netlib_s_select3)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("sgges", C.LAPACKE_sgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgges.f.
+func (Checked) Dgges(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) error {
+	var _selctg C.LAPACK_D_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_D_SELECT3(C.netlib_d_select3)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("dgges", C.LAPACKE_dgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgges.f.
+func (Checked) Cgges(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) error {
+	var _selctg C.LAPACK_C_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_C_SELECT2(C.netlib_c_select2)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("cgges", C.LAPACKE_cgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgges.f.
+func (Checked) Zgges(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) error {
+	var _selctg C.LAPACK_Z_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_Z_SELECT2(C.netlib_z_select2)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("zgges", C.LAPACKE_zgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgges3.f.
+func (Checked) Sgges3(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) error {
+	var _selctg C.LAPACK_S_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_S_SELECT3(C.netlib_s_select3)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("sgges3", C.LAPACKE_sgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgges3.f.
+func (Checked) Dgges3(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) error {
+	var _selctg C.LAPACK_D_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_D_SELECT3(C.netlib_d_select3)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("dgges3", C.LAPACKE_dgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgges3.f.
+func (Checked) Cgges3(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) error {
+	var _selctg C.LAPACK_C_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_C_SELECT2(C.netlib_c_select2)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("cgges3", C.LAPACKE_cgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgges3.f.
+func (Checked) Zgges3(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) error {
+	var _selctg C.LAPACK_Z_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_Z_SELECT2(C.netlib_z_select2)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("zgges3", C.LAPACKE_zgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggesx.f.
+func (Checked) Sggesx(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float32) bool, sense byte, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, rconde, rcondv, work []float32, lwork int, iwork []Int, liwork int, bwork []Int) error {
+	var _selctg C.LAPACK_S_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_S_SELECT3(C.netlib_s_select3)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("sggesx", C.LAPACKE_sggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggesx.f.
+func (Checked) Dggesx(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float64) bool, sense byte, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, rconde, rcondv, work []float64, lwork int, iwork []Int, liwork int, bwork []Int) error {
+	var _selctg C.LAPACK_D_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_D_SELECT3(C.netlib_d_select3)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("dggesx", C.LAPACKE_dggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggesx.f.
+func (Checked) Cggesx(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex64) bool, sense byte, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32, iwork []Int, liwork int, bwork []Int) error {
+	var _selctg C.LAPACK_C_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_C_SELECT2(C.netlib_c_select2)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("cggesx", C.LAPACKE_cggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggesx.f.
+func (Checked) Zggesx(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex128) bool, sense byte, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64, iwork []Int, liwork int, bwork []Int) error {
+	var _selctg C.LAPACK_Z_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_Z_SELECT2(C.netlib_z_select2)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("zggesx", C.LAPACKE_zggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggev.f.
+func (Checked) Sggev(jobvl, jobvr byte, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sggev", C.LAPACKE_sggev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggev.f.
+func (Checked) Dggev(jobvl, jobvr byte, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dggev", C.LAPACKE_dggev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggev.f.
+func (Checked) Cggev(jobvl, jobvr byte, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, vl []complex64, ldvl int, vr []complex64, ldvr int, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cggev", C.LAPACKE_cggev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggev.f.
+func (Checked) Zggev(jobvl, jobvr byte, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zggev", C.LAPACKE_zggev_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggev3.f.
+func (Checked) Sggev3(jobvl, jobvr byte, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sggev3", C.LAPACKE_sggev3_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggev3.f.
+func (Checked) Dggev3(jobvl, jobvr byte, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dggev3", C.LAPACKE_dggev3_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggev3.f.
+func (Checked) Cggev3(jobvl, jobvr byte, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, vl []complex64, ldvl int, vr []complex64, ldvr int, work []complex64, lwork int, rwork []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cggev3", C.LAPACKE_cggev3_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggev3.f.
+func (Checked) Zggev3(jobvl, jobvr byte, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zggev3", C.LAPACKE_zggev3_work((C.int)(rowMajor), (C.char)(jobvl), (C.char)(jobvr), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggevx.f.
+func (Checked) Sggevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vl []float32, ldvl int, vr []float32, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv, work []float32, lwork int, iwork, bwork []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float32
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float32
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _abnrm *float32
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _bbnrm *float32
+	if len(bbnrm) > 0 {
+		_bbnrm = &bbnrm[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("sggevx", C.LAPACKE_sggevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_lscale), (*C.float)(_rscale), (*C.float)(_abnrm), (*C.float)(_bbnrm), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggevx.f.
+func (Checked) Dggevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv, work []float64, lwork int, iwork, bwork []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float64
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float64
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _abnrm *float64
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _bbnrm *float64
+	if len(bbnrm) > 0 {
+		_bbnrm = &bbnrm[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("dggevx", C.LAPACKE_dggevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_lscale), (*C.double)(_rscale), (*C.double)(_abnrm), (*C.double)(_bbnrm), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggevx.f.
+func (Checked) Cggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, vl []complex64, ldvl int, vr []complex64, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32, iwork, bwork []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float32
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float32
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _abnrm *float32
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _bbnrm *float32
+	if len(bbnrm) > 0 {
+		_bbnrm = &bbnrm[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("cggevx", C.LAPACKE_cggevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.float)(_lscale), (*C.float)(_rscale), (*C.float)(_abnrm), (*C.float)(_bbnrm), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggevx.f.
+func (Checked) Zggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, vl []complex128, ldvl int, vr []complex128, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64, iwork, bwork []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _ilo *Int
+	if len(ilo) > 0 {
+		_ilo = &ilo[0]
+	}
+	var _ihi *Int
+	if len(ihi) > 0 {
+		_ihi = &ihi[0]
+	}
+	var _lscale *float64
+	if len(lscale) > 0 {
+		_lscale = &lscale[0]
+	}
+	var _rscale *float64
+	if len(rscale) > 0 {
+		_rscale = &rscale[0]
+	}
+	var _abnrm *float64
+	if len(abnrm) > 0 {
+		_abnrm = &abnrm[0]
+	}
+	var _bbnrm *float64
+	if len(bbnrm) > 0 {
+		_bbnrm = &bbnrm[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return newError("zggevx", C.LAPACKE_zggevx_work((C.int)(rowMajor), (C.char)(balanc), (C.char)(jobvl), (C.char)(jobvr), (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_lscale), (*C.double)(_rscale), (*C.double)(_abnrm), (*C.double)(_bbnrm), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggglm.f.
+func (Checked) Sggglm(n, m, p int, a []float32, lda int, b []float32, ldb int, d, x, y, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sggglm", C.LAPACKE_sggglm_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_d), (*C.float)(_x), (*C.float)(_y), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggglm.f.
+func (Checked) Dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dggglm", C.LAPACKE_dggglm_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_d), (*C.double)(_x), (*C.double)(_y), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggglm.f.
+func (Checked) Cggglm(n, m, p int, a []complex64, lda int, b []complex64, ldb int, d, x, y, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cggglm", C.LAPACKE_cggglm_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_x), (*C.lapack_complex_float)(_y), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggglm.f.
+func (Checked) Zggglm(n, m, p int, a []complex128, lda int, b []complex128, ldb int, d, x, y, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zggglm", C.LAPACKE_zggglm_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_x), (*C.lapack_complex_double)(_y), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgghrd.f.
+func (Checked) Sgghrd(compq, compz byte, n, ilo, ihi int, a []float32, lda int, b []float32, ldb int, q []float32, ldq int, z []float32, ldz int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	return newError("sgghrd", C.LAPACKE_sgghrd_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_z), (C.lapack_int)(ldz)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgghrd.f.
+func (Checked) Dgghrd(compq, compz byte, n, ilo, ihi int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	return newError("dgghrd", C.LAPACKE_dgghrd_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_z), (C.lapack_int)(ldz)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgghrd.f.
+func (Checked) Cgghrd(compq, compz byte, n, ilo, ihi int, a []complex64, lda int, b []complex64, ldb int, q []complex64, ldq int, z []complex64, ldz int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	return newError("cgghrd", C.LAPACKE_cgghrd_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgghrd.f.
+func (Checked) Zgghrd(compq, compz byte, n, ilo, ihi int, a []complex128, lda int, b []complex128, ldb int, q []complex128, ldq int, z []complex128, ldz int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	return newError("zgghrd", C.LAPACKE_zgghrd_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgghd3.f.
+func (Checked) Sgghd3(compq, compz byte, n, ilo, ihi int, a []float32, lda int, b []float32, ldb int, q []float32, ldq int, z []float32, ldz int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgghd3", C.LAPACKE_sgghd3_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgghd3.f.
+func (Checked) Dgghd3(compq, compz byte, n, ilo, ihi int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgghd3", C.LAPACKE_dgghd3_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgghd3.f.
+func (Checked) Cgghd3(compq, compz byte, n, ilo, ihi int, a []complex64, lda int, b []complex64, ldb int, q []complex64, ldq int, z []complex64, ldz int, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgghd3", C.LAPACKE_cgghd3_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgghd3.f.
+func (Checked) Zgghd3(compq, compz byte, n, ilo, ihi int, a []complex128, lda int, b []complex128, ldb int, q []complex128, ldq int, z []complex128, ldz int, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgghd3", C.LAPACKE_zgghd3_work((C.int)(rowMajor), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgglse.f.
+func (Checked) Sgglse(m, n, p int, a []float32, lda int, b []float32, ldb int, c, d, x, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgglse", C.LAPACKE_sgglse_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_c), (*C.float)(_d), (*C.float)(_x), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgglse.f.
+func (Checked) Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgglse", C.LAPACKE_dgglse_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_c), (*C.double)(_d), (*C.double)(_x), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgglse.f.
+func (Checked) Cgglse(m, n, p int, a []complex64, lda int, b []complex64, ldb int, c, d, x, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgglse", C.LAPACKE_cgglse_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_c), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_x), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgglse.f.
+func (Checked) Zgglse(m, n, p int, a []complex128, lda int, b []complex128, ldb int, c, d, x, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgglse", C.LAPACKE_zgglse_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_c), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_x), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggqrf.f.
+func (Checked) Sggqrf(n, m, p int, a []float32, lda int, taua, b []float32, ldb int, taub, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *float32
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *float32
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sggqrf", C.LAPACKE_sggqrf_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_taua), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_taub), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggqrf.f.
+func (Checked) Dggqrf(n, m, p int, a []float64, lda int, taua, b []float64, ldb int, taub, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *float64
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *float64
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dggqrf", C.LAPACKE_dggqrf_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_taua), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_taub), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggqrf.f.
+func (Checked) Cggqrf(n, m, p int, a []complex64, lda int, taua, b []complex64, ldb int, taub, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *complex64
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *complex64
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cggqrf", C.LAPACKE_cggqrf_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_taua), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_taub), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggqrf.f.
+func (Checked) Zggqrf(n, m, p int, a []complex128, lda int, taua, b []complex128, ldb int, taub, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *complex128
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *complex128
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zggqrf", C.LAPACKE_zggqrf_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(m), (C.lapack_int)(p), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_taua), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_taub), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggrqf.f.
+func (Checked) Sggrqf(m, p, n int, a []float32, lda int, taua, b []float32, ldb int, taub, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *float32
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *float32
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sggrqf", C.LAPACKE_sggrqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_taua), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_taub), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggrqf.f.
+func (Checked) Dggrqf(m, p, n int, a []float64, lda int, taua, b []float64, ldb int, taub, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *float64
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *float64
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dggrqf", C.LAPACKE_dggrqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_taua), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_taub), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggrqf.f.
+func (Checked) Cggrqf(m, p, n int, a []complex64, lda int, taua, b []complex64, ldb int, taub, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *complex64
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *complex64
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cggrqf", C.LAPACKE_cggrqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_taua), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_taub), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggrqf.f.
+func (Checked) Zggrqf(m, p, n int, a []complex128, lda int, taua, b []complex128, ldb int, taub, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _taua *complex128
+	if len(taua) > 0 {
+		_taua = &taua[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _taub *complex128
+	if len(taub) > 0 {
+		_taub = &taub[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zggrqf", C.LAPACKE_zggrqf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_taua), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_taub), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggsvd3.f.
+func (Checked) Sggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []float32, lda int, b []float32, ldb int, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, lwork int, iwork []Int) error {
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float32
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sggsvd3", C.LAPACKE_sggsvd3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_alpha), (*C.float)(_beta), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggsvd3.f.
+func (Checked) Dggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []Int) error {
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dggsvd3", C.LAPACKE_dggsvd3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_alpha), (*C.double)(_beta), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggsvd3.f.
+func (Checked) Cggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []complex64, lda int, b []complex64, ldb int, alpha, beta []float32, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, work []complex64, lwork int, rwork []float32, iwork []Int) error {
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float32
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cggsvd3", C.LAPACKE_cggsvd3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.float)(_alpha), (*C.float)(_beta), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggsvd3.f.
+func (Checked) Zggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, lwork int, rwork []float64, iwork []Int) error {
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zggsvd3", C.LAPACKE_zggsvd3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(p), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.double)(_alpha), (*C.double)(_beta), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggsvp3.f.
+func (Checked) Sggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, k, l []Int, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, iwork []Int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sggsvp3", C.LAPACKE_sggsvp3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (C.float)(tola), (C.float)(tolb), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_q), (C.lapack_int)(ldq), (*C.lapack_int)(_iwork), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggsvp3.f.
+func (Checked) Dggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, k, l []Int, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, iwork []Int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dggsvp3", C.LAPACKE_dggsvp3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (C.double)(tola), (C.double)(tolb), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_q), (C.lapack_int)(ldq), (*C.lapack_int)(_iwork), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggsvp3.f.
+func (Checked) Cggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex64, lda int, b []complex64, ldb int, tola, tolb float32, k, l []Int, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, iwork []Int, rwork []float32, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cggsvp3", C.LAPACKE_cggsvp3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (C.float)(tola), (C.float)(tolb), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_int)(_iwork), (*C.float)(_rwork), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggsvp3.f.
+func (Checked) Zggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, k, l []Int, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, iwork []Int, rwork []float64, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	var _l *Int
+	if len(l) > 0 {
+		_l = &l[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zggsvp3", C.LAPACKE_zggsvp3_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (C.double)(tola), (C.double)(tolb), (*C.lapack_int)(_k), (*C.lapack_int)(_l), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_int)(_iwork), (*C.double)(_rwork), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgtcon.f.
+func (Checked) Sgtcon(norm byte, n int, dl, d, du, du2 []float32, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) error {
+	var _dl *float32
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float32
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *float32
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgtcon", C.LAPACKE_sgtcon_work((C.char)(norm), (C.lapack_int)(n), (*C.float)(_dl), (*C.float)(_d), (*C.float)(_du), (*C.float)(_du2), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgtcon.f.
+func (Checked) Dgtcon(norm byte, n int, dl, d, du, du2 []float64, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) error {
+	var _dl *float64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *float64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgtcon", C.LAPACKE_dgtcon_work((C.char)(norm), (C.lapack_int)(n), (*C.double)(_dl), (*C.double)(_d), (*C.double)(_du), (*C.double)(_du2), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgtcon.f.
+func (Checked) Cgtcon(norm byte, n int, dl, d, du, du2 []complex64, ipiv []Int, anorm float32, rcond []float32, work []complex64) error {
+	var _dl *complex64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *complex64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgtcon", C.LAPACKE_cgtcon_work((C.char)(norm), (C.lapack_int)(n), (*C.lapack_complex_float)(_dl), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_du), (*C.lapack_complex_float)(_du2), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgtcon.f.
+func (Checked) Zgtcon(norm byte, n int, dl, d, du, du2 []complex128, ipiv []Int, anorm float64, rcond []float64, work []complex128) error {
+	var _dl *complex128
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex128
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *complex128
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgtcon", C.LAPACKE_zgtcon_work((C.char)(norm), (C.lapack_int)(n), (*C.lapack_complex_double)(_dl), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_du), (*C.lapack_complex_double)(_du2), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgtrfs.f.
+func (Checked) Sgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *float32
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float32
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *float32
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *float32
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *float32
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *float32
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgtrfs", C.LAPACKE_sgtrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_dl), (*C.float)(_d), (*C.float)(_du), (*C.float)(_dlf), (*C.float)(_df), (*C.float)(_duf), (*C.float)(_du2), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgtrfs.f.
+func (Checked) Dgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *float64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *float64
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *float64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *float64
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *float64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgtrfs", C.LAPACKE_dgtrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_dl), (*C.double)(_d), (*C.double)(_du), (*C.double)(_dlf), (*C.double)(_df), (*C.double)(_duf), (*C.double)(_du2), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgtrfs.f.
+func (Checked) Cgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *complex64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *complex64
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *complex64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *complex64
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *complex64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgtrfs", C.LAPACKE_cgtrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_dl), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_du), (*C.lapack_complex_float)(_dlf), (*C.lapack_complex_float)(_df), (*C.lapack_complex_float)(_duf), (*C.lapack_complex_float)(_du2), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgtrfs.f.
+func (Checked) Zgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *complex128
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex128
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *complex128
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *complex128
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *complex128
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *complex128
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgtrfs", C.LAPACKE_zgtrfs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_dl), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_du), (*C.lapack_complex_double)(_dlf), (*C.lapack_complex_double)(_df), (*C.lapack_complex_double)(_duf), (*C.lapack_complex_double)(_du2), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgtsv.f.
+func (Checked) Sgtsv(n, nrhs int, dl, d, du, b []float32, ldb int) error {
+	var _dl *float32
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float32
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sgtsv", C.LAPACKE_sgtsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_dl), (*C.float)(_d), (*C.float)(_du), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgtsv.f.
+func (Checked) Dgtsv(n, nrhs int, dl, d, du, b []float64, ldb int) error {
+	var _dl *float64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dgtsv", C.LAPACKE_dgtsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_dl), (*C.double)(_d), (*C.double)(_du), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgtsv.f.
+func (Checked) Cgtsv(n, nrhs int, dl, d, du, b []complex64, ldb int) error {
+	var _dl *complex64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cgtsv", C.LAPACKE_cgtsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_dl), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_du), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgtsv.f.
+func (Checked) Zgtsv(n, nrhs int, dl, d, du, b []complex128, ldb int) error {
+	var _dl *complex128
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex128
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zgtsv", C.LAPACKE_zgtsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_dl), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_du), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgtsvx.f.
+func (Checked) Sgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *float32
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float32
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *float32
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *float32
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *float32
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *float32
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sgtsvx", C.LAPACKE_sgtsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_dl), (*C.float)(_d), (*C.float)(_du), (*C.float)(_dlf), (*C.float)(_df), (*C.float)(_duf), (*C.float)(_du2), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgtsvx.f.
+func (Checked) Dgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *float64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *float64
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *float64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *float64
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *float64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dgtsvx", C.LAPACKE_dgtsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_dl), (*C.double)(_d), (*C.double)(_du), (*C.double)(_dlf), (*C.double)(_df), (*C.double)(_duf), (*C.double)(_du2), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgtsvx.f.
+func (Checked) Cgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *complex64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *complex64
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *complex64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *complex64
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *complex64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cgtsvx", C.LAPACKE_cgtsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_dl), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_du), (*C.lapack_complex_float)(_dlf), (*C.lapack_complex_float)(_df), (*C.lapack_complex_float)(_duf), (*C.lapack_complex_float)(_du2), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgtsvx.f.
+func (Checked) Zgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *complex128
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex128
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _dlf *complex128
+	if len(dlf) > 0 {
+		_dlf = &dlf[0]
+	}
+	var _df *complex128
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _duf *complex128
+	if len(duf) > 0 {
+		_duf = &duf[0]
+	}
+	var _du2 *complex128
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zgtsvx", C.LAPACKE_zgtsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_dl), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_du), (*C.lapack_complex_double)(_dlf), (*C.lapack_complex_double)(_df), (*C.lapack_complex_double)(_duf), (*C.lapack_complex_double)(_du2), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgttrf.f.
+func (Checked) Sgttrf(n int, dl, d, du, du2 []float32, ipiv []Int) error {
+	var _dl *float32
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float32
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *float32
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("sgttrf", C.LAPACKE_sgttrf_work((C.lapack_int)(n), (*C.float)(_dl), (*C.float)(_d), (*C.float)(_du), (*C.float)(_du2), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgttrf.f.
+func (Checked) Dgttrf(n int, dl, d, du, du2 []float64, ipiv []Int) error {
+	var _dl *float64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *float64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("dgttrf", C.LAPACKE_dgttrf_work((C.lapack_int)(n), (*C.double)(_dl), (*C.double)(_d), (*C.double)(_du), (*C.double)(_du2), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgttrf.f.
+func (Checked) Cgttrf(n int, dl, d, du, du2 []complex64, ipiv []Int) error {
+	var _dl *complex64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *complex64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("cgttrf", C.LAPACKE_cgttrf_work((C.lapack_int)(n), (*C.lapack_complex_float)(_dl), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_du), (*C.lapack_complex_float)(_du2), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgttrf.f.
+func (Checked) Zgttrf(n int, dl, d, du, du2 []complex128, ipiv []Int) error {
+	var _dl *complex128
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex128
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *complex128
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zgttrf", C.LAPACKE_zgttrf_work((C.lapack_int)(n), (*C.lapack_complex_double)(_dl), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_du), (*C.lapack_complex_double)(_du2), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgttrs.f.
+func (Checked) Sgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float32, ipiv []Int, b []float32, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *float32
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float32
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *float32
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sgttrs", C.LAPACKE_sgttrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_dl), (*C.float)(_d), (*C.float)(_du), (*C.float)(_du2), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgttrs.f.
+func (Checked) Dgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float64, ipiv []Int, b []float64, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *float64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *float64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *float64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dgttrs", C.LAPACKE_dgttrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_dl), (*C.double)(_d), (*C.double)(_du), (*C.double)(_du2), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgttrs.f.
+func (Checked) Cgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex64, ipiv []Int, b []complex64, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *complex64
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex64
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *complex64
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cgttrs", C.LAPACKE_cgttrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_dl), (*C.lapack_complex_float)(_d), (*C.lapack_complex_float)(_du), (*C.lapack_complex_float)(_du2), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgttrs.f.
+func (Checked) Zgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex128, ipiv []Int, b []complex128, ldb int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _dl *complex128
+	if len(dl) > 0 {
+		_dl = &dl[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _du *complex128
+	if len(du) > 0 {
+		_du = &du[0]
+	}
+	var _du2 *complex128
+	if len(du2) > 0 {
+		_du2 = &du2[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zgttrs", C.LAPACKE_zgttrs_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_dl), (*C.lapack_complex_double)(_d), (*C.lapack_complex_double)(_du), (*C.lapack_complex_double)(_du2), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbev.f.
+func (Checked) Chbev(jobz, ul byte, n, kd int, ab []complex64, ldab int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chbev", C.LAPACKE_chbev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbev.f.
+func (Checked) Zhbev(jobz, ul byte, n, kd int, ab []complex128, ldab int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhbev", C.LAPACKE_zhbev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbevd.f.
+func (Checked) Chbevd(jobz, ul byte, n, kd int, ab []complex64, ldab int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("chbevd", C.LAPACKE_chbevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbevd.f.
+func (Checked) Zhbevd(jobz, ul byte, n, kd int, ab []complex128, ldab int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zhbevd", C.LAPACKE_zhbevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbevx.f.
+func (Checked) Chbevx(jobz, rng, ul byte, n, kd int, ab []complex64, ldab int, q []complex64, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("chbevx", C.LAPACKE_chbevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbevx.f.
+func (Checked) Zhbevx(jobz, rng, ul byte, n, kd int, ab []complex128, ldab int, q []complex128, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("zhbevx", C.LAPACKE_zhbevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbgst.f.
+func (Checked) Chbgst(vect, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, x []complex64, ldx int, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chbgst", C.LAPACKE_chbgst_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_bb), (C.lapack_int)(ldbb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbgst.f.
+func (Checked) Zhbgst(vect, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, x []complex128, ldx int, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex128
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhbgst", C.LAPACKE_zhbgst_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_bb), (C.lapack_int)(ldbb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbgv.f.
+func (Checked) Chbgv(jobz, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chbgv", C.LAPACKE_chbgv_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_bb), (C.lapack_int)(ldbb), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbgv.f.
+func (Checked) Zhbgv(jobz, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex128
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhbgv", C.LAPACKE_zhbgv_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_bb), (C.lapack_int)(ldbb), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbgvd.f.
+func (Checked) Chbgvd(jobz, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("chbgvd", C.LAPACKE_chbgvd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_bb), (C.lapack_int)(ldbb), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbgvd.f.
+func (Checked) Zhbgvd(jobz, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex128
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zhbgvd", C.LAPACKE_zhbgvd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_bb), (C.lapack_int)(ldbb), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbgvx.f.
+func (Checked) Chbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, q []complex64, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("chbgvx", C.LAPACKE_chbgvx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_bb), (C.lapack_int)(ldbb), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbgvx.f.
+func (Checked) Zhbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, q []complex128, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *complex128
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("zhbgvx", C.LAPACKE_zhbgvx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_bb), (C.lapack_int)(ldbb), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbtrd.f.
+func (Checked) Chbtrd(vect, ul byte, n, kd int, ab []complex64, ldab int, d, e []float32, q []complex64, ldq int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chbtrd", C.LAPACKE_chbtrd_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbtrd.f.
+func (Checked) Zhbtrd(vect, ul byte, n, kd int, ab []complex128, ldab int, d, e []float64, q []complex128, ldq int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhbtrd", C.LAPACKE_zhbtrd_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/checon.f.
+func (Checked) Checon(ul byte, n int, a []complex64, lda int, ipiv []Int, anorm float32, rcond []float32, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("checon", C.LAPACKE_checon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhecon.f.
+func (Checked) Zhecon(ul byte, n int, a []complex128, lda int, ipiv []Int, anorm float64, rcond []float64, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhecon", C.LAPACKE_zhecon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheequb.f.
+func (Checked) Cheequb(ul byte, n int, a []complex64, lda int, s, scond, amax []float32, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cheequb", C.LAPACKE_cheequb_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheequb.f.
+func (Checked) Zheequb(ul byte, n int, a []complex128, lda int, s, scond, amax []float64, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zheequb", C.LAPACKE_zheequb_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheev.f.
+func (Checked) Cheev(jobz, ul byte, n int, a []complex64, lda int, w []float32, work []complex64, lwork int, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cheev", C.LAPACKE_cheev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_w), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheev.f.
+func (Checked) Zheev(jobz, ul byte, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zheev", C.LAPACKE_zheev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_w), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheevd.f.
+func (Checked) Cheevd(jobz, ul byte, n int, a []complex64, lda int, w []float32, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cheevd", C.LAPACKE_cheevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_w), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheevd.f.
+func (Checked) Zheevd(jobz, ul byte, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zheevd", C.LAPACKE_zheevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_w), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheevr.f.
+func (Checked) Cheevr(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, isuppz []Int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cheevr", C.LAPACKE_cheevr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheevr.f.
+func (Checked) Zheevr(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, isuppz []Int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zheevr", C.LAPACKE_zheevr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheevx.f.
+func (Checked) Cheevx(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("cheevx", C.LAPACKE_cheevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheevx.f.
+func (Checked) Zheevx(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("zheevx", C.LAPACKE_zheevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chegst.f.
+func (Checked) Chegst(itype int, ul byte, n int, a []complex64, lda int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("chegst", C.LAPACKE_chegst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhegst.f.
+func (Checked) Zhegst(itype int, ul byte, n int, a []complex128, lda int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zhegst", C.LAPACKE_zhegst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chegv.f.
+func (Checked) Chegv(itype int, jobz, ul byte, n int, a []complex64, lda int, b []complex64, ldb int, w []float32, work []complex64, lwork int, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chegv", C.LAPACKE_chegv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.float)(_w), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhegv.f.
+func (Checked) Zhegv(itype int, jobz, ul byte, n int, a []complex128, lda int, b []complex128, ldb int, w []float64, work []complex128, lwork int, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhegv", C.LAPACKE_zhegv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.double)(_w), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chegvd.f.
+func (Checked) Chegvd(itype int, jobz, ul byte, n int, a []complex64, lda int, b []complex64, ldb int, w []float32, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("chegvd", C.LAPACKE_chegvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.float)(_w), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhegvd.f.
+func (Checked) Zhegvd(itype int, jobz, ul byte, n int, a []complex128, lda int, b []complex128, ldb int, w []float64, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zhegvd", C.LAPACKE_zhegvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.double)(_w), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chegvx.f.
+func (Checked) Chegvx(itype int, jobz, rng, ul byte, n int, a []complex64, lda int, b []complex64, ldb int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("chegvx", C.LAPACKE_chegvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhegvx.f.
+func (Checked) Zhegvx(itype int, jobz, rng, ul byte, n int, a []complex128, lda int, b []complex128, ldb int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("zhegvx", C.LAPACKE_zhegvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cherfs.f.
+func (Checked) Cherfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cherfs", C.LAPACKE_cherfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zherfs.f.
+func (Checked) Zherfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zherfs", C.LAPACKE_zherfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chesv.f.
+func (Checked) Chesv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chesv", C.LAPACKE_chesv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhesv.f.
+func (Checked) Zhesv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhesv", C.LAPACKE_zhesv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chesvx.f.
+func (Checked) Chesvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, lwork int, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chesvx", C.LAPACKE_chesvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhesvx.f.
+func (Checked) Zhesvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, lwork int, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhesvx", C.LAPACKE_zhesvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetrd.f.
+func (Checked) Chetrd(ul byte, n int, a []complex64, lda int, d, e []float32, tau, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chetrd", C.LAPACKE_chetrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetrd.f.
+func (Checked) Zhetrd(ul byte, n int, a []complex128, lda int, d, e []float64, tau, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhetrd", C.LAPACKE_zhetrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetrf.f.
+func (Checked) Chetrf(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chetrf", C.LAPACKE_chetrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetrf.f.
+func (Checked) Zhetrf(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhetrf", C.LAPACKE_zhetrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetri.f.
+func (Checked) Chetri(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chetri", C.LAPACKE_chetri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetri.f.
+func (Checked) Zhetri(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhetri", C.LAPACKE_zhetri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetrs.f.
+func (Checked) Chetrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("chetrs", C.LAPACKE_chetrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetrs.f.
+func (Checked) Zhetrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zhetrs", C.LAPACKE_zhetrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chfrk.f.
+func (Checked) Chfrk(transr, ul, trans byte, n, k int, alpha float32, a []complex64, lda int, beta float32, c []complex64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	return newError("chfrk", C.LAPACKE_chfrk_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(k), (C.float)(alpha), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (C.float)(beta), (*C.lapack_complex_float)(_c)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhfrk.f.
+func (Checked) Zhfrk(transr, ul, trans byte, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	return newError("zhfrk", C.LAPACKE_zhfrk_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(k), (C.double)(alpha), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (C.double)(beta), (*C.lapack_complex_double)(_c)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/shgeqz.f.
+func (Checked) Shgeqz(job, compq, compz byte, n, ilo, ihi int, h []float32, ldh int, t []float32, ldt int, alphar, alphai, beta, q []float32, ldq int, z []float32, ldz int, work []float32, lwork int) error {
+	var _h *float32
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("shgeqz", C.LAPACKE_shgeqz_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_h), (C.lapack_int)(ldh), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dhgeqz.f.
+func (Checked) Dhgeqz(job, compq, compz byte, n, ilo, ihi int, h []float64, ldh int, t []float64, ldt int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, work []float64, lwork int) error {
+	var _h *float64
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dhgeqz", C.LAPACKE_dhgeqz_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_h), (C.lapack_int)(ldh), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chgeqz.f.
+func (Checked) Chgeqz(job, compq, compz byte, n, ilo, ihi int, h []complex64, ldh int, t []complex64, ldt int, alpha, beta, q []complex64, ldq int, z []complex64, ldz int, work []complex64, lwork int, rwork []float32) error {
+	var _h *complex64
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chgeqz", C.LAPACKE_chgeqz_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_float)(_h), (C.lapack_int)(ldh), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhgeqz.f.
+func (Checked) Zhgeqz(job, compq, compz byte, n, ilo, ihi int, h []complex128, ldh int, t []complex128, ldt int, alpha, beta, q []complex128, ldq int, z []complex128, ldz int, work []complex128, lwork int, rwork []float64) error {
+	var _h *complex128
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhgeqz", C.LAPACKE_zhgeqz_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_double)(_h), (C.lapack_int)(ldh), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpcon.f.
+func (Checked) Chpcon(ul byte, n int, ap []complex64, ipiv []Int, anorm float32, rcond []float32, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chpcon", C.LAPACKE_chpcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpcon.f.
+func (Checked) Zhpcon(ul byte, n int, ap []complex128, ipiv []Int, anorm float64, rcond []float64, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhpcon", C.LAPACKE_zhpcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpev.f.
+func (Checked) Chpev(jobz, ul byte, n int, ap []complex64, w []float32, z []complex64, ldz int, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chpev", C.LAPACKE_chpev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpev.f.
+func (Checked) Zhpev(jobz, ul byte, n int, ap []complex128, w []float64, z []complex128, ldz int, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhpev", C.LAPACKE_zhpev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpevd.f.
+func (Checked) Chpevd(jobz, ul byte, n int, ap []complex64, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("chpevd", C.LAPACKE_chpevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpevd.f.
+func (Checked) Zhpevd(jobz, ul byte, n int, ap []complex128, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zhpevd", C.LAPACKE_zhpevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpevx.f.
+func (Checked) Chpevx(jobz, rng, ul byte, n int, ap []complex64, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("chpevx", C.LAPACKE_chpevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpevx.f.
+func (Checked) Zhpevx(jobz, rng, ul byte, n int, ap []complex128, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("zhpevx", C.LAPACKE_zhpevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpgst.f.
+func (Checked) Chpgst(itype int, ul byte, n int, ap, bp []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	return newError("chpgst", C.LAPACKE_chpgst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_bp)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpgst.f.
+func (Checked) Zhpgst(itype int, ul byte, n int, ap, bp []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex128
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	return newError("zhpgst", C.LAPACKE_zhpgst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_bp)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpgv.f.
+func (Checked) Chpgv(itype int, jobz, ul byte, n int, ap, bp []complex64, w []float32, z []complex64, ldz int, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chpgv", C.LAPACKE_chpgv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_bp), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpgv.f.
+func (Checked) Zhpgv(itype int, jobz, ul byte, n int, ap, bp []complex128, w []float64, z []complex128, ldz int, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex128
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhpgv", C.LAPACKE_zhpgv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_bp), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpgvd.f.
+func (Checked) Chpgvd(itype int, jobz, ul byte, n int, ap, bp []complex64, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("chpgvd", C.LAPACKE_chpgvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_bp), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpgvd.f.
+func (Checked) Zhpgvd(itype int, jobz, ul byte, n int, ap, bp []complex128, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex128
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zhpgvd", C.LAPACKE_zhpgvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_bp), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpgvx.f.
+func (Checked) Chpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex64, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("chpgvx", C.LAPACKE_chpgvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_bp), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpgvx.f.
+func (Checked) Zhpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex128, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *complex128
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("zhpgvx", C.LAPACKE_zhpgvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_bp), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chprfs.f.
+func (Checked) Chprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chprfs", C.LAPACKE_chprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhprfs.f.
+func (Checked) Zhprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex128
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhprfs", C.LAPACKE_zhprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpsv.f.
+func (Checked) Chpsv(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("chpsv", C.LAPACKE_chpsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpsv.f.
+func (Checked) Zhpsv(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zhpsv", C.LAPACKE_zhpsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpsvx.f.
+func (Checked) Chpsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("chpsvx", C.LAPACKE_chpsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpsvx.f.
+func (Checked) Zhpsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex128
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zhpsvx", C.LAPACKE_zhpsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chptrd.f.
+func (Checked) Chptrd(ul byte, n int, ap []complex64, d, e []float32, tau []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("chptrd", C.LAPACKE_chptrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhptrd.f.
+func (Checked) Zhptrd(ul byte, n int, ap []complex128, d, e []float64, tau []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("zhptrd", C.LAPACKE_zhptrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chptrf.f.
+func (Checked) Chptrf(ul byte, n int, ap []complex64, ipiv []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("chptrf", C.LAPACKE_chptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhptrf.f.
+func (Checked) Zhptrf(ul byte, n int, ap []complex128, ipiv []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zhptrf", C.LAPACKE_zhptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chptri.f.
+func (Checked) Chptri(ul byte, n int, ap []complex64, ipiv []Int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chptri", C.LAPACKE_chptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhptri.f.
+func (Checked) Zhptri(ul byte, n int, ap []complex128, ipiv []Int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhptri", C.LAPACKE_zhptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chptrs.f.
+func (Checked) Chptrs(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("chptrs", C.LAPACKE_chptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhptrs.f.
+func (Checked) Zhptrs(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zhptrs", C.LAPACKE_zhptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/shsein.f.
+func (Checked) Shsein(job, eigsrc, initv byte, sel []Int, n int, h []float32, ldh int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []Int, work []float32, ifaill, ifailr []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _h *float32
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _ifaill *Int
+	if len(ifaill) > 0 {
+		_ifaill = &ifaill[0]
+	}
+	var _ifailr *Int
+	if len(ifailr) > 0 {
+		_ifailr = &ifailr[0]
+	}
+	return newError("shsein", C.LAPACKE_shsein_work((C.int)(rowMajor), (C.char)(job), (C.char)(eigsrc), (C.char)(initv), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.float)(_h), (C.lapack_int)(ldh), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.float)(_work), (*C.lapack_int)(_ifaill), (*C.lapack_int)(_ifailr)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dhsein.f.
+func (Checked) Dhsein(job, eigsrc, initv byte, sel []Int, n int, h []float64, ldh int, wr, wi, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []Int, work []float64, ifaill, ifailr []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _h *float64
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _ifaill *Int
+	if len(ifaill) > 0 {
+		_ifaill = &ifaill[0]
+	}
+	var _ifailr *Int
+	if len(ifailr) > 0 {
+		_ifailr = &ifailr[0]
+	}
+	return newError("dhsein", C.LAPACKE_dhsein_work((C.int)(rowMajor), (C.char)(job), (C.char)(eigsrc), (C.char)(initv), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.double)(_h), (C.lapack_int)(ldh), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.double)(_work), (*C.lapack_int)(_ifaill), (*C.lapack_int)(_ifailr)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chsein.f.
+func (Checked) Chsein(job, eigsrc, initv byte, sel []Int, n int, h []complex64, ldh int, w, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []Int, work []complex64, rwork []float32, ifaill, ifailr []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _h *complex64
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _ifaill *Int
+	if len(ifaill) > 0 {
+		_ifaill = &ifaill[0]
+	}
+	var _ifailr *Int
+	if len(ifailr) > 0 {
+		_ifailr = &ifailr[0]
+	}
+	return newError("chsein", C.LAPACKE_chsein_work((C.int)(rowMajor), (C.char)(job), (C.char)(eigsrc), (C.char)(initv), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_float)(_h), (C.lapack_int)(ldh), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_float)(_work), (*C.float)(_rwork), (*C.lapack_int)(_ifaill), (*C.lapack_int)(_ifailr)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhsein.f.
+func (Checked) Zhsein(job, eigsrc, initv byte, sel []Int, n int, h []complex128, ldh int, w, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []Int, work []complex128, rwork []float64, ifaill, ifailr []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _h *complex128
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _ifaill *Int
+	if len(ifaill) > 0 {
+		_ifaill = &ifaill[0]
+	}
+	var _ifailr *Int
+	if len(ifailr) > 0 {
+		_ifailr = &ifailr[0]
+	}
+	return newError("zhsein", C.LAPACKE_zhsein_work((C.int)(rowMajor), (C.char)(job), (C.char)(eigsrc), (C.char)(initv), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_double)(_h), (C.lapack_int)(ldh), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_double)(_work), (*C.double)(_rwork), (*C.lapack_int)(_ifaill), (*C.lapack_int)(_ifailr)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/shseqr.f.
+func (Checked) Shseqr(job, compz byte, n, ilo, ihi int, h []float32, ldh int, wr, wi, z []float32, ldz int, work []float32, lwork int) error {
+	var _h *float32
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("shseqr", C.LAPACKE_shseqr_work((C.int)(rowMajor), (C.char)(job), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_h), (C.lapack_int)(ldh), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dhseqr.f.
+func (Checked) Dhseqr(job, compz byte, n, ilo, ihi int, h []float64, ldh int, wr, wi, z []float64, ldz int, work []float64, lwork int) error {
+	var _h *float64
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dhseqr", C.LAPACKE_dhseqr_work((C.int)(rowMajor), (C.char)(job), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_h), (C.lapack_int)(ldh), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chseqr.f.
+func (Checked) Chseqr(job, compz byte, n, ilo, ihi int, h []complex64, ldh int, w, z []complex64, ldz int, work []complex64, lwork int) error {
+	var _h *complex64
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chseqr", C.LAPACKE_chseqr_work((C.int)(rowMajor), (C.char)(job), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_float)(_h), (C.lapack_int)(ldh), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhseqr.f.
+func (Checked) Zhseqr(job, compz byte, n, ilo, ihi int, h []complex128, ldh int, w, z []complex128, ldz int, work []complex128, lwork int) error {
+	var _h *complex128
+	if len(h) > 0 {
+		_h = &h[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhseqr", C.LAPACKE_zhseqr_work((C.int)(rowMajor), (C.char)(job), (C.char)(compz), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_double)(_h), (C.lapack_int)(ldh), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clacgv.f.
+func (Checked) Clacgv(n int, x []complex64, incx int) error {
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return newError("clacgv", C.LAPACKE_clacgv_work((C.lapack_int)(n), (*C.lapack_complex_float)(_x), (C.lapack_int)(incx)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlacgv.f.
+func (Checked) Zlacgv(n int, x []complex128, incx int) error {
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return newError("zlacgv", C.LAPACKE_zlacgv_work((C.lapack_int)(n), (*C.lapack_complex_double)(_x), (C.lapack_int)(incx)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slacn2.f.
+func (Checked) Slacn2(n int, v, x []float32, isgn []Int, est []float32, kase, isave []Int) error {
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _isgn *Int
+	if len(isgn) > 0 {
+		_isgn = &isgn[0]
+	}
+	var _est *float32
+	if len(est) > 0 {
+		_est = &est[0]
+	}
+	var _kase *Int
+	if len(kase) > 0 {
+		_kase = &kase[0]
+	}
+	var _isave *Int
+	if len(isave) > 0 {
+		_isave = &isave[0]
+	}
+	return newError("slacn2", C.LAPACKE_slacn2_work((C.lapack_int)(n), (*C.float)(_v), (*C.float)(_x), (*C.lapack_int)(_isgn), (*C.float)(_est), (*C.lapack_int)(_kase), (*C.lapack_int)(_isave)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlacn2.f.
+func (Checked) Dlacn2(n int, v, x []float64, isgn []Int, est []float64, kase, isave []Int) error {
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _isgn *Int
+	if len(isgn) > 0 {
+		_isgn = &isgn[0]
+	}
+	var _est *float64
+	if len(est) > 0 {
+		_est = &est[0]
+	}
+	var _kase *Int
+	if len(kase) > 0 {
+		_kase = &kase[0]
+	}
+	var _isave *Int
+	if len(isave) > 0 {
+		_isave = &isave[0]
+	}
+	return newError("dlacn2", C.LAPACKE_dlacn2_work((C.lapack_int)(n), (*C.double)(_v), (*C.double)(_x), (*C.lapack_int)(_isgn), (*C.double)(_est), (*C.lapack_int)(_kase), (*C.lapack_int)(_isave)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clacn2.f.
+func (Checked) Clacn2(n int, v, x []complex64, est []float32, kase, isave []Int) error {
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _est *float32
+	if len(est) > 0 {
+		_est = &est[0]
+	}
+	var _kase *Int
+	if len(kase) > 0 {
+		_kase = &kase[0]
+	}
+	var _isave *Int
+	if len(isave) > 0 {
+		_isave = &isave[0]
+	}
+	return newError("clacn2", C.LAPACKE_clacn2_work((C.lapack_int)(n), (*C.lapack_complex_float)(_v), (*C.lapack_complex_float)(_x), (*C.float)(_est), (*C.lapack_int)(_kase), (*C.lapack_int)(_isave)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlacn2.f.
+func (Checked) Zlacn2(n int, v, x []complex128, est []float64, kase, isave []Int) error {
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _est *float64
+	if len(est) > 0 {
+		_est = &est[0]
+	}
+	var _kase *Int
+	if len(kase) > 0 {
+		_kase = &kase[0]
+	}
+	var _isave *Int
+	if len(isave) > 0 {
+		_isave = &isave[0]
+	}
+	return newError("zlacn2", C.LAPACKE_zlacn2_work((C.lapack_int)(n), (*C.lapack_complex_double)(_v), (*C.lapack_complex_double)(_x), (*C.double)(_est), (*C.lapack_int)(_kase), (*C.lapack_int)(_isave)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slacpy.f.
+func (Checked) Slacpy(ul byte, m, n int, a []float32, lda int, b []float32, ldb int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("slacpy", C.LAPACKE_slacpy_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlacpy.f.
+func (Checked) Dlacpy(ul byte, m, n int, a []float64, lda int, b []float64, ldb int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dlacpy", C.LAPACKE_dlacpy_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clacpy.f.
+func (Checked) Clacpy(ul byte, m, n int, a []complex64, lda int, b []complex64, ldb int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("clacpy", C.LAPACKE_clacpy_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlacpy.f.
+func (Checked) Zlacpy(ul byte, m, n int, a []complex128, lda int, b []complex128, ldb int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zlacpy", C.LAPACKE_zlacpy_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clacp2.f.
+func (Checked) Clacp2(ul byte, m, n int, a []float32, lda int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("clacp2", C.LAPACKE_clacp2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlacp2.f.
+func (Checked) Zlacp2(ul byte, m, n int, a []float64, lda int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zlacp2", C.LAPACKE_zlacp2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlag2c.f.
+func (Checked) Zlag2c(m, n int, a []complex128, lda int, sa []complex64, ldsa int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sa *complex64
+	if len(sa) > 0 {
+		_sa = &sa[0]
+	}
+	return newError("zlag2c", C.LAPACKE_zlag2c_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_sa), (C.lapack_int)(ldsa)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slag2d.f.
+func (Checked) Slag2d(m, n int, sa []float32, ldsa int, a []float64, lda int) error {
+	var _sa *float32
+	if len(sa) > 0 {
+		_sa = &sa[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("slag2d", C.LAPACKE_slag2d_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_sa), (C.lapack_int)(ldsa), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlag2s.f.
+func (Checked) Dlag2s(m, n int, a []float64, lda int, sa []float32, ldsa int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sa *float32
+	if len(sa) > 0 {
+		_sa = &sa[0]
+	}
+	return newError("dlag2s", C.LAPACKE_dlag2s_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.float)(_sa), (C.lapack_int)(ldsa)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clag2z.f.
+func (Checked) Clag2z(m, n int, sa []complex64, ldsa int, a []complex128, lda int) error {
+	var _sa *complex64
+	if len(sa) > 0 {
+		_sa = &sa[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("clag2z", C.LAPACKE_clag2z_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_sa), (C.lapack_int)(ldsa), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slagge.f.
+func (Checked) Slagge(m, n, kl, ku int, d, a []float32, lda int, iseed []Int, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("slagge", C.LAPACKE_slagge_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.float)(_d), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlagge.f.
+func (Checked) Dlagge(m, n, kl, ku int, d, a []float64, lda int, iseed []Int, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dlagge", C.LAPACKE_dlagge_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.double)(_d), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clagge.f.
+func (Checked) Clagge(m, n, kl, ku int, d []float32, a []complex64, lda int, iseed []Int, work []complex64) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("clagge", C.LAPACKE_clagge_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.float)(_d), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlagge.f.
+func (Checked) Zlagge(m, n, kl, ku int, d []float64, a []complex128, lda int, iseed []Int, work []complex128) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zlagge", C.LAPACKE_zlagge_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(kl), (C.lapack_int)(ku), (*C.double)(_d), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/claghe.f.
+func (Checked) Claghe(n, k int, d []float32, a []complex64, lda int, iseed []Int, work []complex64) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("claghe", C.LAPACKE_claghe_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_d), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlaghe.f.
+func (Checked) Zlaghe(n, k int, d []float64, a []complex128, lda int, iseed []Int, work []complex128) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zlaghe", C.LAPACKE_zlaghe_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_d), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slagsy.f.
+func (Checked) Slagsy(n, k int, d, a []float32, lda int, iseed []Int, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("slagsy", C.LAPACKE_slagsy_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_d), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlagsy.f.
+func (Checked) Dlagsy(n, k int, d, a []float64, lda int, iseed []Int, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dlagsy", C.LAPACKE_dlagsy_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_d), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clagsy.f.
+func (Checked) Clagsy(n, k int, d []float32, a []complex64, lda int, iseed []Int, work []complex64) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("clagsy", C.LAPACKE_clagsy_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_d), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlagsy.f.
+func (Checked) Zlagsy(n, k int, d []float64, a []complex128, lda int, iseed []Int, work []complex128) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zlagsy", C.LAPACKE_zlagsy_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_d), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_iseed), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slapmr.f.
+func (Checked) Slapmr(forwrd Int, m, n int, x []float32, ldx int, k []Int) error {
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("slapmr", C.LAPACKE_slapmr_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlapmr.f.
+func (Checked) Dlapmr(forwrd Int, m, n int, x []float64, ldx int, k []Int) error {
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("dlapmr", C.LAPACKE_dlapmr_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clapmr.f.
+func (Checked) Clapmr(forwrd Int, m, n int, x []complex64, ldx int, k []Int) error {
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("clapmr", C.LAPACKE_clapmr_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlapmr.f.
+func (Checked) Zlapmr(forwrd Int, m, n int, x []complex128, ldx int, k []Int) error {
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("zlapmr", C.LAPACKE_zlapmr_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slapmt.f.
+func (Checked) Slapmt(forwrd Int, m, n int, x []float32, ldx int, k []Int) error {
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("slapmt", C.LAPACKE_slapmt_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlapmt.f.
+func (Checked) Dlapmt(forwrd Int, m, n int, x []float64, ldx int, k []Int) error {
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("dlapmt", C.LAPACKE_dlapmt_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clapmt.f.
+func (Checked) Clapmt(forwrd Int, m, n int, x []complex64, ldx int, k []Int) error {
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("clapmt", C.LAPACKE_clapmt_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlapmt.f.
+func (Checked) Zlapmt(forwrd Int, m, n int, x []complex128, ldx int, k []Int) error {
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _k *Int
+	if len(k) > 0 {
+		_k = &k[0]
+	}
+	return newError("zlapmt", C.LAPACKE_zlapmt_work((C.int)(rowMajor), (C.lapack_logical)(forwrd), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.lapack_int)(_k)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slartgp.f.
+func (Checked) Slartgp(f, g float32, cs, sn, r []float32) error {
+	var _cs *float32
+	if len(cs) > 0 {
+		_cs = &cs[0]
+	}
+	var _sn *float32
+	if len(sn) > 0 {
+		_sn = &sn[0]
+	}
+	var _r *float32
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	return newError("slartgp", C.LAPACKE_slartgp_work((C.float)(f), (C.float)(g), (*C.float)(_cs), (*C.float)(_sn), (*C.float)(_r)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlartgp.f.
+func (Checked) Dlartgp(f, g float64, cs, sn, r []float64) error {
+	var _cs *float64
+	if len(cs) > 0 {
+		_cs = &cs[0]
+	}
+	var _sn *float64
+	if len(sn) > 0 {
+		_sn = &sn[0]
+	}
+	var _r *float64
+	if len(r) > 0 {
+		_r = &r[0]
+	}
+	return newError("dlartgp", C.LAPACKE_dlartgp_work((C.double)(f), (C.double)(g), (*C.double)(_cs), (*C.double)(_sn), (*C.double)(_r)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slartgs.f.
+func (Checked) Slartgs(x, y, sigma float32, cs, sn []float32) error {
+	var _cs *float32
+	if len(cs) > 0 {
+		_cs = &cs[0]
+	}
+	var _sn *float32
+	if len(sn) > 0 {
+		_sn = &sn[0]
+	}
+	return newError("slartgs", C.LAPACKE_slartgs_work((C.float)(x), (C.float)(y), (C.float)(sigma), (*C.float)(_cs), (*C.float)(_sn)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlartgs.f.
+func (Checked) Dlartgs(x, y, sigma float64, cs, sn []float64) error {
+	var _cs *float64
+	if len(cs) > 0 {
+		_cs = &cs[0]
+	}
+	var _sn *float64
+	if len(sn) > 0 {
+		_sn = &sn[0]
+	}
+	return newError("dlartgs", C.LAPACKE_dlartgs_work((C.double)(x), (C.double)(y), (C.double)(sigma), (*C.double)(_cs), (*C.double)(_sn)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slarfb.f.
+func (Checked) Slarfb(side, trans, direct, storev byte, m, n, k int, v []float32, ldv int, t []float32, ldt int, c []float32, ldc int, work []float32, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("slarfb", C.LAPACKE_slarfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlarfb.f.
+func (Checked) Dlarfb(side, trans, direct, storev byte, m, n, k int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dlarfb", C.LAPACKE_dlarfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clarfb.f.
+func (Checked) Clarfb(side, trans, direct, storev byte, m, n, k int, v []complex64, ldv int, t []complex64, ldt int, c []complex64, ldc int, work []complex64, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("clarfb", C.LAPACKE_clarfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlarfb.f.
+func (Checked) Zlarfb(side, trans, direct, storev byte, m, n, k int, v []complex128, ldv int, t []complex128, ldt int, c []complex128, ldc int, work []complex128, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zlarfb", C.LAPACKE_zlarfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slarfg.f.
+func (Checked) Slarfg(n int, alpha, x []float32, incx int, tau []float32) error {
+	var _alpha *float32
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("slarfg", C.LAPACKE_slarfg_work((C.lapack_int)(n), (*C.float)(_alpha), (*C.float)(_x), (C.lapack_int)(incx), (*C.float)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlarfg.f.
+func (Checked) Dlarfg(n int, alpha, x []float64, incx int, tau []float64) error {
+	var _alpha *float64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("dlarfg", C.LAPACKE_dlarfg_work((C.lapack_int)(n), (*C.double)(_alpha), (*C.double)(_x), (C.lapack_int)(incx), (*C.double)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clarfg.f.
+func (Checked) Clarfg(n int, alpha, x []complex64, incx int, tau []complex64) error {
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("clarfg", C.LAPACKE_clarfg_work((C.lapack_int)(n), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_x), (C.lapack_int)(incx), (*C.lapack_complex_float)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlarfg.f.
+func (Checked) Zlarfg(n int, alpha, x []complex128, incx int, tau []complex128) error {
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("zlarfg", C.LAPACKE_zlarfg_work((C.lapack_int)(n), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_x), (C.lapack_int)(incx), (*C.lapack_complex_double)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slarft.f.
+func (Checked) Slarft(direct, storev byte, n, k int, v []float32, ldv int, tau, t []float32, ldt int) error {
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("slarft", C.LAPACKE_slarft_work((C.int)(rowMajor), (C.char)(direct), (C.char)(storev), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_tau), (*C.float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlarft.f.
+func (Checked) Dlarft(direct, storev byte, n, k int, v []float64, ldv int, tau, t []float64, ldt int) error {
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("dlarft", C.LAPACKE_dlarft_work((C.int)(rowMajor), (C.char)(direct), (C.char)(storev), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_tau), (*C.double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clarft.f.
+func (Checked) Clarft(direct, storev byte, n, k int, v []complex64, ldv int, tau, t []complex64, ldt int) error {
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("clarft", C.LAPACKE_clarft_work((C.int)(rowMajor), (C.char)(direct), (C.char)(storev), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlarft.f.
+func (Checked) Zlarft(direct, storev byte, n, k int, v []complex128, ldv int, tau, t []complex128, ldt int) error {
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("zlarft", C.LAPACKE_zlarft_work((C.int)(rowMajor), (C.char)(direct), (C.char)(storev), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slarfx.f.
+func (Checked) Slarfx(side byte, m, n int, v []float32, tau float32, c []float32, ldc int, work []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("slarfx", C.LAPACKE_slarfx_work((C.int)(rowMajor), (C.char)(side), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_v), (C.float)(tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlarfx.f.
+func (Checked) Dlarfx(side byte, m, n int, v []float64, tau float64, c []float64, ldc int, work []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dlarfx", C.LAPACKE_dlarfx_work((C.int)(rowMajor), (C.char)(side), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_v), (C.double)(tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clarfx.f.
+func (Checked) Clarfx(side byte, m, n int, v []complex64, tau complex64, c []complex64, ldc int, work []complex64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("clarfx", C.LAPACKE_clarfx_work((C.int)(rowMajor), (C.char)(side), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_v), (C.lapack_complex_float)(tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlarfx.f.
+func (Checked) Zlarfx(side byte, m, n int, v []complex128, tau complex128, c []complex128, ldc int, work []complex128) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zlarfx", C.LAPACKE_zlarfx_work((C.int)(rowMajor), (C.char)(side), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_v), (C.lapack_complex_double)(tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slarnv.f.
+func (Checked) Slarnv(idist int, iseed []Int, n int, x []float32) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return newError("slarnv", C.LAPACKE_slarnv_work((C.lapack_int)(idist), (*C.lapack_int)(_iseed), (C.lapack_int)(n), (*C.float)(_x)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlarnv.f.
+func (Checked) Dlarnv(idist int, iseed []Int, n int, x []float64) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return newError("dlarnv", C.LAPACKE_dlarnv_work((C.lapack_int)(idist), (*C.lapack_int)(_iseed), (C.lapack_int)(n), (*C.double)(_x)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clarnv.f.
+func (Checked) Clarnv(idist int, iseed []Int, n int, x []complex64) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return newError("clarnv", C.LAPACKE_clarnv_work((C.lapack_int)(idist), (*C.lapack_int)(_iseed), (C.lapack_int)(n), (*C.lapack_complex_float)(_x)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlarnv.f.
+func (Checked) Zlarnv(idist int, iseed []Int, n int, x []complex128) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return newError("zlarnv", C.LAPACKE_zlarnv_work((C.lapack_int)(idist), (*C.lapack_int)(_iseed), (C.lapack_int)(n), (*C.lapack_complex_double)(_x)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slascl.f.
+func (Checked) Slascl(typ byte, kl, ku int, cfrom, cto float32, m, n int, a []float32, lda int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("slascl", C.LAPACKE_slascl_work((C.int)(rowMajor), (C.char)(typ), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.float)(cfrom), (C.float)(cto), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlascl.f.
+func (Checked) Dlascl(typ byte, kl, ku int, cfrom, cto float64, m, n int, a []float64, lda int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dlascl", C.LAPACKE_dlascl_work((C.int)(rowMajor), (C.char)(typ), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.double)(cfrom), (C.double)(cto), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clascl.f.
+func (Checked) Clascl(typ byte, kl, ku int, cfrom, cto float32, m, n int, a []complex64, lda int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("clascl", C.LAPACKE_clascl_work((C.int)(rowMajor), (C.char)(typ), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.float)(cfrom), (C.float)(cto), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlascl.f.
+func (Checked) Zlascl(typ byte, kl, ku int, cfrom, cto float64, m, n int, a []complex128, lda int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zlascl", C.LAPACKE_zlascl_work((C.int)(rowMajor), (C.char)(typ), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.double)(cfrom), (C.double)(cto), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slaset.f.
+func (Checked) Slaset(ul byte, m, n int, alpha, beta float32, a []float32, lda int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("slaset", C.LAPACKE_slaset_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (C.float)(alpha), (C.float)(beta), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlaset.f.
+func (Checked) Dlaset(ul byte, m, n int, alpha, beta float64, a []float64, lda int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dlaset", C.LAPACKE_dlaset_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (C.double)(alpha), (C.double)(beta), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/claset.f.
+func (Checked) Claset(ul byte, m, n int, alpha, beta complex64, a []complex64, lda int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("claset", C.LAPACKE_claset_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_complex_float)(alpha), (C.lapack_complex_float)(beta), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlaset.f.
+func (Checked) Zlaset(ul byte, m, n int, alpha, beta complex128, a []complex128, lda int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zlaset", C.LAPACKE_zlaset_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_complex_double)(alpha), (C.lapack_complex_double)(beta), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slasrt.f.
+func (Checked) Slasrt(id byte, n int, d []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	return newError("slasrt", C.LAPACKE_slasrt_work((C.char)(id), (C.lapack_int)(n), (*C.float)(_d)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlasrt.f.
+func (Checked) Dlasrt(id byte, n int, d []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	return newError("dlasrt", C.LAPACKE_dlasrt_work((C.char)(id), (C.lapack_int)(n), (*C.double)(_d)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slaswp.f.
+func (Checked) Slaswp(n int, a []float32, lda, k1, k2 int, ipiv []Int, incx int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("slaswp", C.LAPACKE_slaswp_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (C.lapack_int)(k1), (C.lapack_int)(k2), (*C.lapack_int)(_ipiv), (C.lapack_int)(incx)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlaswp.f.
+func (Checked) Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []Int, incx int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("dlaswp", C.LAPACKE_dlaswp_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (C.lapack_int)(k1), (C.lapack_int)(k2), (*C.lapack_int)(_ipiv), (C.lapack_int)(incx)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/claswp.f.
+func (Checked) Claswp(n int, a []complex64, lda, k1, k2 int, ipiv []Int, incx int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("claswp", C.LAPACKE_claswp_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (C.lapack_int)(k1), (C.lapack_int)(k2), (*C.lapack_int)(_ipiv), (C.lapack_int)(incx)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlaswp.f.
+func (Checked) Zlaswp(n int, a []complex128, lda, k1, k2 int, ipiv []Int, incx int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zlaswp", C.LAPACKE_zlaswp_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (C.lapack_int)(k1), (C.lapack_int)(k2), (*C.lapack_int)(_ipiv), (C.lapack_int)(incx)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slatms.f.
+func (Checked) Slatms(m, n int, dist byte, iseed []Int, sym byte, d []float32, mode int, cond, dmax float32, kl, ku int, pack byte, a []float32, lda int, work []float32) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("slatms", C.LAPACKE_slatms_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.char)(dist), (*C.lapack_int)(_iseed), (C.char)(sym), (*C.float)(_d), (C.lapack_int)(mode), (C.float)(cond), (C.float)(dmax), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.char)(pack), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlatms.f.
+func (Checked) Dlatms(m, n int, dist byte, iseed []Int, sym byte, d []float64, mode int, cond, dmax float64, kl, ku int, pack byte, a []float64, lda int, work []float64) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dlatms", C.LAPACKE_dlatms_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.char)(dist), (*C.lapack_int)(_iseed), (C.char)(sym), (*C.double)(_d), (C.lapack_int)(mode), (C.double)(cond), (C.double)(dmax), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.char)(pack), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clatms.f.
+func (Checked) Clatms(m, n int, dist byte, iseed []Int, sym byte, d []float32, mode int, cond, dmax float32, kl, ku int, pack byte, a []complex64, lda int, work []complex64) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("clatms", C.LAPACKE_clatms_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.char)(dist), (*C.lapack_int)(_iseed), (C.char)(sym), (*C.float)(_d), (C.lapack_int)(mode), (C.float)(cond), (C.float)(dmax), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.char)(pack), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlatms.f.
+func (Checked) Zlatms(m, n int, dist byte, iseed []Int, sym byte, d []float64, mode int, cond, dmax float64, kl, ku int, pack byte, a []complex128, lda int, work []complex128) error {
+	var _iseed *Int
+	if len(iseed) > 0 {
+		_iseed = &iseed[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zlatms", C.LAPACKE_zlatms_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.char)(dist), (*C.lapack_int)(_iseed), (C.char)(sym), (*C.double)(_d), (C.lapack_int)(mode), (C.double)(cond), (C.double)(dmax), (C.lapack_int)(kl), (C.lapack_int)(ku), (C.char)(pack), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slauum.f.
+func (Checked) Slauum(ul byte, n int, a []float32, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("slauum", C.LAPACKE_slauum_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlauum.f.
+func (Checked) Dlauum(ul byte, n int, a []float64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dlauum", C.LAPACKE_dlauum_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clauum.f.
+func (Checked) Clauum(ul byte, n int, a []complex64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("clauum", C.LAPACKE_clauum_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlauum.f.
+func (Checked) Zlauum(ul byte, n int, a []complex128, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zlauum", C.LAPACKE_zlauum_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sopgtr.f.
+func (Checked) Sopgtr(ul byte, n int, ap, tau, q []float32, ldq int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sopgtr", C.LAPACKE_sopgtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_tau), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dopgtr.f.
+func (Checked) Dopgtr(ul byte, n int, ap, tau, q []float64, ldq int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dopgtr", C.LAPACKE_dopgtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_tau), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sopmtr.f.
+func (Checked) Sopmtr(side, ul, trans byte, m, n int, ap, tau, c []float32, ldc int, work []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sopmtr", C.LAPACKE_sopmtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dopmtr.f.
+func (Checked) Dopmtr(side, ul, trans byte, m, n int, ap, tau, c []float64, ldc int, work []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dopmtr", C.LAPACKE_dopmtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorgbr.f.
+func (Checked) Sorgbr(vect byte, m, n, k int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorgbr", C.LAPACKE_sorgbr_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorgbr.f.
+func (Checked) Dorgbr(vect byte, m, n, k int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorgbr", C.LAPACKE_dorgbr_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorghr.f.
+func (Checked) Sorghr(n, ilo, ihi int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorghr", C.LAPACKE_sorghr_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorghr.f.
+func (Checked) Dorghr(n, ilo, ihi int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorghr", C.LAPACKE_dorghr_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorglq.f.
+func (Checked) Sorglq(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorglq", C.LAPACKE_sorglq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorglq.f.
+func (Checked) Dorglq(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorglq", C.LAPACKE_dorglq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorgql.f.
+func (Checked) Sorgql(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorgql", C.LAPACKE_sorgql_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorgql.f.
+func (Checked) Dorgql(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorgql", C.LAPACKE_dorgql_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorgqr.f.
+func (Checked) Sorgqr(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorgqr", C.LAPACKE_sorgqr_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorgqr.f.
+func (Checked) Dorgqr(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorgqr", C.LAPACKE_dorgqr_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorgrq.f.
+func (Checked) Sorgrq(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorgrq", C.LAPACKE_sorgrq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorgrq.f.
+func (Checked) Dorgrq(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorgrq", C.LAPACKE_dorgrq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorgtr.f.
+func (Checked) Sorgtr(ul byte, n int, a []float32, lda int, tau, work []float32, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorgtr", C.LAPACKE_sorgtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorgtr.f.
+func (Checked) Dorgtr(ul byte, n int, a []float64, lda int, tau, work []float64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorgtr", C.LAPACKE_dorgtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormbr.f.
+func (Checked) Sormbr(vect, side, trans byte, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormbr", C.LAPACKE_sormbr_work((C.int)(rowMajor), (C.char)(vect), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormbr.f.
+func (Checked) Dormbr(vect, side, trans byte, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormbr", C.LAPACKE_dormbr_work((C.int)(rowMajor), (C.char)(vect), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormhr.f.
+func (Checked) Sormhr(side, trans byte, m, n, ilo, ihi int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormhr", C.LAPACKE_sormhr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormhr.f.
+func (Checked) Dormhr(side, trans byte, m, n, ilo, ihi int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormhr", C.LAPACKE_dormhr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormlq.f.
+func (Checked) Sormlq(side, trans byte, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormlq", C.LAPACKE_sormlq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormlq.f.
+func (Checked) Dormlq(side, trans byte, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormlq", C.LAPACKE_dormlq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormql.f.
+func (Checked) Sormql(side, trans byte, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormql", C.LAPACKE_sormql_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormql.f.
+func (Checked) Dormql(side, trans byte, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormql", C.LAPACKE_dormql_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormqr.f.
+func (Checked) Sormqr(side, trans byte, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormqr", C.LAPACKE_sormqr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormqr.f.
+func (Checked) Dormqr(side, trans byte, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormqr", C.LAPACKE_dormqr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormrq.f.
+func (Checked) Sormrq(side, trans byte, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormrq", C.LAPACKE_sormrq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormrq.f.
+func (Checked) Dormrq(side, trans byte, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormrq", C.LAPACKE_dormrq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormrz.f.
+func (Checked) Sormrz(side, trans byte, m, n, k, l int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormrz", C.LAPACKE_sormrz_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormrz.f.
+func (Checked) Dormrz(side, trans byte, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormrz", C.LAPACKE_dormrz_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sormtr.f.
+func (Checked) Sormtr(side, ul, trans byte, m, n int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sormtr", C.LAPACKE_sormtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dormtr.f.
+func (Checked) Dormtr(side, ul, trans byte, m, n int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dormtr", C.LAPACKE_dormtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbcon.f.
+func (Checked) Spbcon(ul byte, n, kd int, ab []float32, ldab int, anorm float32, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("spbcon", C.LAPACKE_spbcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbcon.f.
+func (Checked) Dpbcon(ul byte, n, kd int, ab []float64, ldab int, anorm float64, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dpbcon", C.LAPACKE_dpbcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbcon.f.
+func (Checked) Cpbcon(ul byte, n, kd int, ab []complex64, ldab int, anorm float32, rcond []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cpbcon", C.LAPACKE_cpbcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbcon.f.
+func (Checked) Zpbcon(ul byte, n, kd int, ab []complex128, ldab int, anorm float64, rcond []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zpbcon", C.LAPACKE_zpbcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbequ.f.
+func (Checked) Spbequ(ul byte, n, kd int, ab []float32, ldab int, s, scond, amax []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("spbequ", C.LAPACKE_spbequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbequ.f.
+func (Checked) Dpbequ(ul byte, n, kd int, ab []float64, ldab int, s, scond, amax []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dpbequ", C.LAPACKE_dpbequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbequ.f.
+func (Checked) Cpbequ(ul byte, n, kd int, ab []complex64, ldab int, s, scond, amax []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cpbequ", C.LAPACKE_cpbequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbequ.f.
+func (Checked) Zpbequ(ul byte, n, kd int, ab []complex128, ldab int, s, scond, amax []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zpbequ", C.LAPACKE_zpbequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbrfs.f.
+func (Checked) Spbrfs(ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float32
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("spbrfs", C.LAPACKE_spbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_afb), (C.lapack_int)(ldafb), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbrfs.f.
+func (Checked) Dpbrfs(ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dpbrfs", C.LAPACKE_dpbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_afb), (C.lapack_int)(ldafb), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbrfs.f.
+func (Checked) Cpbrfs(ul byte, n, kd, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cpbrfs", C.LAPACKE_cpbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_afb), (C.lapack_int)(ldafb), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbrfs.f.
+func (Checked) Zpbrfs(ul byte, n, kd, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex128
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zpbrfs", C.LAPACKE_zpbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_afb), (C.lapack_int)(ldafb), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbstf.f.
+func (Checked) Spbstf(ul byte, n, kb int, bb []float32, ldbb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _bb *float32
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	return newError("spbstf", C.LAPACKE_spbstf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kb), (*C.float)(_bb), (C.lapack_int)(ldbb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbstf.f.
+func (Checked) Dpbstf(ul byte, n, kb int, bb []float64, ldbb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _bb *float64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	return newError("dpbstf", C.LAPACKE_dpbstf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kb), (*C.double)(_bb), (C.lapack_int)(ldbb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbstf.f.
+func (Checked) Cpbstf(ul byte, n, kb int, bb []complex64, ldbb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _bb *complex64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	return newError("cpbstf", C.LAPACKE_cpbstf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kb), (*C.lapack_complex_float)(_bb), (C.lapack_int)(ldbb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbstf.f.
+func (Checked) Zpbstf(ul byte, n, kb int, bb []complex128, ldbb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _bb *complex128
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	return newError("zpbstf", C.LAPACKE_zpbstf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kb), (*C.lapack_complex_double)(_bb), (C.lapack_int)(ldbb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbsv.f.
+func (Checked) Spbsv(ul byte, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("spbsv", C.LAPACKE_spbsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbsv.f.
+func (Checked) Dpbsv(ul byte, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dpbsv", C.LAPACKE_dpbsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbsv.f.
+func (Checked) Cpbsv(ul byte, n, kd, nrhs int, ab []complex64, ldab int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cpbsv", C.LAPACKE_cpbsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbsv.f.
+func (Checked) Zpbsv(ul byte, n, kd, nrhs int, ab []complex128, ldab int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zpbsv", C.LAPACKE_zpbsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbsvx.f.
+func (Checked) Spbsvx(fact, ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float32
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("spbsvx", C.LAPACKE_spbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_afb), (C.lapack_int)(ldafb), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_s), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbsvx.f.
+func (Checked) Dpbsvx(fact, ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *float64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dpbsvx", C.LAPACKE_dpbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_afb), (C.lapack_int)(ldafb), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_s), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbsvx.f.
+func (Checked) Cpbsvx(fact, ul byte, n, kd, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, equed []byte, s []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex64
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cpbsvx", C.LAPACKE_cpbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_afb), (C.lapack_int)(ldafb), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_s), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbsvx.f.
+func (Checked) Zpbsvx(fact, ul byte, n, kd, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, equed []byte, s []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _afb *complex128
+	if len(afb) > 0 {
+		_afb = &afb[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zpbsvx", C.LAPACKE_zpbsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_afb), (C.lapack_int)(ldafb), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_s), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbtrf.f.
+func (Checked) Spbtrf(ul byte, n, kd int, ab []float32, ldab int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	return newError("spbtrf", C.LAPACKE_spbtrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbtrf.f.
+func (Checked) Dpbtrf(ul byte, n, kd int, ab []float64, ldab int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	return newError("dpbtrf", C.LAPACKE_dpbtrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbtrf.f.
+func (Checked) Cpbtrf(ul byte, n, kd int, ab []complex64, ldab int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	return newError("cpbtrf", C.LAPACKE_cpbtrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbtrf.f.
+func (Checked) Zpbtrf(ul byte, n, kd int, ab []complex128, ldab int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	return newError("zpbtrf", C.LAPACKE_zpbtrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbtrs.f.
+func (Checked) Spbtrs(ul byte, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("spbtrs", C.LAPACKE_spbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbtrs.f.
+func (Checked) Dpbtrs(ul byte, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dpbtrs", C.LAPACKE_dpbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpbtrs.f.
+func (Checked) Cpbtrs(ul byte, n, kd, nrhs int, ab []complex64, ldab int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cpbtrs", C.LAPACKE_cpbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpbtrs.f.
+func (Checked) Zpbtrs(ul byte, n, kd, nrhs int, ab []complex128, ldab int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zpbtrs", C.LAPACKE_zpbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spftrf.f.
+func (Checked) Spftrf(transr, ul byte, n int, a []float32) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("spftrf", C.LAPACKE_spftrf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpftrf.f.
+func (Checked) Dpftrf(transr, ul byte, n int, a []float64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dpftrf", C.LAPACKE_dpftrf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpftrf.f.
+func (Checked) Cpftrf(transr, ul byte, n int, a []complex64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("cpftrf", C.LAPACKE_cpftrf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpftrf.f.
+func (Checked) Zpftrf(transr, ul byte, n int, a []complex128) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zpftrf", C.LAPACKE_zpftrf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spftri.f.
+func (Checked) Spftri(transr, ul byte, n int, a []float32) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("spftri", C.LAPACKE_spftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpftri.f.
+func (Checked) Dpftri(transr, ul byte, n int, a []float64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dpftri", C.LAPACKE_dpftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpftri.f.
+func (Checked) Cpftri(transr, ul byte, n int, a []complex64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("cpftri", C.LAPACKE_cpftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpftri.f.
+func (Checked) Zpftri(transr, ul byte, n int, a []complex128) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zpftri", C.LAPACKE_zpftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spftrs.f.
+func (Checked) Spftrs(transr, ul byte, n, nrhs int, a, b []float32, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("spftrs", C.LAPACKE_spftrs_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpftrs.f.
+func (Checked) Dpftrs(transr, ul byte, n, nrhs int, a, b []float64, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dpftrs", C.LAPACKE_dpftrs_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpftrs.f.
+func (Checked) Cpftrs(transr, ul byte, n, nrhs int, a, b []complex64, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cpftrs", C.LAPACKE_cpftrs_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpftrs.f.
+func (Checked) Zpftrs(transr, ul byte, n, nrhs int, a, b []complex128, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zpftrs", C.LAPACKE_zpftrs_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spocon.f.
+func (Checked) Spocon(ul byte, n int, a []float32, lda int, anorm float32, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("spocon", C.LAPACKE_spocon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpocon.f.
+func (Checked) Dpocon(ul byte, n int, a []float64, lda int, anorm float64, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dpocon", C.LAPACKE_dpocon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpocon.f.
+func (Checked) Cpocon(ul byte, n int, a []complex64, lda int, anorm float32, rcond []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cpocon", C.LAPACKE_cpocon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpocon.f.
+func (Checked) Zpocon(ul byte, n int, a []complex128, lda int, anorm float64, rcond []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zpocon", C.LAPACKE_zpocon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spoequ.f.
+func (Checked) Spoequ(n int, a []float32, lda int, s, scond, amax []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("spoequ", C.LAPACKE_spoequ_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpoequ.f.
+func (Checked) Dpoequ(n int, a []float64, lda int, s, scond, amax []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dpoequ", C.LAPACKE_dpoequ_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpoequ.f.
+func (Checked) Cpoequ(n int, a []complex64, lda int, s, scond, amax []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cpoequ", C.LAPACKE_cpoequ_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpoequ.f.
+func (Checked) Zpoequ(n int, a []complex128, lda int, s, scond, amax []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zpoequ", C.LAPACKE_zpoequ_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spoequb.f.
+func (Checked) Spoequb(n int, a []float32, lda int, s, scond, amax []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("spoequb", C.LAPACKE_spoequb_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpoequb.f.
+func (Checked) Dpoequb(n int, a []float64, lda int, s, scond, amax []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dpoequb", C.LAPACKE_dpoequb_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpoequb.f.
+func (Checked) Cpoequb(n int, a []complex64, lda int, s, scond, amax []float32) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cpoequb", C.LAPACKE_cpoequb_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpoequb.f.
+func (Checked) Zpoequb(n int, a []complex128, lda int, s, scond, amax []float64) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zpoequb", C.LAPACKE_zpoequb_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sporfs.f.
+func (Checked) Sporfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float32
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sporfs", C.LAPACKE_sporfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_af), (C.lapack_int)(ldaf), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dporfs.f.
+func (Checked) Dporfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dporfs", C.LAPACKE_dporfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_af), (C.lapack_int)(ldaf), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cporfs.f.
+func (Checked) Cporfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cporfs", C.LAPACKE_cporfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zporfs.f.
+func (Checked) Zporfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zporfs", C.LAPACKE_zporfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sposv.f.
+func (Checked) Sposv(ul byte, n, nrhs int, a []float32, lda int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sposv", C.LAPACKE_sposv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dposv.f.
+func (Checked) Dposv(ul byte, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dposv", C.LAPACKE_dposv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cposv.f.
+func (Checked) Cposv(ul byte, n, nrhs int, a []complex64, lda int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cposv", C.LAPACKE_cposv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zposv.f.
+func (Checked) Zposv(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zposv", C.LAPACKE_zposv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsposv.f.
+func (Checked) Dsposv(ul byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iter []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _swork *float32
+	if len(swork) > 0 {
+		_swork = &swork[0]
+	}
+	var _iter *Int
+	if len(iter) > 0 {
+		_iter = &iter[0]
+	}
+	return newError("dsposv", C.LAPACKE_dsposv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_work), (*C.float)(_swork), (*C.lapack_int)(_iter)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zcposv.f.
+func (Checked) Zcposv(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, x []complex128, ldx int, work []complex128, swork []complex64, rwork []float64, iter []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _swork *complex64
+	if len(swork) > 0 {
+		_swork = &swork[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iter *Int
+	if len(iter) > 0 {
+		_iter = &iter[0]
+	}
+	return newError("zcposv", C.LAPACKE_zcposv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.lapack_complex_double)(_work), (*C.lapack_complex_float)(_swork), (*C.double)(_rwork), (*C.lapack_int)(_iter)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sposvx.f.
+func (Checked) Sposvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float32
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sposvx", C.LAPACKE_sposvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_af), (C.lapack_int)(ldaf), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_s), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dposvx.f.
+func (Checked) Dposvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dposvx", C.LAPACKE_dposvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_af), (C.lapack_int)(ldaf), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_s), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cposvx.f.
+func (Checked) Cposvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, equed []byte, s []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cposvx", C.LAPACKE_cposvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_s), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zposvx.f.
+func (Checked) Zposvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, equed []byte, s []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zposvx", C.LAPACKE_zposvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_s), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spotrf2.f.
+func (Checked) Spotrf2(ul byte, n int, a []float32, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("spotrf2", C.LAPACKE_spotrf2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpotrf2.f.
+func (Checked) Dpotrf2(ul byte, n int, a []float64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dpotrf2", C.LAPACKE_dpotrf2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpotrf2.f.
+func (Checked) Cpotrf2(ul byte, n int, a []complex64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("cpotrf2", C.LAPACKE_cpotrf2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpotrf2.f.
+func (Checked) Zpotrf2(ul byte, n int, a []complex128, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zpotrf2", C.LAPACKE_zpotrf2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spotrf.f.
+func (Checked) Spotrf(ul byte, n int, a []float32, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("spotrf", C.LAPACKE_spotrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpotrf.f.
+func (Checked) Dpotrf(ul byte, n int, a []float64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dpotrf", C.LAPACKE_dpotrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpotrf.f.
+func (Checked) Cpotrf(ul byte, n int, a []complex64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("cpotrf", C.LAPACKE_cpotrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpotrf.f.
+func (Checked) Zpotrf(ul byte, n int, a []complex128, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zpotrf", C.LAPACKE_zpotrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spotri.f.
+func (Checked) Spotri(ul byte, n int, a []float32, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("spotri", C.LAPACKE_spotri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpotri.f.
+func (Checked) Dpotri(ul byte, n int, a []float64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dpotri", C.LAPACKE_dpotri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpotri.f.
+func (Checked) Cpotri(ul byte, n int, a []complex64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("cpotri", C.LAPACKE_cpotri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpotri.f.
+func (Checked) Zpotri(ul byte, n int, a []complex128, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zpotri", C.LAPACKE_zpotri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spotrs.f.
+func (Checked) Spotrs(ul byte, n, nrhs int, a []float32, lda int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("spotrs", C.LAPACKE_spotrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpotrs.f.
+func (Checked) Dpotrs(ul byte, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dpotrs", C.LAPACKE_dpotrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpotrs.f.
+func (Checked) Cpotrs(ul byte, n, nrhs int, a []complex64, lda int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cpotrs", C.LAPACKE_cpotrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpotrs.f.
+func (Checked) Zpotrs(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zpotrs", C.LAPACKE_zpotrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sppcon.f.
+func (Checked) Sppcon(ul byte, n int, ap []float32, anorm float32, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sppcon", C.LAPACKE_sppcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dppcon.f.
+func (Checked) Dppcon(ul byte, n int, ap []float64, anorm float64, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dppcon", C.LAPACKE_dppcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cppcon.f.
+func (Checked) Cppcon(ul byte, n int, ap []complex64, anorm float32, rcond []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cppcon", C.LAPACKE_cppcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zppcon.f.
+func (Checked) Zppcon(ul byte, n int, ap []complex128, anorm float64, rcond []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zppcon", C.LAPACKE_zppcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sppequ.f.
+func (Checked) Sppequ(ul byte, n int, ap, s, scond, amax []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("sppequ", C.LAPACKE_sppequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dppequ.f.
+func (Checked) Dppequ(ul byte, n int, ap, s, scond, amax []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("dppequ", C.LAPACKE_dppequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cppequ.f.
+func (Checked) Cppequ(ul byte, n int, ap []complex64, s, scond, amax []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("cppequ", C.LAPACKE_cppequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zppequ.f.
+func (Checked) Zppequ(ul byte, n int, ap []complex128, s, scond, amax []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	return newError("zppequ", C.LAPACKE_zppequ_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spprfs.f.
+func (Checked) Spprfs(ul byte, n, nrhs int, ap, afp, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float32
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("spprfs", C.LAPACKE_spprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_afp), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpprfs.f.
+func (Checked) Dpprfs(ul byte, n, nrhs int, ap, afp, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dpprfs", C.LAPACKE_dpprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_afp), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpprfs.f.
+func (Checked) Cpprfs(ul byte, n, nrhs int, ap, afp, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cpprfs", C.LAPACKE_cpprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_afp), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpprfs.f.
+func (Checked) Zpprfs(ul byte, n, nrhs int, ap, afp, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex128
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zpprfs", C.LAPACKE_zpprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_afp), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sppsv.f.
+func (Checked) Sppsv(ul byte, n, nrhs int, ap, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sppsv", C.LAPACKE_sppsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dppsv.f.
+func (Checked) Dppsv(ul byte, n, nrhs int, ap, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dppsv", C.LAPACKE_dppsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cppsv.f.
+func (Checked) Cppsv(ul byte, n, nrhs int, ap, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cppsv", C.LAPACKE_cppsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zppsv.f.
+func (Checked) Zppsv(ul byte, n, nrhs int, ap, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zppsv", C.LAPACKE_zppsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sppsvx.f.
+func (Checked) Sppsvx(fact, ul byte, n, nrhs int, ap, afp []float32, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float32
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sppsvx", C.LAPACKE_sppsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_afp), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_s), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dppsvx.f.
+func (Checked) Dppsvx(fact, ul byte, n, nrhs int, ap, afp []float64, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dppsvx", C.LAPACKE_dppsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_afp), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_s), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cppsvx.f.
+func (Checked) Cppsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, equed []byte, s []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cppsvx", C.LAPACKE_cppsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_afp), (*C.char)(unsafe.Pointer(_equed)), (*C.float)(_s), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zppsvx.f.
+func (Checked) Zppsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, equed []byte, s []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex128
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _equed *byte
+	if len(equed) > 0 {
+		_equed = &equed[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zppsvx", C.LAPACKE_zppsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_afp), (*C.char)(unsafe.Pointer(_equed)), (*C.double)(_s), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spptrf.f.
+func (Checked) Spptrf(ul byte, n int, ap []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("spptrf", C.LAPACKE_spptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpptrf.f.
+func (Checked) Dpptrf(ul byte, n int, ap []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("dpptrf", C.LAPACKE_dpptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpptrf.f.
+func (Checked) Cpptrf(ul byte, n int, ap []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("cpptrf", C.LAPACKE_cpptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpptrf.f.
+func (Checked) Zpptrf(ul byte, n int, ap []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("zpptrf", C.LAPACKE_zpptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spptri.f.
+func (Checked) Spptri(ul byte, n int, ap []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("spptri", C.LAPACKE_spptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpptri.f.
+func (Checked) Dpptri(ul byte, n int, ap []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("dpptri", C.LAPACKE_dpptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpptri.f.
+func (Checked) Cpptri(ul byte, n int, ap []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("cpptri", C.LAPACKE_cpptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpptri.f.
+func (Checked) Zpptri(ul byte, n int, ap []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("zpptri", C.LAPACKE_zpptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spptrs.f.
+func (Checked) Spptrs(ul byte, n, nrhs int, ap, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("spptrs", C.LAPACKE_spptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpptrs.f.
+func (Checked) Dpptrs(ul byte, n, nrhs int, ap, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dpptrs", C.LAPACKE_dpptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpptrs.f.
+func (Checked) Cpptrs(ul byte, n, nrhs int, ap, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cpptrs", C.LAPACKE_cpptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpptrs.f.
+func (Checked) Zpptrs(ul byte, n, nrhs int, ap, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zpptrs", C.LAPACKE_zpptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spstrf.f.
+func (Checked) Spstrf(ul byte, n int, a []float32, lda int, piv, rank []Int, tol float32, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _piv *Int
+	if len(piv) > 0 {
+		_piv = &piv[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("spstrf", C.LAPACKE_spstrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_piv), (*C.lapack_int)(_rank), (C.float)(tol), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpstrf.f.
+func (Checked) Dpstrf(ul byte, n int, a []float64, lda int, piv, rank []Int, tol float64, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _piv *Int
+	if len(piv) > 0 {
+		_piv = &piv[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dpstrf", C.LAPACKE_dpstrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_piv), (*C.lapack_int)(_rank), (C.double)(tol), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpstrf.f.
+func (Checked) Cpstrf(ul byte, n int, a []complex64, lda int, piv, rank []Int, tol float32, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _piv *Int
+	if len(piv) > 0 {
+		_piv = &piv[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cpstrf", C.LAPACKE_cpstrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_piv), (*C.lapack_int)(_rank), (C.float)(tol), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpstrf.f.
+func (Checked) Zpstrf(ul byte, n int, a []complex128, lda int, piv, rank []Int, tol float64, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _piv *Int
+	if len(piv) > 0 {
+		_piv = &piv[0]
+	}
+	var _rank *Int
+	if len(rank) > 0 {
+		_rank = &rank[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zpstrf", C.LAPACKE_zpstrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_piv), (*C.lapack_int)(_rank), (C.double)(tol), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sptcon.f.
+func (Checked) Sptcon(n int, d, e []float32, anorm float32, rcond, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sptcon", C.LAPACKE_sptcon_work((C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dptcon.f.
+func (Checked) Dptcon(n int, d, e []float64, anorm float64, rcond, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dptcon", C.LAPACKE_dptcon_work((C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cptcon.f.
+func (Checked) Cptcon(n int, d []float32, e []complex64, anorm float32, rcond, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cptcon", C.LAPACKE_cptcon_work((C.lapack_int)(n), (*C.float)(_d), (*C.lapack_complex_float)(_e), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zptcon.f.
+func (Checked) Zptcon(n int, d []float64, e []complex128, anorm float64, rcond, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex128
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zptcon", C.LAPACKE_zptcon_work((C.lapack_int)(n), (*C.double)(_d), (*C.lapack_complex_double)(_e), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spteqr.f.
+func (Checked) Spteqr(compz byte, n int, d, e, z []float32, ldz int, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("spteqr", C.LAPACKE_spteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpteqr.f.
+func (Checked) Dpteqr(compz byte, n int, d, e, z []float64, ldz int, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dpteqr", C.LAPACKE_dpteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpteqr.f.
+func (Checked) Cpteqr(compz byte, n int, d, e []float32, z []complex64, ldz int, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cpteqr", C.LAPACKE_cpteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpteqr.f.
+func (Checked) Zpteqr(compz byte, n int, d, e []float64, z []complex128, ldz int, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zpteqr", C.LAPACKE_zpteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sptrfs.f.
+func (Checked) Sptrfs(n, nrhs int, d, e, df, ef, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float32
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *float32
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sptrfs", C.LAPACKE_sptrfs_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.float)(_e), (*C.float)(_df), (*C.float)(_ef), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dptrfs.f.
+func (Checked) Dptrfs(n, nrhs int, d, e, df, ef, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *float64
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dptrfs", C.LAPACKE_dptrfs_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.double)(_e), (*C.double)(_df), (*C.double)(_ef), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cptrfs.f.
+func (Checked) Cptrfs(ul byte, n, nrhs int, d []float32, e []complex64, df []float32, ef, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float32
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *complex64
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cptrfs", C.LAPACKE_cptrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.lapack_complex_float)(_e), (*C.float)(_df), (*C.lapack_complex_float)(_ef), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zptrfs.f.
+func (Checked) Zptrfs(ul byte, n, nrhs int, d []float64, e []complex128, df []float64, ef, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex128
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *complex128
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zptrfs", C.LAPACKE_zptrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.lapack_complex_double)(_e), (*C.double)(_df), (*C.lapack_complex_double)(_ef), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sptsv.f.
+func (Checked) Sptsv(n, nrhs int, d, e, b []float32, ldb int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sptsv", C.LAPACKE_sptsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.float)(_e), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dptsv.f.
+func (Checked) Dptsv(n, nrhs int, d, e, b []float64, ldb int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dptsv", C.LAPACKE_dptsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.double)(_e), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cptsv.f.
+func (Checked) Cptsv(n, nrhs int, d []float32, e, b []complex64, ldb int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cptsv", C.LAPACKE_cptsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.lapack_complex_float)(_e), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zptsv.f.
+func (Checked) Zptsv(n, nrhs int, d []float64, e, b []complex128, ldb int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex128
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zptsv", C.LAPACKE_zptsv_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.lapack_complex_double)(_e), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sptsvx.f.
+func (Checked) Sptsvx(fact byte, n, nrhs int, d, e, df, ef, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float32
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *float32
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sptsvx", C.LAPACKE_sptsvx_work((C.int)(rowMajor), (C.char)(fact), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.float)(_e), (*C.float)(_df), (*C.float)(_ef), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dptsvx.f.
+func (Checked) Dptsvx(fact byte, n, nrhs int, d, e, df, ef, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *float64
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dptsvx", C.LAPACKE_dptsvx_work((C.int)(rowMajor), (C.char)(fact), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.double)(_e), (*C.double)(_df), (*C.double)(_ef), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cptsvx.f.
+func (Checked) Cptsvx(fact byte, n, nrhs int, d []float32, e []complex64, df []float32, ef, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float32
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *complex64
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cptsvx", C.LAPACKE_cptsvx_work((C.int)(rowMajor), (C.char)(fact), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.lapack_complex_float)(_e), (*C.float)(_df), (*C.lapack_complex_float)(_ef), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zptsvx.f.
+func (Checked) Zptsvx(fact byte, n, nrhs int, d []float64, e []complex128, df []float64, ef, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex128
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _df *float64
+	if len(df) > 0 {
+		_df = &df[0]
+	}
+	var _ef *complex128
+	if len(ef) > 0 {
+		_ef = &ef[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zptsvx", C.LAPACKE_zptsvx_work((C.int)(rowMajor), (C.char)(fact), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.lapack_complex_double)(_e), (*C.double)(_df), (*C.lapack_complex_double)(_ef), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spttrf.f.
+func (Checked) Spttrf(n int, d, e []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	return newError("spttrf", C.LAPACKE_spttrf_work((C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpttrf.f.
+func (Checked) Dpttrf(n int, d, e []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	return newError("dpttrf", C.LAPACKE_dpttrf_work((C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpttrf.f.
+func (Checked) Cpttrf(n int, d []float32, e []complex64) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	return newError("cpttrf", C.LAPACKE_cpttrf_work((C.lapack_int)(n), (*C.float)(_d), (*C.lapack_complex_float)(_e)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpttrf.f.
+func (Checked) Zpttrf(n int, d []float64, e []complex128) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex128
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	return newError("zpttrf", C.LAPACKE_zpttrf_work((C.lapack_int)(n), (*C.double)(_d), (*C.lapack_complex_double)(_e)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spttrs.f.
+func (Checked) Spttrs(n, nrhs int, d, e, b []float32, ldb int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("spttrs", C.LAPACKE_spttrs_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.float)(_e), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpttrs.f.
+func (Checked) Dpttrs(n, nrhs int, d, e, b []float64, ldb int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dpttrs", C.LAPACKE_dpttrs_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.double)(_e), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpttrs.f.
+func (Checked) Cpttrs(ul byte, n, nrhs int, d []float32, e, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cpttrs", C.LAPACKE_cpttrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_d), (*C.lapack_complex_float)(_e), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpttrs.f.
+func (Checked) Zpttrs(ul byte, n, nrhs int, d []float64, e, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex128
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zpttrs", C.LAPACKE_zpttrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_d), (*C.lapack_complex_double)(_e), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbev.f.
+func (Checked) Ssbev(jobz, ul byte, n, kd int, ab []float32, ldab int, w, z []float32, ldz int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssbev", C.LAPACKE_ssbev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbev.f.
+func (Checked) Dsbev(jobz, ul byte, n, kd int, ab []float64, ldab int, w, z []float64, ldz int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsbev", C.LAPACKE_dsbev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbevd.f.
+func (Checked) Ssbevd(jobz, ul byte, n, kd int, ab []float32, ldab int, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssbevd", C.LAPACKE_ssbevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbevd.f.
+func (Checked) Dsbevd(jobz, ul byte, n, kd int, ab []float64, ldab int, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsbevd", C.LAPACKE_dsbevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbevx.f.
+func (Checked) Ssbevx(jobz, rng, ul byte, n, kd int, ab []float32, ldab int, q []float32, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("ssbevx", C.LAPACKE_ssbevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_q), (C.lapack_int)(ldq), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbevx.f.
+func (Checked) Dsbevx(jobz, rng, ul byte, n, kd int, ab []float64, ldab int, q []float64, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("dsbevx", C.LAPACKE_dsbevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_q), (C.lapack_int)(ldq), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbgst.f.
+func (Checked) Ssbgst(vect, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, x []float32, ldx int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float32
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssbgst", C.LAPACKE_ssbgst_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_bb), (C.lapack_int)(ldbb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbgst.f.
+func (Checked) Dsbgst(vect, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, x []float64, ldx int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsbgst", C.LAPACKE_dsbgst_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_bb), (C.lapack_int)(ldbb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbgv.f.
+func (Checked) Ssbgv(jobz, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, w, z []float32, ldz int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float32
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssbgv", C.LAPACKE_ssbgv_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_bb), (C.lapack_int)(ldbb), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbgv.f.
+func (Checked) Dsbgv(jobz, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, w, z []float64, ldz int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsbgv", C.LAPACKE_dsbgv_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_bb), (C.lapack_int)(ldbb), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbgvd.f.
+func (Checked) Ssbgvd(jobz, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float32
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssbgvd", C.LAPACKE_ssbgvd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_bb), (C.lapack_int)(ldbb), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbgvd.f.
+func (Checked) Dsbgvd(jobz, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsbgvd", C.LAPACKE_dsbgvd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_bb), (C.lapack_int)(ldbb), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbgvx.f.
+func (Checked) Ssbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, q []float32, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float32
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("ssbgvx", C.LAPACKE_ssbgvx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_bb), (C.lapack_int)(ldbb), (*C.float)(_q), (C.lapack_int)(ldq), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbgvx.f.
+func (Checked) Dsbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, q []float64, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _bb *float64
+	if len(bb) > 0 {
+		_bb = &bb[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("dsbgvx", C.LAPACKE_dsbgvx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(ka), (C.lapack_int)(kb), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_bb), (C.lapack_int)(ldbb), (*C.double)(_q), (C.lapack_int)(ldq), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbtrd.f.
+func (Checked) Ssbtrd(vect, ul byte, n, kd int, ab []float32, ldab int, d, e, q []float32, ldq int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssbtrd", C.LAPACKE_ssbtrd_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_d), (*C.float)(_e), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbtrd.f.
+func (Checked) Dsbtrd(vect, ul byte, n, kd int, ab []float64, ldab int, d, e, q []float64, ldq int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsbtrd", C.LAPACKE_dsbtrd_work((C.int)(rowMajor), (C.char)(vect), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_d), (*C.double)(_e), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssfrk.f.
+func (Checked) Ssfrk(transr, ul, trans byte, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	return newError("ssfrk", C.LAPACKE_ssfrk_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(k), (C.float)(alpha), (*C.float)(_a), (C.lapack_int)(lda), (C.float)(beta), (*C.float)(_c)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsfrk.f.
+func (Checked) Dsfrk(transr, ul, trans byte, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	return newError("dsfrk", C.LAPACKE_dsfrk_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(trans), (C.lapack_int)(n), (C.lapack_int)(k), (C.double)(alpha), (*C.double)(_a), (C.lapack_int)(lda), (C.double)(beta), (*C.double)(_c)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspcon.f.
+func (Checked) Sspcon(ul byte, n int, ap []float32, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sspcon", C.LAPACKE_sspcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspcon.f.
+func (Checked) Dspcon(ul byte, n int, ap []float64, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dspcon", C.LAPACKE_dspcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cspcon.f.
+func (Checked) Cspcon(ul byte, n int, ap []complex64, ipiv []Int, anorm float32, rcond []float32, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cspcon", C.LAPACKE_cspcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zspcon.f.
+func (Checked) Zspcon(ul byte, n int, ap []complex128, ipiv []Int, anorm float64, rcond []float64, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zspcon", C.LAPACKE_zspcon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspev.f.
+func (Checked) Sspev(jobz, ul byte, n int, ap, w, z []float32, ldz int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sspev", C.LAPACKE_sspev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspev.f.
+func (Checked) Dspev(jobz, ul byte, n int, ap, w, z []float64, ldz int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dspev", C.LAPACKE_dspev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspevd.f.
+func (Checked) Sspevd(jobz, ul byte, n int, ap, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sspevd", C.LAPACKE_sspevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspevd.f.
+func (Checked) Dspevd(jobz, ul byte, n int, ap, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dspevd", C.LAPACKE_dspevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspevx.f.
+func (Checked) Sspevx(jobz, rng, ul byte, n int, ap []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("sspevx", C.LAPACKE_sspevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspevx.f.
+func (Checked) Dspevx(jobz, rng, ul byte, n int, ap []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("dspevx", C.LAPACKE_dspevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspgst.f.
+func (Checked) Sspgst(itype int, ul byte, n int, ap, bp []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float32
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	return newError("sspgst", C.LAPACKE_sspgst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_bp)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspgst.f.
+func (Checked) Dspgst(itype int, ul byte, n int, ap, bp []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	return newError("dspgst", C.LAPACKE_dspgst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_bp)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspgv.f.
+func (Checked) Sspgv(itype int, jobz, ul byte, n int, ap, bp, w, z []float32, ldz int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float32
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sspgv", C.LAPACKE_sspgv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_bp), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspgv.f.
+func (Checked) Dspgv(itype int, jobz, ul byte, n int, ap, bp, w, z []float64, ldz int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dspgv", C.LAPACKE_dspgv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_bp), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspgvd.f.
+func (Checked) Sspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float32
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sspgvd", C.LAPACKE_sspgvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_bp), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspgvd.f.
+func (Checked) Dspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dspgvd", C.LAPACKE_dspgvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_bp), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspgvx.f.
+func (Checked) Sspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float32
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("sspgvx", C.LAPACKE_sspgvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_bp), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspgvx.f.
+func (Checked) Dspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _bp *float64
+	if len(bp) > 0 {
+		_bp = &bp[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("dspgvx", C.LAPACKE_dspgvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_bp), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssprfs.f.
+func (Checked) Ssprfs(ul byte, n, nrhs int, ap, afp []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float32
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssprfs", C.LAPACKE_ssprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_afp), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsprfs.f.
+func (Checked) Dsprfs(ul byte, n, nrhs int, ap, afp []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsprfs", C.LAPACKE_dsprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_afp), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csprfs.f.
+func (Checked) Csprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("csprfs", C.LAPACKE_csprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsprfs.f.
+func (Checked) Zsprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex128
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zsprfs", C.LAPACKE_zsprfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspsv.f.
+func (Checked) Sspsv(ul byte, n, nrhs int, ap []float32, ipiv []Int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("sspsv", C.LAPACKE_sspsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspsv.f.
+func (Checked) Dspsv(ul byte, n, nrhs int, ap []float64, ipiv []Int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dspsv", C.LAPACKE_dspsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cspsv.f.
+func (Checked) Cspsv(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("cspsv", C.LAPACKE_cspsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zspsv.f.
+func (Checked) Zspsv(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zspsv", C.LAPACKE_zspsv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspsvx.f.
+func (Checked) Sspsvx(fact, ul byte, n, nrhs int, ap, afp []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float32
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sspsvx", C.LAPACKE_sspsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_afp), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspsvx.f.
+func (Checked) Dspsvx(fact, ul byte, n, nrhs int, ap, afp []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *float64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dspsvx", C.LAPACKE_dspsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_afp), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cspsvx.f.
+func (Checked) Cspsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex64
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cspsvx", C.LAPACKE_cspsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zspsvx.f.
+func (Checked) Zspsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _afp *complex128
+	if len(afp) > 0 {
+		_afp = &afp[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zspsvx", C.LAPACKE_zspsvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_afp), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssptrd.f.
+func (Checked) Ssptrd(ul byte, n int, ap, d, e, tau []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("ssptrd", C.LAPACKE_ssptrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_d), (*C.float)(_e), (*C.float)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsptrd.f.
+func (Checked) Dsptrd(ul byte, n int, ap, d, e, tau []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	return newError("dsptrd", C.LAPACKE_dsptrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_d), (*C.double)(_e), (*C.double)(_tau)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssptrf.f.
+func (Checked) Ssptrf(ul byte, n int, ap []float32, ipiv []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("ssptrf", C.LAPACKE_ssptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsptrf.f.
+func (Checked) Dsptrf(ul byte, n int, ap []float64, ipiv []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("dsptrf", C.LAPACKE_dsptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csptrf.f.
+func (Checked) Csptrf(ul byte, n int, ap []complex64, ipiv []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("csptrf", C.LAPACKE_csptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsptrf.f.
+func (Checked) Zsptrf(ul byte, n int, ap []complex128, ipiv []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	return newError("zsptrf", C.LAPACKE_zsptrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssptri.f.
+func (Checked) Ssptri(ul byte, n int, ap []float32, ipiv []Int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssptri", C.LAPACKE_ssptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.lapack_int)(_ipiv), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsptri.f.
+func (Checked) Dsptri(ul byte, n int, ap []float64, ipiv []Int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsptri", C.LAPACKE_dsptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.lapack_int)(_ipiv), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csptri.f.
+func (Checked) Csptri(ul byte, n int, ap []complex64, ipiv []Int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csptri", C.LAPACKE_csptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsptri.f.
+func (Checked) Zsptri(ul byte, n int, ap []complex128, ipiv []Int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsptri", C.LAPACKE_zsptri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssptrs.f.
+func (Checked) Ssptrs(ul byte, n, nrhs int, ap []float32, ipiv []Int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ssptrs", C.LAPACKE_ssptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsptrs.f.
+func (Checked) Dsptrs(ul byte, n, nrhs int, ap []float64, ipiv []Int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dsptrs", C.LAPACKE_dsptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csptrs.f.
+func (Checked) Csptrs(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("csptrs", C.LAPACKE_csptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsptrs.f.
+func (Checked) Zsptrs(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zsptrs", C.LAPACKE_zsptrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstebz.f.
+func (Checked) Sstebz(rng, order byte, n int, vl, vu float32, il, iu int, abstol float32, d, e []float32, m, nsplit []Int, w []float32, iblock, isplit []Int, work []float32, iwork []Int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _nsplit *Int
+	if len(nsplit) > 0 {
+		_nsplit = &nsplit[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _iblock *Int
+	if len(iblock) > 0 {
+		_iblock = &iblock[0]
+	}
+	var _isplit *Int
+	if len(isplit) > 0 {
+		_isplit = &isplit[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sstebz", C.LAPACKE_sstebz_work((C.char)(rng), (C.char)(order), (C.lapack_int)(n), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.float)(_d), (*C.float)(_e), (*C.lapack_int)(_m), (*C.lapack_int)(_nsplit), (*C.float)(_w), (*C.lapack_int)(_iblock), (*C.lapack_int)(_isplit), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstebz.f.
+func (Checked) Dstebz(rng, order byte, n int, vl, vu float64, il, iu int, abstol float64, d, e []float64, m, nsplit []Int, w []float64, iblock, isplit []Int, work []float64, iwork []Int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _nsplit *Int
+	if len(nsplit) > 0 {
+		_nsplit = &nsplit[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _iblock *Int
+	if len(iblock) > 0 {
+		_iblock = &iblock[0]
+	}
+	var _isplit *Int
+	if len(isplit) > 0 {
+		_isplit = &isplit[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dstebz", C.LAPACKE_dstebz_work((C.char)(rng), (C.char)(order), (C.lapack_int)(n), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.double)(_d), (*C.double)(_e), (*C.lapack_int)(_m), (*C.lapack_int)(_nsplit), (*C.double)(_w), (*C.lapack_int)(_iblock), (*C.lapack_int)(_isplit), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstedc.f.
+func (Checked) Sstedc(compz byte, n int, d, e, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sstedc", C.LAPACKE_sstedc_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstedc.f.
+func (Checked) Dstedc(compz byte, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dstedc", C.LAPACKE_dstedc_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstedc.f.
+func (Checked) Cstedc(compz byte, n int, d, e []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cstedc", C.LAPACKE_cstedc_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstedc.f.
+func (Checked) Zstedc(compz byte, n int, d, e []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zstedc", C.LAPACKE_zstedc_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstegr.f.
+func (Checked) Sstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sstegr", C.LAPACKE_sstegr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstegr.f.
+func (Checked) Dstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dstegr", C.LAPACKE_dstegr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstegr.f.
+func (Checked) Cstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cstegr", C.LAPACKE_cstegr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstegr.f.
+func (Checked) Zstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zstegr", C.LAPACKE_zstegr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstein.f.
+func (Checked) Sstein(n int, d, e []float32, m int, w []float32, iblock, isplit []Int, z []float32, ldz int, work []float32, iwork, ifailv []Int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _iblock *Int
+	if len(iblock) > 0 {
+		_iblock = &iblock[0]
+	}
+	var _isplit *Int
+	if len(isplit) > 0 {
+		_isplit = &isplit[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifailv *Int
+	if len(ifailv) > 0 {
+		_ifailv = &ifailv[0]
+	}
+	return newError("sstein", C.LAPACKE_sstein_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.lapack_int)(m), (*C.float)(_w), (*C.lapack_int)(_iblock), (*C.lapack_int)(_isplit), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifailv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstein.f.
+func (Checked) Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []Int, z []float64, ldz int, work []float64, iwork, ifailv []Int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _iblock *Int
+	if len(iblock) > 0 {
+		_iblock = &iblock[0]
+	}
+	var _isplit *Int
+	if len(isplit) > 0 {
+		_isplit = &isplit[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifailv *Int
+	if len(ifailv) > 0 {
+		_ifailv = &ifailv[0]
+	}
+	return newError("dstein", C.LAPACKE_dstein_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.lapack_int)(m), (*C.double)(_w), (*C.lapack_int)(_iblock), (*C.lapack_int)(_isplit), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifailv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstein.f.
+func (Checked) Cstein(n int, d, e []float32, m int, w []float32, iblock, isplit []Int, z []complex64, ldz int, work []float32, iwork, ifailv []Int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _iblock *Int
+	if len(iblock) > 0 {
+		_iblock = &iblock[0]
+	}
+	var _isplit *Int
+	if len(isplit) > 0 {
+		_isplit = &isplit[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifailv *Int
+	if len(ifailv) > 0 {
+		_ifailv = &ifailv[0]
+	}
+	return newError("cstein", C.LAPACKE_cstein_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.lapack_int)(m), (*C.float)(_w), (*C.lapack_int)(_iblock), (*C.lapack_int)(_isplit), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifailv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstein.f.
+func (Checked) Zstein(n int, d, e []float64, m int, w []float64, iblock, isplit []Int, z []complex128, ldz int, work []float64, iwork, ifailv []Int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _iblock *Int
+	if len(iblock) > 0 {
+		_iblock = &iblock[0]
+	}
+	var _isplit *Int
+	if len(isplit) > 0 {
+		_isplit = &isplit[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifailv *Int
+	if len(ifailv) > 0 {
+		_ifailv = &ifailv[0]
+	}
+	return newError("zstein", C.LAPACKE_zstein_work((C.int)(rowMajor), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.lapack_int)(m), (*C.double)(_w), (*C.lapack_int)(_iblock), (*C.lapack_int)(_isplit), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifailv)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstemr.f.
+func (Checked) Sstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m []Int, w, z []float32, ldz, nzc int, isuppz, tryrac []Int, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _tryrac *Int
+	if len(tryrac) > 0 {
+		_tryrac = &tryrac[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sstemr", C.LAPACKE_sstemr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (C.lapack_int)(nzc), (*C.lapack_int)(_isuppz), (*C.lapack_logical)(_tryrac), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstemr.f.
+func (Checked) Dstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m []Int, w, z []float64, ldz, nzc int, isuppz, tryrac []Int, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _tryrac *Int
+	if len(tryrac) > 0 {
+		_tryrac = &tryrac[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dstemr", C.LAPACKE_dstemr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (C.lapack_int)(nzc), (*C.lapack_int)(_isuppz), (*C.lapack_logical)(_tryrac), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstemr.f.
+func (Checked) Cstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m []Int, w []float32, z []complex64, ldz, nzc int, isuppz, tryrac []Int, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _tryrac *Int
+	if len(tryrac) > 0 {
+		_tryrac = &tryrac[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cstemr", C.LAPACKE_cstemr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (*C.lapack_int)(_m), (*C.float)(_w), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (C.lapack_int)(nzc), (*C.lapack_int)(_isuppz), (*C.lapack_logical)(_tryrac), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstemr.f.
+func (Checked) Zstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m []Int, w []float64, z []complex128, ldz, nzc int, isuppz, tryrac []Int, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _tryrac *Int
+	if len(tryrac) > 0 {
+		_tryrac = &tryrac[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zstemr", C.LAPACKE_zstemr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (*C.lapack_int)(_m), (*C.double)(_w), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (C.lapack_int)(nzc), (*C.lapack_int)(_isuppz), (*C.lapack_logical)(_tryrac), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssteqr.f.
+func (Checked) Ssteqr(compz byte, n int, d, e, z []float32, ldz int, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssteqr", C.LAPACKE_ssteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsteqr.f.
+func (Checked) Dsteqr(compz byte, n int, d, e, z []float64, ldz int, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsteqr", C.LAPACKE_dsteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csteqr.f.
+func (Checked) Csteqr(compz byte, n int, d, e []float32, z []complex64, ldz int, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csteqr", C.LAPACKE_csteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsteqr.f.
+func (Checked) Zsteqr(compz byte, n int, d, e []float64, z []complex128, ldz int, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsteqr", C.LAPACKE_zsteqr_work((C.int)(rowMajor), (C.char)(compz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssterf.f.
+func (Checked) Ssterf(n int, d, e []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	return newError("ssterf", C.LAPACKE_ssterf_work((C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsterf.f.
+func (Checked) Dsterf(n int, d, e []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	return newError("dsterf", C.LAPACKE_dsterf_work((C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstev.f.
+func (Checked) Sstev(jobz byte, n int, d, e, z []float32, ldz int, work []float32) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sstev", C.LAPACKE_sstev_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstev.f.
+func (Checked) Dstev(jobz byte, n int, d, e, z []float64, ldz int, work []float64) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dstev", C.LAPACKE_dstev_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstevd.f.
+func (Checked) Sstevd(jobz byte, n int, d, e, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sstevd", C.LAPACKE_sstevd_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstevd.f.
+func (Checked) Dstevd(jobz byte, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dstevd", C.LAPACKE_dstevd_work((C.int)(rowMajor), (C.char)(jobz), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstevr.f.
+func (Checked) Sstevr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sstevr", C.LAPACKE_sstevr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstevr.f.
+func (Checked) Dstevr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dstevr", C.LAPACKE_dstevr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstevx.f.
+func (Checked) Sstevx(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) error {
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("sstevx", C.LAPACKE_sstevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.float)(_d), (*C.float)(_e), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstevx.f.
+func (Checked) Dstevx(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) error {
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("dstevx", C.LAPACKE_dstevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.lapack_int)(n), (*C.double)(_d), (*C.double)(_e), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssycon.f.
+func (Checked) Ssycon(ul byte, n int, a []float32, lda int, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssycon", C.LAPACKE_ssycon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsycon.f.
+func (Checked) Dsycon(ul byte, n int, a []float64, lda int, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsycon", C.LAPACKE_dsycon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csycon.f.
+func (Checked) Csycon(ul byte, n int, a []complex64, lda int, ipiv []Int, anorm float32, rcond []float32, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csycon", C.LAPACKE_csycon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (C.float)(anorm), (*C.float)(_rcond), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsycon.f.
+func (Checked) Zsycon(ul byte, n int, a []complex128, lda int, ipiv []Int, anorm float64, rcond []float64, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsycon", C.LAPACKE_zsycon_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (C.double)(anorm), (*C.double)(_rcond), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyequb.f.
+func (Checked) Ssyequb(ul byte, n int, a []float32, lda int, s, scond, amax, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssyequb", C.LAPACKE_ssyequb_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyequb.f.
+func (Checked) Dsyequb(ul byte, n int, a []float64, lda int, s, scond, amax, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsyequb", C.LAPACKE_dsyequb_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csyequb.f.
+func (Checked) Csyequb(ul byte, n int, a []complex64, lda int, s, scond, amax []float32, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float32
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float32
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csyequb", C.LAPACKE_csyequb_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_s), (*C.float)(_scond), (*C.float)(_amax), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsyequb.f.
+func (Checked) Zsyequb(ul byte, n int, a []complex128, lda int, s, scond, amax []float64, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _scond *float64
+	if len(scond) > 0 {
+		_scond = &scond[0]
+	}
+	var _amax *float64
+	if len(amax) > 0 {
+		_amax = &amax[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsyequb", C.LAPACKE_zsyequb_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_s), (*C.double)(_scond), (*C.double)(_amax), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyev.f.
+func (Checked) Ssyev(jobz, ul byte, n int, a []float32, lda int, w, work []float32, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssyev", C.LAPACKE_ssyev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_w), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyev.f.
+func (Checked) Dsyev(jobz, ul byte, n int, a []float64, lda int, w, work []float64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsyev", C.LAPACKE_dsyev_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_w), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyevd.f.
+func (Checked) Ssyevd(jobz, ul byte, n int, a []float32, lda int, w, work []float32, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssyevd", C.LAPACKE_ssyevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_w), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyevd.f.
+func (Checked) Dsyevd(jobz, ul byte, n int, a []float64, lda int, w, work []float64, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsyevd", C.LAPACKE_dsyevd_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_w), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyevr.f.
+func (Checked) Ssyevr(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssyevr", C.LAPACKE_ssyevr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyevr.f.
+func (Checked) Dsyevr(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _isuppz *Int
+	if len(isuppz) > 0 {
+		_isuppz = &isuppz[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsyevr", C.LAPACKE_dsyevr_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_isuppz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyevx.f.
+func (Checked) Ssyevx(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, lwork int, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("ssyevx", C.LAPACKE_ssyevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyevx.f.
+func (Checked) Dsyevx(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("dsyevx", C.LAPACKE_dsyevx_work((C.int)(rowMajor), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssygst.f.
+func (Checked) Ssygst(itype int, ul byte, n int, a []float32, lda int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ssygst", C.LAPACKE_ssygst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsygst.f.
+func (Checked) Dsygst(itype int, ul byte, n int, a []float64, lda int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dsygst", C.LAPACKE_dsygst_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssygv.f.
+func (Checked) Ssygv(itype int, jobz, ul byte, n int, a []float32, lda int, b []float32, ldb int, w, work []float32, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssygv", C.LAPACKE_ssygv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_w), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsygv.f.
+func (Checked) Dsygv(itype int, jobz, ul byte, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsygv", C.LAPACKE_dsygv_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_w), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssygvd.f.
+func (Checked) Ssygvd(itype int, jobz, ul byte, n int, a []float32, lda int, b []float32, ldb int, w, work []float32, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssygvd", C.LAPACKE_ssygvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_w), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsygvd.f.
+func (Checked) Dsygvd(itype int, jobz, ul byte, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int, iwork []Int, liwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsygvd", C.LAPACKE_dsygvd_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_w), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssygvx.f.
+func (Checked) Ssygvx(itype int, jobz, rng, ul byte, n int, a []float32, lda int, b []float32, ldb int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, lwork int, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float32
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("ssygvx", C.LAPACKE_ssygvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (C.float)(vl), (C.float)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.float)(abstol), (*C.lapack_int)(_m), (*C.float)(_w), (*C.float)(_z), (C.lapack_int)(ldz), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsygvx.f.
+func (Checked) Dsygvx(itype int, jobz, rng, ul byte, n int, a []float64, lda int, b []float64, ldb int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _w *float64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _ifail *Int
+	if len(ifail) > 0 {
+		_ifail = &ifail[0]
+	}
+	return newError("dsygvx", C.LAPACKE_dsygvx_work((C.int)(rowMajor), (C.lapack_int)(itype), (C.char)(jobz), (C.char)(rng), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (C.double)(vl), (C.double)(vu), (C.lapack_int)(il), (C.lapack_int)(iu), (C.double)(abstol), (*C.lapack_int)(_m), (*C.double)(_w), (*C.double)(_z), (C.lapack_int)(ldz), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (*C.lapack_int)(_ifail)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyrfs.f.
+func (Checked) Ssyrfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float32
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssyrfs", C.LAPACKE_ssyrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyrfs.f.
+func (Checked) Dsyrfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsyrfs", C.LAPACKE_dsyrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csyrfs.f.
+func (Checked) Csyrfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("csyrfs", C.LAPACKE_csyrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsyrfs.f.
+func (Checked) Zsyrfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zsyrfs", C.LAPACKE_zsyrfs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssysv.f.
+func (Checked) Ssysv(ul byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int, work []float32, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssysv", C.LAPACKE_ssysv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsysv.f.
+func (Checked) Dsysv(ul byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int, work []float64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsysv", C.LAPACKE_dsysv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csysv.f.
+func (Checked) Csysv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csysv", C.LAPACKE_csysv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsysv.f.
+func (Checked) Zsysv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsysv", C.LAPACKE_zsysv_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssysvx.f.
+func (Checked) Ssysvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, lwork int, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float32
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ssysvx", C.LAPACKE_ssysvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsysvx.f.
+func (Checked) Dsysvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, lwork int, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *float64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dsysvx", C.LAPACKE_dsysvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csysvx.f.
+func (Checked) Csysvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, lwork int, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex64
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("csysvx", C.LAPACKE_csysvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_rcond), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsysvx.f.
+func (Checked) Zsysvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, lwork int, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _af *complex128
+	if len(af) > 0 {
+		_af = &af[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zsysvx", C.LAPACKE_zsysvx_work((C.int)(rowMajor), (C.char)(fact), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_af), (C.lapack_int)(ldaf), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_rcond), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytrd.f.
+func (Checked) Ssytrd(ul byte, n int, a []float32, lda int, d, e, tau, work []float32, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssytrd", C.LAPACKE_ssytrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_d), (*C.float)(_e), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytrd.f.
+func (Checked) Dsytrd(ul byte, n int, a []float64, lda int, d, e, tau, work []float64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsytrd", C.LAPACKE_dsytrd_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_d), (*C.double)(_e), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytrf.f.
+func (Checked) Ssytrf(ul byte, n int, a []float32, lda int, ipiv []Int, work []float32, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssytrf", C.LAPACKE_ssytrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytrf.f.
+func (Checked) Dsytrf(ul byte, n int, a []float64, lda int, ipiv []Int, work []float64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsytrf", C.LAPACKE_dsytrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytrf.f.
+func (Checked) Csytrf(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csytrf", C.LAPACKE_csytrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytrf.f.
+func (Checked) Zsytrf(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsytrf", C.LAPACKE_zsytrf_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytri.f.
+func (Checked) Ssytri(ul byte, n int, a []float32, lda int, ipiv []Int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssytri", C.LAPACKE_ssytri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytri.f.
+func (Checked) Dsytri(ul byte, n int, a []float64, lda int, ipiv []Int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsytri", C.LAPACKE_dsytri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytri.f.
+func (Checked) Csytri(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csytri", C.LAPACKE_csytri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytri.f.
+func (Checked) Zsytri(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsytri", C.LAPACKE_zsytri_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytrs.f.
+func (Checked) Ssytrs(ul byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ssytrs", C.LAPACKE_ssytrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytrs.f.
+func (Checked) Dsytrs(ul byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dsytrs", C.LAPACKE_dsytrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytrs.f.
+func (Checked) Csytrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("csytrs", C.LAPACKE_csytrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytrs.f.
+func (Checked) Zsytrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("zsytrs", C.LAPACKE_zsytrs_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stbcon.f.
+func (Checked) Stbcon(norm, ul, d byte, n, kd int, ab []float32, ldab int, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("stbcon", C.LAPACKE_stbcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtbcon.f.
+func (Checked) Dtbcon(norm, ul, d byte, n, kd int, ab []float64, ldab int, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtbcon", C.LAPACKE_dtbcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctbcon.f.
+func (Checked) Ctbcon(norm, ul, d byte, n, kd int, ab []complex64, ldab int, rcond []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctbcon", C.LAPACKE_ctbcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztbcon.f.
+func (Checked) Ztbcon(norm, ul, d byte, n, kd int, ab []complex128, ldab int, rcond []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztbcon", C.LAPACKE_ztbcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stbrfs.f.
+func (Checked) Stbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("stbrfs", C.LAPACKE_stbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtbrfs.f.
+func (Checked) Dtbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtbrfs", C.LAPACKE_dtbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctbrfs.f.
+func (Checked) Ctbrfs(ul, trans, d byte, n, kd, nrhs int, ab []complex64, ldab int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctbrfs", C.LAPACKE_ctbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztbrfs.f.
+func (Checked) Ztbrfs(ul, trans, d byte, n, kd, nrhs int, ab []complex128, ldab int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztbrfs", C.LAPACKE_ztbrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stbtrs.f.
+func (Checked) Stbtrs(ul, trans, d byte, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *float32
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("stbtrs", C.LAPACKE_stbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.float)(_ab), (C.lapack_int)(ldab), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtbtrs.f.
+func (Checked) Dtbtrs(ul, trans, d byte, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *float64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dtbtrs", C.LAPACKE_dtbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.double)(_ab), (C.lapack_int)(ldab), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctbtrs.f.
+func (Checked) Ctbtrs(ul, trans, d byte, n, kd, nrhs int, ab []complex64, ldab int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *complex64
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ctbtrs", C.LAPACKE_ctbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztbtrs.f.
+func (Checked) Ztbtrs(ul, trans, d byte, n, kd, nrhs int, ab []complex128, ldab int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ab *complex128
+	if len(ab) > 0 {
+		_ab = &ab[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ztbtrs", C.LAPACKE_ztbtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(kd), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ab), (C.lapack_int)(ldab), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stfsm.f.
+func (Checked) Stfsm(transr, side, ul, trans, d byte, m, n int, alpha float32, a, b []float32, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("stfsm", C.LAPACKE_stfsm_work((C.int)(rowMajor), (C.char)(transr), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(m), (C.lapack_int)(n), (C.float)(alpha), (*C.float)(_a), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtfsm.f.
+func (Checked) Dtfsm(transr, side, ul, trans, d byte, m, n int, alpha float64, a, b []float64, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dtfsm", C.LAPACKE_dtfsm_work((C.int)(rowMajor), (C.char)(transr), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(m), (C.lapack_int)(n), (C.double)(alpha), (*C.double)(_a), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctfsm.f.
+func (Checked) Ctfsm(transr, side, ul, trans, d byte, m, n int, alpha complex64, a, b []complex64, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ctfsm", C.LAPACKE_ctfsm_work((C.int)(rowMajor), (C.char)(transr), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_complex_float)(alpha), (*C.lapack_complex_float)(_a), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztfsm.f.
+func (Checked) Ztfsm(transr, side, ul, trans, d byte, m, n int, alpha complex128, a, b []complex128, ldb int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ztfsm", C.LAPACKE_ztfsm_work((C.int)(rowMajor), (C.char)(transr), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_complex_double)(alpha), (*C.lapack_complex_double)(_a), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stftri.f.
+func (Checked) Stftri(transr, ul, d byte, n int, a []float32) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("stftri", C.LAPACKE_stftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.float)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtftri.f.
+func (Checked) Dtftri(transr, ul, d byte, n int, a []float64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dtftri", C.LAPACKE_dtftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.double)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctftri.f.
+func (Checked) Ctftri(transr, ul, d byte, n int, a []complex64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ctftri", C.LAPACKE_ctftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_float)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztftri.f.
+func (Checked) Ztftri(transr, ul, d byte, n int, a []complex128) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ztftri", C.LAPACKE_ztftri_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_double)(_a)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stfttp.f.
+func (Checked) Stfttp(transr, ul byte, n int, arf, ap []float32) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *float32
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("stfttp", C.LAPACKE_stfttp_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_arf), (*C.float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtfttp.f.
+func (Checked) Dtfttp(transr, ul byte, n int, arf, ap []float64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *float64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("dtfttp", C.LAPACKE_dtfttp_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_arf), (*C.double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctfttp.f.
+func (Checked) Ctfttp(transr, ul byte, n int, arf, ap []complex64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *complex64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("ctfttp", C.LAPACKE_ctfttp_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_arf), (*C.lapack_complex_float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztfttp.f.
+func (Checked) Ztfttp(transr, ul byte, n int, arf, ap []complex128) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *complex128
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("ztfttp", C.LAPACKE_ztfttp_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_arf), (*C.lapack_complex_double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stfttr.f.
+func (Checked) Stfttr(transr, ul byte, n int, arf, a []float32, lda int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *float32
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("stfttr", C.LAPACKE_stfttr_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_arf), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtfttr.f.
+func (Checked) Dtfttr(transr, ul byte, n int, arf, a []float64, lda int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *float64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dtfttr", C.LAPACKE_dtfttr_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_arf), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctfttr.f.
+func (Checked) Ctfttr(transr, ul byte, n int, arf, a []complex64, lda int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *complex64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ctfttr", C.LAPACKE_ctfttr_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_arf), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztfttr.f.
+func (Checked) Ztfttr(transr, ul byte, n int, arf, a []complex128, lda int) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _arf *complex128
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ztfttr", C.LAPACKE_ztfttr_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_arf), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgevc.f.
+func (Checked) Stgevc(side, howmny byte, sel []Int, n int, s []float32, lds int, p []float32, ldp int, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []Int, work []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _p *float32
+	if len(p) > 0 {
+		_p = &p[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("stgevc", C.LAPACKE_stgevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.float)(_s), (C.lapack_int)(lds), (*C.float)(_p), (C.lapack_int)(ldp), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgevc.f.
+func (Checked) Dtgevc(side, howmny byte, sel []Int, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []Int, work []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _p *float64
+	if len(p) > 0 {
+		_p = &p[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtgevc", C.LAPACKE_dtgevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.double)(_s), (C.lapack_int)(lds), (*C.double)(_p), (C.lapack_int)(ldp), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgevc.f.
+func (Checked) Ctgevc(side, howmny byte, sel []Int, n int, s []complex64, lds int, p []complex64, ldp int, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []Int, work []complex64, rwork []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _s *complex64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _p *complex64
+	if len(p) > 0 {
+		_p = &p[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctgevc", C.LAPACKE_ctgevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_float)(_s), (C.lapack_int)(lds), (*C.lapack_complex_float)(_p), (C.lapack_int)(ldp), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgevc.f.
+func (Checked) Ztgevc(side, howmny byte, sel []Int, n int, s []complex128, lds int, p []complex128, ldp int, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []Int, work []complex128, rwork []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _s *complex128
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _p *complex128
+	if len(p) > 0 {
+		_p = &p[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztgevc", C.LAPACKE_ztgevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_double)(_s), (C.lapack_int)(lds), (*C.lapack_complex_double)(_p), (C.lapack_int)(ldp), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgexc.f.
+func (Checked) Stgexc(wantq, wantz Int, n int, a []float32, lda int, b []float32, ldb int, q []float32, ldq int, z []float32, ldz int, ifst, ilst []Int, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _ifst *Int
+	if len(ifst) > 0 {
+		_ifst = &ifst[0]
+	}
+	var _ilst *Int
+	if len(ilst) > 0 {
+		_ilst = &ilst[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("stgexc", C.LAPACKE_stgexc_work((C.int)(rowMajor), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_ifst), (*C.lapack_int)(_ilst), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgexc.f.
+func (Checked) Dtgexc(wantq, wantz Int, n int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int, ifst, ilst []Int, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _ifst *Int
+	if len(ifst) > 0 {
+		_ifst = &ifst[0]
+	}
+	var _ilst *Int
+	if len(ilst) > 0 {
+		_ilst = &ilst[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtgexc", C.LAPACKE_dtgexc_work((C.int)(rowMajor), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_ifst), (*C.lapack_int)(_ilst), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgexc.f.
+func (Checked) Ctgexc(wantq, wantz Int, n int, a []complex64, lda int, b []complex64, ldb int, q []complex64, ldq int, z []complex64, ldz, ifst, ilst int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	return newError("ctgexc", C.LAPACKE_ctgexc_work((C.int)(rowMajor), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (C.lapack_int)(ifst), (C.lapack_int)(ilst)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgexc.f.
+func (Checked) Ztgexc(wantq, wantz Int, n int, a []complex128, lda int, b []complex128, ldb int, q []complex128, ldq int, z []complex128, ldz, ifst, ilst int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	return newError("ztgexc", C.LAPACKE_ztgexc_work((C.int)(rowMajor), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (C.lapack_int)(ifst), (C.lapack_int)(ilst)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsen.f.
+func (Checked) Stgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, q []float32, ldq int, z []float32, ldz int, m []Int, pl, pr, dif, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float32
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _pl *float32
+	if len(pl) > 0 {
+		_pl = &pl[0]
+	}
+	var _pr *float32
+	if len(pr) > 0 {
+		_pr = &pr[0]
+	}
+	var _dif *float32
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("stgsen", C.LAPACKE_stgsen_work((C.int)(rowMajor), (C.lapack_int)(ijob), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_m), (*C.float)(_pl), (*C.float)(_pr), (*C.float)(_dif), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsen.f.
+func (Checked) Dtgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, m []Int, pl, pr, dif, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *float64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _pl *float64
+	if len(pl) > 0 {
+		_pl = &pl[0]
+	}
+	var _pr *float64
+	if len(pr) > 0 {
+		_pr = &pr[0]
+	}
+	var _dif *float64
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtgsen", C.LAPACKE_dtgsen_work((C.int)(rowMajor), (C.lapack_int)(ijob), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_m), (*C.double)(_pl), (*C.double)(_pr), (*C.double)(_dif), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsen.f.
+func (Checked) Ctgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, q []complex64, ldq int, z []complex64, ldz int, m []Int, pl, pr, dif []float32, work []complex64, lwork int, iwork []Int, liwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex64
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _pl *float32
+	if len(pl) > 0 {
+		_pl = &pl[0]
+	}
+	var _pr *float32
+	if len(pr) > 0 {
+		_pr = &pr[0]
+	}
+	var _dif *float32
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ctgsen", C.LAPACKE_ctgsen_work((C.int)(rowMajor), (C.lapack_int)(ijob), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_m), (*C.float)(_pl), (*C.float)(_pr), (*C.float)(_dif), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsen.f.
+func (Checked) Ztgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, q []complex128, ldq int, z []complex128, ldz int, m []Int, pl, pr, dif []float64, work []complex128, lwork int, iwork []Int, liwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _z *complex128
+	if len(z) > 0 {
+		_z = &z[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _pl *float64
+	if len(pl) > 0 {
+		_pl = &pl[0]
+	}
+	var _pr *float64
+	if len(pr) > 0 {
+		_pr = &pr[0]
+	}
+	var _dif *float64
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ztgsen", C.LAPACKE_ztgsen_work((C.int)(rowMajor), (C.lapack_int)(ijob), (C.lapack_logical)(wantq), (C.lapack_logical)(wantz), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_z), (C.lapack_int)(ldz), (*C.lapack_int)(_m), (*C.double)(_pl), (*C.double)(_pr), (*C.double)(_dif), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsja.f.
+func (Checked) Stgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, ncycle []Int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float32
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *float32
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _ncycle *Int
+	if len(ncycle) > 0 {
+		_ncycle = &ncycle[0]
+	}
+	return newError("stgsja", C.LAPACKE_stgsja_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (C.float)(tola), (C.float)(tolb), (*C.float)(_alpha), (*C.float)(_beta), (*C.float)(_u), (C.lapack_int)(ldu), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_work), (*C.lapack_int)(_ncycle)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsja.f.
+func (Checked) Dtgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, ncycle []Int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *float64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _ncycle *Int
+	if len(ncycle) > 0 {
+		_ncycle = &ncycle[0]
+	}
+	return newError("dtgsja", C.LAPACKE_dtgsja_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (C.double)(tola), (C.double)(tolb), (*C.double)(_alpha), (*C.double)(_beta), (*C.double)(_u), (C.lapack_int)(ldu), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_work), (*C.lapack_int)(_ncycle)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsja.f.
+func (Checked) Ctgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex64, lda int, b []complex64, ldb int, tola, tolb float32, alpha, beta []float32, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, work []complex64, ncycle []Int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float32
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *complex64
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _ncycle *Int
+	if len(ncycle) > 0 {
+		_ncycle = &ncycle[0]
+	}
+	return newError("ctgsja", C.LAPACKE_ctgsja_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (C.float)(tola), (C.float)(tolb), (*C.float)(_alpha), (*C.float)(_beta), (*C.lapack_complex_float)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_work), (*C.lapack_int)(_ncycle)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsja.f.
+func (Checked) Ztgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, ncycle []Int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _alpha *float64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _u *complex128
+	if len(u) > 0 {
+		_u = &u[0]
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _ncycle *Int
+	if len(ncycle) > 0 {
+		_ncycle = &ncycle[0]
+	}
+	return newError("ztgsja", C.LAPACKE_ztgsja_work((C.int)(rowMajor), (C.char)(jobu), (C.char)(jobv), (C.char)(jobq), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (C.double)(tola), (C.double)(tolb), (*C.double)(_alpha), (*C.double)(_beta), (*C.lapack_complex_double)(_u), (C.lapack_int)(ldu), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_work), (*C.lapack_int)(_ncycle)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsna.f.
+func (Checked) Stgsna(job, howmny byte, sel []Int, n int, a []float32, lda int, b []float32, ldb int, vl []float32, ldvl int, vr []float32, ldvr int, s, dif []float32, mm int, m []Int, work []float32, lwork int, iwork []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _dif *float32
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("stgsna", C.LAPACKE_stgsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (*C.float)(_s), (*C.float)(_dif), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsna.f.
+func (Checked) Dtgsna(job, howmny byte, sel []Int, n int, a []float64, lda int, b []float64, ldb int, vl []float64, ldvl int, vr []float64, ldvr int, s, dif []float64, mm int, m []Int, work []float64, lwork int, iwork []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _dif *float64
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtgsna", C.LAPACKE_dtgsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (*C.double)(_s), (*C.double)(_dif), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsna.f.
+func (Checked) Ctgsna(job, howmny byte, sel []Int, n int, a []complex64, lda int, b []complex64, ldb int, vl []complex64, ldvl int, vr []complex64, ldvr int, s, dif []float32, mm int, m []Int, work []complex64, lwork int, iwork []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _dif *float32
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ctgsna", C.LAPACKE_ctgsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (*C.float)(_s), (*C.float)(_dif), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsna.f.
+func (Checked) Ztgsna(job, howmny byte, sel []Int, n int, a []complex128, lda int, b []complex128, ldb int, vl []complex128, ldvl int, vr []complex128, ldvr int, s, dif []float64, mm int, m []Int, work []complex128, lwork int, iwork []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _dif *float64
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ztgsna", C.LAPACKE_ztgsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (*C.double)(_s), (*C.double)(_dif), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsyl.f.
+func (Checked) Stgsyl(trans byte, ijob byte, m, n int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, d []float32, ldd int, e []float32, lde int, f []float32, ldf int, scale, dif, work []float32, lwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *float32
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float32
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _f *float32
+	if len(f) > 0 {
+		_f = &f[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _dif *float32
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("stgsyl", C.LAPACKE_stgsyl_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(ijob), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_d), (C.lapack_int)(ldd), (*C.float)(_e), (C.lapack_int)(lde), (*C.float)(_f), (C.lapack_int)(ldf), (*C.float)(_scale), (*C.float)(_dif), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsyl.f.
+func (Checked) Dtgsyl(trans byte, ijob byte, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, d []float64, ldd int, e []float64, lde int, f []float64, ldf int, scale, dif, work []float64, lwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *float64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *float64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _f *float64
+	if len(f) > 0 {
+		_f = &f[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _dif *float64
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtgsyl", C.LAPACKE_dtgsyl_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(ijob), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_d), (C.lapack_int)(ldd), (*C.double)(_e), (C.lapack_int)(lde), (*C.double)(_f), (C.lapack_int)(ldf), (*C.double)(_scale), (*C.double)(_dif), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsyl.f.
+func (Checked) Ctgsyl(trans byte, ijob byte, m, n int, a []complex64, lda int, b []complex64, ldb int, c []complex64, ldc int, d []complex64, ldd int, e []complex64, lde int, f []complex64, ldf int, scale, dif []float32, work []complex64, lwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *complex64
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex64
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _f *complex64
+	if len(f) > 0 {
+		_f = &f[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _dif *float32
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ctgsyl", C.LAPACKE_ctgsyl_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(ijob), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_d), (C.lapack_int)(ldd), (*C.lapack_complex_float)(_e), (C.lapack_int)(lde), (*C.lapack_complex_float)(_f), (C.lapack_int)(ldf), (*C.float)(_scale), (*C.float)(_dif), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsyl.f.
+func (Checked) Ztgsyl(trans byte, ijob byte, m, n int, a []complex128, lda int, b []complex128, ldb int, c []complex128, ldc int, d []complex128, ldd int, e []complex128, lde int, f []complex128, ldf int, scale, dif []float64, work []complex128, lwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _d *complex128
+	if len(d) > 0 {
+		_d = &d[0]
+	}
+	var _e *complex128
+	if len(e) > 0 {
+		_e = &e[0]
+	}
+	var _f *complex128
+	if len(f) > 0 {
+		_f = &f[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	var _dif *float64
+	if len(dif) > 0 {
+		_dif = &dif[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("ztgsyl", C.LAPACKE_ztgsyl_work((C.int)(rowMajor), (C.char)(trans), (C.lapack_int)(ijob), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_d), (C.lapack_int)(ldd), (*C.lapack_complex_double)(_e), (C.lapack_int)(lde), (*C.lapack_complex_double)(_f), (C.lapack_int)(ldf), (*C.double)(_scale), (*C.double)(_dif), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stpcon.f.
+func (Checked) Stpcon(norm, ul, d byte, n int, ap, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("stpcon", C.LAPACKE_stpcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtpcon.f.
+func (Checked) Dtpcon(norm, ul, d byte, n int, ap, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtpcon", C.LAPACKE_dtpcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctpcon.f.
+func (Checked) Ctpcon(norm, ul, d byte, n int, ap []complex64, rcond []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctpcon", C.LAPACKE_ctpcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztpcon.f.
+func (Checked) Ztpcon(norm, ul, d byte, n int, ap []complex128, rcond []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztpcon", C.LAPACKE_ztpcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stprfs.f.
+func (Checked) Stprfs(ul, trans, d byte, n, nrhs int, ap, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("stprfs", C.LAPACKE_stprfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtprfs.f.
+func (Checked) Dtprfs(ul, trans, d byte, n, nrhs int, ap, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtprfs", C.LAPACKE_dtprfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctprfs.f.
+func (Checked) Ctprfs(ul, trans, d byte, n, nrhs int, ap, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctprfs", C.LAPACKE_ctprfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztprfs.f.
+func (Checked) Ztprfs(ul, trans, d byte, n, nrhs int, ap, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztprfs", C.LAPACKE_ztprfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stptri.f.
+func (Checked) Stptri(ul, d byte, n int, ap []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("stptri", C.LAPACKE_stptri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtptri.f.
+func (Checked) Dtptri(ul, d byte, n int, ap []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("dtptri", C.LAPACKE_dtptri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctptri.f.
+func (Checked) Ctptri(ul, d byte, n int, ap []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("ctptri", C.LAPACKE_ctptri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztptri.f.
+func (Checked) Ztptri(ul, d byte, n int, ap []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("ztptri", C.LAPACKE_ztptri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stptrs.f.
+func (Checked) Stptrs(ul, trans, d byte, n, nrhs int, ap, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("stptrs", C.LAPACKE_stptrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_ap), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtptrs.f.
+func (Checked) Dtptrs(ul, trans, d byte, n, nrhs int, ap, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dtptrs", C.LAPACKE_dtptrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_ap), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctptrs.f.
+func (Checked) Ctptrs(ul, trans, d byte, n, nrhs int, ap, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ctptrs", C.LAPACKE_ctptrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztptrs.f.
+func (Checked) Ztptrs(ul, trans, d byte, n, nrhs int, ap, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ztptrs", C.LAPACKE_ztptrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stpttf.f.
+func (Checked) Stpttf(transr, ul byte, n int, ap, arf []float32) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _arf *float32
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("stpttf", C.LAPACKE_stpttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtpttf.f.
+func (Checked) Dtpttf(transr, ul byte, n int, ap, arf []float64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _arf *float64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("dtpttf", C.LAPACKE_dtpttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctpttf.f.
+func (Checked) Ctpttf(transr, ul byte, n int, ap, arf []complex64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _arf *complex64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("ctpttf", C.LAPACKE_ctpttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztpttf.f.
+func (Checked) Ztpttf(transr, ul byte, n int, ap, arf []complex128) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _arf *complex128
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("ztpttf", C.LAPACKE_ztpttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stpttr.f.
+func (Checked) Stpttr(ul byte, n int, ap, a []float32, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("stpttr", C.LAPACKE_stpttr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_ap), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtpttr.f.
+func (Checked) Dtpttr(ul byte, n int, ap, a []float64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dtpttr", C.LAPACKE_dtpttr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_ap), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctpttr.f.
+func (Checked) Ctpttr(ul byte, n int, ap, a []complex64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ctpttr", C.LAPACKE_ctpttr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztpttr.f.
+func (Checked) Ztpttr(ul byte, n int, ap, a []complex128, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ztpttr", C.LAPACKE_ztpttr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strcon.f.
+func (Checked) Strcon(norm, ul, d byte, n int, a []float32, lda int, rcond, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("strcon", C.LAPACKE_strcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_rcond), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrcon.f.
+func (Checked) Dtrcon(norm, ul, d byte, n int, a []float64, lda int, rcond, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtrcon", C.LAPACKE_dtrcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_rcond), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrcon.f.
+func (Checked) Ctrcon(norm, ul, d byte, n int, a []complex64, lda int, rcond []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float32
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctrcon", C.LAPACKE_ctrcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.float)(_rcond), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrcon.f.
+func (Checked) Ztrcon(norm, ul, d byte, n int, a []complex128, lda int, rcond []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _rcond *float64
+	if len(rcond) > 0 {
+		_rcond = &rcond[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztrcon", C.LAPACKE_ztrcon_work((C.int)(rowMajor), (C.char)(norm), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_rcond), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strevc.f.
+func (Checked) Strevc(side, howmny byte, sel []Int, n int, t []float32, ldt int, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []Int, work []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("strevc", C.LAPACKE_strevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrevc.f.
+func (Checked) Dtrevc(side, howmny byte, sel []Int, n int, t []float64, ldt int, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []Int, work []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtrevc", C.LAPACKE_dtrevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrevc.f.
+func (Checked) Ctrevc(side, howmny byte, sel []Int, n int, t []complex64, ldt int, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []Int, work []complex64, rwork []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctrevc", C.LAPACKE_ctrevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrevc.f.
+func (Checked) Ztrevc(side, howmny byte, sel []Int, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []Int, work []complex128, rwork []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztrevc", C.LAPACKE_ztrevc_work((C.int)(rowMajor), (C.char)(side), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strexc.f.
+func (Checked) Strexc(compq byte, n int, t []float32, ldt int, q []float32, ldq int, ifst, ilst []Int, work []float32) error {
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _ifst *Int
+	if len(ifst) > 0 {
+		_ifst = &ifst[0]
+	}
+	var _ilst *Int
+	if len(ilst) > 0 {
+		_ilst = &ilst[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("strexc", C.LAPACKE_strexc_work((C.int)(rowMajor), (C.char)(compq), (C.lapack_int)(n), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_q), (C.lapack_int)(ldq), (*C.lapack_int)(_ifst), (*C.lapack_int)(_ilst), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrexc.f.
+func (Checked) Dtrexc(compq byte, n int, t []float64, ldt int, q []float64, ldq int, ifst, ilst []Int, work []float64) error {
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _ifst *Int
+	if len(ifst) > 0 {
+		_ifst = &ifst[0]
+	}
+	var _ilst *Int
+	if len(ilst) > 0 {
+		_ilst = &ilst[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtrexc", C.LAPACKE_dtrexc_work((C.int)(rowMajor), (C.char)(compq), (C.lapack_int)(n), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_q), (C.lapack_int)(ldq), (*C.lapack_int)(_ifst), (*C.lapack_int)(_ilst), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrexc.f.
+func (Checked) Ctrexc(compq byte, n int, t []complex64, ldt int, q []complex64, ldq, ifst, ilst int) error {
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	return newError("ctrexc", C.LAPACKE_ctrexc_work((C.int)(rowMajor), (C.char)(compq), (C.lapack_int)(n), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (C.lapack_int)(ifst), (C.lapack_int)(ilst)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrexc.f.
+func (Checked) Ztrexc(compq byte, n int, t []complex128, ldt int, q []complex128, ldq, ifst, ilst int) error {
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	return newError("ztrexc", C.LAPACKE_ztrexc_work((C.int)(rowMajor), (C.char)(compq), (C.lapack_int)(n), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (C.lapack_int)(ifst), (C.lapack_int)(ilst)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strrfs.f.
+func (Checked) Strrfs(ul, trans, d byte, n, nrhs int, a []float32, lda int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("strrfs", C.LAPACKE_strrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.float)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrrfs.f.
+func (Checked) Dtrrfs(ul, trans, d byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *float64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtrrfs", C.LAPACKE_dtrrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.double)(_work), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrrfs.f.
+func (Checked) Ctrrfs(ul, trans, d byte, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float32
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float32
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctrrfs", C.LAPACKE_ctrrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_x), (C.lapack_int)(ldx), (*C.float)(_ferr), (*C.float)(_berr), (*C.lapack_complex_float)(_work), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrrfs.f.
+func (Checked) Ztrrfs(ul, trans, d byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ferr *float64
+	if len(ferr) > 0 {
+		_ferr = &ferr[0]
+	}
+	var _berr *float64
+	if len(berr) > 0 {
+		_berr = &berr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztrrfs", C.LAPACKE_ztrrfs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_x), (C.lapack_int)(ldx), (*C.double)(_ferr), (*C.double)(_berr), (*C.lapack_complex_double)(_work), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strsen.f.
+func (Checked) Strsen(job, compq byte, sel []Int, n int, t []float32, ldt int, q []float32, ldq int, wr, wi []float32, m []Int, s, sep, work []float32, lwork int, iwork []Int, liwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *float32
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float32
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("strsen", C.LAPACKE_strsen_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_q), (C.lapack_int)(ldq), (*C.float)(_wr), (*C.float)(_wi), (*C.lapack_int)(_m), (*C.float)(_s), (*C.float)(_sep), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrsen.f.
+func (Checked) Dtrsen(job, compq byte, sel []Int, n int, t []float64, ldt int, q []float64, ldq int, wr, wi []float64, m []Int, s, sep, work []float64, lwork int, iwork []Int, liwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *float64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float64
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtrsen", C.LAPACKE_dtrsen_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_q), (C.lapack_int)(ldq), (*C.double)(_wr), (*C.double)(_wi), (*C.lapack_int)(_m), (*C.double)(_s), (*C.double)(_sep), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrsen.f.
+func (Checked) Ctrsen(job, compq byte, sel []Int, n int, t []complex64, ldt int, q []complex64, ldq int, w []complex64, m []Int, s, sep []float32, work []complex64, lwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float32
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ctrsen", C.LAPACKE_ctrsen_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_w), (*C.lapack_int)(_m), (*C.float)(_s), (*C.float)(_sep), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrsen.f.
+func (Checked) Ztrsen(job, compq byte, sel []Int, n int, t []complex128, ldt int, q []complex128, ldq int, w []complex128, m []Int, s, sep []float64, work []complex128, lwork int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float64
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ztrsen", C.LAPACKE_ztrsen_work((C.int)(rowMajor), (C.char)(job), (C.char)(compq), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_w), (*C.lapack_int)(_m), (*C.double)(_s), (*C.double)(_sep), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strsna.f.
+func (Checked) Strsna(job, howmny byte, sel []Int, n int, t []float32, ldt int, vl []float32, ldvl int, vr []float32, ldvr int, s, sep []float32, mm int, m []Int, work []float32, ldwork int, iwork []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *float32
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float32
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float32
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("strsna", C.LAPACKE_strsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_vl), (C.lapack_int)(ldvl), (*C.float)(_vr), (C.lapack_int)(ldvr), (*C.float)(_s), (*C.float)(_sep), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.float)(_work), (C.lapack_int)(ldwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrsna.f.
+func (Checked) Dtrsna(job, howmny byte, sel []Int, n int, t []float64, ldt int, vl []float64, ldvl int, vr []float64, ldvr int, s, sep []float64, mm int, m []Int, work []float64, ldwork int, iwork []Int) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *float64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *float64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float64
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dtrsna", C.LAPACKE_dtrsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_vl), (C.lapack_int)(ldvl), (*C.double)(_vr), (C.lapack_int)(ldvr), (*C.double)(_s), (*C.double)(_sep), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.double)(_work), (C.lapack_int)(ldwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrsna.f.
+func (Checked) Ctrsna(job, howmny byte, sel []Int, n int, t []complex64, ldt int, vl []complex64, ldvl int, vr []complex64, ldvr int, s, sep []float32, mm int, m []Int, work []complex64, ldwork int, rwork []float32) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *complex64
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex64
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float32
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float32
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ctrsna", C.LAPACKE_ctrsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_float)(_vr), (C.lapack_int)(ldvr), (*C.float)(_s), (*C.float)(_sep), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_float)(_work), (C.lapack_int)(ldwork), (*C.float)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrsna.f.
+func (Checked) Ztrsna(job, howmny byte, sel []Int, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr int, s, sep []float64, mm int, m []Int, work []complex128, ldwork int, rwork []float64) error {
+	var _sel *Int
+	if len(sel) > 0 {
+		_sel = &sel[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _vl *complex128
+	if len(vl) > 0 {
+		_vl = &vl[0]
+	}
+	var _vr *complex128
+	if len(vr) > 0 {
+		_vr = &vr[0]
+	}
+	var _s *float64
+	if len(s) > 0 {
+		_s = &s[0]
+	}
+	var _sep *float64
+	if len(sep) > 0 {
+		_sep = &sep[0]
+	}
+	var _m *Int
+	if len(m) > 0 {
+		_m = &m[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("ztrsna", C.LAPACKE_ztrsna_work((C.int)(rowMajor), (C.char)(job), (C.char)(howmny), (*C.lapack_int)(_sel), (C.lapack_int)(n), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_vl), (C.lapack_int)(ldvl), (*C.lapack_complex_double)(_vr), (C.lapack_int)(ldvr), (*C.double)(_s), (*C.double)(_sep), (C.lapack_int)(mm), (*C.lapack_int)(_m), (*C.lapack_complex_double)(_work), (C.lapack_int)(ldwork), (*C.double)(_rwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strsyl.f.
+func (Checked) Strsyl(trana, tranb byte, isgn, m, n int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, scale []float32) error {
+	switch trana {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trana")
+	}
+	switch tranb {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad tranb")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("strsyl", C.LAPACKE_strsyl_work((C.int)(rowMajor), (C.char)(trana), (C.char)(tranb), (C.lapack_int)(isgn), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrsyl.f.
+func (Checked) Dtrsyl(trana, tranb byte, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, scale []float64) error {
+	switch trana {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trana")
+	}
+	switch tranb {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad tranb")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("dtrsyl", C.LAPACKE_dtrsyl_work((C.int)(rowMajor), (C.char)(trana), (C.char)(tranb), (C.lapack_int)(isgn), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrsyl.f.
+func (Checked) Ctrsyl(trana, tranb byte, isgn, m, n int, a []complex64, lda int, b []complex64, ldb int, c []complex64, ldc int, scale []float32) error {
+	switch trana {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trana")
+	}
+	switch tranb {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad tranb")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _scale *float32
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("ctrsyl", C.LAPACKE_ctrsyl_work((C.int)(rowMajor), (C.char)(trana), (C.char)(tranb), (C.lapack_int)(isgn), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.float)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrsyl.f.
+func (Checked) Ztrsyl(trana, tranb byte, isgn, m, n int, a []complex128, lda int, b []complex128, ldb int, c []complex128, ldc int, scale []float64) error {
+	switch trana {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trana")
+	}
+	switch tranb {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad tranb")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _scale *float64
+	if len(scale) > 0 {
+		_scale = &scale[0]
+	}
+	return newError("ztrsyl", C.LAPACKE_ztrsyl_work((C.int)(rowMajor), (C.char)(trana), (C.char)(tranb), (C.lapack_int)(isgn), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.double)(_scale)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strtri.f.
+func (Checked) Strtri(ul, d byte, n int, a []float32, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("strtri", C.LAPACKE_strtri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrtri.f.
+func (Checked) Dtrtri(ul, d byte, n int, a []float64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dtrtri", C.LAPACKE_dtrtri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrtri.f.
+func (Checked) Ctrtri(ul, d byte, n int, a []complex64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ctrtri", C.LAPACKE_ctrtri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrtri.f.
+func (Checked) Ztrtri(ul, d byte, n int, a []complex128, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ztrtri", C.LAPACKE_ztrtri_work((C.int)(rowMajor), (C.char)(ul), (C.char)(d), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strtrs.f.
+func (Checked) Strtrs(ul, trans, d byte, n, nrhs int, a []float32, lda int, b []float32, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("strtrs", C.LAPACKE_strtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrtrs.f.
+func (Checked) Dtrtrs(ul, trans, d byte, n, nrhs int, a []float64, lda int, b []float64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("dtrtrs", C.LAPACKE_dtrtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrtrs.f.
+func (Checked) Ctrtrs(ul, trans, d byte, n, nrhs int, a []complex64, lda int, b []complex64, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ctrtrs", C.LAPACKE_ctrtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrtrs.f.
+func (Checked) Ztrtrs(ul, trans, d byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch d {
+	case 'U', 'N':
+	default:
+		panic("lapack: bad diagonal")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	return newError("ztrtrs", C.LAPACKE_ztrtrs_work((C.int)(rowMajor), (C.char)(ul), (C.char)(trans), (C.char)(d), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strttf.f.
+func (Checked) Strttf(transr, ul byte, n int, a []float32, lda int, arf []float32) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _arf *float32
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("strttf", C.LAPACKE_strttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrttf.f.
+func (Checked) Dtrttf(transr, ul byte, n int, a []float64, lda int, arf []float64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _arf *float64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("dtrttf", C.LAPACKE_dtrttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrttf.f.
+func (Checked) Ctrttf(transr, ul byte, n int, a []complex64, lda int, arf []complex64) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _arf *complex64
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("ctrttf", C.LAPACKE_ctrttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrttf.f.
+func (Checked) Ztrttf(transr, ul byte, n int, a []complex128, lda int, arf []complex128) error {
+	switch transr {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad transr")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _arf *complex128
+	if len(arf) > 0 {
+		_arf = &arf[0]
+	}
+	return newError("ztrttf", C.LAPACKE_ztrttf_work((C.int)(rowMajor), (C.char)(transr), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_arf)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strttp.f.
+func (Checked) Strttp(ul byte, n int, a []float32, lda int, ap []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ap *float32
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("strttp", C.LAPACKE_strttp_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrttp.f.
+func (Checked) Dtrttp(ul byte, n int, a []float64, lda int, ap []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ap *float64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("dtrttp", C.LAPACKE_dtrttp_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrttp.f.
+func (Checked) Ctrttp(ul byte, n int, a []complex64, lda int, ap []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("ctrttp", C.LAPACKE_ctrttp_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrttp.f.
+func (Checked) Ztrttp(ul byte, n int, a []complex128, lda int, ap []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	return newError("ztrttp", C.LAPACKE_ztrttp_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_ap)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stzrzf.f.
+func (Checked) Stzrzf(m, n int, a []float32, lda int, tau, work []float32, lwork int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float32
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("stzrzf", C.LAPACKE_stzrzf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_tau), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtzrzf.f.
+func (Checked) Dtzrzf(m, n int, a []float64, lda int, tau, work []float64, lwork int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *float64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtzrzf", C.LAPACKE_dtzrzf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_tau), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctzrzf.f.
+func (Checked) Ctzrzf(m, n int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ctzrzf", C.LAPACKE_ctzrzf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztzrzf.f.
+func (Checked) Ztzrzf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ztzrzf", C.LAPACKE_ztzrzf_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cungbr.f.
+func (Checked) Cungbr(vect byte, m, n, k int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cungbr", C.LAPACKE_cungbr_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zungbr.f.
+func (Checked) Zungbr(vect byte, m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zungbr", C.LAPACKE_zungbr_work((C.int)(rowMajor), (C.char)(vect), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunghr.f.
+func (Checked) Cunghr(n, ilo, ihi int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunghr", C.LAPACKE_cunghr_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunghr.f.
+func (Checked) Zunghr(n, ilo, ihi int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunghr", C.LAPACKE_zunghr_work((C.int)(rowMajor), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunglq.f.
+func (Checked) Cunglq(m, n, k int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunglq", C.LAPACKE_cunglq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunglq.f.
+func (Checked) Zunglq(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunglq", C.LAPACKE_zunglq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cungql.f.
+func (Checked) Cungql(m, n, k int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cungql", C.LAPACKE_cungql_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zungql.f.
+func (Checked) Zungql(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zungql", C.LAPACKE_zungql_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cungqr.f.
+func (Checked) Cungqr(m, n, k int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cungqr", C.LAPACKE_cungqr_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zungqr.f.
+func (Checked) Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zungqr", C.LAPACKE_zungqr_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cungrq.f.
+func (Checked) Cungrq(m, n, k int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cungrq", C.LAPACKE_cungrq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zungrq.f.
+func (Checked) Zungrq(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zungrq", C.LAPACKE_zungrq_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cungtr.f.
+func (Checked) Cungtr(ul byte, n int, a []complex64, lda int, tau, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cungtr", C.LAPACKE_cungtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zungtr.f.
+func (Checked) Zungtr(ul byte, n int, a []complex128, lda int, tau, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zungtr", C.LAPACKE_zungtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmbr.f.
+func (Checked) Cunmbr(vect, side, trans byte, m, n, k int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmbr", C.LAPACKE_cunmbr_work((C.int)(rowMajor), (C.char)(vect), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmbr.f.
+func (Checked) Zunmbr(vect, side, trans byte, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmbr", C.LAPACKE_zunmbr_work((C.int)(rowMajor), (C.char)(vect), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmhr.f.
+func (Checked) Cunmhr(side, trans byte, m, n, ilo, ihi int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmhr", C.LAPACKE_cunmhr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmhr.f.
+func (Checked) Zunmhr(side, trans byte, m, n, ilo, ihi int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmhr", C.LAPACKE_zunmhr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(ilo), (C.lapack_int)(ihi), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmlq.f.
+func (Checked) Cunmlq(side, trans byte, m, n, k int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmlq", C.LAPACKE_cunmlq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmlq.f.
+func (Checked) Zunmlq(side, trans byte, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmlq", C.LAPACKE_zunmlq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmql.f.
+func (Checked) Cunmql(side, trans byte, m, n, k int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmql", C.LAPACKE_cunmql_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmql.f.
+func (Checked) Zunmql(side, trans byte, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmql", C.LAPACKE_zunmql_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmqr.f.
+func (Checked) Cunmqr(side, trans byte, m, n, k int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmqr", C.LAPACKE_cunmqr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmqr.f.
+func (Checked) Zunmqr(side, trans byte, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmqr", C.LAPACKE_zunmqr_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmrq.f.
+func (Checked) Cunmrq(side, trans byte, m, n, k int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmrq", C.LAPACKE_cunmrq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmrq.f.
+func (Checked) Zunmrq(side, trans byte, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmrq", C.LAPACKE_zunmrq_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmrz.f.
+func (Checked) Cunmrz(side, trans byte, m, n, k, l int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmrz", C.LAPACKE_cunmrz_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmrz.f.
+func (Checked) Zunmrz(side, trans byte, m, n, k, l int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmrz", C.LAPACKE_zunmrz_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunmtr.f.
+func (Checked) Cunmtr(side, ul, trans byte, m, n int, a []complex64, lda int, tau, c []complex64, ldc int, work []complex64, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunmtr", C.LAPACKE_cunmtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunmtr.f.
+func (Checked) Zunmtr(side, ul, trans byte, m, n int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunmtr", C.LAPACKE_zunmtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cupgtr.f.
+func (Checked) Cupgtr(ul byte, n int, ap, tau, q []complex64, ldq int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _q *complex64
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cupgtr", C.LAPACKE_cupgtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zupgtr.f.
+func (Checked) Zupgtr(ul byte, n int, ap, tau, q []complex128, ldq int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _q *complex128
+	if len(q) > 0 {
+		_q = &q[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zupgtr", C.LAPACKE_zupgtr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_q), (C.lapack_int)(ldq), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cupmtr.f.
+func (Checked) Cupmtr(side, ul, trans byte, m, n int, ap, tau, c []complex64, ldc int, work []complex64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ap *complex64
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *complex64
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cupmtr", C.LAPACKE_cupmtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_ap), (*C.lapack_complex_float)(_tau), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zupmtr.f.
+func (Checked) Zupmtr(side, ul, trans byte, m, n int, ap, tau, c []complex128, ldc int, work []complex128) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _tau *complex128
+	if len(tau) > 0 {
+		_tau = &tau[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zupmtr", C.LAPACKE_zupmtr_work((C.int)(rowMajor), (C.char)(side), (C.char)(ul), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_ap), (*C.lapack_complex_double)(_tau), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cbbcsd.f.
+func (Checked) Cbbcsd(jobu1, jobu2, jobv1t, jobv2t, trans byte, m, p, q int, theta, phi []float32, u1 []complex64, ldu1 int, u2 []complex64, ldu2 int, v1t []complex64, ldv1t int, v2t []complex64, ldv2t int, b11d, b11e, b12d, b12e, b21d, b21e, b22d, b22e, rwork []float32, lrwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _theta *float32
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float32
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _u1 *complex64
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *complex64
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *complex64
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *complex64
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _b11d *float32
+	if len(b11d) > 0 {
+		_b11d = &b11d[0]
+	}
+	var _b11e *float32
+	if len(b11e) > 0 {
+		_b11e = &b11e[0]
+	}
+	var _b12d *float32
+	if len(b12d) > 0 {
+		_b12d = &b12d[0]
+	}
+	var _b12e *float32
+	if len(b12e) > 0 {
+		_b12e = &b12e[0]
+	}
+	var _b21d *float32
+	if len(b21d) > 0 {
+		_b21d = &b21d[0]
+	}
+	var _b21e *float32
+	if len(b21e) > 0 {
+		_b21e = &b21e[0]
+	}
+	var _b22d *float32
+	if len(b22d) > 0 {
+		_b22d = &b22d[0]
+	}
+	var _b22e *float32
+	if len(b22e) > 0 {
+		_b22e = &b22e[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("cbbcsd", C.LAPACKE_cbbcsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.float)(_theta), (*C.float)(_phi), (*C.lapack_complex_float)(_u1), (C.lapack_int)(ldu1), (*C.lapack_complex_float)(_u2), (C.lapack_int)(ldu2), (*C.lapack_complex_float)(_v1t), (C.lapack_int)(ldv1t), (*C.lapack_complex_float)(_v2t), (C.lapack_int)(ldv2t), (*C.float)(_b11d), (*C.float)(_b11e), (*C.float)(_b12d), (*C.float)(_b12e), (*C.float)(_b21d), (*C.float)(_b21e), (*C.float)(_b22d), (*C.float)(_b22e), (*C.float)(_rwork), (C.lapack_int)(lrwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheswapr.f.
+func (Checked) Cheswapr(ul byte, n int, a []complex64, i1, i2 int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("cheswapr", C.LAPACKE_cheswapr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(i1), (C.lapack_int)(i2)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetri2.f.
+func (Checked) Chetri2(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chetri2", C.LAPACKE_chetri2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetri2x.f.
+func (Checked) Chetri2x(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, nb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chetri2x", C.LAPACKE_chetri2x_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(nb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetrs2.f.
+func (Checked) Chetrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("chetrs2", C.LAPACKE_chetrs2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csyconv.f.
+func (Checked) Csyconv(ul, way byte, n int, a []complex64, lda int, ipiv []Int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csyconv", C.LAPACKE_csyconv_work((C.int)(rowMajor), (C.char)(ul), (C.char)(way), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csyswapr.f.
+func (Checked) Csyswapr(ul byte, n int, a []complex64, i1, i2 int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("csyswapr", C.LAPACKE_csyswapr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(i1), (C.lapack_int)(i2)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytri2.f.
+func (Checked) Csytri2(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csytri2", C.LAPACKE_csytri2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytri2x.f.
+func (Checked) Csytri2x(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, nb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csytri2x", C.LAPACKE_csytri2x_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(nb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytrs2.f.
+func (Checked) Csytrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("csytrs2", C.LAPACKE_csytrs2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cunbdb.f.
+func (Checked) Cunbdb(trans, signs byte, m, p, q int, x11 []complex64, ldx11 int, x12 []complex64, ldx12 int, x21 []complex64, ldx21 int, x22 []complex64, ldx22 int, theta, phi []float32, taup1, taup2, tauq1, tauq2, work []complex64, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *complex64
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *complex64
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *complex64
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *complex64
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float32
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float32
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _taup1 *complex64
+	if len(taup1) > 0 {
+		_taup1 = &taup1[0]
+	}
+	var _taup2 *complex64
+	if len(taup2) > 0 {
+		_taup2 = &taup2[0]
+	}
+	var _tauq1 *complex64
+	if len(tauq1) > 0 {
+		_tauq1 = &tauq1[0]
+	}
+	var _tauq2 *complex64
+	if len(tauq2) > 0 {
+		_tauq2 = &tauq2[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cunbdb", C.LAPACKE_cunbdb_work((C.int)(rowMajor), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.lapack_complex_float)(_x11), (C.lapack_int)(ldx11), (*C.lapack_complex_float)(_x12), (C.lapack_int)(ldx12), (*C.lapack_complex_float)(_x21), (C.lapack_int)(ldx21), (*C.lapack_complex_float)(_x22), (C.lapack_int)(ldx22), (*C.float)(_theta), (*C.float)(_phi), (*C.lapack_complex_float)(_taup1), (*C.lapack_complex_float)(_taup2), (*C.lapack_complex_float)(_tauq1), (*C.lapack_complex_float)(_tauq2), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cuncsd.f.
+func (Checked) Cuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []complex64, ldx11 int, x12 []complex64, ldx12 int, x21 []complex64, ldx21 int, x22 []complex64, ldx22 int, theta []float32, u1 []complex64, ldu1 int, u2 []complex64, ldu2 int, v1t []complex64, ldv1t int, v2t []complex64, ldv2t int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *complex64
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *complex64
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *complex64
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *complex64
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float32
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *complex64
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *complex64
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *complex64
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *complex64
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cuncsd", C.LAPACKE_cuncsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.lapack_complex_float)(_x11), (C.lapack_int)(ldx11), (*C.lapack_complex_float)(_x12), (C.lapack_int)(ldx12), (*C.lapack_complex_float)(_x21), (C.lapack_int)(ldx21), (*C.lapack_complex_float)(_x22), (C.lapack_int)(ldx22), (*C.float)(_theta), (*C.lapack_complex_float)(_u1), (C.lapack_int)(ldu1), (*C.lapack_complex_float)(_u2), (C.lapack_int)(ldu2), (*C.lapack_complex_float)(_v1t), (C.lapack_int)(ldv1t), (*C.lapack_complex_float)(_v2t), (C.lapack_int)(ldv2t), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cuncsd2by1.f.
+func (Checked) Cuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex64, ldx11 int, x21 []complex64, ldx21 int, theta, u1 []complex64, ldu1 int, u2 []complex64, ldu2 int, v1t []complex64, ldv1t int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int) error {
+	var _x11 *complex64
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x21 *complex64
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _theta *complex64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *complex64
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *complex64
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *complex64
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("cuncsd2by1", C.LAPACKE_cuncsd2by1_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.lapack_complex_float)(_x11), (C.lapack_int)(ldx11), (*C.lapack_complex_float)(_x21), (C.lapack_int)(ldx21), (*C.lapack_complex_float)(_theta), (*C.lapack_complex_float)(_u1), (C.lapack_int)(ldu1), (*C.lapack_complex_float)(_u2), (C.lapack_int)(ldu2), (*C.lapack_complex_float)(_v1t), (C.lapack_int)(ldv1t), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dbbcsd.f.
+func (Checked) Dbbcsd(jobu1, jobu2, jobv1t, jobv2t, trans byte, m, p, q int, theta, phi, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, v2t []float64, ldv2t int, b11d, b11e, b12d, b12e, b21d, b21e, b22d, b22e, work []float64, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _theta *float64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float64
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _u1 *float64
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *float64
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *float64
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *float64
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _b11d *float64
+	if len(b11d) > 0 {
+		_b11d = &b11d[0]
+	}
+	var _b11e *float64
+	if len(b11e) > 0 {
+		_b11e = &b11e[0]
+	}
+	var _b12d *float64
+	if len(b12d) > 0 {
+		_b12d = &b12d[0]
+	}
+	var _b12e *float64
+	if len(b12e) > 0 {
+		_b12e = &b12e[0]
+	}
+	var _b21d *float64
+	if len(b21d) > 0 {
+		_b21d = &b21d[0]
+	}
+	var _b21e *float64
+	if len(b21e) > 0 {
+		_b21e = &b21e[0]
+	}
+	var _b22d *float64
+	if len(b22d) > 0 {
+		_b22d = &b22d[0]
+	}
+	var _b22e *float64
+	if len(b22e) > 0 {
+		_b22e = &b22e[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dbbcsd", C.LAPACKE_dbbcsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.double)(_theta), (*C.double)(_phi), (*C.double)(_u1), (C.lapack_int)(ldu1), (*C.double)(_u2), (C.lapack_int)(ldu2), (*C.double)(_v1t), (C.lapack_int)(ldv1t), (*C.double)(_v2t), (C.lapack_int)(ldv2t), (*C.double)(_b11d), (*C.double)(_b11e), (*C.double)(_b12d), (*C.double)(_b12e), (*C.double)(_b21d), (*C.double)(_b21e), (*C.double)(_b22d), (*C.double)(_b22e), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorbdb.f.
+func (Checked) Dorbdb(trans, signs byte, m, p, q int, x11 []float64, ldx11 int, x12 []float64, ldx12 int, x21 []float64, ldx21 int, x22 []float64, ldx22 int, theta, phi, taup1, taup2, tauq1, tauq2, work []float64, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *float64
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *float64
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *float64
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *float64
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float64
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _taup1 *float64
+	if len(taup1) > 0 {
+		_taup1 = &taup1[0]
+	}
+	var _taup2 *float64
+	if len(taup2) > 0 {
+		_taup2 = &taup2[0]
+	}
+	var _tauq1 *float64
+	if len(tauq1) > 0 {
+		_tauq1 = &tauq1[0]
+	}
+	var _tauq2 *float64
+	if len(tauq2) > 0 {
+		_tauq2 = &tauq2[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dorbdb", C.LAPACKE_dorbdb_work((C.int)(rowMajor), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.double)(_x11), (C.lapack_int)(ldx11), (*C.double)(_x12), (C.lapack_int)(ldx12), (*C.double)(_x21), (C.lapack_int)(ldx21), (*C.double)(_x22), (C.lapack_int)(ldx22), (*C.double)(_theta), (*C.double)(_phi), (*C.double)(_taup1), (*C.double)(_taup2), (*C.double)(_tauq1), (*C.double)(_tauq2), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorcsd.f.
+func (Checked) Dorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []float64, ldx11 int, x12 []float64, ldx12 int, x21 []float64, ldx21 int, x22 []float64, ldx22 int, theta, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, v2t []float64, ldv2t int, work []float64, lwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *float64
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *float64
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *float64
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *float64
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *float64
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *float64
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *float64
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *float64
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dorcsd", C.LAPACKE_dorcsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.double)(_x11), (C.lapack_int)(ldx11), (*C.double)(_x12), (C.lapack_int)(ldx12), (*C.double)(_x21), (C.lapack_int)(ldx21), (*C.double)(_x22), (C.lapack_int)(ldx22), (*C.double)(_theta), (*C.double)(_u1), (C.lapack_int)(ldu1), (*C.double)(_u2), (C.lapack_int)(ldu2), (*C.double)(_v1t), (C.lapack_int)(ldv1t), (*C.double)(_v2t), (C.lapack_int)(ldv2t), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorcsd2by1.f.
+func (Checked) Dorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float64, ldx11 int, x21 []float64, ldx21 int, theta, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, work []float64, lwork int, iwork []Int) error {
+	var _x11 *float64
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x21 *float64
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _theta *float64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *float64
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *float64
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *float64
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("dorcsd2by1", C.LAPACKE_dorcsd2by1_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.double)(_x11), (C.lapack_int)(ldx11), (*C.double)(_x21), (C.lapack_int)(ldx21), (*C.double)(_theta), (*C.double)(_u1), (C.lapack_int)(ldu1), (*C.double)(_u2), (C.lapack_int)(ldu2), (*C.double)(_v1t), (C.lapack_int)(ldv1t), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyconv.f.
+func (Checked) Dsyconv(ul, way byte, n int, a []float64, lda int, ipiv []Int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsyconv", C.LAPACKE_dsyconv_work((C.int)(rowMajor), (C.char)(ul), (C.char)(way), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyswapr.f.
+func (Checked) Dsyswapr(ul byte, n int, a []float64, i1, i2 int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("dsyswapr", C.LAPACKE_dsyswapr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(i1), (C.lapack_int)(i2)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytri2.f.
+func (Checked) Dsytri2(ul byte, n int, a []float64, lda int, ipiv []Int, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsytri2", C.LAPACKE_dsytri2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytri2x.f.
+func (Checked) Dsytri2x(ul byte, n int, a []float64, lda int, ipiv []Int, work []float64, nb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsytri2x", C.LAPACKE_dsytri2x_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_work), (C.lapack_int)(nb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytrs2.f.
+func (Checked) Dsytrs2(ul byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int, work []float64) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dsytrs2", C.LAPACKE_dsytrs2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sbbcsd.f.
+func (Checked) Sbbcsd(jobu1, jobu2, jobv1t, jobv2t, trans byte, m, p, q int, theta, phi, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, v2t []float32, ldv2t int, b11d, b11e, b12d, b12e, b21d, b21e, b22d, b22e, work []float32, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _theta *float32
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float32
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _u1 *float32
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *float32
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *float32
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *float32
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _b11d *float32
+	if len(b11d) > 0 {
+		_b11d = &b11d[0]
+	}
+	var _b11e *float32
+	if len(b11e) > 0 {
+		_b11e = &b11e[0]
+	}
+	var _b12d *float32
+	if len(b12d) > 0 {
+		_b12d = &b12d[0]
+	}
+	var _b12e *float32
+	if len(b12e) > 0 {
+		_b12e = &b12e[0]
+	}
+	var _b21d *float32
+	if len(b21d) > 0 {
+		_b21d = &b21d[0]
+	}
+	var _b21e *float32
+	if len(b21e) > 0 {
+		_b21e = &b21e[0]
+	}
+	var _b22d *float32
+	if len(b22d) > 0 {
+		_b22d = &b22d[0]
+	}
+	var _b22e *float32
+	if len(b22e) > 0 {
+		_b22e = &b22e[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sbbcsd", C.LAPACKE_sbbcsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.float)(_theta), (*C.float)(_phi), (*C.float)(_u1), (C.lapack_int)(ldu1), (*C.float)(_u2), (C.lapack_int)(ldu2), (*C.float)(_v1t), (C.lapack_int)(ldv1t), (*C.float)(_v2t), (C.lapack_int)(ldv2t), (*C.float)(_b11d), (*C.float)(_b11e), (*C.float)(_b12d), (*C.float)(_b12e), (*C.float)(_b21d), (*C.float)(_b21e), (*C.float)(_b22d), (*C.float)(_b22e), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorbdb.f.
+func (Checked) Sorbdb(trans, signs byte, m, p, q int, x11 []float32, ldx11 int, x12 []float32, ldx12 int, x21 []float32, ldx21 int, x22 []float32, ldx22 int, theta, phi, taup1, taup2, tauq1, tauq2, work []float32, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *float32
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *float32
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *float32
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *float32
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float32
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float32
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _taup1 *float32
+	if len(taup1) > 0 {
+		_taup1 = &taup1[0]
+	}
+	var _taup2 *float32
+	if len(taup2) > 0 {
+		_taup2 = &taup2[0]
+	}
+	var _tauq1 *float32
+	if len(tauq1) > 0 {
+		_tauq1 = &tauq1[0]
+	}
+	var _tauq2 *float32
+	if len(tauq2) > 0 {
+		_tauq2 = &tauq2[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sorbdb", C.LAPACKE_sorbdb_work((C.int)(rowMajor), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.float)(_x11), (C.lapack_int)(ldx11), (*C.float)(_x12), (C.lapack_int)(ldx12), (*C.float)(_x21), (C.lapack_int)(ldx21), (*C.float)(_x22), (C.lapack_int)(ldx22), (*C.float)(_theta), (*C.float)(_phi), (*C.float)(_taup1), (*C.float)(_taup2), (*C.float)(_tauq1), (*C.float)(_tauq2), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorcsd.f.
+func (Checked) Sorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []float32, ldx11 int, x12 []float32, ldx12 int, x21 []float32, ldx21 int, x22 []float32, ldx22 int, theta, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, v2t []float32, ldv2t int, work []float32, lwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *float32
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *float32
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *float32
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *float32
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float32
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *float32
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *float32
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *float32
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *float32
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sorcsd", C.LAPACKE_sorcsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.float)(_x11), (C.lapack_int)(ldx11), (*C.float)(_x12), (C.lapack_int)(ldx12), (*C.float)(_x21), (C.lapack_int)(ldx21), (*C.float)(_x22), (C.lapack_int)(ldx22), (*C.float)(_theta), (*C.float)(_u1), (C.lapack_int)(ldu1), (*C.float)(_u2), (C.lapack_int)(ldu2), (*C.float)(_v1t), (C.lapack_int)(ldv1t), (*C.float)(_v2t), (C.lapack_int)(ldv2t), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorcsd2by1.f.
+func (Checked) Sorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float32, ldx11 int, x21 []float32, ldx21 int, theta, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, work []float32, lwork int, iwork []Int) error {
+	var _x11 *float32
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x21 *float32
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _theta *float32
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *float32
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *float32
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *float32
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("sorcsd2by1", C.LAPACKE_sorcsd2by1_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.float)(_x11), (C.lapack_int)(ldx11), (*C.float)(_x21), (C.lapack_int)(ldx21), (*C.float)(_theta), (*C.float)(_u1), (C.lapack_int)(ldu1), (*C.float)(_u2), (C.lapack_int)(ldu2), (*C.float)(_v1t), (C.lapack_int)(ldv1t), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyconv.f.
+func (Checked) Ssyconv(ul, way byte, n int, a []float32, lda int, ipiv []Int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssyconv", C.LAPACKE_ssyconv_work((C.int)(rowMajor), (C.char)(ul), (C.char)(way), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyswapr.f.
+func (Checked) Ssyswapr(ul byte, n int, a []float32, i1, i2 int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("ssyswapr", C.LAPACKE_ssyswapr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(i1), (C.lapack_int)(i2)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytri2.f.
+func (Checked) Ssytri2(ul byte, n int, a []float32, lda int, ipiv []Int, work []complex64, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssytri2", C.LAPACKE_ssytri2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytri2x.f.
+func (Checked) Ssytri2x(ul byte, n int, a []float32, lda int, ipiv []Int, work []float32, nb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssytri2x", C.LAPACKE_ssytri2x_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_work), (C.lapack_int)(nb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytrs2.f.
+func (Checked) Ssytrs2(ul byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int, work []float32) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ssytrs2", C.LAPACKE_ssytrs2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zbbcsd.f.
+func (Checked) Zbbcsd(jobu1, jobu2, jobv1t, jobv2t, trans byte, m, p, q int, theta, phi []float64, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, v2t []complex128, ldv2t int, b11d, b11e, b12d, b12e, b21d, b21e, b22d, b22e, rwork []float64, lrwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _theta *float64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float64
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _u1 *complex128
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *complex128
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *complex128
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *complex128
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _b11d *float64
+	if len(b11d) > 0 {
+		_b11d = &b11d[0]
+	}
+	var _b11e *float64
+	if len(b11e) > 0 {
+		_b11e = &b11e[0]
+	}
+	var _b12d *float64
+	if len(b12d) > 0 {
+		_b12d = &b12d[0]
+	}
+	var _b12e *float64
+	if len(b12e) > 0 {
+		_b12e = &b12e[0]
+	}
+	var _b21d *float64
+	if len(b21d) > 0 {
+		_b21d = &b21d[0]
+	}
+	var _b21e *float64
+	if len(b21e) > 0 {
+		_b21e = &b21e[0]
+	}
+	var _b22d *float64
+	if len(b22d) > 0 {
+		_b22d = &b22d[0]
+	}
+	var _b22e *float64
+	if len(b22e) > 0 {
+		_b22e = &b22e[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	return newError("zbbcsd", C.LAPACKE_zbbcsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.double)(_theta), (*C.double)(_phi), (*C.lapack_complex_double)(_u1), (C.lapack_int)(ldu1), (*C.lapack_complex_double)(_u2), (C.lapack_int)(ldu2), (*C.lapack_complex_double)(_v1t), (C.lapack_int)(ldv1t), (*C.lapack_complex_double)(_v2t), (C.lapack_int)(ldv2t), (*C.double)(_b11d), (*C.double)(_b11e), (*C.double)(_b12d), (*C.double)(_b12e), (*C.double)(_b21d), (*C.double)(_b21e), (*C.double)(_b22d), (*C.double)(_b22e), (*C.double)(_rwork), (C.lapack_int)(lrwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheswapr.f.
+func (Checked) Zheswapr(ul byte, n int, a []complex128, i1, i2 int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zheswapr", C.LAPACKE_zheswapr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(i1), (C.lapack_int)(i2)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetri2.f.
+func (Checked) Zhetri2(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhetri2", C.LAPACKE_zhetri2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetri2x.f.
+func (Checked) Zhetri2x(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, nb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhetri2x", C.LAPACKE_zhetri2x_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(nb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetrs2.f.
+func (Checked) Zhetrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zhetrs2", C.LAPACKE_zhetrs2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsyconv.f.
+func (Checked) Zsyconv(ul, way byte, n int, a []complex128, lda int, ipiv []Int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsyconv", C.LAPACKE_zsyconv_work((C.int)(rowMajor), (C.char)(ul), (C.char)(way), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsyswapr.f.
+func (Checked) Zsyswapr(ul byte, n int, a []complex128, i1, i2 int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zsyswapr", C.LAPACKE_zsyswapr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(i1), (C.lapack_int)(i2)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytri2.f.
+func (Checked) Zsytri2(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsytri2", C.LAPACKE_zsytri2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytri2x.f.
+func (Checked) Zsytri2x(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, nb int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsytri2x", C.LAPACKE_zsytri2x_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_work), (C.lapack_int)(nb)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytrs2.f.
+func (Checked) Zsytrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _ipiv *Int
+	if len(ipiv) > 0 {
+		_ipiv = &ipiv[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zsytrs2", C.LAPACKE_zsytrs2_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_ipiv), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zunbdb.f.
+func (Checked) Zunbdb(trans, signs byte, m, p, q int, x11 []complex128, ldx11 int, x12 []complex128, ldx12 int, x21 []complex128, ldx21 int, x22 []complex128, ldx22 int, theta, phi []float64, taup1, taup2, tauq1, tauq2, work []complex128, lwork int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *complex128
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *complex128
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *complex128
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *complex128
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _phi *float64
+	if len(phi) > 0 {
+		_phi = &phi[0]
+	}
+	var _taup1 *complex128
+	if len(taup1) > 0 {
+		_taup1 = &taup1[0]
+	}
+	var _taup2 *complex128
+	if len(taup2) > 0 {
+		_taup2 = &taup2[0]
+	}
+	var _tauq1 *complex128
+	if len(tauq1) > 0 {
+		_tauq1 = &tauq1[0]
+	}
+	var _tauq2 *complex128
+	if len(tauq2) > 0 {
+		_tauq2 = &tauq2[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zunbdb", C.LAPACKE_zunbdb_work((C.int)(rowMajor), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.lapack_complex_double)(_x11), (C.lapack_int)(ldx11), (*C.lapack_complex_double)(_x12), (C.lapack_int)(ldx12), (*C.lapack_complex_double)(_x21), (C.lapack_int)(ldx21), (*C.lapack_complex_double)(_x22), (C.lapack_int)(ldx22), (*C.double)(_theta), (*C.double)(_phi), (*C.lapack_complex_double)(_taup1), (*C.lapack_complex_double)(_taup2), (*C.lapack_complex_double)(_tauq1), (*C.lapack_complex_double)(_tauq2), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zuncsd.f.
+func (Checked) Zuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []complex128, ldx11 int, x12 []complex128, ldx12 int, x21 []complex128, ldx21 int, x22 []complex128, ldx22 int, theta []float64, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, v2t []complex128, ldv2t int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int) error {
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _x11 *complex128
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x12 *complex128
+	if len(x12) > 0 {
+		_x12 = &x12[0]
+	}
+	var _x21 *complex128
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _x22 *complex128
+	if len(x22) > 0 {
+		_x22 = &x22[0]
+	}
+	var _theta *float64
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *complex128
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *complex128
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *complex128
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _v2t *complex128
+	if len(v2t) > 0 {
+		_v2t = &v2t[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zuncsd", C.LAPACKE_zuncsd_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.char)(jobv2t), (C.char)(trans), (C.char)(signs), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.lapack_complex_double)(_x11), (C.lapack_int)(ldx11), (*C.lapack_complex_double)(_x12), (C.lapack_int)(ldx12), (*C.lapack_complex_double)(_x21), (C.lapack_int)(ldx21), (*C.lapack_complex_double)(_x22), (C.lapack_int)(ldx22), (*C.double)(_theta), (*C.lapack_complex_double)(_u1), (C.lapack_int)(ldu1), (*C.lapack_complex_double)(_u2), (C.lapack_int)(ldu2), (*C.lapack_complex_double)(_v1t), (C.lapack_int)(ldv1t), (*C.lapack_complex_double)(_v2t), (C.lapack_int)(ldv2t), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zuncsd2by1.f.
+func (Checked) Zuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex128, ldx11 int, x21 []complex128, ldx21 int, theta, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int) error {
+	var _x11 *complex128
+	if len(x11) > 0 {
+		_x11 = &x11[0]
+	}
+	var _x21 *complex128
+	if len(x21) > 0 {
+		_x21 = &x21[0]
+	}
+	var _theta *complex128
+	if len(theta) > 0 {
+		_theta = &theta[0]
+	}
+	var _u1 *complex128
+	if len(u1) > 0 {
+		_u1 = &u1[0]
+	}
+	var _u2 *complex128
+	if len(u2) > 0 {
+		_u2 = &u2[0]
+	}
+	var _v1t *complex128
+	if len(v1t) > 0 {
+		_v1t = &v1t[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	return newError("zuncsd2by1", C.LAPACKE_zuncsd2by1_work((C.int)(rowMajor), (C.char)(jobu1), (C.char)(jobu2), (C.char)(jobv1t), (C.lapack_int)(m), (C.lapack_int)(p), (C.lapack_int)(q), (*C.lapack_complex_double)(_x11), (C.lapack_int)(ldx11), (*C.lapack_complex_double)(_x21), (C.lapack_int)(ldx21), (*C.lapack_complex_double)(_theta), (*C.lapack_complex_double)(_u1), (C.lapack_int)(ldu1), (*C.lapack_complex_double)(_u2), (C.lapack_int)(ldu2), (*C.lapack_complex_double)(_v1t), (C.lapack_int)(ldv1t), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (C.lapack_int)(lrwork), (*C.lapack_int)(_iwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgemqrt.f.
+func (Checked) Sgemqrt(side, trans byte, m, n, k, nb int, v []float32, ldv int, t []float32, ldt int, c []float32, ldc int, work []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *float32
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgemqrt", C.LAPACKE_sgemqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(nb), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_c), (C.lapack_int)(ldc), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgemqrt.f.
+func (Checked) Dgemqrt(side, trans byte, m, n, k, nb int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *float64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgemqrt", C.LAPACKE_dgemqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(nb), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_c), (C.lapack_int)(ldc), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgemqrt.f.
+func (Checked) Cgemqrt(side, trans byte, m, n, k, nb int, v []complex64, ldv int, t []complex64, ldt int, c []complex64, ldc int, work []complex64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *complex64
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgemqrt", C.LAPACKE_cgemqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(nb), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgemqrt.f.
+func (Checked) Zgemqrt(side, trans byte, m, n, k, nb int, v []complex128, ldv int, t []complex128, ldt int, c []complex128, ldc int, work []complex128) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgemqrt", C.LAPACKE_zgemqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(nb), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_c), (C.lapack_int)(ldc), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqrt.f.
+func (Checked) Sgeqrt(m, n, nb int, a []float32, lda int, t []float32, ldt int, work []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("sgeqrt", C.LAPACKE_sgeqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nb), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqrt.f.
+func (Checked) Dgeqrt(m, n, nb int, a []float64, lda int, t []float64, ldt int, work []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dgeqrt", C.LAPACKE_dgeqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nb), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqrt.f.
+func (Checked) Cgeqrt(m, n, nb int, a []complex64, lda int, t []complex64, ldt int, work []complex64) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("cgeqrt", C.LAPACKE_cgeqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nb), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqrt.f.
+func (Checked) Zgeqrt(m, n, nb int, a []complex128, lda int, t []complex128, ldt int, work []complex128) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("zgeqrt", C.LAPACKE_zgeqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nb), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqrt2.f.
+func (Checked) Sgeqrt2(m, n int, a []float32, lda int, t []float32, ldt int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("sgeqrt2", C.LAPACKE_sgeqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqrt2.f.
+func (Checked) Dgeqrt2(m, n int, a []float64, lda int, t []float64, ldt int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("dgeqrt2", C.LAPACKE_dgeqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqrt2.f.
+func (Checked) Cgeqrt2(m, n int, a []complex64, lda int, t []complex64, ldt int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("cgeqrt2", C.LAPACKE_cgeqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqrt2.f.
+func (Checked) Zgeqrt2(m, n int, a []complex128, lda int, t []complex128, ldt int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("zgeqrt2", C.LAPACKE_zgeqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqrt3.f.
+func (Checked) Sgeqrt3(m, n int, a []float32, lda int, t []float32, ldt int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("sgeqrt3", C.LAPACKE_sgeqrt3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqrt3.f.
+func (Checked) Dgeqrt3(m, n int, a []float64, lda int, t []float64, ldt int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("dgeqrt3", C.LAPACKE_dgeqrt3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqrt3.f.
+func (Checked) Cgeqrt3(m, n int, a []complex64, lda int, t []complex64, ldt int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("cgeqrt3", C.LAPACKE_cgeqrt3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqrt3.f.
+func (Checked) Zgeqrt3(m, n int, a []complex128, lda int, t []complex128, ldt int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("zgeqrt3", C.LAPACKE_zgeqrt3_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stpmqrt.f.
+func (Checked) Stpmqrt(side, trans byte, m, n, k, l, nb int, v []float32, ldv int, t []float32, ldt int, a []float32, lda int, b []float32, ldb int, work []float32) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("stpmqrt", C.LAPACKE_stpmqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtpmqrt.f.
+func (Checked) Dtpmqrt(side, trans byte, m, n, k, l, nb int, v []float64, ldv int, t []float64, ldt int, a []float64, lda int, b []float64, ldb int, work []float64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtpmqrt", C.LAPACKE_dtpmqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctpmqrt.f.
+func (Checked) Ctpmqrt(side, trans byte, m, n, k, l, nb int, v []complex64, ldv int, t []complex64, ldt int, a []complex64, lda int, b []complex64, ldb int, work []complex64) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ctpmqrt", C.LAPACKE_ctpmqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztpmqrt.f.
+func (Checked) Ztpmqrt(side, trans byte, m, n, k, l, nb int, v []complex128, ldv int, t []complex128, ldt int, a []complex128, lda int, b []complex128, ldb int, work []complex128) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ztpmqrt", C.LAPACKE_ztpmqrt_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stpqrt.f.
+func (Checked) Stpqrt(m, n, l, nb int, a []float32, lda int, b []float32, ldb int, t []float32, ldt int, work []float32) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("stpqrt", C.LAPACKE_stpqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtpqrt.f.
+func (Checked) Dtpqrt(m, n, l, nb int, a []float64, lda int, b []float64, ldb int, t []float64, ldt int, work []float64) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtpqrt", C.LAPACKE_dtpqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctpqrt.f.
+func (Checked) Ctpqrt(m, n, l, nb int, a []complex64, lda int, b []complex64, ldb int, t []complex64, ldt int, work []complex64) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ctpqrt", C.LAPACKE_ctpqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztpqrt.f.
+func (Checked) Ztpqrt(m, n, l, nb int, a []complex128, lda int, b []complex128, ldb int, t []complex128, ldt int, work []complex128) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ztpqrt", C.LAPACKE_ztpqrt_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (C.lapack_int)(nb), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_work)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stpqrt2.f.
+func (Checked) Stpqrt2(m, n, l int, a []float32, lda int, b []float32, ldb int, t []float32, ldt int) error {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("stpqrt2", C.LAPACKE_stpqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtpqrt2.f.
+func (Checked) Dtpqrt2(m, n, l int, a []float64, lda int, b []float64, ldb int, t []float64, ldt int) error {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("dtpqrt2", C.LAPACKE_dtpqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctpqrt2.f.
+func (Checked) Ctpqrt2(m, n, l int, a []complex64, lda int, b []complex64, ldb int, t []complex64, ldt int) error {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("ctpqrt2", C.LAPACKE_ctpqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztpqrt2.f.
+func (Checked) Ztpqrt2(m, n, l int, a []complex128, lda int, b []complex128, ldb int, t []complex128, ldt int) error {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	return newError("ztpqrt2", C.LAPACKE_ztpqrt2_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(l), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stprfb.f.
+func (Checked) Stprfb(side, trans, direct, storev byte, m, n, k, l int, v []float32, ldv int, t []float32, ldt int, a []float32, lda int, b []float32, ldb int, work []float32, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float32
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float32
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("stprfb", C.LAPACKE_stprfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.float)(_v), (C.lapack_int)(ldv), (*C.float)(_t), (C.lapack_int)(ldt), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtprfb.f.
+func (Checked) Dtprfb(side, trans, direct, storev byte, m, n, k, l int, v []float64, ldv int, t []float64, ldt int, a []float64, lda int, b []float64, ldb int, work []float64, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *float64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *float64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("dtprfb", C.LAPACKE_dtprfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.double)(_v), (C.lapack_int)(ldv), (*C.double)(_t), (C.lapack_int)(ldt), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctprfb.f.
+func (Checked) Ctprfb(side, trans, direct, storev byte, m, n, k, l int, v []complex64, ldv int, t []complex64, ldt int, a []complex64, lda int, b []complex64, ldb int, work []complex64, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex64
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex64
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ctprfb", C.LAPACKE_ctprfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.lapack_complex_float)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_float)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_float)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztprfb.f.
+func (Checked) Ztprfb(side, trans, direct, storev byte, m, n, k, l int, v []complex128, ldv int, t []complex128, ldt int, a []complex128, lda int, b []complex128, ldb int, work []complex128, ldwork int) error {
+	switch side {
+	case 'L', 'R':
+	default:
+		panic("lapack: bad side")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _v *complex128
+	if len(v) > 0 {
+		_v = &v[0]
+	}
+	var _t *complex128
+	if len(t) > 0 {
+		_t = &t[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	return newError("ztprfb", C.LAPACKE_ztprfb_work((C.int)(rowMajor), (C.char)(side), (C.char)(trans), (C.char)(direct), (C.char)(storev), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(k), (C.lapack_int)(l), (*C.lapack_complex_double)(_v), (C.lapack_int)(ldv), (*C.lapack_complex_double)(_t), (C.lapack_int)(ldt), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_complex_double)(_work), (C.lapack_int)(ldwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csyr.f.
+func (Checked) Csyr(ul byte, n int, alpha complex64, x []complex64, incx int, a []complex64, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _x *complex64
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("csyr", C.LAPACKE_csyr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_complex_float)(alpha), (*C.lapack_complex_float)(_x), (C.lapack_int)(incx), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsyr.f.
+func (Checked) Zsyr(ul byte, n int, alpha complex128, x []complex128, incx int, a []complex128, lda int) error {
+	switch ul {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad triangle")
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	return newError("zsyr", C.LAPACKE_zsyr_work((C.int)(rowMajor), (C.char)(ul), (C.lapack_int)(n), (C.lapack_complex_double)(alpha), (*C.lapack_complex_double)(_x), (C.lapack_int)(incx), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda)))
+}
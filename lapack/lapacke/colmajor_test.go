@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package lapacke
+
+import (
+	"math"
+	"testing"
+)
+
+func TestColMajorDgetrs(t *testing.T) {
+	const (
+		n    = 3
+		nrhs = 2
+		tol  = 1e-12
+	)
+	a := [n][n]float64{
+		{4, -2, 1},
+		{3, 6, -4},
+		{2, 1, 8},
+	}
+	b := [n][nrhs]float64{
+		{12, -1},
+		{-25, 3},
+		{32, 0.5},
+	}
+
+	// Solve A*X = B with the row-major bindings.
+	aRow := make([]float64, n*n)
+	bRow := make([]float64, n*nrhs)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aRow[i*n+j] = a[i][j]
+		}
+		for j := 0; j < nrhs; j++ {
+			bRow[i*nrhs+j] = b[i][j]
+		}
+	}
+	ipivRow := make([]Int, n)
+	if !Dgetrf(n, n, aRow, n, ipivRow) {
+		t.Fatal("unexpected row-major Dgetrf failure")
+	}
+	if !Dgetrs('N', n, nrhs, aRow, n, ipivRow, bRow, nrhs) {
+		t.Fatal("unexpected row-major Dgetrs failure")
+	}
+
+	// Solve the same system with the column-major bindings using leading
+	// dimensions larger than the number of rows.
+	const lda, ldb = n + 1, n + 2
+	aCol := make([]float64, n*lda)
+	bCol := make([]float64, nrhs*ldb)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aCol[i+j*lda] = a[i][j]
+		}
+		for j := 0; j < nrhs; j++ {
+			bCol[i+j*ldb] = b[i][j]
+		}
+	}
+	ipivCol := make([]Int, n)
+	if !(ColMajor{}).Dgetrf(n, n, aCol, lda, ipivCol) {
+		t.Fatal("unexpected column-major Dgetrf failure")
+	}
+	if !(ColMajor{}).Dgetrs('N', n, nrhs, aCol, lda, ipivCol, bCol, ldb) {
+		t.Fatal("unexpected column-major Dgetrs failure")
+	}
+
+	for i := 0; i < n; i++ {
+		if ipivCol[i] != ipivRow[i] {
+			t.Errorf("unexpected pivot %d: got %d, want %d", i, ipivCol[i], ipivRow[i])
+		}
+		for j := 0; j < nrhs; j++ {
+			got := bCol[i+j*ldb]
+			want := bRow[i*nrhs+j]
+			if math.Abs(got-want) > tol {
+				t.Errorf("unexpected X[%d,%d]: got %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestColMajorBadLd(t *testing.T) {
+	a := []float64{1, 0, 0, 1, 0, 0}
+	ipiv := make([]Int, 2)
+	// A 3×2 column-major matrix needs lda of at least 3.
+	if (ColMajor{}).Dgetrf(3, 2, a, 2, ipiv) {
+		t.Error("expected failure of column-major Dgetrf with lda < m")
+	}
+	// The leading 2×2 block is the identity matrix.
+	if !(ColMajor{}).Dgetrf(2, 2, a, 2, ipiv) {
+		t.Error("unexpected failure of column-major Dgetrf with lda == m")
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include "suffix64.h"
+#include "lapacke.h"
+*/
+import "C"
+
+import "fmt"
+
+// Info values returned by LAPACKE when it fails to allocate memory.
+const (
+	workMemoryError      = -1010 // LAPACK_WORK_MEMORY_ERROR
+	transposeMemoryError = -1011 // LAPACK_TRANSPOSE_MEMORY_ERROR
+)
+
+// Error is the error returned by the methods of Checked when the info value
+// reported by LAPACKE is not zero.
+//
+// A negative Info reports an illegal argument or a failed memory allocation
+// inside LAPACKE and indicates a programming error. When the program links
+// gonum.org/v1/netlib/blas/netlib without the dlopen build tag, an illegal
+// argument that is detected by the LAPACK routine rather than by LAPACKE causes
+// a panic instead, since that package replaces the xerbla_ error handler of the
+// library. A positive Info reports a numerical failure such as a singular
+// matrix or an eigensolver that did not converge, and its interpretation
+// depends on the routine.
+type Error struct {
+	// Routine is the name of the LAPACK routine, for example "dgetrf".
+	Routine string
+	// Info is the info value reported by LAPACKE.
+	Info int
+	// Meaning is a description of Info for Routine.
+	Meaning string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("lapacke: %s returned info=%d: %s", e.Routine, e.Info, e.Meaning)
+}
+
+// Argument returns the one-based position of the argument of the Go binding
+// that had an illegal value, or zero if e does not report an illegal argument.
+func (e *Error) Argument() int {
+	if e.Info >= 0 || e.OutOfMemory() {
+		return 0
+	}
+	// The info value counts the matrix layout argument of the LAPACKE
+	// function, which is not present in the Go bindings.
+	return -e.Info - 1
+}
+
+// OutOfMemory returns whether e reports that LAPACKE failed to allocate
+// workspace or a transposed copy of a matrix.
+func (e *Error) OutOfMemory() bool {
+	return e.Info == workMemoryError || e.Info == transposeMemoryError
+}
+
+// Numerical returns whether e reports a numerical failure of the routine
+// rather than an illegal argument or a failed memory allocation.
+func (e *Error) Numerical() bool {
+	return e.Info > 0
+}
+
+// newError returns an *Error for the info value returned by the LAPACKE
+// function implementing the LAPACK routine with the given name, or nil if
+// info is zero.
+func newError(routine string, info C.lapack_int) error {
+	if info == 0 {
+		return nil
+	}
+	e := &Error{Routine: routine, Info: int(info)}
+	switch {
+	case e.Info == workMemoryError:
+		e.Meaning = "not enough memory to allocate workspace"
+	case e.Info == transposeMemoryError:
+		e.Meaning = "not enough memory to transpose a matrix"
+	case e.Info < 0:
+		e.Meaning = fmt.Sprintf("argument %d had an illegal value", e.Argument())
+	default:
+		var ok bool
+		// The routines in meanings are keyed without their type prefix.
+		e.Meaning, ok = meanings[routine[1:]]
+		if !ok {
+			e.Meaning = "the computation failed"
+		}
+	}
+	return e
+}
+
+// meanings holds the description of positive info values of the major
+// drivers and computational routines. The keys are the routine names without
+// the leading type character so that they cover all four numeric types.
+var meanings = map[string]string{
+	// Linear systems with general, band and tridiagonal matrices.
+	"getrf":  "U[info-1,info-1] is exactly zero so the factor U is singular",
+	"getrf2": "U[info-1,info-1] is exactly zero so the factor U is singular",
+	"getf2":  "U[info-1,info-1] is exactly zero so the factor U is singular",
+	"gesv":   "U[info-1,info-1] is exactly zero so the factor U is singular and the solution has not been computed",
+	"getri":  "U[info-1,info-1] is exactly zero so the matrix is singular and its inverse could not be computed",
+	"gbtrf":  "U[info-1,info-1] is exactly zero so the factor U is singular",
+	"gbsv":   "U[info-1,info-1] is exactly zero so the factor U is singular and the solution has not been computed",
+	"gttrf":  "U[info-1,info-1] is exactly zero so the factor U is singular",
+	"gtsv":   "U[info-1,info-1] is exactly zero so the matrix is singular and the solution has not been computed",
+	"gesvx":  "U[info-1,info-1] is exactly zero so the matrix is singular if info <= n, or the matrix is singular to working precision if info == n+1",
+	"gbsvx":  "U[info-1,info-1] is exactly zero so the matrix is singular if info <= n, or the matrix is singular to working precision if info == n+1",
+	"gtsvx":  "U[info-1,info-1] is exactly zero so the matrix is singular if info <= n, or the matrix is singular to working precision if info == n+1",
+	"sgesv":  "U[info-1,info-1] of the double precision factorization is exactly zero so the solution has not been computed",
+	"cgesv":  "U[info-1,info-1] of the double precision factorization is exactly zero so the solution has not been computed",
+
+	// Linear systems with symmetric positive definite matrices.
+	"potrf":  "the leading minor of order info is not positive definite",
+	"potrf2": "the leading minor of order info is not positive definite",
+	"potri":  "the factor L[info-1,info-1] is exactly zero so the inverse could not be computed",
+	"posv":   "the leading minor of order info is not positive definite and the solution has not been computed",
+	"posvx":  "the leading minor of order info is not positive definite if info <= n, or the matrix is singular to working precision if info == n+1",
+	"pbtrf":  "the leading minor of order info is not positive definite",
+	"pbsv":   "the leading minor of order info is not positive definite and the solution has not been computed",
+	"pptrf":  "the leading minor of order info is not positive definite",
+	"ppsv":   "the leading minor of order info is not positive definite and the solution has not been computed",
+	"pttrf":  "the leading minor of order info is not positive definite",
+	"ptsv":   "the leading minor of order info is not positive definite and the solution has not been computed",
+	"pstrf":  "the matrix is not positive definite or is rank deficient",
+	"sposv":  "the leading minor of order info is not positive definite and the solution has not been computed",
+	"cposv":  "the leading minor of order info is not positive definite and the solution has not been computed",
+
+	// Linear systems with symmetric indefinite and triangular matrices.
+	"sytrf": "D[info-1,info-1] is exactly zero so the block diagonal factor D is singular",
+	"hetrf": "D[info-1,info-1] is exactly zero so the block diagonal factor D is singular",
+	"sytri": "D[info-1,info-1] is exactly zero so the matrix is singular and its inverse could not be computed",
+	"hetri": "D[info-1,info-1] is exactly zero so the matrix is singular and its inverse could not be computed",
+	"sysv":  "D[info-1,info-1] is exactly zero so the matrix is singular and the solution has not been computed",
+	"hesv":  "D[info-1,info-1] is exactly zero so the matrix is singular and the solution has not been computed",
+	"sysvx": "D[info-1,info-1] is exactly zero so the matrix is singular if info <= n, or the matrix is singular to working precision if info == n+1",
+	"hesvx": "D[info-1,info-1] is exactly zero so the matrix is singular if info <= n, or the matrix is singular to working precision if info == n+1",
+	"trtri": "A[info-1,info-1] is exactly zero so the triangular matrix is singular and its inverse could not be computed",
+	"trtrs": "A[info-1,info-1] is exactly zero so the triangular matrix is singular and the solution has not been computed",
+
+	// Least squares problems.
+	"gels":   "the diagonal element info-1 of the triangular factor is zero so A does not have full rank",
+	"gelsd":  "the algorithm for computing the singular value decomposition failed to converge",
+	"gelss":  "the algorithm for computing the singular value decomposition failed to converge",
+	"gglse":  "B does not have full row rank if info == 1, or (A, B) does not have full column rank if info == 2",
+	"ggglm":  "A does not have full column rank if info == 1, or (A, B) does not have full row rank if info == 2",
+	"gesvd":  "the QR iteration failed to converge and info superdiagonals of the intermediate bidiagonal form did not converge to zero",
+	"gesdd":  "the divide and conquer algorithm failed to converge",
+	"bdsqr":  "the QR iteration failed to converge and info superdiagonals did not converge to zero",
+	"bdsdc":  "the divide and conquer algorithm failed to compute a singular value",
+	"gejsv":  "the Jacobi rotations did not converge",
+	"gesvj":  "the Jacobi rotations did not converge",
+	"gesvdx": "the bisection and inverse iteration failed to converge for info singular vectors",
+
+	// Symmetric eigenproblems.
+	"syev":  "the algorithm failed to converge and info off-diagonal elements of the intermediate tridiagonal form did not converge to zero",
+	"heev":  "the algorithm failed to converge and info off-diagonal elements of the intermediate tridiagonal form did not converge to zero",
+	"syevd": "the algorithm failed to converge",
+	"heevd": "the algorithm failed to converge",
+	"syevr": "an internal error occurred in the computation of the eigenvalues",
+	"heevr": "an internal error occurred in the computation of the eigenvalues",
+	"syevx": "info eigenvectors failed to converge",
+	"heevx": "info eigenvectors failed to converge",
+	"steqr": "the algorithm failed to find all the eigenvalues and info off-diagonal elements did not converge to zero",
+	"sterf": "the algorithm failed to find all the eigenvalues and info off-diagonal elements did not converge to zero",
+	"stev":  "the algorithm failed to converge and info off-diagonal elements did not converge to zero",
+	"stedc": "the algorithm failed to compute an eigenvalue",
+	"sygv":  "the eigensolver failed to converge if info <= n, or the leading minor of order info-n of B is not positive definite",
+	"hegv":  "the eigensolver failed to converge if info <= n, or the leading minor of order info-n of B is not positive definite",
+	"sygvd": "the eigensolver failed to converge if info <= n, or the leading minor of order info-n of B is not positive definite",
+	"hegvd": "the eigensolver failed to converge if info <= n, or the leading minor of order info-n of B is not positive definite",
+	"sygvx": "info eigenvectors failed to converge if info <= n, or the leading minor of order info-n of B is not positive definite",
+	"hegvx": "info eigenvectors failed to converge if info <= n, or the leading minor of order info-n of B is not positive definite",
+
+	// Nonsymmetric eigenproblems.
+	"geev":  "the QR algorithm failed to compute all the eigenvalues; eigenvalues info:n have converged and no eigenvectors have been computed",
+	"geevx": "the QR algorithm failed to compute all the eigenvalues; eigenvalues info:n have converged and no eigenvectors have been computed",
+	"hseqr": "the QR algorithm failed to compute all the eigenvalues; eigenvalues info:n have converged",
+	"gees":  "the QR algorithm failed to compute all the eigenvalues if info <= n, or the eigenvalues could not be reordered if info > n",
+	"geesx": "the QR algorithm failed to compute all the eigenvalues if info <= n, or the eigenvalues could not be reordered if info > n",
+	"trsen": "the reordering failed because some eigenvalues are too close to separate",
+	"trexc": "the two blocks are too close to swap",
+
+	// Generalized nonsymmetric eigenproblems.
+	"ggev":   "the QZ iteration failed if info <= n, or an error occurred in the computation of the eigenvectors if info > n",
+	"ggev3":  "the QZ iteration failed if info <= n, or an error occurred in the computation of the eigenvectors if info > n",
+	"ggevx":  "the QZ iteration failed if info <= n, or an error occurred in the computation of the eigenvectors if info > n",
+	"gges":   "the QZ iteration failed if info <= n, or the generalized eigenvalues could not be reordered if info > n",
+	"gges3":  "the QZ iteration failed if info <= n, or the generalized eigenvalues could not be reordered if info > n",
+	"ggesx":  "the QZ iteration failed if info <= n, or the generalized eigenvalues could not be reordered if info > n",
+	"hgeqz":  "the QZ iteration failed to converge",
+	"tgsen":  "the reordering failed because some generalized eigenvalues are too close to separate",
+	"tgexc":  "the two blocks are too close to swap",
+	"ggsvd3": "the Jacobi-type procedure failed to converge",
+}
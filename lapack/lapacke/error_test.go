@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package lapacke
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorNumerical(t *testing.T) {
+	// The second column is zero so U[1,1] is exactly zero.
+	a := []float64{
+		1, 0,
+		2, 0,
+	}
+	ipiv := make([]Int, 2)
+	err := Checked{}.Dgetrf(2, 2, a, 2, ipiv)
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("unexpected error type for singular matrix: %T", err)
+	}
+	if e.Routine != "dgetrf" {
+		t.Errorf("unexpected routine: got %q, want %q", e.Routine, "dgetrf")
+	}
+	if e.Info != 2 {
+		t.Errorf("unexpected info: got %d, want 2", e.Info)
+	}
+	if !e.Numerical() || e.OutOfMemory() || e.Argument() != 0 {
+		t.Errorf("singular matrix not reported as numerical failure: %v", e)
+	}
+	if e.Meaning != meanings["getrf"] {
+		t.Errorf("unexpected meaning: got %q, want %q", e.Meaning, meanings["getrf"])
+	}
+}
+
+func TestErrorArgument(t *testing.T) {
+	a := make([]float64, 6)
+	ipiv := make([]Int, 2)
+	// lda is less than n.
+	err := Checked{}.Dgetrf(2, 3, a, 2, ipiv)
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("unexpected error type for bad lda: %T", err)
+	}
+	// LAPACKE_dgetrf_work reports lda as its fifth argument, which is the
+	// fourth argument of Dgetrf.
+	if e.Info != -5 {
+		t.Errorf("unexpected info: got %d, want -5", e.Info)
+	}
+	if got := e.Argument(); got != 4 {
+		t.Errorf("unexpected argument: got %d, want 4", got)
+	}
+	if e.Numerical() || e.OutOfMemory() {
+		t.Errorf("bad lda not reported as illegal argument: %v", e)
+	}
+	if want := "argument 4 had an illegal value"; e.Meaning != want {
+		t.Errorf("unexpected meaning: got %q, want %q", e.Meaning, want)
+	}
+}
+
+func TestErrorMixedPrecision(t *testing.T) {
+	const n = 2
+	ipiv := make([]Int, n)
+	iter := make([]Int, 1)
+
+	// A zero matrix is singular in both precisions.
+	err := Checked{}.Dsgesv(n, 1, make([]float64, n*n), n, ipiv, make([]float64, n), 1, make([]float64, n), 1,
+		make([]float64, n*(n+1)), make([]float32, n*(n+1)), iter)
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("unexpected error type for Dsgesv: %T", err)
+	}
+	if e.Routine != "dsgesv" || e.Info != 1 {
+		t.Errorf("unexpected Dsgesv error: %v", e)
+	}
+	if e.Meaning != meanings["sgesv"] {
+		t.Errorf("unexpected Dsgesv meaning: got %q, want %q", e.Meaning, meanings["sgesv"])
+	}
+
+	err = Checked{}.Zcgesv(n, 1, make([]complex128, n*n), n, ipiv, make([]complex128, n), 1, make([]complex128, n), 1,
+		make([]complex128, n*(n+1)), make([]complex64, n*(n+1)), make([]float64, n), iter)
+	if !errors.As(err, &e) {
+		t.Fatalf("unexpected error type for Zcgesv: %T", err)
+	}
+	if e.Routine != "zcgesv" || e.Info != 1 {
+		t.Errorf("unexpected Zcgesv error: %v", e)
+	}
+	if e.Meaning != meanings["cgesv"] {
+		t.Errorf("unexpected Zcgesv meaning: got %q, want %q", e.Meaning, meanings["cgesv"])
+	}
+}
@@ -27,6 +27,11 @@ const (
 	header = "lapacke.h"
 	target = "lapacke.go"
 
+	checkedTarget  = "checked.go"
+	colMajorTarget = "colmajor.go"
+
+	suffixTarget = "suffix64.h"
+
 	prefix = "LAPACKE_"
 	suffix = "_work"
 )
@@ -90,12 +95,12 @@ var cToGoTypeConv = map[string]string{
 var cgoEnums = map[string]*template.Template{}
 
 var intTypes = map[string]string{
-	"forwrd": "int32",
+	"forwrd": "Int",
 
 	"ijob": "byte",
 
-	"wantq": "int32",
-	"wantz": "int32",
+	"wantq": "Int",
+	"wantz": "Int",
 }
 
 func typeForInt(n string) string {
@@ -133,7 +138,7 @@ var goTypes = map[binding.TypeKey]*template.Template{
 	{Kind: cc.Int}:                            template.Must(template.New("int").Funcs(map[string]interface{}{"typefor": typeForInt}).Parse("{{typefor .}}")),
 	{Kind: cc.Char}:                           template.Must(template.New("byte").Parse("byte")),
 	{Kind: cc.Char, IsPointer: true}:          template.Must(template.New("[]byte").Parse("[]byte")),
-	{Kind: cc.Int, IsPointer: true}:           template.Must(template.New("[]int32").Parse("[]int32")),
+	{Kind: cc.Int, IsPointer: true}:           template.Must(template.New("[]Int").Parse("[]Int")),
 	{Kind: cc.FloatComplex, IsPointer: true}:  template.Must(template.New("[]complex64").Parse("[]complex64")),
 	{Kind: cc.DoubleComplex, IsPointer: true}: template.Must(template.New("[]complex128").Parse("[]complex128")),
 }
@@ -180,7 +185,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	var buf bytes.Buffer
+	var buf, checked, colMajor, renames bytes.Buffer
 
 	h, err := template.New("handwritten").
 		Funcs(map[string]interface{}{"join": join}).
@@ -212,18 +217,35 @@ func main() {
 		case strings.HasSuffix(lapackeName, "rook"):
 			continue
 		}
-		if hasFuncParameter(d) {
-			continue
-		}
-
-		goSignature(&buf, d)
+		fmt.Fprintf(&renames, "#define %[1]s %[1]s64_\n", d.Name)
+		goSignature(&buf, d, "", false)
 		if noteOrigin {
 			fmt.Fprintf(&buf, "\t// %s %s %s ...\n\n", d.Position(), d.Return, d.Name)
 		}
 		parameterChecks(&buf, d, parameterCheckRules)
 		buf.WriteByte('\t')
-		cgoCall(&buf, d)
+		cgoCall(&buf, d, "rowMajor", false)
 		buf.WriteString("}\n")
+
+		// Routines that take a matrix layout are also provided as
+		// methods of ColMajor that pass column-major matrices.
+		if hasLayout(d) {
+			goSignature(&colMajor, d, "ColMajor", false)
+			parameterChecks(&colMajor, d, parameterCheckRules)
+			colMajor.WriteByte('\t')
+			cgoCall(&colMajor, d, "colMajor", false)
+			colMajor.WriteString("}\n")
+		}
+
+		// Routines that return the LAPACKE info value are also provided
+		// as methods of Checked that return it as an error.
+		if d.Return.String() == "int" {
+			goSignature(&checked, d, "Checked", true)
+			parameterChecks(&checked, d, parameterCheckRules)
+			checked.WriteByte('\t')
+			cgoCall(&checked, d, "rowMajor", true)
+			checked.WriteString("}\n")
+		}
 	}
 
 	b, err := format.Source(buf.Bytes())
@@ -234,29 +256,226 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	buf.Reset()
+	c, err := template.New("checked").Parse(checkedHeader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = c.Execute(&buf, struct {
+		Header string
+		Unsafe bool
+	}{
+		Header: header,
+		Unsafe: bytes.Contains(checked.Bytes(), []byte("unsafe.")),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	buf.Write(checked.Bytes())
+	b, err = format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(checkedTarget, b, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf.Reset()
+	c, err = template.New("colmajor").Parse(colMajorHeader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = c.Execute(&buf, struct {
+		Header string
+		Unsafe bool
+	}{
+		Header: header,
+		Unsafe: bytes.Contains(colMajor.Bytes(), []byte("unsafe.")),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	buf.Write(colMajor.Bytes())
+	b, err = format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(colMajorTarget, b, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf.Reset()
+	fmt.Fprintf(&buf, suffixHeader, header, renames.Bytes())
+	err = ioutil.WriteFile(suffixTarget, buf.Bytes(), 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// checkedHeader is the template of the preamble of the file holding the
+// methods of Checked.
+const checkedHeader = `// Code generated by "go generate gonum.org/v1/netlib/lapack/lapacke" from {{.Header}}; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include "suffix64.h"
+#include "{{.Header}}"
+#include "select.h"
+*/
+import "C"
+{{if .Unsafe}}
+import "unsafe"
+{{end}}
+// Checked provides the LAPACKE bindings that return the info value reported
+// by LAPACKE as an error instead of a success boolean. A non-nil error
+// returned by a Checked method is always of type *Error.
+type Checked struct{}
+`
+
+// colMajorHeader is the template of the preamble of the file holding the
+// methods of ColMajor.
+const colMajorHeader = `// Code generated by "go generate gonum.org/v1/netlib/lapack/lapacke" from {{.Header}}; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include "suffix64.h"
+#include "{{.Header}}"
+#include "select.h"
+*/
+import "C"
+{{if .Unsafe}}
+import "unsafe"
+{{end}}
+// ColMajor provides the LAPACKE bindings for matrices stored in column-major
+// order, as used by Fortran and by the 'F' order arrays of NumPy and Julia.
+// The package level functions pass their matrices to LAPACKE in row-major
+// order, which LAPACKE transposes into temporary copies before calling LAPACK
+// and transposes back afterwards. The methods of ColMajor pass their matrices
+// to LAPACK unchanged.
+//
+// The element (i, j) of a matrix a with leading dimension lda is a[i+j*lda],
+// so the leading dimension must not be less than the number of rows of the
+// matrix. Band and packed matrices use the storage formats described in the
+// LAPACK documentation.
+//
+// The routines that do not take a matrix layout, such as Dlamch and Dgttrf,
+// are only provided as package level functions.
+type ColMajor struct{}
+`
+
+// suffixHeader is the template of a C header that renames the LAPACKE
+// functions to the names exported by OpenBLAS builds with the 64_ symbol
+// suffix.
+const suffixHeader = `// Code generated by "go generate gonum.org/v1/netlib/lapack/lapacke" from %s; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#ifndef NETLIB_SUFFIX64_H
+#define NETLIB_SUFFIX64_H
+
+// When NETLIB_SUFFIX64 is defined, the LAPACKE functions are renamed to the
+// names exported by ILP64 builds of OpenBLAS with the 64_ symbol suffix, such
+// as libopenblas64_.
+#ifdef NETLIB_SUFFIX64
+%s#endif
+
+#endif // NETLIB_SUFFIX64_H
+`
+
+// isFuncParameter returns whether p is a function pointer parameter. These are
+// the select and selctg parameters of the gees and gges families of routines.
+func isFuncParameter(p binding.Parameter) bool {
+	return p.Kind() == cc.Ptr && p.Elem().Kind() == cc.Function
+}
+
+// selectTypes maps the C selector function types to the Go function types
+// that are passed to the bindings in their place.
+var selectTypes = map[string]string{
+	"LAPACK_S_SELECT2": "func(wr, wi float32) bool",
+	"LAPACK_S_SELECT3": "func(alphar, alphai, beta float32) bool",
+	"LAPACK_D_SELECT2": "func(wr, wi float64) bool",
+	"LAPACK_D_SELECT3": "func(alphar, alphai, beta float64) bool",
+	"LAPACK_C_SELECT1": "func(w complex64) bool",
+	"LAPACK_C_SELECT2": "func(alpha, beta complex64) bool",
+	"LAPACK_Z_SELECT1": "func(w complex128) bool",
+	"LAPACK_Z_SELECT2": "func(alpha, beta complex128) bool",
+}
+
+// selectType returns the name of the C selector function type taken by the
+// routine of the declaration d. The gees routines select a single eigenvalue,
+// and the gges routines select a generalized eigenvalue, which the real
+// routines represent with three and the complex routines with two values.
+func selectType(d binding.Declaration) string {
+	lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
+	typ := lapackeName[0]
+	n := 1
+	if strings.HasPrefix(lapackeName[1:], "gges") {
+		n++
+	}
+	if typ == 's' || typ == 'd' {
+		n++
+	}
+	return fmt.Sprintf("LAPACK_%c_SELECT%d", typ-'a'+'A', n)
+}
+
+// trampoline returns the name of the C function that calls the Go selector
+// function for the C selector function type typ.
+func trampoline(typ string) string {
+	return "netlib_" + strings.ToLower(strings.TrimPrefix(typ, "LAPACK_"))
 }
 
-// This removes select and selctg parameterised functions.
-func hasFuncParameter(d binding.Declaration) bool {
+// goType returns the Go type of the parameter p of the declaration d.
+func goType(d binding.Declaration, p binding.Parameter) string {
+	if isFuncParameter(p) {
+		return selectTypes[selectType(d)]
+	}
+	n := shorten(binding.LowerCaseFirst(p.Name()))
+	if p.Kind() == cc.Enum {
+		return binding.GoTypeForEnum(p.Type(), n)
+	}
+	return binding.GoTypeFor(p.Type(), n, goTypes)
+}
+
+// hasLayout returns whether the declaration d takes a matrix layout.
+func hasLayout(d binding.Declaration) bool {
 	for _, p := range d.Parameters() {
-		if p.Kind() != cc.Ptr {
-			continue
-		}
-		if p.Elem().Kind() == cc.Function {
+		if p.Name() == "matrix_layout" {
 			return true
 		}
 	}
 	return false
 }
 
-func goSignature(buf *bytes.Buffer, d binding.Declaration) {
+// goSignature writes the Go signature of the binding for the declaration d.
+// If recv is not empty, the binding is a method of the type recv. If checked
+// is true, the binding returns the LAPACKE info value as an error.
+func goSignature(buf *bytes.Buffer, d binding.Declaration, recv string, checked bool) {
 	lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
 	goName := binding.UpperCaseFirst(lapackeName)
 
 	parameters := d.Parameters()
 
 	fmt.Fprintf(buf, "\n// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/%s.f.\n", lapackeName)
-	fmt.Fprintf(buf, "func %s(", goName)
+	if recv != "" {
+		fmt.Fprintf(buf, "func (%s) %s(", recv, goName)
+	} else {
+		fmt.Fprintf(buf, "func %s(", goName)
+	}
 	c := 0
 	for i, p := range parameters {
 		if p.Name() == "matrix_layout" {
@@ -270,28 +489,20 @@ func goSignature(buf *bytes.Buffer, d binding.Declaration) {
 		n := shorten(binding.LowerCaseFirst(p.Name()))
 		var this, next string
 
-		if p.Kind() == cc.Enum {
-			this = binding.GoTypeForEnum(p.Type(), n)
-		} else {
-			this = binding.GoTypeFor(p.Type(), n, goTypes)
-		}
+		this = goType(d, p)
 
 		if elideRepeat && i < len(parameters)-1 && p.Type().Kind() == parameters[i+1].Type().Kind() {
-			p := parameters[i+1]
-			n := shorten(binding.LowerCaseFirst(p.Name()))
-			if p.Kind() == cc.Enum {
-				next = binding.GoTypeForEnum(p.Type(), n)
-			} else {
-				next = binding.GoTypeFor(p.Type(), n, goTypes)
-			}
+			next = goType(d, parameters[i+1])
 		}
-		if next == this {
+		if next == this && !isFuncParameter(p) {
 			buf.WriteString(n)
 		} else {
 			fmt.Fprintf(buf, "%s %s", n, this)
 		}
 	}
-	if d.Return.Kind() != cc.Void {
+	if checked {
+		buf.WriteString(") error {\n")
+	} else if d.Return.Kind() != cc.Void {
 		var must string
 		if needsInt[lapackeName[1:]] {
 			must = "_must"
@@ -314,8 +525,14 @@ func parameterChecks(buf *bytes.Buffer, d binding.Declaration, rules []func(*byt
 	}
 }
 
-func cgoCall(buf *bytes.Buffer, d binding.Declaration) {
-	if d.Return.Kind() != cc.Void {
+// cgoCall writes the call to the C function of the declaration d, passing
+// the order constant named by layout as the matrix layout. If checked is
+// true, the returned info value is converted to an error.
+func cgoCall(buf *bytes.Buffer, d binding.Declaration, layout string, checked bool) {
+	if checked {
+		lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
+		fmt.Fprintf(buf, "return newError(%q, ", lapackeName)
+	} else if d.Return.Kind() != cc.Void {
 		lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
 		var must string
 		if needsInt[lapackeName[1:]] {
@@ -328,7 +545,11 @@ func cgoCall(buf *bytes.Buffer, d binding.Declaration) {
 		if i != 0 {
 			buf.WriteString(", ")
 		}
-		if p.Type().Kind() == cc.Enum {
+		if isFuncParameter(p) {
+			buf.WriteString("_" + shorten(binding.LowerCaseFirst(p.Name())))
+		} else if p.Name() == "matrix_layout" {
+			fmt.Fprintf(buf, "(C.int)(%s)", layout)
+		} else if p.Type().Kind() == cc.Enum {
 			buf.WriteString(binding.CgoConversionForEnum(shorten(binding.LowerCaseFirst(p.Name())), p.Type()))
 		} else {
 			buf.WriteString(binding.CgoConversionFor(shorten(binding.LowerCaseFirst(p.Name())), p.Type(), cgoTypes))
@@ -345,6 +566,7 @@ var parameterCheckRules = []func(*bytes.Buffer, binding.Declaration, binding.Par
 	diag,
 	side,
 	trans,
+	selector,
 	address,
 }
 
@@ -404,9 +626,23 @@ func trans(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) bool {
 	return false
 }
 
+func selector(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) bool {
+	if !isFuncParameter(p) {
+		return false
+	}
+	typ := selectType(d)
+	fmt.Fprintf(buf, `	var _%[1]s C.%[2]s
+	if %[1]s != nil {
+		defer useSelect(%[1]s)()
+		_%[1]s = C.%[2]s(C.%[3]s)
+	}
+`, shorten(binding.LowerCaseFirst(p.Name())), typ, trampoline(typ))
+	return false
+}
+
 var addrTypes = map[string]string{
 	"char":           "byte",
-	"int":            "int32",
+	"int":            "Int",
 	"float":          "float32",
 	"double":         "float64",
 	"float complex":  "complex64",
@@ -415,7 +651,7 @@ var addrTypes = map[string]string{
 
 func address(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) bool {
 	n := shorten(binding.LowerCaseFirst(p.Name()))
-	if p.Type().Kind() == cc.Ptr {
+	if p.Type().Kind() == cc.Ptr && !isFuncParameter(p) {
 		t := strings.TrimPrefix(p.Type().Element().String(), "const ")
 		fmt.Fprintf(buf, `	var _%[1]s *%[2]s
 	if len(%[1]s) > 0 {
@@ -440,7 +676,9 @@ package lapacke
 /*
 #cgo CFLAGS: -g -O2{{if .Lib}}
 #cgo LDFLAGS: {{join .Lib}}{{end}}
+#include "suffix64.h"
 #include "{{.Header}}"
+#include "select.h"
 */
 import "C"
 
@@ -455,5 +693,5 @@ const (
 	colMajor
 )
 
-func isZero(ret C.int) bool { return ret == 0 }
+func isZero(ret C.lapack_int) bool { return ret == 0 }
 `
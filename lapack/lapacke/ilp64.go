@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ilp64
+
+package lapacke
+
+// The ilp64 build tag selects the LAPACKE interface with 64-bit integers
+// provided by ILP64 builds of OpenBLAS and by MKL ILP64. lapacke_config.h
+// defines lapack_int as long, so the tag is only supported on LP64 platforms.
+
+/*
+#cgo CFLAGS: -DHAVE_LAPACK_CONFIG_H -DLAPACK_ILP64
+*/
+import "C"
+
+// Int is the Go type corresponding to lapack_int. It is int64 when the
+// package is built with the ilp64 build tag.
+type Int = int64
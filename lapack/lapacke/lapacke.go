@@ -11,7 +11,9 @@ package lapacke
 
 /*
 #cgo CFLAGS: -g -O2
+#include "suffix64.h"
 #include "lapacke.h"
+#include "select.h"
 */
 import "C"
 
@@ -26,10 +28,10 @@ const (
 	colMajor
 )
 
-func isZero(ret C.int) bool { return ret == 0 }
+func isZero(ret C.lapack_int) bool { return ret == 0 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sbdsdc.f.
-func Sbdsdc(ul, compq byte, n int, d, e, u []float32, ldu int, vt []float32, ldvt int, q []float32, iq []int32, work []float32, iwork []int32) bool {
+func Sbdsdc(ul, compq byte, n int, d, e, u []float32, ldu int, vt []float32, ldvt int, q []float32, iq []Int, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -55,7 +57,7 @@ func Sbdsdc(ul, compq byte, n int, d, e, u []float32, ldu int, vt []float32, ldv
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _iq *int32
+	var _iq *Int
 	if len(iq) > 0 {
 		_iq = &iq[0]
 	}
@@ -63,7 +65,7 @@ func Sbdsdc(ul, compq byte, n int, d, e, u []float32, ldu int, vt []float32, ldv
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -71,7 +73,7 @@ func Sbdsdc(ul, compq byte, n int, d, e, u []float32, ldu int, vt []float32, ldv
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dbdsdc.f.
-func Dbdsdc(ul, compq byte, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, q []float64, iq []int32, work []float64, iwork []int32) bool {
+func Dbdsdc(ul, compq byte, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, q []float64, iq []Int, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -97,7 +99,7 @@ func Dbdsdc(ul, compq byte, n int, d, e, u []float64, ldu int, vt []float64, ldv
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _iq *int32
+	var _iq *Int
 	if len(iq) > 0 {
 		_iq = &iq[0]
 	}
@@ -105,7 +107,7 @@ func Dbdsdc(ul, compq byte, n int, d, e, u []float64, ldu int, vt []float64, ldv
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -113,7 +115,7 @@ func Dbdsdc(ul, compq byte, n int, d, e, u []float64, ldu int, vt []float64, ldv
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sbdsvdx.f.
-func Sbdsvdx(ul, jobz, rng byte, n int, d, e []float32, vl, vu, il, iu, ns int, s, z []float32, ldz int, work []float32, iwork []int32) bool {
+func Sbdsvdx(ul, jobz, rng byte, n int, d, e []float32, vl, vu, il, iu, ns int, s, z []float32, ldz int, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -139,7 +141,7 @@ func Sbdsvdx(ul, jobz, rng byte, n int, d, e []float32, vl, vu, il, iu, ns int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -147,7 +149,7 @@ func Sbdsvdx(ul, jobz, rng byte, n int, d, e []float32, vl, vu, il, iu, ns int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dbdsvdx.f.
-func Dbdsvdx(ul, jobz, rng byte, n int, d, e []float64, vl, vu, il, iu, ns int, s, z []float64, ldz int, work []float64, iwork []int32) bool {
+func Dbdsvdx(ul, jobz, rng byte, n int, d, e []float64, vl, vu, il, iu, ns int, s, z []float64, ldz int, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -173,7 +175,7 @@ func Dbdsvdx(ul, jobz, rng byte, n int, d, e []float64, vl, vu, il, iu, ns int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -483,12 +485,12 @@ func Zgbbrd(vect byte, m, n, ncc, kl, ku int, ab []complex128, ldab int, d, e []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbcon.f.
-func Sgbcon(norm byte, n, kl, ku int, ab []float32, ldab int, ipiv []int32, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Sgbcon(norm byte, n, kl, ku int, ab []float32, ldab int, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) bool {
 	var _ab *float32
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -500,7 +502,7 @@ func Sgbcon(norm byte, n, kl, ku int, ab []float32, ldab int, ipiv []int32, anor
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -508,12 +510,12 @@ func Sgbcon(norm byte, n, kl, ku int, ab []float32, ldab int, ipiv []int32, anor
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbcon.f.
-func Dgbcon(norm byte, n, kl, ku int, ab []float64, ldab int, ipiv []int32, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dgbcon(norm byte, n, kl, ku int, ab []float64, ldab int, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) bool {
 	var _ab *float64
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -525,7 +527,7 @@ func Dgbcon(norm byte, n, kl, ku int, ab []float64, ldab int, ipiv []int32, anor
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -533,12 +535,12 @@ func Dgbcon(norm byte, n, kl, ku int, ab []float64, ldab int, ipiv []int32, anor
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbcon.f.
-func Cgbcon(norm byte, n, kl, ku int, ab []complex64, ldab int, ipiv []int32, anorm float32, rcond []float32, work []complex64, rwork []float32) bool {
+func Cgbcon(norm byte, n, kl, ku int, ab []complex64, ldab int, ipiv []Int, anorm float32, rcond []float32, work []complex64, rwork []float32) bool {
 	var _ab *complex64
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -558,12 +560,12 @@ func Cgbcon(norm byte, n, kl, ku int, ab []complex64, ldab int, ipiv []int32, an
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbcon.f.
-func Zgbcon(norm byte, n, kl, ku int, ab []complex128, ldab int, ipiv []int32, anorm float64, rcond []float64, work []complex128, rwork []float64) bool {
+func Zgbcon(norm byte, n, kl, ku int, ab []complex128, ldab int, ipiv []Int, anorm float64, rcond []float64, work []complex128, rwork []float64) bool {
 	var _ab *complex128
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -815,7 +817,7 @@ func Zgbequb(m, n, kl, ku int, ab []complex128, ldab int, r, c, rowcnd, colcnd,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbrfs.f.
-func Sgbrfs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, ipiv []int32, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Sgbrfs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -829,7 +831,7 @@ func Sgbrfs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -853,7 +855,7 @@ func Sgbrfs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -861,7 +863,7 @@ func Sgbrfs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbrfs.f.
-func Dgbrfs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, ipiv []int32, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dgbrfs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -875,7 +877,7 @@ func Dgbrfs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -899,7 +901,7 @@ func Dgbrfs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -907,7 +909,7 @@ func Dgbrfs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbrfs.f.
-func Cgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -921,7 +923,7 @@ func Cgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []com
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -953,7 +955,7 @@ func Cgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []com
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbrfs.f.
-func Zgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -967,7 +969,7 @@ func Zgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []co
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -999,12 +1001,12 @@ func Zgbrfs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbsv.f.
-func Sgbsv(n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int32, b []float32, ldb int) bool {
+func Sgbsv(n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []Int, b []float32, ldb int) bool {
 	var _ab *float32
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1016,12 +1018,12 @@ func Sgbsv(n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int32, b []float3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbsv.f.
-func Dgbsv(n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int32, b []float64, ldb int) bool {
+func Dgbsv(n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []Int, b []float64, ldb int) bool {
 	var _ab *float64
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1033,12 +1035,12 @@ func Dgbsv(n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int32, b []float6
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbsv.f.
-func Cgbsv(n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []int32, b []complex64, ldb int) bool {
+func Cgbsv(n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []Int, b []complex64, ldb int) bool {
 	var _ab *complex64
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1050,12 +1052,12 @@ func Cgbsv(n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []int32, b []comp
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbsv.f.
-func Zgbsv(n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []int32, b []complex128, ldb int) bool {
+func Zgbsv(n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []Int, b []complex128, ldb int) bool {
 	var _ab *complex128
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1067,7 +1069,7 @@ func Zgbsv(n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []int32, b []com
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbsvx.f.
-func Sgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, ipiv []int32, equed []byte, r, c, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []int32) bool {
+func Sgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, ipiv []Int, equed []byte, r, c, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1081,7 +1083,7 @@ func Sgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb [
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1121,7 +1123,7 @@ func Sgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb [
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -1129,7 +1131,7 @@ func Sgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, afb [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbsvx.f.
-func Dgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, ipiv []int32, equed []byte, r, c, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []int32) bool {
+func Dgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, ipiv []Int, equed []byte, r, c, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1143,7 +1145,7 @@ func Dgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb [
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1183,7 +1185,7 @@ func Dgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb [
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -1191,7 +1193,7 @@ func Dgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, afb [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbsvx.f.
-func Cgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, ipiv []int32, equed []byte, r, c []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb []complex64, ldafb int, ipiv []Int, equed []byte, r, c []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1205,7 +1207,7 @@ func Cgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1253,7 +1255,7 @@ func Cgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, afb
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbsvx.f.
-func Zgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, ipiv []int32, equed []byte, r, c []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, afb []complex128, ldafb int, ipiv []Int, equed []byte, r, c []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1267,7 +1269,7 @@ func Zgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, af
 	if len(afb) > 0 {
 		_afb = &afb[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1315,12 +1317,12 @@ func Zgbsvx(fact, trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, af
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbtrf.f.
-func Sgbtrf(m, n, kl, ku int, ab []float32, ldab int, ipiv []int32) bool {
+func Sgbtrf(m, n, kl, ku int, ab []float32, ldab int, ipiv []Int) bool {
 	var _ab *float32
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1328,12 +1330,12 @@ func Sgbtrf(m, n, kl, ku int, ab []float32, ldab int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbtrf.f.
-func Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int32) bool {
+func Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []Int) bool {
 	var _ab *float64
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1341,12 +1343,12 @@ func Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbtrf.f.
-func Cgbtrf(m, n, kl, ku int, ab []complex64, ldab int, ipiv []int32) bool {
+func Cgbtrf(m, n, kl, ku int, ab []complex64, ldab int, ipiv []Int) bool {
 	var _ab *complex64
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1354,12 +1356,12 @@ func Cgbtrf(m, n, kl, ku int, ab []complex64, ldab int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbtrf.f.
-func Zgbtrf(m, n, kl, ku int, ab []complex128, ldab int, ipiv []int32) bool {
+func Zgbtrf(m, n, kl, ku int, ab []complex128, ldab int, ipiv []Int) bool {
 	var _ab *complex128
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1367,7 +1369,7 @@ func Zgbtrf(m, n, kl, ku int, ab []complex128, ldab int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgbtrs.f.
-func Sgbtrs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int32, b []float32, ldb int) bool {
+func Sgbtrs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []Int, b []float32, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1377,7 +1379,7 @@ func Sgbtrs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int3
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1389,7 +1391,7 @@ func Sgbtrs(trans byte, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgbtrs.f.
-func Dgbtrs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int32, b []float64, ldb int) bool {
+func Dgbtrs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []Int, b []float64, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1399,7 +1401,7 @@ func Dgbtrs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int3
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1411,7 +1413,7 @@ func Dgbtrs(trans byte, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgbtrs.f.
-func Cgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []int32, b []complex64, ldb int) bool {
+func Cgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []Int, b []complex64, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1421,7 +1423,7 @@ func Cgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []in
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1433,7 +1435,7 @@ func Cgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex64, ldab int, ipiv []in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgbtrs.f.
-func Zgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []int32, b []complex128, ldb int) bool {
+func Zgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []Int, b []complex128, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -1443,7 +1445,7 @@ func Zgbtrs(trans byte, n, kl, ku, nrhs int, ab []complex128, ldab int, ipiv []i
 	if len(ab) > 0 {
 		_ab = &ab[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -1527,16 +1529,16 @@ func Zgebak(job, side byte, n, ilo, ihi int, scale []float64, m int, v []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgebal.f.
-func Sgebal(job byte, n int, a []float32, lda int, ilo, ihi []int32, scale []float32) bool {
+func Sgebal(job byte, n int, a []float32, lda int, ilo, ihi []Int, scale []float32) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -1548,16 +1550,16 @@ func Sgebal(job byte, n int, a []float32, lda int, ilo, ihi []int32, scale []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgebal.f.
-func Dgebal(job byte, n int, a []float64, lda int, ilo, ihi []int32, scale []float64) bool {
+func Dgebal(job byte, n int, a []float64, lda int, ilo, ihi []Int, scale []float64) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -1569,16 +1571,16 @@ func Dgebal(job byte, n int, a []float64, lda int, ilo, ihi []int32, scale []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgebal.f.
-func Cgebal(job byte, n int, a []complex64, lda int, ilo, ihi []int32, scale []float32) bool {
+func Cgebal(job byte, n int, a []complex64, lda int, ilo, ihi []Int, scale []float32) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -1590,16 +1592,16 @@ func Cgebal(job byte, n int, a []complex64, lda int, ilo, ihi []int32, scale []f
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgebal.f.
-func Zgebal(job byte, n int, a []complex128, lda int, ilo, ihi []int32, scale []float64) bool {
+func Zgebal(job byte, n int, a []complex128, lda int, ilo, ihi []Int, scale []float64) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -1727,7 +1729,7 @@ func Zgebrd(m, n int, a []complex128, lda int, d, e []float64, tauq, taup, work
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgecon.f.
-func Sgecon(norm byte, n int, a []float32, lda int, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Sgecon(norm byte, n int, a []float32, lda int, anorm float32, rcond, work []float32, iwork []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -1740,7 +1742,7 @@ func Sgecon(norm byte, n int, a []float32, lda int, anorm float32, rcond, work [
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -1748,7 +1750,7 @@ func Sgecon(norm byte, n int, a []float32, lda int, anorm float32, rcond, work [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgecon.f.
-func Dgecon(norm byte, n int, a []float64, lda int, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dgecon(norm byte, n int, a []float64, lda int, anorm float64, rcond, work []float64, iwork []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -1761,7 +1763,7 @@ func Dgecon(norm byte, n int, a []float64, lda int, anorm float64, rcond, work [
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2042,6 +2044,350 @@ func Zgeequb(m, n int, a []complex128, lda int, r, c, rowcnd, colcnd, amax []flo
 	return isZero(C.LAPACKE_zgeequb_work((C.int)(rowMajor), (C.lapack_int)(m), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.double)(_r), (*C.double)(_c), (*C.double)(_rowcnd), (*C.double)(_colcnd), (*C.double)(_amax)))
 }
 
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgees.f.
+func Sgees(jobvs, sort byte, sel func(wr, wi float32) bool, n int, a []float32, lda int, sdim []Int, wr, wi, vs []float32, ldvs int, work []float32, lwork int, bwork []Int) bool {
+	var _sel C.LAPACK_S_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_S_SELECT2(C.netlib_s_select2)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float32
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_sgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vs), (C.lapack_int)(ldvs), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgees.f.
+func Dgees(jobvs, sort byte, sel func(wr, wi float64) bool, n int, a []float64, lda int, sdim []Int, wr, wi, vs []float64, ldvs int, work []float64, lwork int, bwork []Int) bool {
+	var _sel C.LAPACK_D_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_D_SELECT2(C.netlib_d_select2)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_dgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vs), (C.lapack_int)(ldvs), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgees.f.
+func Cgees(jobvs, sort byte, sel func(w complex64) bool, n int, a []complex64, lda int, sdim []Int, w, vs []complex64, ldvs int, work []complex64, lwork int, rwork []float32, bwork []Int) bool {
+	var _sel C.LAPACK_C_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_C_SELECT1(C.netlib_c_select1)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_cgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vs), (C.lapack_int)(ldvs), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgees.f.
+func Zgees(jobvs, sort byte, sel func(w complex128) bool, n int, a []complex128, lda int, sdim []Int, w, vs []complex128, ldvs int, work []complex128, lwork int, rwork []float64, bwork []Int) bool {
+	var _sel C.LAPACK_Z_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_Z_SELECT1(C.netlib_z_select1)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex128
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_zgees_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vs), (C.lapack_int)(ldvs), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeesx.f.
+func Sgeesx(jobvs, sort byte, sel func(wr, wi float32) bool, sense byte, n int, a []float32, lda int, sdim []Int, wr, wi, vs []float32, ldvs int, rconde, rcondv, work []float32, lwork int, iwork []Int, liwork int, bwork []Int) bool {
+	var _sel C.LAPACK_S_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_S_SELECT2(C.netlib_s_select2)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float32
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_sgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vs), (C.lapack_int)(ldvs), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeesx.f.
+func Dgeesx(jobvs, sort byte, sel func(wr, wi float64) bool, sense byte, n int, a []float64, lda int, sdim []Int, wr, wi, vs []float64, ldvs int, rconde, rcondv, work []float64, lwork int, iwork []Int, liwork int, bwork []Int) bool {
+	var _sel C.LAPACK_D_SELECT2
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_D_SELECT2(C.netlib_d_select2)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_dgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vs), (C.lapack_int)(ldvs), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeesx.f.
+func Cgeesx(jobvs, sort byte, sel func(w complex64) bool, sense byte, n int, a []complex64, lda int, sdim []Int, w, vs []complex64, ldvs int, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32, bwork []Int) bool {
+	var _sel C.LAPACK_C_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_C_SELECT1(C.netlib_c_select1)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_cgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vs), (C.lapack_int)(ldvs), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeesx.f.
+func Zgeesx(jobvs, sort byte, sel func(w complex128) bool, sense byte, n int, a []complex128, lda int, sdim []Int, w, vs []complex128, ldvs int, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64, bwork []Int) bool {
+	var _sel C.LAPACK_Z_SELECT1
+	if sel != nil {
+		defer useSelect(sel)()
+		_sel = C.LAPACK_Z_SELECT1(C.netlib_z_select1)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex128
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_zgeesx_work((C.int)(rowMajor), (C.char)(jobvs), (C.char)(sort), _sel, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vs), (C.lapack_int)(ldvs), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeev.f.
 func Sgeev(jobvl, jobvr byte, n int, a []float32, lda int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) int {
 	var _a *float32
@@ -2159,7 +2505,7 @@ func Zgeev(jobvl, jobvr byte, n int, a []complex128, lda int, w, vl []complex128
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeevx.f.
-func Sgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr int, ilo, ihi []int32, scale, abnrm, rconde, rcondv, work []float32, lwork int, iwork []int32) int {
+func Sgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv, work []float32, lwork int, iwork []Int) int {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2180,11 +2526,11 @@ func Sgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, wr, w
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -2208,7 +2554,7 @@ func Sgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, wr, w
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2216,7 +2562,7 @@ func Sgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, wr, w
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeevx.f.
-func Dgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, wr, wi, vl []float64, ldvl int, vr []float64, ldvr int, ilo, ihi []int32, scale, abnrm, rconde, rcondv, work []float64, lwork int, iwork []int32) int {
+func Dgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, wr, wi, vl []float64, ldvl int, vr []float64, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv, work []float64, lwork int, iwork []Int) int {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2237,11 +2583,11 @@ func Dgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, wr, w
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -2265,7 +2611,7 @@ func Dgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, wr, w
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2273,7 +2619,7 @@ func Dgeevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, wr, w
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeevx.f.
-func Cgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, w, vl []complex64, ldvl int, vr []complex64, ldvr int, ilo, ihi []int32, scale, abnrm, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32) int {
+func Cgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, w, vl []complex64, ldvl int, vr []complex64, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32) int {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2290,11 +2636,11 @@ func Cgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, w,
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -2326,7 +2672,7 @@ func Cgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, w,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeevx.f.
-func Zgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, w, vl []complex128, ldvl int, vr []complex128, ldvr int, ilo, ihi []int32, scale, abnrm, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64) int {
+func Zgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, w, vl []complex128, ldvl int, vr []complex128, ldvr int, ilo, ihi []Int, scale, abnrm, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64) int {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2343,11 +2689,11 @@ func Zgeevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, w,
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -2447,7 +2793,7 @@ func Zgehrd(n, ilo, ihi int, a []complex128, lda int, tau, work []complex128, lw
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgejsv.f.
-func Sgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float32, lda int, sva, u []float32, ldu int, v []float32, ldv int, work []float32, lwork int, iwork []int32) bool {
+func Sgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float32, lda int, sva, u []float32, ldu int, v []float32, ldv int, work []float32, lwork int, iwork []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2468,7 +2814,7 @@ func Sgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float32, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2476,7 +2822,7 @@ func Sgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float32, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgejsv.f.
-func Dgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float64, lda int, sva, u []float64, ldu int, v []float64, ldv int, work []float64, lwork int, iwork []int32) bool {
+func Dgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float64, lda int, sva, u []float64, ldu int, v []float64, ldv int, work []float64, lwork int, iwork []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2497,7 +2843,7 @@ func Dgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float64, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2505,7 +2851,7 @@ func Dgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []float64, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgejsv.f.
-func Cgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex64, lda int, sva []float32, u []complex64, ldu int, v []complex64, ldv int, cwork []complex64, lwork int, work []float32, lrwork int, iwork []int32) bool {
+func Cgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex64, lda int, sva []float32, u []complex64, ldu int, v []complex64, ldv int, cwork []complex64, lwork int, work []float32, lrwork int, iwork []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2530,7 +2876,7 @@ func Cgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex64, ld
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2538,7 +2884,7 @@ func Cgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgejsv.f.
-func Zgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex128, lda int, sva []float64, u []complex128, ldu int, v []complex128, ldv int, cwork []complex128, lwork int, work []float64, lrwork int, iwork []int32) bool {
+func Zgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex128, lda int, sva []float64, u []complex128, ldu int, v []complex128, ldv int, cwork []complex128, lwork int, work []float64, lrwork int, iwork []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2563,7 +2909,7 @@ func Zgejsv(joba, jobu, jobv, jobr, jobt, jobp byte, m, n int, a []complex128, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2795,7 +3141,7 @@ func Zgels(trans byte, m, n, nrhs int, a []complex128, lda int, b []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelsd.f.
-func Sgelsd(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []float32, rcond float32, rank []int32, work []float32, lwork int, iwork []int32) bool {
+func Sgelsd(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []float32, rcond float32, rank []Int, work []float32, lwork int, iwork []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2808,7 +3154,7 @@ func Sgelsd(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []floa
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -2816,7 +3162,7 @@ func Sgelsd(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2824,7 +3170,7 @@ func Sgelsd(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelsd.f.
-func Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, rank []int32, work []float64, lwork int, iwork []int32) bool {
+func Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, rank []Int, work []float64, lwork int, iwork []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2837,7 +3183,7 @@ func Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []floa
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -2845,7 +3191,7 @@ func Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2853,7 +3199,7 @@ func Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelsd.f.
-func Cgelsd(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []float32, rcond float32, rank []int32, work []complex64, lwork int, rwork []float32, iwork []int32) bool {
+func Cgelsd(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []float32, rcond float32, rank []Int, work []complex64, lwork int, rwork []float32, iwork []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2866,7 +3212,7 @@ func Cgelsd(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -2878,7 +3224,7 @@ func Cgelsd(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2886,7 +3232,7 @@ func Cgelsd(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelsd.f.
-func Zgelsd(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s []float64, rcond float64, rank []int32, work []complex128, lwork int, rwork []float64, iwork []int32) bool {
+func Zgelsd(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s []float64, rcond float64, rank []Int, work []complex128, lwork int, rwork []float64, iwork []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2899,7 +3245,7 @@ func Zgelsd(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -2911,7 +3257,7 @@ func Zgelsd(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -2919,7 +3265,7 @@ func Zgelsd(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelss.f.
-func Sgelss(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []float32, rcond float32, rank []int32, work []float32, lwork int) bool {
+func Sgelss(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []float32, rcond float32, rank []Int, work []float32, lwork int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2932,7 +3278,7 @@ func Sgelss(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []floa
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -2944,7 +3290,7 @@ func Sgelss(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelss.f.
-func Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, rank []int32, work []float64, lwork int) bool {
+func Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, rank []Int, work []float64, lwork int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2957,7 +3303,7 @@ func Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []floa
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -2969,7 +3315,7 @@ func Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelss.f.
-func Cgelss(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []float32, rcond float32, rank []int32, work []complex64, lwork int, rwork []float32) bool {
+func Cgelss(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []float32, rcond float32, rank []Int, work []complex64, lwork int, rwork []float32) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -2982,7 +3328,7 @@ func Cgelss(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -2998,7 +3344,7 @@ func Cgelss(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, s []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelss.f.
-func Zgelss(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s []float64, rcond float64, rank []int32, work []complex128, lwork int, rwork []float64) bool {
+func Zgelss(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s []float64, rcond float64, rank []Int, work []complex128, lwork int, rwork []float64) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3011,7 +3357,7 @@ func Zgelss(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s
 	if len(s) > 0 {
 		_s = &s[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -3027,7 +3373,7 @@ func Zgelss(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, s
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgelsy.f.
-func Sgelsy(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, jpvt []int32, rcond float32, rank []int32, work []float32, lwork int) bool {
+func Sgelsy(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, jpvt []Int, rcond float32, rank []Int, work []float32, lwork int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3036,11 +3382,11 @@ func Sgelsy(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, jpvt []i
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -3052,7 +3398,7 @@ func Sgelsy(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, jpvt []i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgelsy.f.
-func Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []int32, rcond float64, rank []int32, work []float64, lwork int) bool {
+func Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []Int, rcond float64, rank []Int, work []float64, lwork int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3061,11 +3407,11 @@ func Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []i
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -3077,7 +3423,7 @@ func Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgelsy.f.
-func Cgelsy(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, jpvt []int32, rcond float32, rank []int32, work []complex64, lwork int, rwork []float32) bool {
+func Cgelsy(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, jpvt []Int, rcond float32, rank []Int, work []complex64, lwork int, rwork []float32) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3086,11 +3432,11 @@ func Cgelsy(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, jpvt
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -3106,7 +3452,7 @@ func Cgelsy(m, n, nrhs int, a []complex64, lda int, b []complex64, ldb int, jpvt
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgelsy.f.
-func Zgelsy(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, jpvt []int32, rcond float64, rank []int32, work []complex128, lwork int, rwork []float64) bool {
+func Zgelsy(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, jpvt []Int, rcond float64, rank []Int, work []complex128, lwork int, rwork []float64) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3115,11 +3461,11 @@ func Zgelsy(m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, jp
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -3203,12 +3549,12 @@ func Zgeqlf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgeqp3.f.
-func Sgeqp3(m, n int, a []float32, lda int, jpvt []int32, tau, work []float32, lwork int) bool {
+func Sgeqp3(m, n int, a []float32, lda int, jpvt []Int, tau, work []float32, lwork int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
@@ -3224,12 +3570,12 @@ func Sgeqp3(m, n int, a []float32, lda int, jpvt []int32, tau, work []float32, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgeqp3.f.
-func Dgeqp3(m, n int, a []float64, lda int, jpvt []int32, tau, work []float64, lwork int) bool {
+func Dgeqp3(m, n int, a []float64, lda int, jpvt []Int, tau, work []float64, lwork int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
@@ -3245,12 +3591,12 @@ func Dgeqp3(m, n int, a []float64, lda int, jpvt []int32, tau, work []float64, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgeqp3.f.
-func Cgeqp3(m, n int, a []complex64, lda int, jpvt []int32, tau, work []complex64, lwork int, rwork []float32) bool {
+func Cgeqp3(m, n int, a []complex64, lda int, jpvt []Int, tau, work []complex64, lwork int, rwork []float32) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
@@ -3270,12 +3616,12 @@ func Cgeqp3(m, n int, a []complex64, lda int, jpvt []int32, tau, work []complex6
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgeqp3.f.
-func Zgeqp3(m, n int, a []complex128, lda int, jpvt []int32, tau, work []complex128, lwork int, rwork []float64) bool {
+func Zgeqp3(m, n int, a []complex128, lda int, jpvt []Int, tau, work []complex128, lwork int, rwork []float64) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _jpvt *int32
+	var _jpvt *Int
 	if len(jpvt) > 0 {
 		_jpvt = &jpvt[0]
 	}
@@ -3499,7 +3845,7 @@ func Zgeqrfp(m, n int, a []complex128, lda int, tau, work []complex128, lwork in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgerfs.f.
-func Sgerfs(trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []int32, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Sgerfs(trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -3513,7 +3859,7 @@ func Sgerfs(trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf in
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3537,7 +3883,7 @@ func Sgerfs(trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf in
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -3545,7 +3891,7 @@ func Sgerfs(trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgerfs.f.
-func Dgerfs(trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int32, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dgerfs(trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -3559,7 +3905,7 @@ func Dgerfs(trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf in
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3583,7 +3929,7 @@ func Dgerfs(trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf in
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -3591,7 +3937,7 @@ func Dgerfs(trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgerfs.f.
-func Cgerfs(trans byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cgerfs(trans byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -3605,7 +3951,7 @@ func Cgerfs(trans byte, n, nrhs int, a []complex64, lda int, af []complex64, lda
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3637,7 +3983,7 @@ func Cgerfs(trans byte, n, nrhs int, a []complex64, lda int, af []complex64, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgerfs.f.
-func Zgerfs(trans byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zgerfs(trans byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -3651,7 +3997,7 @@ func Zgerfs(trans byte, n, nrhs int, a []complex128, lda int, af []complex128, l
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3751,7 +4097,7 @@ func Zgerqf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesdd.f.
-func Sgesdd(jobz byte, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []int32) bool {
+func Sgesdd(jobz byte, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3772,7 +4118,7 @@ func Sgesdd(jobz byte, m, n int, a []float32, lda int, s, u []float32, ldu int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -3780,7 +4126,7 @@ func Sgesdd(jobz byte, m, n int, a []float32, lda int, s, u []float32, ldu int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesdd.f.
-func Dgesdd(jobz byte, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int32) bool {
+func Dgesdd(jobz byte, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3801,7 +4147,7 @@ func Dgesdd(jobz byte, m, n int, a []float64, lda int, s, u []float64, ldu int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -3809,7 +4155,7 @@ func Dgesdd(jobz byte, m, n int, a []float64, lda int, s, u []float64, ldu int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesdd.f.
-func Cgesdd(jobz byte, m, n int, a []complex64, lda int, s []float32, u []complex64, ldu int, vt []complex64, ldvt int, work []complex64, lwork int, rwork []float32, iwork []int32) bool {
+func Cgesdd(jobz byte, m, n int, a []complex64, lda int, s []float32, u []complex64, ldu int, vt []complex64, ldvt int, work []complex64, lwork int, rwork []float32, iwork []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3834,7 +4180,7 @@ func Cgesdd(jobz byte, m, n int, a []complex64, lda int, s []float32, u []comple
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -3842,7 +4188,7 @@ func Cgesdd(jobz byte, m, n int, a []complex64, lda int, s []float32, u []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesdd.f.
-func Zgesdd(jobz byte, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64, iwork []int32) bool {
+func Zgesdd(jobz byte, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64, iwork []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -3867,7 +4213,7 @@ func Zgesdd(jobz byte, m, n int, a []complex128, lda int, s []float64, u []compl
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -3875,12 +4221,12 @@ func Zgesdd(jobz byte, m, n int, a []complex128, lda int, s []float64, u []compl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesv.f.
-func Sgesv(n, nrhs int, a []float32, lda int, ipiv []int32, b []float32, ldb int) bool {
+func Sgesv(n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3892,12 +4238,12 @@ func Sgesv(n, nrhs int, a []float32, lda int, ipiv []int32, b []float32, ldb int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesv.f.
-func Dgesv(n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb int) bool {
+func Dgesv(n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3909,12 +4255,12 @@ func Dgesv(n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesv.f.
-func Cgesv(n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int) bool {
+func Cgesv(n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3926,12 +4272,12 @@ func Cgesv(n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesv.f.
-func Zgesv(n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int) bool {
+func Zgesv(n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3943,12 +4289,12 @@ func Zgesv(n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsgesv.f.
-func Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iter []int32) bool {
+func Dsgesv(n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iter []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -3968,7 +4314,7 @@ func Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb in
 	if len(swork) > 0 {
 		_swork = &swork[0]
 	}
-	var _iter *int32
+	var _iter *Int
 	if len(iter) > 0 {
 		_iter = &iter[0]
 	}
@@ -3976,12 +4322,12 @@ func Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zcgesv.f.
-func Zcgesv(n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, work []complex128, swork []complex64, rwork []float64, iter []int32) bool {
+func Zcgesv(n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, work []complex128, swork []complex64, rwork []float64, iter []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4005,7 +4351,7 @@ func Zcgesv(n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128,
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iter *int32
+	var _iter *Int
 	if len(iter) > 0 {
 		_iter = &iter[0]
 	}
@@ -4121,7 +4467,7 @@ func Zgesvd(jobu, jobvt byte, m, n int, a []complex128, lda int, s []float64, u
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesvdx.f.
-func Sgesvdx(jobu, jobvt, rng byte, m, n int, a []float32, lda, vl, vu, il, iu, ns int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []int32) bool {
+func Sgesvdx(jobu, jobvt, rng byte, m, n int, a []float32, lda, vl, vu, il, iu, ns int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -4142,7 +4488,7 @@ func Sgesvdx(jobu, jobvt, rng byte, m, n int, a []float32, lda, vl, vu, il, iu,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -4150,7 +4496,7 @@ func Sgesvdx(jobu, jobvt, rng byte, m, n int, a []float32, lda, vl, vu, il, iu,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesvdx.f.
-func Dgesvdx(jobu, jobvt, rng byte, m, n int, a []float64, lda, vl, vu, il, iu, ns int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int32) bool {
+func Dgesvdx(jobu, jobvt, rng byte, m, n int, a []float64, lda, vl, vu, il, iu, ns int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -4171,7 +4517,7 @@ func Dgesvdx(jobu, jobvt, rng byte, m, n int, a []float64, lda, vl, vu, il, iu,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -4179,7 +4525,7 @@ func Dgesvdx(jobu, jobvt, rng byte, m, n int, a []float64, lda, vl, vu, il, iu,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesvdx.f.
-func Cgesvdx(jobu, jobvt, rng byte, m, n int, a []complex64, lda, vl, vu, il, iu, ns int, s []float32, u []complex64, ldu int, vt []complex64, ldvt int, work []complex64, lwork int, rwork []float32, iwork []int32) bool {
+func Cgesvdx(jobu, jobvt, rng byte, m, n int, a []complex64, lda, vl, vu, il, iu, ns int, s []float32, u []complex64, ldu int, vt []complex64, ldvt int, work []complex64, lwork int, rwork []float32, iwork []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -4204,7 +4550,7 @@ func Cgesvdx(jobu, jobvt, rng byte, m, n int, a []complex64, lda, vl, vu, il, iu
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -4212,7 +4558,7 @@ func Cgesvdx(jobu, jobvt, rng byte, m, n int, a []complex64, lda, vl, vu, il, iu
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesvdx.f.
-func Zgesvdx(jobu, jobvt, rng byte, m, n int, a []complex128, lda, vl, vu, il, iu, ns int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64, iwork []int32) bool {
+func Zgesvdx(jobu, jobvt, rng byte, m, n int, a []complex128, lda, vl, vu, il, iu, ns int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64, iwork []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -4237,7 +4583,7 @@ func Zgesvdx(jobu, jobvt, rng byte, m, n int, a []complex128, lda, vl, vu, il, i
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -4337,7 +4683,7 @@ func Zgesvj(joba, jobu, jobv byte, m, n int, a []complex128, lda int, sva []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgesvx.f.
-func Sgesvx(fact, trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []int32, equed []byte, r, c, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []int32) bool {
+func Sgesvx(fact, trans byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, equed []byte, r, c, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4351,7 +4697,7 @@ func Sgesvx(fact, trans byte, n, nrhs int, a []float32, lda int, af []float32, l
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4391,7 +4737,7 @@ func Sgesvx(fact, trans byte, n, nrhs int, a []float32, lda int, af []float32, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -4399,7 +4745,7 @@ func Sgesvx(fact, trans byte, n, nrhs int, a []float32, lda int, af []float32, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgesvx.f.
-func Dgesvx(fact, trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int32, equed []byte, r, c, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []int32) bool {
+func Dgesvx(fact, trans byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, equed []byte, r, c, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4413,7 +4759,7 @@ func Dgesvx(fact, trans byte, n, nrhs int, a []float64, lda int, af []float64, l
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4453,7 +4799,7 @@ func Dgesvx(fact, trans byte, n, nrhs int, a []float64, lda int, af []float64, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -4461,7 +4807,7 @@ func Dgesvx(fact, trans byte, n, nrhs int, a []float64, lda int, af []float64, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgesvx.f.
-func Cgesvx(fact, trans byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []int32, equed []byte, r, c []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cgesvx(fact, trans byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, equed []byte, r, c []float32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4475,7 +4821,7 @@ func Cgesvx(fact, trans byte, n, nrhs int, a []complex64, lda int, af []complex6
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4523,7 +4869,7 @@ func Cgesvx(fact, trans byte, n, nrhs int, a []complex64, lda int, af []complex6
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgesvx.f.
-func Zgesvx(fact, trans byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []int32, equed []byte, r, c []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zgesvx(fact, trans byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, equed []byte, r, c []float64, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4537,7 +4883,7 @@ func Zgesvx(fact, trans byte, n, nrhs int, a []complex128, lda int, af []complex
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4585,12 +4931,12 @@ func Zgesvx(fact, trans byte, n, nrhs int, a []complex128, lda int, af []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetf2.f.
-func Sgetf2(m, n int, a []float32, lda int, ipiv []int32) bool {
+func Sgetf2(m, n int, a []float32, lda int, ipiv []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4598,12 +4944,12 @@ func Sgetf2(m, n int, a []float32, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetf2.f.
-func Dgetf2(m, n int, a []float64, lda int, ipiv []int32) bool {
+func Dgetf2(m, n int, a []float64, lda int, ipiv []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4611,12 +4957,12 @@ func Dgetf2(m, n int, a []float64, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetf2.f.
-func Cgetf2(m, n int, a []complex64, lda int, ipiv []int32) bool {
+func Cgetf2(m, n int, a []complex64, lda int, ipiv []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4624,12 +4970,12 @@ func Cgetf2(m, n int, a []complex64, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetf2.f.
-func Zgetf2(m, n int, a []complex128, lda int, ipiv []int32) bool {
+func Zgetf2(m, n int, a []complex128, lda int, ipiv []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4637,12 +4983,12 @@ func Zgetf2(m, n int, a []complex128, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetrf.f.
-func Sgetrf(m, n int, a []float32, lda int, ipiv []int32) bool {
+func Sgetrf(m, n int, a []float32, lda int, ipiv []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4650,12 +4996,12 @@ func Sgetrf(m, n int, a []float32, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetrf.f.
-func Dgetrf(m, n int, a []float64, lda int, ipiv []int32) bool {
+func Dgetrf(m, n int, a []float64, lda int, ipiv []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4663,12 +5009,12 @@ func Dgetrf(m, n int, a []float64, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetrf.f.
-func Cgetrf(m, n int, a []complex64, lda int, ipiv []int32) bool {
+func Cgetrf(m, n int, a []complex64, lda int, ipiv []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4676,12 +5022,12 @@ func Cgetrf(m, n int, a []complex64, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetrf.f.
-func Zgetrf(m, n int, a []complex128, lda int, ipiv []int32) bool {
+func Zgetrf(m, n int, a []complex128, lda int, ipiv []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4689,12 +5035,12 @@ func Zgetrf(m, n int, a []complex128, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetrf2.f.
-func Sgetrf2(m, n int, a []float32, lda int, ipiv []int32) bool {
+func Sgetrf2(m, n int, a []float32, lda int, ipiv []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4702,12 +5048,12 @@ func Sgetrf2(m, n int, a []float32, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetrf2.f.
-func Dgetrf2(m, n int, a []float64, lda int, ipiv []int32) bool {
+func Dgetrf2(m, n int, a []float64, lda int, ipiv []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4715,12 +5061,12 @@ func Dgetrf2(m, n int, a []float64, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetrf2.f.
-func Cgetrf2(m, n int, a []complex64, lda int, ipiv []int32) bool {
+func Cgetrf2(m, n int, a []complex64, lda int, ipiv []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4728,12 +5074,12 @@ func Cgetrf2(m, n int, a []complex64, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetrf2.f.
-func Zgetrf2(m, n int, a []complex128, lda int, ipiv []int32) bool {
+func Zgetrf2(m, n int, a []complex128, lda int, ipiv []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4741,12 +5087,12 @@ func Zgetrf2(m, n int, a []complex128, lda int, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetri.f.
-func Sgetri(n int, a []float32, lda int, ipiv []int32, work []float32, lwork int) bool {
+func Sgetri(n int, a []float32, lda int, ipiv []Int, work []float32, lwork int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4758,12 +5104,12 @@ func Sgetri(n int, a []float32, lda int, ipiv []int32, work []float32, lwork int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetri.f.
-func Dgetri(n int, a []float64, lda int, ipiv []int32, work []float64, lwork int) bool {
+func Dgetri(n int, a []float64, lda int, ipiv []Int, work []float64, lwork int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4775,12 +5121,12 @@ func Dgetri(n int, a []float64, lda int, ipiv []int32, work []float64, lwork int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetri.f.
-func Cgetri(n int, a []complex64, lda int, ipiv []int32, work []complex64, lwork int) bool {
+func Cgetri(n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4792,12 +5138,12 @@ func Cgetri(n int, a []complex64, lda int, ipiv []int32, work []complex64, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetri.f.
-func Zgetri(n int, a []complex128, lda int, ipiv []int32, work []complex128, lwork int) bool {
+func Zgetri(n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4809,7 +5155,7 @@ func Zgetri(n int, a []complex128, lda int, ipiv []int32, work []complex128, lwo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetrs.f.
-func Sgetrs(trans byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float32, ldb int) bool {
+func Sgetrs(trans byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4819,7 +5165,7 @@ func Sgetrs(trans byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []flo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4831,7 +5177,7 @@ func Sgetrs(trans byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetrs.f.
-func Dgetrs(trans byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb int) bool {
+func Dgetrs(trans byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4841,7 +5187,7 @@ func Dgetrs(trans byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []flo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4853,7 +5199,7 @@ func Dgetrs(trans byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgetrs.f.
-func Cgetrs(trans byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int) bool {
+func Cgetrs(trans byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4863,7 +5209,7 @@ func Cgetrs(trans byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []c
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4875,7 +5221,7 @@ func Cgetrs(trans byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgetrs.f.
-func Zgetrs(trans byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int) bool {
+func Zgetrs(trans byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -4885,7 +5231,7 @@ func Zgetrs(trans byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -4985,7 +5331,7 @@ func Zggbak(job, side byte, n, ilo, ihi int, lscale, rscale []float64, m int, v
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggbal.f.
-func Sggbal(job byte, n int, a []float32, lda int, b []float32, ldb int, ilo, ihi []int32, lscale, rscale, work []float32) bool {
+func Sggbal(job byte, n int, a []float32, lda int, b []float32, ldb int, ilo, ihi []Int, lscale, rscale, work []float32) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -4994,11 +5340,11 @@ func Sggbal(job byte, n int, a []float32, lda int, b []float32, ldb int, ilo, ih
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5018,7 +5364,7 @@ func Sggbal(job byte, n int, a []float32, lda int, b []float32, ldb int, ilo, ih
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggbal.f.
-func Dggbal(job byte, n int, a []float64, lda int, b []float64, ldb int, ilo, ihi []int32, lscale, rscale, work []float64) bool {
+func Dggbal(job byte, n int, a []float64, lda int, b []float64, ldb int, ilo, ihi []Int, lscale, rscale, work []float64) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -5027,11 +5373,11 @@ func Dggbal(job byte, n int, a []float64, lda int, b []float64, ldb int, ilo, ih
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5051,7 +5397,7 @@ func Dggbal(job byte, n int, a []float64, lda int, b []float64, ldb int, ilo, ih
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggbal.f.
-func Cggbal(job byte, n int, a []complex64, lda int, b []complex64, ldb int, ilo, ihi []int32, lscale, rscale, work []float32) bool {
+func Cggbal(job byte, n int, a []complex64, lda int, b []complex64, ldb int, ilo, ihi []Int, lscale, rscale, work []float32) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -5060,11 +5406,11 @@ func Cggbal(job byte, n int, a []complex64, lda int, b []complex64, ldb int, ilo
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5084,7 +5430,7 @@ func Cggbal(job byte, n int, a []complex64, lda int, b []complex64, ldb int, ilo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggbal.f.
-func Zggbal(job byte, n int, a []complex128, lda int, b []complex128, ldb int, ilo, ihi []int32, lscale, rscale, work []float64) bool {
+func Zggbal(job byte, n int, a []complex128, lda int, b []complex128, ldb int, ilo, ihi []Int, lscale, rscale, work []float64) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -5093,11 +5439,11 @@ func Zggbal(job byte, n int, a []complex128, lda int, b []complex128, ldb int, i
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5116,6 +5462,654 @@ func Zggbal(job byte, n int, a []complex128, lda int, b []complex128, ldb int, i
 	return isZero(C.LAPACKE_zggbal_work((C.int)(rowMajor), (C.char)(job), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_ilo), (*C.lapack_int)(_ihi), (*C.double)(_lscale), (*C.double)(_rscale), (*C.double)(_work)))
 }
 
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgges.f.
+func Sgges(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_S_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_S_SELECT3(C.netlib_s_select3)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_sgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgges.f.
+func Dgges(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_D_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_D_SELECT3(C.netlib_d_select3)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_dgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgges.f.
+func Cgges(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) bool {
+	var _selctg C.LAPACK_C_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_C_SELECT2(C.netlib_c_select2)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_cgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgges.f.
+func Zgges(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) bool {
+	var _selctg C.LAPACK_Z_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_Z_SELECT2(C.netlib_z_select2)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_zgges_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgges3.f.
+func Sgges3(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_S_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_S_SELECT3(C.netlib_s_select3)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_sgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgges3.f.
+func Dgges3(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_D_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_D_SELECT3(C.netlib_d_select3)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_dgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgges3.f.
+func Cgges3(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) bool {
+	var _selctg C.LAPACK_C_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_C_SELECT2(C.netlib_c_select2)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_cgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgges3.f.
+func Zgges3(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) bool {
+	var _selctg C.LAPACK_Z_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_Z_SELECT2(C.netlib_z_select2)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_zgges3_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggesx.f.
+func Sggesx(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float32) bool, sense byte, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, rconde, rcondv, work []float32, lwork int, iwork []Int, liwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_S_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_S_SELECT3(C.netlib_s_select3)
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_sggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggesx.f.
+func Dggesx(jobvsl, jobvsr, sort byte, selctg func(alphar, alphai, beta float64) bool, sense byte, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, rconde, rcondv, work []float64, lwork int, iwork []Int, liwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_D_SELECT3
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_D_SELECT3(C.netlib_d_select3)
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_dggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggesx.f.
+func Cggesx(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex64) bool, sense byte, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32, iwork []Int, liwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_C_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_C_SELECT2(C.netlib_c_select2)
+	}
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float32
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float32
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_cggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_rconde), (*C.float)(_rcondv), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggesx.f.
+func Zggesx(jobvsl, jobvsr, sort byte, selctg func(alpha, beta complex128) bool, sense byte, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64, iwork []Int, liwork int, bwork []Int) bool {
+	var _selctg C.LAPACK_Z_SELECT2
+	if selctg != nil {
+		defer useSelect(selctg)()
+		_selctg = C.LAPACK_Z_SELECT2(C.netlib_z_select2)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _rconde *float64
+	if len(rconde) > 0 {
+		_rconde = &rconde[0]
+	}
+	var _rcondv *float64
+	if len(rcondv) > 0 {
+		_rcondv = &rcondv[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _iwork *Int
+	if len(iwork) > 0 {
+		_iwork = &iwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	return isZero(C.LAPACKE_zggesx_work((C.int)(rowMajor), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), _selctg, (C.char)(sense), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_rconde), (*C.double)(_rcondv), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_int)(_iwork), (C.lapack_int)(liwork), (*C.lapack_logical)(_bwork)))
+}
+
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggev.f.
 func Sggev(jobvl, jobvr byte, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) bool {
 	var _a *float32
@@ -5413,7 +6407,7 @@ func Zggev3(jobvl, jobvr byte, n int, a []complex128, lda int, b []complex128, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggevx.f.
-func Sggevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vl []float32, ldvl int, vr []float32, ldvr int, ilo, ihi []int32, lscale, rscale, abnrm, bbnrm, rconde, rcondv, work []float32, lwork int, iwork, bwork []int32) bool {
+func Sggevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vl []float32, ldvl int, vr []float32, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv, work []float32, lwork int, iwork, bwork []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -5442,11 +6436,11 @@ func Sggevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, b []f
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5478,11 +6472,11 @@ func Sggevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, b []f
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _bwork *int32
+	var _bwork *Int
 	if len(bwork) > 0 {
 		_bwork = &bwork[0]
 	}
@@ -5490,7 +6484,7 @@ func Sggevx(balanc, jobvl, jobvr, sense byte, n int, a []float32, lda int, b []f
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggevx.f.
-func Dggevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, ilo, ihi []int32, lscale, rscale, abnrm, bbnrm, rconde, rcondv, work []float64, lwork int, iwork, bwork []int32) bool {
+func Dggevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv, work []float64, lwork int, iwork, bwork []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -5519,11 +6513,11 @@ func Dggevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, b []f
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5555,11 +6549,11 @@ func Dggevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, b []f
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _bwork *int32
+	var _bwork *Int
 	if len(bwork) > 0 {
 		_bwork = &bwork[0]
 	}
@@ -5567,7 +6561,7 @@ func Dggevx(balanc, jobvl, jobvr, sense byte, n int, a []float64, lda int, b []f
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggevx.f.
-func Cggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, vl []complex64, ldvl int, vr []complex64, ldvr int, ilo, ihi []int32, lscale, rscale, abnrm, bbnrm, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32, iwork, bwork []int32) bool {
+func Cggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, vl []complex64, ldvl int, vr []complex64, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv []float32, work []complex64, lwork int, rwork []float32, iwork, bwork []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -5592,11 +6586,11 @@ func Cggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, b [
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5632,11 +6626,11 @@ func Cggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, b [
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _bwork *int32
+	var _bwork *Int
 	if len(bwork) > 0 {
 		_bwork = &bwork[0]
 	}
@@ -5644,7 +6638,7 @@ func Cggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex64, lda int, b [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggevx.f.
-func Zggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, vl []complex128, ldvl int, vr []complex128, ldvr int, ilo, ihi []int32, lscale, rscale, abnrm, bbnrm, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64, iwork, bwork []int32) bool {
+func Zggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, vl []complex128, ldvl int, vr []complex128, ldvr int, ilo, ihi []Int, lscale, rscale, abnrm, bbnrm, rconde, rcondv []float64, work []complex128, lwork int, rwork []float64, iwork, bwork []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -5669,11 +6663,11 @@ func Zggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, b
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _ilo *int32
+	var _ilo *Int
 	if len(ilo) > 0 {
 		_ilo = &ilo[0]
 	}
-	var _ihi *int32
+	var _ihi *Int
 	if len(ihi) > 0 {
 		_ihi = &ihi[0]
 	}
@@ -5709,11 +6703,11 @@ func Zggevx(balanc, jobvl, jobvr, sense byte, n int, a []complex128, lda int, b
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _bwork *int32
+	var _bwork *Int
 	if len(bwork) > 0 {
 		_bwork = &bwork[0]
 	}
@@ -6337,12 +7331,12 @@ func Zggrqf(m, p, n int, a []complex128, lda int, taua, b []complex128, ldb int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggsvd3.f.
-func Sggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []float32, lda int, b []float32, ldb int, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, lwork int, iwork []int32) bool {
-	var _k *int32
+func Sggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []float32, lda int, b []float32, ldb int, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, lwork int, iwork []Int) bool {
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6378,7 +7372,7 @@ func Sggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []float32, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6386,12 +7380,12 @@ func Sggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []float32, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggsvd3.f.
-func Dggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []int32) bool {
-	var _k *int32
+func Dggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []Int) bool {
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6427,7 +7421,7 @@ func Dggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []float64, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6435,12 +7429,12 @@ func Dggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []float64, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggsvd3.f.
-func Cggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []complex64, lda int, b []complex64, ldb int, alpha, beta []float32, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, work []complex64, lwork int, rwork []float32, iwork []int32) bool {
-	var _k *int32
+func Cggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []complex64, lda int, b []complex64, ldb int, alpha, beta []float32, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, work []complex64, lwork int, rwork []float32, iwork []Int) bool {
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6480,7 +7474,7 @@ func Cggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []complex64, ld
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6488,12 +7482,12 @@ func Cggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []complex64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggsvd3.f.
-func Zggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, lwork int, rwork []float64, iwork []int32) bool {
-	var _k *int32
+func Zggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []Int, a []complex128, lda int, b []complex128, ldb int, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, lwork int, rwork []float64, iwork []Int) bool {
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6533,7 +7527,7 @@ func Zggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []complex128, l
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6541,7 +7535,7 @@ func Zggsvd3(jobu, jobv, jobq byte, m, n, p int, k, l []int32, a []complex128, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sggsvp3.f.
-func Sggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, k, l []int32, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, iwork []int32, tau, work []float32, lwork int) bool {
+func Sggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, k, l []Int, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, iwork []Int, tau, work []float32, lwork int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -6550,11 +7544,11 @@ func Sggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float32, lda int, b []float
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6570,7 +7564,7 @@ func Sggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float32, lda int, b []float
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6586,7 +7580,7 @@ func Sggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float32, lda int, b []float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dggsvp3.f.
-func Dggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, k, l []int32, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, iwork []int32, tau, work []float64, lwork int) bool {
+func Dggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, k, l []Int, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, iwork []Int, tau, work []float64, lwork int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -6595,11 +7589,11 @@ func Dggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float64, lda int, b []float
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6615,7 +7609,7 @@ func Dggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float64, lda int, b []float
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6631,7 +7625,7 @@ func Dggsvp3(jobu, jobv, jobq byte, m, p, n int, a []float64, lda int, b []float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cggsvp3.f.
-func Cggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex64, lda int, b []complex64, ldb int, tola, tolb float32, k, l []int32, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, iwork []int32, rwork []float32, tau, work []complex64, lwork int) bool {
+func Cggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex64, lda int, b []complex64, ldb int, tola, tolb float32, k, l []Int, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, iwork []Int, rwork []float32, tau, work []complex64, lwork int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -6640,11 +7634,11 @@ func Cggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex64, lda int, b []com
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6660,7 +7654,7 @@ func Cggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex64, lda int, b []com
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6680,7 +7674,7 @@ func Cggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex64, lda int, b []com
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zggsvp3.f.
-func Zggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, k, l []int32, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, iwork []int32, rwork []float64, tau, work []complex128, lwork int) bool {
+func Zggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, k, l []Int, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, iwork []Int, rwork []float64, tau, work []complex128, lwork int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -6689,11 +7683,11 @@ func Zggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex128, lda int, b []co
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
-	var _l *int32
+	var _l *Int
 	if len(l) > 0 {
 		_l = &l[0]
 	}
@@ -6709,7 +7703,7 @@ func Zggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex128, lda int, b []co
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6729,7 +7723,7 @@ func Zggsvp3(jobu, jobv, jobq byte, m, p, n int, a []complex128, lda int, b []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgtcon.f.
-func Sgtcon(norm byte, n int, dl, d, du, du2 []float32, ipiv []int32, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Sgtcon(norm byte, n int, dl, d, du, du2 []float32, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) bool {
 	var _dl *float32
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -6746,7 +7740,7 @@ func Sgtcon(norm byte, n int, dl, d, du, du2 []float32, ipiv []int32, anorm floa
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -6758,7 +7752,7 @@ func Sgtcon(norm byte, n int, dl, d, du, du2 []float32, ipiv []int32, anorm floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6766,7 +7760,7 @@ func Sgtcon(norm byte, n int, dl, d, du, du2 []float32, ipiv []int32, anorm floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgtcon.f.
-func Dgtcon(norm byte, n int, dl, d, du, du2 []float64, ipiv []int32, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dgtcon(norm byte, n int, dl, d, du, du2 []float64, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) bool {
 	var _dl *float64
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -6783,7 +7777,7 @@ func Dgtcon(norm byte, n int, dl, d, du, du2 []float64, ipiv []int32, anorm floa
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -6795,7 +7789,7 @@ func Dgtcon(norm byte, n int, dl, d, du, du2 []float64, ipiv []int32, anorm floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6803,7 +7797,7 @@ func Dgtcon(norm byte, n int, dl, d, du, du2 []float64, ipiv []int32, anorm floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgtcon.f.
-func Cgtcon(norm byte, n int, dl, d, du, du2 []complex64, ipiv []int32, anorm float32, rcond []float32, work []complex64) bool {
+func Cgtcon(norm byte, n int, dl, d, du, du2 []complex64, ipiv []Int, anorm float32, rcond []float32, work []complex64) bool {
 	var _dl *complex64
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -6820,7 +7814,7 @@ func Cgtcon(norm byte, n int, dl, d, du, du2 []complex64, ipiv []int32, anorm fl
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -6836,7 +7830,7 @@ func Cgtcon(norm byte, n int, dl, d, du, du2 []complex64, ipiv []int32, anorm fl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgtcon.f.
-func Zgtcon(norm byte, n int, dl, d, du, du2 []complex128, ipiv []int32, anorm float64, rcond []float64, work []complex128) bool {
+func Zgtcon(norm byte, n int, dl, d, du, du2 []complex128, ipiv []Int, anorm float64, rcond []float64, work []complex128) bool {
 	var _dl *complex128
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -6853,7 +7847,7 @@ func Zgtcon(norm byte, n int, dl, d, du, du2 []complex128, ipiv []int32, anorm f
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -6869,7 +7863,7 @@ func Zgtcon(norm byte, n int, dl, d, du, du2 []complex128, ipiv []int32, anorm f
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgtrfs.f.
-func Sgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipiv []int32, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Sgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -6903,7 +7897,7 @@ func Sgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipi
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -6927,7 +7921,7 @@ func Sgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipi
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -6935,7 +7929,7 @@ func Sgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipi
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgtrfs.f.
-func Dgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipiv []int32, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -6969,7 +7963,7 @@ func Dgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipi
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -6993,7 +7987,7 @@ func Dgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipi
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -7001,7 +7995,7 @@ func Dgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipi
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgtrfs.f.
-func Cgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7035,7 +8029,7 @@ func Cgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, i
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7067,7 +8061,7 @@ func Cgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgtrfs.f.
-func Zgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex128, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7101,7 +8095,7 @@ func Zgtrfs(trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex128,
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7217,7 +8211,7 @@ func Zgtsv(n, nrhs int, dl, d, du, b []complex128, ldb int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgtsvx.f.
-func Sgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipiv []int32, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []int32) bool {
+func Sgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7251,7 +8245,7 @@ func Sgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float3
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7279,7 +8273,7 @@ func Sgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float3
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -7287,7 +8281,7 @@ func Sgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgtsvx.f.
-func Dgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipiv []int32, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []int32) bool {
+func Dgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7321,7 +8315,7 @@ func Dgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float6
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7349,7 +8343,7 @@ func Dgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float6
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -7357,7 +8351,7 @@ func Dgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []float6
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgtsvx.f.
-func Cgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7391,7 +8385,7 @@ func Cgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []comple
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7427,7 +8421,7 @@ func Cgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgtsvx.f.
-func Zgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex128, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7461,7 +8455,7 @@ func Zgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []comple
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7497,7 +8491,7 @@ func Zgtsvx(fact, trans byte, n, nrhs int, dl, d, du, dlf, df, duf, du2 []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgttrf.f.
-func Sgttrf(n int, dl, d, du, du2 []float32, ipiv []int32) bool {
+func Sgttrf(n int, dl, d, du, du2 []float32, ipiv []Int) bool {
 	var _dl *float32
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -7514,7 +8508,7 @@ func Sgttrf(n int, dl, d, du, du2 []float32, ipiv []int32) bool {
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7522,7 +8516,7 @@ func Sgttrf(n int, dl, d, du, du2 []float32, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgttrf.f.
-func Dgttrf(n int, dl, d, du, du2 []float64, ipiv []int32) bool {
+func Dgttrf(n int, dl, d, du, du2 []float64, ipiv []Int) bool {
 	var _dl *float64
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -7539,7 +8533,7 @@ func Dgttrf(n int, dl, d, du, du2 []float64, ipiv []int32) bool {
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7547,7 +8541,7 @@ func Dgttrf(n int, dl, d, du, du2 []float64, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgttrf.f.
-func Cgttrf(n int, dl, d, du, du2 []complex64, ipiv []int32) bool {
+func Cgttrf(n int, dl, d, du, du2 []complex64, ipiv []Int) bool {
 	var _dl *complex64
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -7564,7 +8558,7 @@ func Cgttrf(n int, dl, d, du, du2 []complex64, ipiv []int32) bool {
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7572,7 +8566,7 @@ func Cgttrf(n int, dl, d, du, du2 []complex64, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgttrf.f.
-func Zgttrf(n int, dl, d, du, du2 []complex128, ipiv []int32) bool {
+func Zgttrf(n int, dl, d, du, du2 []complex128, ipiv []Int) bool {
 	var _dl *complex128
 	if len(dl) > 0 {
 		_dl = &dl[0]
@@ -7589,7 +8583,7 @@ func Zgttrf(n int, dl, d, du, du2 []complex128, ipiv []int32) bool {
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7597,7 +8591,7 @@ func Zgttrf(n int, dl, d, du, du2 []complex128, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgttrs.f.
-func Sgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float32, ipiv []int32, b []float32, ldb int) bool {
+func Sgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float32, ipiv []Int, b []float32, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7619,7 +8613,7 @@ func Sgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float32, ipiv []int32, b [
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7631,7 +8625,7 @@ func Sgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float32, ipiv []int32, b [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgttrs.f.
-func Dgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float64, ipiv []int32, b []float64, ldb int) bool {
+func Dgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float64, ipiv []Int, b []float64, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7653,7 +8647,7 @@ func Dgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float64, ipiv []int32, b [
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7665,7 +8659,7 @@ func Dgttrs(trans byte, n, nrhs int, dl, d, du, du2 []float64, ipiv []int32, b [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgttrs.f.
-func Cgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex64, ipiv []int32, b []complex64, ldb int) bool {
+func Cgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex64, ipiv []Int, b []complex64, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7687,7 +8681,7 @@ func Cgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex64, ipiv []int32, b
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7699,7 +8693,7 @@ func Cgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex64, ipiv []int32, b
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgttrs.f.
-func Zgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex128, ipiv []int32, b []complex128, ldb int) bool {
+func Zgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex128, ipiv []Int, b []complex128, ldb int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -7721,7 +8715,7 @@ func Zgttrs(trans byte, n, nrhs int, dl, d, du, du2 []complex128, ipiv []int32,
 	if len(du2) > 0 {
 		_du2 = &du2[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -7793,7 +8787,7 @@ func Zhbev(jobz, ul byte, n, kd int, ab []complex128, ldab int, w []float64, z [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbevd.f.
-func Chbevd(jobz, ul byte, n, kd int, ab []complex64, ldab int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Chbevd(jobz, ul byte, n, kd int, ab []complex64, ldab int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -7819,7 +8813,7 @@ func Chbevd(jobz, ul byte, n, kd int, ab []complex64, ldab int, w []float32, z [
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -7827,7 +8821,7 @@ func Chbevd(jobz, ul byte, n, kd int, ab []complex64, ldab int, w []float32, z [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbevd.f.
-func Zhbevd(jobz, ul byte, n, kd int, ab []complex128, ldab int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zhbevd(jobz, ul byte, n, kd int, ab []complex128, ldab int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -7853,7 +8847,7 @@ func Zhbevd(jobz, ul byte, n, kd int, ab []complex128, ldab int, w []float64, z
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -7861,7 +8855,7 @@ func Zhbevd(jobz, ul byte, n, kd int, ab []complex128, ldab int, w []float64, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbevx.f.
-func Chbevx(jobz, rng, ul byte, n, kd int, ab []complex64, ldab int, q []complex64, ldq int, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []int32) bool {
+func Chbevx(jobz, rng, ul byte, n, kd int, ab []complex64, ldab int, q []complex64, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -7875,7 +8869,7 @@ func Chbevx(jobz, rng, ul byte, n, kd int, ab []complex64, ldab int, q []complex
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -7895,11 +8889,11 @@ func Chbevx(jobz, rng, ul byte, n, kd int, ab []complex64, ldab int, q []complex
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -7907,7 +8901,7 @@ func Chbevx(jobz, rng, ul byte, n, kd int, ab []complex64, ldab int, q []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbevx.f.
-func Zhbevx(jobz, rng, ul byte, n, kd int, ab []complex128, ldab int, q []complex128, ldq int, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []int32) bool {
+func Zhbevx(jobz, rng, ul byte, n, kd int, ab []complex128, ldab int, q []complex128, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -7921,7 +8915,7 @@ func Zhbevx(jobz, rng, ul byte, n, kd int, ab []complex128, ldab int, q []comple
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -7941,11 +8935,11 @@ func Zhbevx(jobz, rng, ul byte, n, kd int, ab []complex128, ldab int, q []comple
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -8081,7 +9075,7 @@ func Zhbgv(jobz, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbgvd.f.
-func Chbgvd(jobz, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Chbgvd(jobz, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8111,7 +9105,7 @@ func Chbgvd(jobz, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8119,7 +9113,7 @@ func Chbgvd(jobz, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbgvd.f.
-func Zhbgvd(jobz, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zhbgvd(jobz, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8149,7 +9143,7 @@ func Zhbgvd(jobz, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []comple
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8157,7 +9151,7 @@ func Zhbgvd(jobz, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chbgvx.f.
-func Chbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, q []complex64, ldq int, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []int32) bool {
+func Chbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []complex64, ldbb int, q []complex64, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8175,7 +9169,7 @@ func Chbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []co
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8195,11 +9189,11 @@ func Chbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []co
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -8207,7 +9201,7 @@ func Chbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex64, ldab int, bb []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhbgvx.f.
-func Zhbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, q []complex128, ldq int, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []int32) bool {
+func Zhbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []complex128, ldbb int, q []complex128, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8225,7 +9219,7 @@ func Zhbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []c
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8245,11 +9239,11 @@ func Zhbgvx(jobz, rng, ul byte, n, ka, kb int, ab []complex128, ldab int, bb []c
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -8317,7 +9311,7 @@ func Zhbtrd(vect, ul byte, n, kd int, ab []complex128, ldab int, d, e []float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/checon.f.
-func Checon(ul byte, n int, a []complex64, lda int, ipiv []int32, anorm float32, rcond []float32, work []complex64) bool {
+func Checon(ul byte, n int, a []complex64, lda int, ipiv []Int, anorm float32, rcond []float32, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8327,7 +9321,7 @@ func Checon(ul byte, n int, a []complex64, lda int, ipiv []int32, anorm float32,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -8343,7 +9337,7 @@ func Checon(ul byte, n int, a []complex64, lda int, ipiv []int32, anorm float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhecon.f.
-func Zhecon(ul byte, n int, a []complex128, lda int, ipiv []int32, anorm float64, rcond []float64, work []complex128) bool {
+func Zhecon(ul byte, n int, a []complex128, lda int, ipiv []Int, anorm float64, rcond []float64, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8353,7 +9347,7 @@ func Zhecon(ul byte, n int, a []complex128, lda int, ipiv []int32, anorm float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -8481,7 +9475,7 @@ func Zheev(jobz, ul byte, n int, a []complex128, lda int, w []float64, work []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheevd.f.
-func Cheevd(jobz, ul byte, n int, a []complex64, lda int, w []float32, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Cheevd(jobz, ul byte, n int, a []complex64, lda int, w []float32, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8503,7 +9497,7 @@ func Cheevd(jobz, ul byte, n int, a []complex64, lda int, w []float32, work []co
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8511,7 +9505,7 @@ func Cheevd(jobz, ul byte, n int, a []complex64, lda int, w []float32, work []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheevd.f.
-func Zheevd(jobz, ul byte, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zheevd(jobz, ul byte, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8533,7 +9527,7 @@ func Zheevd(jobz, ul byte, n int, a []complex128, lda int, w []float64, work []c
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8541,7 +9535,7 @@ func Zheevd(jobz, ul byte, n int, a []complex128, lda int, w []float64, work []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheevr.f.
-func Cheevr(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, isuppz []int32, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Cheevr(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, isuppz []Int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8551,7 +9545,7 @@ func Cheevr(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, i
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8563,7 +9557,7 @@ func Cheevr(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, i
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -8575,7 +9569,7 @@ func Cheevr(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, i
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8583,7 +9577,7 @@ func Cheevr(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheevr.f.
-func Zheevr(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, isuppz []int32, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zheevr(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, isuppz []Int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8593,7 +9587,7 @@ func Zheevr(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8605,7 +9599,7 @@ func Zheevr(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64,
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -8617,7 +9611,7 @@ func Zheevr(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64,
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8625,7 +9619,7 @@ func Zheevr(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cheevx.f.
-func Cheevx(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, iwork, ifail []int32) bool {
+func Cheevx(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8635,7 +9629,7 @@ func Cheevx(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, i
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8655,11 +9649,11 @@ func Cheevx(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, i
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -8667,7 +9661,7 @@ func Cheevx(jobz, rng, ul byte, n int, a []complex64, lda int, vl, vu float32, i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zheevx.f.
-func Zheevx(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, iwork, ifail []int32) bool {
+func Zheevx(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8677,7 +9671,7 @@ func Zheevx(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8697,11 +9691,11 @@ func Zheevx(jobz, rng, ul byte, n int, a []complex128, lda int, vl, vu float64,
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -8805,7 +9799,7 @@ func Zhegv(itype int, jobz, ul byte, n int, a []complex128, lda int, b []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chegvd.f.
-func Chegvd(itype int, jobz, ul byte, n int, a []complex64, lda int, b []complex64, ldb int, w []float32, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Chegvd(itype int, jobz, ul byte, n int, a []complex64, lda int, b []complex64, ldb int, w []float32, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8831,7 +9825,7 @@ func Chegvd(itype int, jobz, ul byte, n int, a []complex64, lda int, b []complex
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8839,7 +9833,7 @@ func Chegvd(itype int, jobz, ul byte, n int, a []complex64, lda int, b []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhegvd.f.
-func Zhegvd(itype int, jobz, ul byte, n int, a []complex128, lda int, b []complex128, ldb int, w []float64, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zhegvd(itype int, jobz, ul byte, n int, a []complex128, lda int, b []complex128, ldb int, w []float64, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8865,7 +9859,7 @@ func Zhegvd(itype int, jobz, ul byte, n int, a []complex128, lda int, b []comple
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -8873,7 +9867,7 @@ func Zhegvd(itype int, jobz, ul byte, n int, a []complex128, lda int, b []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chegvx.f.
-func Chegvx(itype int, jobz, rng, ul byte, n int, a []complex64, lda int, b []complex64, ldb int, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, iwork, ifail []int32) bool {
+func Chegvx(itype int, jobz, rng, ul byte, n int, a []complex64, lda int, b []complex64, ldb int, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8887,7 +9881,7 @@ func Chegvx(itype int, jobz, rng, ul byte, n int, a []complex64, lda int, b []co
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8907,11 +9901,11 @@ func Chegvx(itype int, jobz, rng, ul byte, n int, a []complex64, lda int, b []co
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -8919,7 +9913,7 @@ func Chegvx(itype int, jobz, rng, ul byte, n int, a []complex64, lda int, b []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhegvx.f.
-func Zhegvx(itype int, jobz, rng, ul byte, n int, a []complex128, lda int, b []complex128, ldb int, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, iwork, ifail []int32) bool {
+func Zhegvx(itype int, jobz, rng, ul byte, n int, a []complex128, lda int, b []complex128, ldb int, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8933,7 +9927,7 @@ func Zhegvx(itype int, jobz, rng, ul byte, n int, a []complex128, lda int, b []c
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -8953,11 +9947,11 @@ func Zhegvx(itype int, jobz, rng, ul byte, n int, a []complex128, lda int, b []c
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -8965,7 +9959,7 @@ func Zhegvx(itype int, jobz, rng, ul byte, n int, a []complex128, lda int, b []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cherfs.f.
-func Cherfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cherfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -8979,7 +9973,7 @@ func Cherfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf i
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9011,7 +10005,7 @@ func Cherfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zherfs.f.
-func Zherfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zherfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9025,7 +10019,7 @@ func Zherfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9057,7 +10051,7 @@ func Zherfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chesv.f.
-func Chesv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int, work []complex64, lwork int) bool {
+func Chesv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9067,7 +10061,7 @@ func Chesv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []compl
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9083,7 +10077,7 @@ func Chesv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []compl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhesv.f.
-func Zhesv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int, work []complex128, lwork int) bool {
+func Zhesv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9093,7 +10087,7 @@ func Zhesv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []comp
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9109,7 +10103,7 @@ func Zhesv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []comp
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chesvx.f.
-func Chesvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, lwork int, rwork []float32) bool {
+func Chesvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, lwork int, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9123,7 +10117,7 @@ func Chesvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64,
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9159,7 +10153,7 @@ func Chesvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhesvx.f.
-func Zhesvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, lwork int, rwork []float64) bool {
+func Zhesvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, lwork int, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9173,7 +10167,7 @@ func Zhesvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9269,7 +10263,7 @@ func Zhetrd(ul byte, n int, a []complex128, lda int, d, e []float64, tau, work [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetrf.f.
-func Chetrf(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64, lwork int) bool {
+func Chetrf(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9279,7 +10273,7 @@ func Chetrf(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9291,7 +10285,7 @@ func Chetrf(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetrf.f.
-func Zhetrf(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128, lwork int) bool {
+func Zhetrf(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9301,7 +10295,7 @@ func Zhetrf(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9313,7 +10307,7 @@ func Zhetrf(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetri.f.
-func Chetri(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64) bool {
+func Chetri(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9323,7 +10317,7 @@ func Chetri(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9335,7 +10329,7 @@ func Chetri(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetri.f.
-func Zhetri(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128) bool {
+func Zhetri(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9345,7 +10339,7 @@ func Zhetri(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9357,7 +10351,7 @@ func Zhetri(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetrs.f.
-func Chetrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int) bool {
+func Chetrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9367,7 +10361,7 @@ func Chetrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []comp
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9379,7 +10373,7 @@ func Chetrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []comp
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetrs.f.
-func Zhetrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int) bool {
+func Zhetrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9389,7 +10383,7 @@ func Zhetrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []com
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9605,7 +10599,7 @@ func Zhgeqz(job, compq, compz byte, n, ilo, ihi int, h []complex128, ldh int, t
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpcon.f.
-func Chpcon(ul byte, n int, ap []complex64, ipiv []int32, anorm float32, rcond []float32, work []complex64) bool {
+func Chpcon(ul byte, n int, ap []complex64, ipiv []Int, anorm float32, rcond []float32, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9615,7 +10609,7 @@ func Chpcon(ul byte, n int, ap []complex64, ipiv []int32, anorm float32, rcond [
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9631,7 +10625,7 @@ func Chpcon(ul byte, n int, ap []complex64, ipiv []int32, anorm float32, rcond [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpcon.f.
-func Zhpcon(ul byte, n int, ap []complex128, ipiv []int32, anorm float64, rcond []float64, work []complex128) bool {
+func Zhpcon(ul byte, n int, ap []complex128, ipiv []Int, anorm float64, rcond []float64, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9641,7 +10635,7 @@ func Zhpcon(ul byte, n int, ap []complex128, ipiv []int32, anorm float64, rcond
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -9717,7 +10711,7 @@ func Zhpev(jobz, ul byte, n int, ap []complex128, w []float64, z []complex128, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpevd.f.
-func Chpevd(jobz, ul byte, n int, ap []complex64, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Chpevd(jobz, ul byte, n int, ap []complex64, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9743,7 +10737,7 @@ func Chpevd(jobz, ul byte, n int, ap []complex64, w []float32, z []complex64, ld
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -9751,7 +10745,7 @@ func Chpevd(jobz, ul byte, n int, ap []complex64, w []float32, z []complex64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpevd.f.
-func Zhpevd(jobz, ul byte, n int, ap []complex128, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zhpevd(jobz, ul byte, n int, ap []complex128, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9777,7 +10771,7 @@ func Zhpevd(jobz, ul byte, n int, ap []complex128, w []float64, z []complex128,
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -9785,7 +10779,7 @@ func Zhpevd(jobz, ul byte, n int, ap []complex128, w []float64, z []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpevx.f.
-func Chpevx(jobz, rng, ul byte, n int, ap []complex64, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []int32) bool {
+func Chpevx(jobz, rng, ul byte, n int, ap []complex64, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9795,7 +10789,7 @@ func Chpevx(jobz, rng, ul byte, n int, ap []complex64, vl, vu float32, il, iu in
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -9815,11 +10809,11 @@ func Chpevx(jobz, rng, ul byte, n int, ap []complex64, vl, vu float32, il, iu in
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -9827,7 +10821,7 @@ func Chpevx(jobz, rng, ul byte, n int, ap []complex64, vl, vu float32, il, iu in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpevx.f.
-func Zhpevx(jobz, rng, ul byte, n int, ap []complex128, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []int32) bool {
+func Zhpevx(jobz, rng, ul byte, n int, ap []complex128, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -9837,7 +10831,7 @@ func Zhpevx(jobz, rng, ul byte, n int, ap []complex128, vl, vu float64, il, iu i
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -9857,11 +10851,11 @@ func Zhpevx(jobz, rng, ul byte, n int, ap []complex128, vl, vu float64, il, iu i
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -9973,7 +10967,7 @@ func Zhpgv(itype int, jobz, ul byte, n int, ap, bp []complex128, w []float64, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpgvd.f.
-func Chpgvd(itype int, jobz, ul byte, n int, ap, bp []complex64, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Chpgvd(itype int, jobz, ul byte, n int, ap, bp []complex64, w []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10003,7 +10997,7 @@ func Chpgvd(itype int, jobz, ul byte, n int, ap, bp []complex64, w []float32, z
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -10011,7 +11005,7 @@ func Chpgvd(itype int, jobz, ul byte, n int, ap, bp []complex64, w []float32, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpgvd.f.
-func Zhpgvd(itype int, jobz, ul byte, n int, ap, bp []complex128, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zhpgvd(itype int, jobz, ul byte, n int, ap, bp []complex128, w []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10041,7 +11035,7 @@ func Zhpgvd(itype int, jobz, ul byte, n int, ap, bp []complex128, w []float64, z
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -10049,7 +11043,7 @@ func Zhpgvd(itype int, jobz, ul byte, n int, ap, bp []complex128, w []float64, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpgvx.f.
-func Chpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex64, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []int32) bool {
+func Chpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex64, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, work []complex64, rwork []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10063,7 +11057,7 @@ func Chpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex64, vl, vu flo
 	if len(bp) > 0 {
 		_bp = &bp[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -10083,11 +11077,11 @@ func Chpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex64, vl, vu flo
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -10095,7 +11089,7 @@ func Chpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex64, vl, vu flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpgvx.f.
-func Zhpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex128, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []int32) bool {
+func Zhpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex128, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, work []complex128, rwork []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10109,7 +11103,7 @@ func Zhpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex128, vl, vu fl
 	if len(bp) > 0 {
 		_bp = &bp[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -10129,11 +11123,11 @@ func Zhpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex128, vl, vu fl
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -10141,7 +11135,7 @@ func Zhpgvx(itype int, jobz, rng, ul byte, n int, ap, bp []complex128, vl, vu fl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chprfs.f.
-func Chprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Chprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10155,7 +11149,7 @@ func Chprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10187,7 +11181,7 @@ func Chprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhprfs.f.
-func Zhprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zhprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10201,7 +11195,7 @@ func Zhprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []comple
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10233,7 +11227,7 @@ func Zhprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpsv.f.
-func Chpsv(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ldb int) bool {
+func Chpsv(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10243,7 +11237,7 @@ func Chpsv(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ld
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10255,7 +11249,7 @@ func Chpsv(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpsv.f.
-func Zhpsv(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128, ldb int) bool {
+func Zhpsv(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10265,7 +11259,7 @@ func Zhpsv(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10277,7 +11271,7 @@ func Zhpsv(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chpsvx.f.
-func Chpsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Chpsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10291,7 +11285,7 @@ func Chpsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []c
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10327,7 +11321,7 @@ func Chpsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhpsvx.f.
-func Zhpsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zhpsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10341,7 +11335,7 @@ func Zhpsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10429,7 +11423,7 @@ func Zhptrd(ul byte, n int, ap []complex128, d, e []float64, tau []complex128) b
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chptrf.f.
-func Chptrf(ul byte, n int, ap []complex64, ipiv []int32) bool {
+func Chptrf(ul byte, n int, ap []complex64, ipiv []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10439,7 +11433,7 @@ func Chptrf(ul byte, n int, ap []complex64, ipiv []int32) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10447,7 +11441,7 @@ func Chptrf(ul byte, n int, ap []complex64, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhptrf.f.
-func Zhptrf(ul byte, n int, ap []complex128, ipiv []int32) bool {
+func Zhptrf(ul byte, n int, ap []complex128, ipiv []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10457,7 +11451,7 @@ func Zhptrf(ul byte, n int, ap []complex128, ipiv []int32) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10465,7 +11459,7 @@ func Zhptrf(ul byte, n int, ap []complex128, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chptri.f.
-func Chptri(ul byte, n int, ap []complex64, ipiv []int32, work []complex64) bool {
+func Chptri(ul byte, n int, ap []complex64, ipiv []Int, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10475,7 +11469,7 @@ func Chptri(ul byte, n int, ap []complex64, ipiv []int32, work []complex64) bool
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10487,7 +11481,7 @@ func Chptri(ul byte, n int, ap []complex64, ipiv []int32, work []complex64) bool
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhptri.f.
-func Zhptri(ul byte, n int, ap []complex128, ipiv []int32, work []complex128) bool {
+func Zhptri(ul byte, n int, ap []complex128, ipiv []Int, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10497,7 +11491,7 @@ func Zhptri(ul byte, n int, ap []complex128, ipiv []int32, work []complex128) bo
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10509,7 +11503,7 @@ func Zhptri(ul byte, n int, ap []complex128, ipiv []int32, work []complex128) bo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chptrs.f.
-func Chptrs(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ldb int) bool {
+func Chptrs(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10519,7 +11513,7 @@ func Chptrs(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, l
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10531,7 +11525,7 @@ func Chptrs(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhptrs.f.
-func Zhptrs(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128, ldb int) bool {
+func Zhptrs(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -10541,7 +11535,7 @@ func Zhptrs(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -10553,8 +11547,8 @@ func Zhptrs(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/shsein.f.
-func Shsein(job, eigsrc, initv byte, sel []int32, n int, h []float32, ldh int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []int32, work []float32, ifaill, ifailr []int32) bool {
-	var _sel *int32
+func Shsein(job, eigsrc, initv byte, sel []Int, n int, h []float32, ldh int, wr, wi, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []Int, work []float32, ifaill, ifailr []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -10578,7 +11572,7 @@ func Shsein(job, eigsrc, initv byte, sel []int32, n int, h []float32, ldh int, w
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -10586,11 +11580,11 @@ func Shsein(job, eigsrc, initv byte, sel []int32, n int, h []float32, ldh int, w
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _ifaill *int32
+	var _ifaill *Int
 	if len(ifaill) > 0 {
 		_ifaill = &ifaill[0]
 	}
-	var _ifailr *int32
+	var _ifailr *Int
 	if len(ifailr) > 0 {
 		_ifailr = &ifailr[0]
 	}
@@ -10598,8 +11592,8 @@ func Shsein(job, eigsrc, initv byte, sel []int32, n int, h []float32, ldh int, w
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dhsein.f.
-func Dhsein(job, eigsrc, initv byte, sel []int32, n int, h []float64, ldh int, wr, wi, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []int32, work []float64, ifaill, ifailr []int32) bool {
-	var _sel *int32
+func Dhsein(job, eigsrc, initv byte, sel []Int, n int, h []float64, ldh int, wr, wi, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []Int, work []float64, ifaill, ifailr []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -10623,7 +11617,7 @@ func Dhsein(job, eigsrc, initv byte, sel []int32, n int, h []float64, ldh int, w
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -10631,11 +11625,11 @@ func Dhsein(job, eigsrc, initv byte, sel []int32, n int, h []float64, ldh int, w
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _ifaill *int32
+	var _ifaill *Int
 	if len(ifaill) > 0 {
 		_ifaill = &ifaill[0]
 	}
-	var _ifailr *int32
+	var _ifailr *Int
 	if len(ifailr) > 0 {
 		_ifailr = &ifailr[0]
 	}
@@ -10643,8 +11637,8 @@ func Dhsein(job, eigsrc, initv byte, sel []int32, n int, h []float64, ldh int, w
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chsein.f.
-func Chsein(job, eigsrc, initv byte, sel []int32, n int, h []complex64, ldh int, w, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []int32, work []complex64, rwork []float32, ifaill, ifailr []int32) bool {
-	var _sel *int32
+func Chsein(job, eigsrc, initv byte, sel []Int, n int, h []complex64, ldh int, w, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []Int, work []complex64, rwork []float32, ifaill, ifailr []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -10664,7 +11658,7 @@ func Chsein(job, eigsrc, initv byte, sel []int32, n int, h []complex64, ldh int,
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -10676,11 +11670,11 @@ func Chsein(job, eigsrc, initv byte, sel []int32, n int, h []complex64, ldh int,
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _ifaill *int32
+	var _ifaill *Int
 	if len(ifaill) > 0 {
 		_ifaill = &ifaill[0]
 	}
-	var _ifailr *int32
+	var _ifailr *Int
 	if len(ifailr) > 0 {
 		_ifailr = &ifailr[0]
 	}
@@ -10688,8 +11682,8 @@ func Chsein(job, eigsrc, initv byte, sel []int32, n int, h []complex64, ldh int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhsein.f.
-func Zhsein(job, eigsrc, initv byte, sel []int32, n int, h []complex128, ldh int, w, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []int32, work []complex128, rwork []float64, ifaill, ifailr []int32) bool {
-	var _sel *int32
+func Zhsein(job, eigsrc, initv byte, sel []Int, n int, h []complex128, ldh int, w, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []Int, work []complex128, rwork []float64, ifaill, ifailr []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -10709,7 +11703,7 @@ func Zhsein(job, eigsrc, initv byte, sel []int32, n int, h []complex128, ldh int
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -10721,11 +11715,11 @@ func Zhsein(job, eigsrc, initv byte, sel []int32, n int, h []complex128, ldh int
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _ifaill *int32
+	var _ifaill *Int
 	if len(ifaill) > 0 {
 		_ifaill = &ifaill[0]
 	}
-	var _ifailr *int32
+	var _ifailr *Int
 	if len(ifailr) > 0 {
 		_ifailr = &ifailr[0]
 	}
@@ -10843,7 +11837,7 @@ func Zlacgv(n int, x []complex128, incx int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slacn2.f.
-func Slacn2(n int, v, x []float32, isgn []int32, est []float32, kase, isave []int32) bool {
+func Slacn2(n int, v, x []float32, isgn []Int, est []float32, kase, isave []Int) bool {
 	var _v *float32
 	if len(v) > 0 {
 		_v = &v[0]
@@ -10852,7 +11846,7 @@ func Slacn2(n int, v, x []float32, isgn []int32, est []float32, kase, isave []in
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _isgn *int32
+	var _isgn *Int
 	if len(isgn) > 0 {
 		_isgn = &isgn[0]
 	}
@@ -10860,11 +11854,11 @@ func Slacn2(n int, v, x []float32, isgn []int32, est []float32, kase, isave []in
 	if len(est) > 0 {
 		_est = &est[0]
 	}
-	var _kase *int32
+	var _kase *Int
 	if len(kase) > 0 {
 		_kase = &kase[0]
 	}
-	var _isave *int32
+	var _isave *Int
 	if len(isave) > 0 {
 		_isave = &isave[0]
 	}
@@ -10872,7 +11866,7 @@ func Slacn2(n int, v, x []float32, isgn []int32, est []float32, kase, isave []in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlacn2.f.
-func Dlacn2(n int, v, x []float64, isgn []int32, est []float64, kase, isave []int32) bool {
+func Dlacn2(n int, v, x []float64, isgn []Int, est []float64, kase, isave []Int) bool {
 	var _v *float64
 	if len(v) > 0 {
 		_v = &v[0]
@@ -10881,7 +11875,7 @@ func Dlacn2(n int, v, x []float64, isgn []int32, est []float64, kase, isave []in
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _isgn *int32
+	var _isgn *Int
 	if len(isgn) > 0 {
 		_isgn = &isgn[0]
 	}
@@ -10889,11 +11883,11 @@ func Dlacn2(n int, v, x []float64, isgn []int32, est []float64, kase, isave []in
 	if len(est) > 0 {
 		_est = &est[0]
 	}
-	var _kase *int32
+	var _kase *Int
 	if len(kase) > 0 {
 		_kase = &kase[0]
 	}
-	var _isave *int32
+	var _isave *Int
 	if len(isave) > 0 {
 		_isave = &isave[0]
 	}
@@ -10901,7 +11895,7 @@ func Dlacn2(n int, v, x []float64, isgn []int32, est []float64, kase, isave []in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clacn2.f.
-func Clacn2(n int, v, x []complex64, est []float32, kase, isave []int32) bool {
+func Clacn2(n int, v, x []complex64, est []float32, kase, isave []Int) bool {
 	var _v *complex64
 	if len(v) > 0 {
 		_v = &v[0]
@@ -10914,11 +11908,11 @@ func Clacn2(n int, v, x []complex64, est []float32, kase, isave []int32) bool {
 	if len(est) > 0 {
 		_est = &est[0]
 	}
-	var _kase *int32
+	var _kase *Int
 	if len(kase) > 0 {
 		_kase = &kase[0]
 	}
-	var _isave *int32
+	var _isave *Int
 	if len(isave) > 0 {
 		_isave = &isave[0]
 	}
@@ -10926,7 +11920,7 @@ func Clacn2(n int, v, x []complex64, est []float32, kase, isave []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlacn2.f.
-func Zlacn2(n int, v, x []complex128, est []float64, kase, isave []int32) bool {
+func Zlacn2(n int, v, x []complex128, est []float64, kase, isave []Int) bool {
 	var _v *complex128
 	if len(v) > 0 {
 		_v = &v[0]
@@ -10939,11 +11933,11 @@ func Zlacn2(n int, v, x []complex128, est []float64, kase, isave []int32) bool {
 	if len(est) > 0 {
 		_est = &est[0]
 	}
-	var _kase *int32
+	var _kase *Int
 	if len(kase) > 0 {
 		_kase = &kase[0]
 	}
-	var _isave *int32
+	var _isave *Int
 	if len(isave) > 0 {
 		_isave = &isave[0]
 	}
@@ -11091,7 +12085,7 @@ func Clag2z(m, n int, sa []complex64, ldsa int, a []complex128, lda int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slagge.f.
-func Slagge(m, n, kl, ku int, d, a []float32, lda int, iseed []int32, work []float32) bool {
+func Slagge(m, n, kl, ku int, d, a []float32, lda int, iseed []Int, work []float32) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11100,7 +12094,7 @@ func Slagge(m, n, kl, ku int, d, a []float32, lda int, iseed []int32, work []flo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11112,7 +12106,7 @@ func Slagge(m, n, kl, ku int, d, a []float32, lda int, iseed []int32, work []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlagge.f.
-func Dlagge(m, n, kl, ku int, d, a []float64, lda int, iseed []int32, work []float64) bool {
+func Dlagge(m, n, kl, ku int, d, a []float64, lda int, iseed []Int, work []float64) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11121,7 +12115,7 @@ func Dlagge(m, n, kl, ku int, d, a []float64, lda int, iseed []int32, work []flo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11133,7 +12127,7 @@ func Dlagge(m, n, kl, ku int, d, a []float64, lda int, iseed []int32, work []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clagge.f.
-func Clagge(m, n, kl, ku int, d []float32, a []complex64, lda int, iseed []int32, work []complex64) bool {
+func Clagge(m, n, kl, ku int, d []float32, a []complex64, lda int, iseed []Int, work []complex64) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11142,7 +12136,7 @@ func Clagge(m, n, kl, ku int, d []float32, a []complex64, lda int, iseed []int32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11154,7 +12148,7 @@ func Clagge(m, n, kl, ku int, d []float32, a []complex64, lda int, iseed []int32
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlagge.f.
-func Zlagge(m, n, kl, ku int, d []float64, a []complex128, lda int, iseed []int32, work []complex128) bool {
+func Zlagge(m, n, kl, ku int, d []float64, a []complex128, lda int, iseed []Int, work []complex128) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11163,7 +12157,7 @@ func Zlagge(m, n, kl, ku int, d []float64, a []complex128, lda int, iseed []int3
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11175,7 +12169,7 @@ func Zlagge(m, n, kl, ku int, d []float64, a []complex128, lda int, iseed []int3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/claghe.f.
-func Claghe(n, k int, d []float32, a []complex64, lda int, iseed []int32, work []complex64) bool {
+func Claghe(n, k int, d []float32, a []complex64, lda int, iseed []Int, work []complex64) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11184,7 +12178,7 @@ func Claghe(n, k int, d []float32, a []complex64, lda int, iseed []int32, work [
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11196,7 +12190,7 @@ func Claghe(n, k int, d []float32, a []complex64, lda int, iseed []int32, work [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlaghe.f.
-func Zlaghe(n, k int, d []float64, a []complex128, lda int, iseed []int32, work []complex128) bool {
+func Zlaghe(n, k int, d []float64, a []complex128, lda int, iseed []Int, work []complex128) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11205,7 +12199,7 @@ func Zlaghe(n, k int, d []float64, a []complex128, lda int, iseed []int32, work
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11217,7 +12211,7 @@ func Zlaghe(n, k int, d []float64, a []complex128, lda int, iseed []int32, work
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slagsy.f.
-func Slagsy(n, k int, d, a []float32, lda int, iseed []int32, work []float32) bool {
+func Slagsy(n, k int, d, a []float32, lda int, iseed []Int, work []float32) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11226,7 +12220,7 @@ func Slagsy(n, k int, d, a []float32, lda int, iseed []int32, work []float32) bo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11238,7 +12232,7 @@ func Slagsy(n, k int, d, a []float32, lda int, iseed []int32, work []float32) bo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlagsy.f.
-func Dlagsy(n, k int, d, a []float64, lda int, iseed []int32, work []float64) bool {
+func Dlagsy(n, k int, d, a []float64, lda int, iseed []Int, work []float64) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11247,7 +12241,7 @@ func Dlagsy(n, k int, d, a []float64, lda int, iseed []int32, work []float64) bo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11259,7 +12253,7 @@ func Dlagsy(n, k int, d, a []float64, lda int, iseed []int32, work []float64) bo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clagsy.f.
-func Clagsy(n, k int, d []float32, a []complex64, lda int, iseed []int32, work []complex64) bool {
+func Clagsy(n, k int, d []float32, a []complex64, lda int, iseed []Int, work []complex64) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11268,7 +12262,7 @@ func Clagsy(n, k int, d []float32, a []complex64, lda int, iseed []int32, work [
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11280,7 +12274,7 @@ func Clagsy(n, k int, d []float32, a []complex64, lda int, iseed []int32, work [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlagsy.f.
-func Zlagsy(n, k int, d []float64, a []complex128, lda int, iseed []int32, work []complex128) bool {
+func Zlagsy(n, k int, d []float64, a []complex128, lda int, iseed []Int, work []complex128) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -11289,7 +12283,7 @@ func Zlagsy(n, k int, d []float64, a []complex128, lda int, iseed []int32, work
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _iseed *int32
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -11301,12 +12295,12 @@ func Zlagsy(n, k int, d []float64, a []complex128, lda int, iseed []int32, work
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slapmr.f.
-func Slapmr(forwrd int32, m, n int, x []float32, ldx int, k []int32) bool {
+func Slapmr(forwrd Int, m, n int, x []float32, ldx int, k []Int) bool {
 	var _x *float32
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -11314,12 +12308,12 @@ func Slapmr(forwrd int32, m, n int, x []float32, ldx int, k []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlapmr.f.
-func Dlapmr(forwrd int32, m, n int, x []float64, ldx int, k []int32) bool {
+func Dlapmr(forwrd Int, m, n int, x []float64, ldx int, k []Int) bool {
 	var _x *float64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -11327,12 +12321,12 @@ func Dlapmr(forwrd int32, m, n int, x []float64, ldx int, k []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clapmr.f.
-func Clapmr(forwrd int32, m, n int, x []complex64, ldx int, k []int32) bool {
+func Clapmr(forwrd Int, m, n int, x []complex64, ldx int, k []Int) bool {
 	var _x *complex64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -11340,12 +12334,12 @@ func Clapmr(forwrd int32, m, n int, x []complex64, ldx int, k []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlapmr.f.
-func Zlapmr(forwrd int32, m, n int, x []complex128, ldx int, k []int32) bool {
+func Zlapmr(forwrd Int, m, n int, x []complex128, ldx int, k []Int) bool {
 	var _x *complex128
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -11353,12 +12347,12 @@ func Zlapmr(forwrd int32, m, n int, x []complex128, ldx int, k []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slapmt.f.
-func Slapmt(forwrd int32, m, n int, x []float32, ldx int, k []int32) bool {
+func Slapmt(forwrd Int, m, n int, x []float32, ldx int, k []Int) bool {
 	var _x *float32
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -11366,12 +12360,12 @@ func Slapmt(forwrd int32, m, n int, x []float32, ldx int, k []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlapmt.f.
-func Dlapmt(forwrd int32, m, n int, x []float64, ldx int, k []int32) bool {
+func Dlapmt(forwrd Int, m, n int, x []float64, ldx int, k []Int) bool {
 	var _x *float64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -11379,12 +12373,12 @@ func Dlapmt(forwrd int32, m, n int, x []float64, ldx int, k []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clapmt.f.
-func Clapmt(forwrd int32, m, n int, x []complex64, ldx int, k []int32) bool {
+func Clapmt(forwrd Int, m, n int, x []complex64, ldx int, k []Int) bool {
 	var _x *complex64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -11392,12 +12386,12 @@ func Clapmt(forwrd int32, m, n int, x []complex64, ldx int, k []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlapmt.f.
-func Zlapmt(forwrd int32, m, n int, x []complex128, ldx int, k []int32) bool {
+func Zlapmt(forwrd Int, m, n int, x []complex128, ldx int, k []Int) bool {
 	var _x *complex128
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _k *int32
+	var _k *Int
 	if len(k) > 0 {
 		_k = &k[0]
 	}
@@ -12095,8 +13089,8 @@ func Zlarfx(side byte, m, n int, v []complex128, tau complex128, c []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slarnv.f.
-func Slarnv(idist int, iseed []int32, n int, x []float32) bool {
-	var _iseed *int32
+func Slarnv(idist int, iseed []Int, n int, x []float32) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -12108,8 +13102,8 @@ func Slarnv(idist int, iseed []int32, n int, x []float32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlarnv.f.
-func Dlarnv(idist int, iseed []int32, n int, x []float64) bool {
-	var _iseed *int32
+func Dlarnv(idist int, iseed []Int, n int, x []float64) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -12121,8 +13115,8 @@ func Dlarnv(idist int, iseed []int32, n int, x []float64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clarnv.f.
-func Clarnv(idist int, iseed []int32, n int, x []complex64) bool {
-	var _iseed *int32
+func Clarnv(idist int, iseed []Int, n int, x []complex64) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -12134,8 +13128,8 @@ func Clarnv(idist int, iseed []int32, n int, x []complex64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlarnv.f.
-func Zlarnv(idist int, iseed []int32, n int, x []complex128) bool {
-	var _iseed *int32
+func Zlarnv(idist int, iseed []Int, n int, x []complex128) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -12237,12 +13231,12 @@ func Dlasrt(id byte, n int, d []float64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slaswp.f.
-func Slaswp(n int, a []float32, lda, k1, k2 int, ipiv []int32, incx int) bool {
+func Slaswp(n int, a []float32, lda, k1, k2 int, ipiv []Int, incx int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -12250,12 +13244,12 @@ func Slaswp(n int, a []float32, lda, k1, k2 int, ipiv []int32, incx int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlaswp.f.
-func Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []int32, incx int) bool {
+func Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []Int, incx int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -12263,12 +13257,12 @@ func Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []int32, incx int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/claswp.f.
-func Claswp(n int, a []complex64, lda, k1, k2 int, ipiv []int32, incx int) bool {
+func Claswp(n int, a []complex64, lda, k1, k2 int, ipiv []Int, incx int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -12276,12 +13270,12 @@ func Claswp(n int, a []complex64, lda, k1, k2 int, ipiv []int32, incx int) bool
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlaswp.f.
-func Zlaswp(n int, a []complex128, lda, k1, k2 int, ipiv []int32, incx int) bool {
+func Zlaswp(n int, a []complex128, lda, k1, k2 int, ipiv []Int, incx int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -12289,8 +13283,8 @@ func Zlaswp(n int, a []complex128, lda, k1, k2 int, ipiv []int32, incx int) bool
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slatms.f.
-func Slatms(m, n int, dist byte, iseed []int32, sym byte, d []float32, mode int, cond, dmax float32, kl, ku int, pack byte, a []float32, lda int, work []float32) bool {
-	var _iseed *int32
+func Slatms(m, n int, dist byte, iseed []Int, sym byte, d []float32, mode int, cond, dmax float32, kl, ku int, pack byte, a []float32, lda int, work []float32) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -12310,8 +13304,8 @@ func Slatms(m, n int, dist byte, iseed []int32, sym byte, d []float32, mode int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlatms.f.
-func Dlatms(m, n int, dist byte, iseed []int32, sym byte, d []float64, mode int, cond, dmax float64, kl, ku int, pack byte, a []float64, lda int, work []float64) bool {
-	var _iseed *int32
+func Dlatms(m, n int, dist byte, iseed []Int, sym byte, d []float64, mode int, cond, dmax float64, kl, ku int, pack byte, a []float64, lda int, work []float64) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -12331,8 +13325,8 @@ func Dlatms(m, n int, dist byte, iseed []int32, sym byte, d []float64, mode int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/clatms.f.
-func Clatms(m, n int, dist byte, iseed []int32, sym byte, d []float32, mode int, cond, dmax float32, kl, ku int, pack byte, a []complex64, lda int, work []complex64) bool {
-	var _iseed *int32
+func Clatms(m, n int, dist byte, iseed []Int, sym byte, d []float32, mode int, cond, dmax float32, kl, ku int, pack byte, a []complex64, lda int, work []complex64) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -12352,8 +13346,8 @@ func Clatms(m, n int, dist byte, iseed []int32, sym byte, d []float32, mode int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zlatms.f.
-func Zlatms(m, n int, dist byte, iseed []int32, sym byte, d []float64, mode int, cond, dmax float64, kl, ku int, pack byte, a []complex128, lda int, work []complex128) bool {
-	var _iseed *int32
+func Zlatms(m, n int, dist byte, iseed []Int, sym byte, d []float64, mode int, cond, dmax float64, kl, ku int, pack byte, a []complex128, lda int, work []complex128) bool {
+	var _iseed *Int
 	if len(iseed) > 0 {
 		_iseed = &iseed[0]
 	}
@@ -13307,7 +14301,7 @@ func Dormtr(side, ul, trans byte, m, n int, a []float64, lda int, tau, c []float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbcon.f.
-func Spbcon(ul byte, n, kd int, ab []float32, ldab int, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Spbcon(ul byte, n, kd int, ab []float32, ldab int, anorm float32, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -13325,7 +14319,7 @@ func Spbcon(ul byte, n, kd int, ab []float32, ldab int, anorm float32, rcond, wo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -13333,7 +14327,7 @@ func Spbcon(ul byte, n, kd int, ab []float32, ldab int, anorm float32, rcond, wo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbcon.f.
-func Dpbcon(ul byte, n, kd int, ab []float64, ldab int, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dpbcon(ul byte, n, kd int, ab []float64, ldab int, anorm float64, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -13351,7 +14345,7 @@ func Dpbcon(ul byte, n, kd int, ab []float64, ldab int, anorm float64, rcond, wo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -13515,7 +14509,7 @@ func Zpbequ(ul byte, n, kd int, ab []complex128, ldab int, s, scond, amax []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbrfs.f.
-func Spbrfs(ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Spbrfs(ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -13549,7 +14543,7 @@ func Spbrfs(ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -13557,7 +14551,7 @@ func Spbrfs(ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbrfs.f.
-func Dpbrfs(ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dpbrfs(ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -13591,7 +14585,7 @@ func Dpbrfs(ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float64, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -13811,7 +14805,7 @@ func Zpbsv(ul byte, n, kd, nrhs int, ab []complex128, ldab int, b []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spbsvx.f.
-func Spbsvx(fact, ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []int32) bool {
+func Spbsvx(fact, ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float32, ldafb int, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -13857,7 +14851,7 @@ func Spbsvx(fact, ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float3
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -13865,7 +14859,7 @@ func Spbsvx(fact, ul byte, n, kd, nrhs int, ab []float32, ldab int, afb []float3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpbsvx.f.
-func Dpbsvx(fact, ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []int32) bool {
+func Dpbsvx(fact, ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -13911,7 +14905,7 @@ func Dpbsvx(fact, ul byte, n, kd, nrhs int, ab []float64, ldab int, afb []float6
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -14399,7 +15393,7 @@ func Zpftrs(transr, ul byte, n, nrhs int, a, b []complex128, ldb int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spocon.f.
-func Spocon(ul byte, n int, a []float32, lda int, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Spocon(ul byte, n int, a []float32, lda int, anorm float32, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -14417,7 +15411,7 @@ func Spocon(ul byte, n int, a []float32, lda int, anorm float32, rcond, work []f
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -14425,7 +15419,7 @@ func Spocon(ul byte, n int, a []float32, lda int, anorm float32, rcond, work []f
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpocon.f.
-func Dpocon(ul byte, n int, a []float64, lda int, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dpocon(ul byte, n int, a []float64, lda int, anorm float64, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -14443,7 +15437,7 @@ func Dpocon(ul byte, n int, a []float64, lda int, anorm float64, rcond, work []f
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -14671,7 +15665,7 @@ func Zpoequb(n int, a []complex128, lda int, s, scond, amax []float64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sporfs.f.
-func Sporfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Sporfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -14705,7 +15699,7 @@ func Sporfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -14713,7 +15707,7 @@ func Sporfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dporfs.f.
-func Dporfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dporfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -14747,7 +15741,7 @@ func Dporfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -14911,7 +15905,7 @@ func Zposv(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsposv.f.
-func Dsposv(ul byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iter []int32) bool {
+func Dsposv(ul byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iter []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -14937,7 +15931,7 @@ func Dsposv(ul byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x
 	if len(swork) > 0 {
 		_swork = &swork[0]
 	}
-	var _iter *int32
+	var _iter *Int
 	if len(iter) > 0 {
 		_iter = &iter[0]
 	}
@@ -14945,7 +15939,7 @@ func Dsposv(ul byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zcposv.f.
-func Zcposv(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, x []complex128, ldx int, work []complex128, swork []complex64, rwork []float64, iter []int32) bool {
+func Zcposv(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, x []complex128, ldx int, work []complex128, swork []complex64, rwork []float64, iter []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -14975,7 +15969,7 @@ func Zcposv(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb i
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iter *int32
+	var _iter *Int
 	if len(iter) > 0 {
 		_iter = &iter[0]
 	}
@@ -14983,7 +15977,7 @@ func Zcposv(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sposvx.f.
-func Sposvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []int32) bool {
+func Sposvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15029,7 +16023,7 @@ func Sposvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -15037,7 +16031,7 @@ func Sposvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dposvx.f.
-func Dposvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []int32) bool {
+func Dposvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15083,7 +16077,7 @@ func Dposvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -15439,7 +16433,7 @@ func Zpotrs(ul byte, n, nrhs int, a []complex128, lda int, b []complex128, ldb i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sppcon.f.
-func Sppcon(ul byte, n int, ap []float32, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Sppcon(ul byte, n int, ap []float32, anorm float32, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15457,7 +16451,7 @@ func Sppcon(ul byte, n int, ap []float32, anorm float32, rcond, work []float32,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -15465,7 +16459,7 @@ func Sppcon(ul byte, n int, ap []float32, anorm float32, rcond, work []float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dppcon.f.
-func Dppcon(ul byte, n int, ap []float64, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dppcon(ul byte, n int, ap []float64, anorm float64, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15483,7 +16477,7 @@ func Dppcon(ul byte, n int, ap []float64, anorm float64, rcond, work []float64,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -15647,7 +16641,7 @@ func Zppequ(ul byte, n int, ap []complex128, s, scond, amax []float64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spprfs.f.
-func Spprfs(ul byte, n, nrhs int, ap, afp, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Spprfs(ul byte, n, nrhs int, ap, afp, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15681,7 +16675,7 @@ func Spprfs(ul byte, n, nrhs int, ap, afp, b []float32, ldb int, x []float32, ld
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -15689,7 +16683,7 @@ func Spprfs(ul byte, n, nrhs int, ap, afp, b []float32, ldb int, x []float32, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpprfs.f.
-func Dpprfs(ul byte, n, nrhs int, ap, afp, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dpprfs(ul byte, n, nrhs int, ap, afp, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15723,7 +16717,7 @@ func Dpprfs(ul byte, n, nrhs int, ap, afp, b []float64, ldb int, x []float64, ld
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -15887,7 +16881,7 @@ func Zppsv(ul byte, n, nrhs int, ap, b []complex128, ldb int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sppsvx.f.
-func Sppsvx(fact, ul byte, n, nrhs int, ap, afp []float32, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []int32) bool {
+func Sppsvx(fact, ul byte, n, nrhs int, ap, afp []float32, equed []byte, s, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15933,7 +16927,7 @@ func Sppsvx(fact, ul byte, n, nrhs int, ap, afp []float32, equed []byte, s, b []
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -15941,7 +16935,7 @@ func Sppsvx(fact, ul byte, n, nrhs int, ap, afp []float32, equed []byte, s, b []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dppsvx.f.
-func Dppsvx(fact, ul byte, n, nrhs int, ap, afp []float64, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []int32) bool {
+func Dppsvx(fact, ul byte, n, nrhs int, ap, afp []float64, equed []byte, s, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -15987,7 +16981,7 @@ func Dppsvx(fact, ul byte, n, nrhs int, ap, afp []float64, equed []byte, s, b []
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -16287,7 +17281,7 @@ func Zpptrs(ul byte, n, nrhs int, ap, b []complex128, ldb int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/spstrf.f.
-func Spstrf(ul byte, n int, a []float32, lda int, piv, rank []int32, tol float32, work []float32) bool {
+func Spstrf(ul byte, n int, a []float32, lda int, piv, rank []Int, tol float32, work []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -16297,11 +17291,11 @@ func Spstrf(ul byte, n int, a []float32, lda int, piv, rank []int32, tol float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _piv *int32
+	var _piv *Int
 	if len(piv) > 0 {
 		_piv = &piv[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -16313,7 +17307,7 @@ func Spstrf(ul byte, n int, a []float32, lda int, piv, rank []int32, tol float32
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dpstrf.f.
-func Dpstrf(ul byte, n int, a []float64, lda int, piv, rank []int32, tol float64, work []float64) bool {
+func Dpstrf(ul byte, n int, a []float64, lda int, piv, rank []Int, tol float64, work []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -16323,11 +17317,11 @@ func Dpstrf(ul byte, n int, a []float64, lda int, piv, rank []int32, tol float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _piv *int32
+	var _piv *Int
 	if len(piv) > 0 {
 		_piv = &piv[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -16339,7 +17333,7 @@ func Dpstrf(ul byte, n int, a []float64, lda int, piv, rank []int32, tol float64
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cpstrf.f.
-func Cpstrf(ul byte, n int, a []complex64, lda int, piv, rank []int32, tol float32, work []float32) bool {
+func Cpstrf(ul byte, n int, a []complex64, lda int, piv, rank []Int, tol float32, work []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -16349,11 +17343,11 @@ func Cpstrf(ul byte, n int, a []complex64, lda int, piv, rank []int32, tol float
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _piv *int32
+	var _piv *Int
 	if len(piv) > 0 {
 		_piv = &piv[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -16365,7 +17359,7 @@ func Cpstrf(ul byte, n int, a []complex64, lda int, piv, rank []int32, tol float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zpstrf.f.
-func Zpstrf(ul byte, n int, a []complex128, lda int, piv, rank []int32, tol float64, work []float64) bool {
+func Zpstrf(ul byte, n int, a []complex128, lda int, piv, rank []Int, tol float64, work []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -16375,11 +17369,11 @@ func Zpstrf(ul byte, n int, a []complex128, lda int, piv, rank []int32, tol floa
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _piv *int32
+	var _piv *Int
 	if len(piv) > 0 {
 		_piv = &piv[0]
 	}
-	var _rank *int32
+	var _rank *Int
 	if len(rank) > 0 {
 		_rank = &rank[0]
 	}
@@ -17179,7 +18173,7 @@ func Dsbev(jobz, ul byte, n, kd int, ab []float64, ldab int, w, z []float64, ldz
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbevd.f.
-func Ssbevd(jobz, ul byte, n, kd int, ab []float32, ldab int, w, z []float32, ldz int, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Ssbevd(jobz, ul byte, n, kd int, ab []float32, ldab int, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17201,7 +18195,7 @@ func Ssbevd(jobz, ul byte, n, kd int, ab []float32, ldab int, w, z []float32, ld
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17209,7 +18203,7 @@ func Ssbevd(jobz, ul byte, n, kd int, ab []float32, ldab int, w, z []float32, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbevd.f.
-func Dsbevd(jobz, ul byte, n, kd int, ab []float64, ldab int, w, z []float64, ldz int, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dsbevd(jobz, ul byte, n, kd int, ab []float64, ldab int, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17231,7 +18225,7 @@ func Dsbevd(jobz, ul byte, n, kd int, ab []float64, ldab int, w, z []float64, ld
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17239,7 +18233,7 @@ func Dsbevd(jobz, ul byte, n, kd int, ab []float64, ldab int, w, z []float64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbevx.f.
-func Ssbevx(jobz, rng, ul byte, n, kd int, ab []float32, ldab int, q []float32, ldq int, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, work []float32, iwork, ifail []int32) bool {
+func Ssbevx(jobz, rng, ul byte, n, kd int, ab []float32, ldab int, q []float32, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17253,7 +18247,7 @@ func Ssbevx(jobz, rng, ul byte, n, kd int, ab []float32, ldab int, q []float32,
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -17269,11 +18263,11 @@ func Ssbevx(jobz, rng, ul byte, n, kd int, ab []float32, ldab int, q []float32,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -17281,7 +18275,7 @@ func Ssbevx(jobz, rng, ul byte, n, kd int, ab []float32, ldab int, q []float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbevx.f.
-func Dsbevx(jobz, rng, ul byte, n, kd int, ab []float64, ldab int, q []float64, ldq int, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, work []float64, iwork, ifail []int32) bool {
+func Dsbevx(jobz, rng, ul byte, n, kd int, ab []float64, ldab int, q []float64, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17295,7 +18289,7 @@ func Dsbevx(jobz, rng, ul byte, n, kd int, ab []float64, ldab int, q []float64,
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -17311,11 +18305,11 @@ func Dsbevx(jobz, rng, ul byte, n, kd int, ab []float64, ldab int, q []float64,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -17435,7 +18429,7 @@ func Dsbgv(jobz, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbgvd.f.
-func Ssbgvd(jobz, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, w, z []float32, ldz int, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Ssbgvd(jobz, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17461,7 +18455,7 @@ func Ssbgvd(jobz, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17469,7 +18463,7 @@ func Ssbgvd(jobz, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbgvd.f.
-func Dsbgvd(jobz, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, w, z []float64, ldz int, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dsbgvd(jobz, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17495,7 +18489,7 @@ func Dsbgvd(jobz, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17503,7 +18497,7 @@ func Dsbgvd(jobz, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssbgvx.f.
-func Ssbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, q []float32, ldq int, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, work []float32, iwork, ifail []int32) bool {
+func Ssbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float32, ldab int, bb []float32, ldbb int, q []float32, ldq int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17521,7 +18515,7 @@ func Ssbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float32, ldab int, bb []floa
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -17537,11 +18531,11 @@ func Ssbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float32, ldab int, bb []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -17549,7 +18543,7 @@ func Ssbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float32, ldab int, bb []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsbgvx.f.
-func Dsbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, q []float64, ldq int, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, work []float64, iwork, ifail []int32) bool {
+func Dsbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float64, ldab int, bb []float64, ldbb int, q []float64, ldq int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17567,7 +18561,7 @@ func Dsbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float64, ldab int, bb []floa
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -17583,11 +18577,11 @@ func Dsbgvx(jobz, rng, ul byte, n, ka, kb int, ab []float64, ldab int, bb []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -17711,7 +18705,7 @@ func Dsfrk(transr, ul, trans byte, n, k int, alpha float64, a []float64, lda int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspcon.f.
-func Sspcon(ul byte, n int, ap []float32, ipiv []int32, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Sspcon(ul byte, n int, ap []float32, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17721,7 +18715,7 @@ func Sspcon(ul byte, n int, ap []float32, ipiv []int32, anorm float32, rcond, wo
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -17733,7 +18727,7 @@ func Sspcon(ul byte, n int, ap []float32, ipiv []int32, anorm float32, rcond, wo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17741,7 +18735,7 @@ func Sspcon(ul byte, n int, ap []float32, ipiv []int32, anorm float32, rcond, wo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspcon.f.
-func Dspcon(ul byte, n int, ap []float64, ipiv []int32, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dspcon(ul byte, n int, ap []float64, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17751,7 +18745,7 @@ func Dspcon(ul byte, n int, ap []float64, ipiv []int32, anorm float64, rcond, wo
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -17763,7 +18757,7 @@ func Dspcon(ul byte, n int, ap []float64, ipiv []int32, anorm float64, rcond, wo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17771,7 +18765,7 @@ func Dspcon(ul byte, n int, ap []float64, ipiv []int32, anorm float64, rcond, wo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cspcon.f.
-func Cspcon(ul byte, n int, ap []complex64, ipiv []int32, anorm float32, rcond []float32, work []complex64) bool {
+func Cspcon(ul byte, n int, ap []complex64, ipiv []Int, anorm float32, rcond []float32, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17781,7 +18775,7 @@ func Cspcon(ul byte, n int, ap []complex64, ipiv []int32, anorm float32, rcond [
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -17797,7 +18791,7 @@ func Cspcon(ul byte, n int, ap []complex64, ipiv []int32, anorm float32, rcond [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zspcon.f.
-func Zspcon(ul byte, n int, ap []complex128, ipiv []int32, anorm float64, rcond []float64, work []complex128) bool {
+func Zspcon(ul byte, n int, ap []complex128, ipiv []Int, anorm float64, rcond []float64, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17807,7 +18801,7 @@ func Zspcon(ul byte, n int, ap []complex128, ipiv []int32, anorm float64, rcond
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -17875,7 +18869,7 @@ func Dspev(jobz, ul byte, n int, ap, w, z []float64, ldz int, work []float64) bo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspevd.f.
-func Sspevd(jobz, ul byte, n int, ap, w, z []float32, ldz int, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Sspevd(jobz, ul byte, n int, ap, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17897,7 +18891,7 @@ func Sspevd(jobz, ul byte, n int, ap, w, z []float32, ldz int, work []float32, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17905,7 +18899,7 @@ func Sspevd(jobz, ul byte, n int, ap, w, z []float32, ldz int, work []float32, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspevd.f.
-func Dspevd(jobz, ul byte, n int, ap, w, z []float64, ldz int, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dspevd(jobz, ul byte, n int, ap, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17927,7 +18921,7 @@ func Dspevd(jobz, ul byte, n int, ap, w, z []float64, ldz int, work []float64, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -17935,7 +18929,7 @@ func Dspevd(jobz, ul byte, n int, ap, w, z []float64, ldz int, work []float64, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspevx.f.
-func Sspevx(jobz, rng, ul byte, n int, ap []float32, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, work []float32, iwork, ifail []int32) bool {
+func Sspevx(jobz, rng, ul byte, n int, ap []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17945,7 +18939,7 @@ func Sspevx(jobz, rng, ul byte, n int, ap []float32, vl, vu float32, il, iu int,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -17961,11 +18955,11 @@ func Sspevx(jobz, rng, ul byte, n int, ap []float32, vl, vu float32, il, iu int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -17973,7 +18967,7 @@ func Sspevx(jobz, rng, ul byte, n int, ap []float32, vl, vu float32, il, iu int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspevx.f.
-func Dspevx(jobz, rng, ul byte, n int, ap []float64, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, work []float64, iwork, ifail []int32) bool {
+func Dspevx(jobz, rng, ul byte, n int, ap []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -17983,7 +18977,7 @@ func Dspevx(jobz, rng, ul byte, n int, ap []float64, vl, vu float64, il, iu int,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -17999,11 +18993,11 @@ func Dspevx(jobz, rng, ul byte, n int, ap []float64, vl, vu float64, il, iu int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -18107,7 +19101,7 @@ func Dspgv(itype int, jobz, ul byte, n int, ap, bp, w, z []float64, ldz int, wor
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspgvd.f.
-func Sspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float32, ldz int, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Sspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18133,7 +19127,7 @@ func Sspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float32, ldz int, wo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -18141,7 +19135,7 @@ func Sspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float32, ldz int, wo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspgvd.f.
-func Dspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float64, ldz int, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18167,7 +19161,7 @@ func Dspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float64, ldz int, wo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -18175,7 +19169,7 @@ func Dspgvd(itype int, jobz, ul byte, n int, ap, bp, w, z []float64, ldz int, wo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspgvx.f.
-func Sspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float32, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, work []float32, iwork, ifail []int32) bool {
+func Sspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18189,7 +19183,7 @@ func Sspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float32, vl, vu float
 	if len(bp) > 0 {
 		_bp = &bp[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -18205,11 +19199,11 @@ func Sspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float32, vl, vu float
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -18217,7 +19211,7 @@ func Sspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float32, vl, vu float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspgvx.f.
-func Dspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float64, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, work []float64, iwork, ifail []int32) bool {
+func Dspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18231,7 +19225,7 @@ func Dspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float64, vl, vu float
 	if len(bp) > 0 {
 		_bp = &bp[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -18247,11 +19241,11 @@ func Dspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float64, vl, vu float
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -18259,7 +19253,7 @@ func Dspgvx(itype int, jobz, rng, ul byte, n int, ap, bp []float64, vl, vu float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssprfs.f.
-func Ssprfs(ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Ssprfs(ul byte, n, nrhs int, ap, afp []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18273,7 +19267,7 @@ func Ssprfs(ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []float32,
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18297,7 +19291,7 @@ func Ssprfs(ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []float32,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -18305,7 +19299,7 @@ func Ssprfs(ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsprfs.f.
-func Dsprfs(ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dsprfs(ul byte, n, nrhs int, ap, afp []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18319,7 +19313,7 @@ func Dsprfs(ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []float64,
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18343,7 +19337,7 @@ func Dsprfs(ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []float64,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -18351,7 +19345,7 @@ func Dsprfs(ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csprfs.f.
-func Csprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Csprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18365,7 +19359,7 @@ func Csprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18397,7 +19391,7 @@ func Csprfs(ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsprfs.f.
-func Zsprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zsprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18411,7 +19405,7 @@ func Zsprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []comple
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18443,7 +19437,7 @@ func Zsprfs(ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspsv.f.
-func Sspsv(ul byte, n, nrhs int, ap []float32, ipiv []int32, b []float32, ldb int) bool {
+func Sspsv(ul byte, n, nrhs int, ap []float32, ipiv []Int, b []float32, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18453,7 +19447,7 @@ func Sspsv(ul byte, n, nrhs int, ap []float32, ipiv []int32, b []float32, ldb in
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18465,7 +19459,7 @@ func Sspsv(ul byte, n, nrhs int, ap []float32, ipiv []int32, b []float32, ldb in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspsv.f.
-func Dspsv(ul byte, n, nrhs int, ap []float64, ipiv []int32, b []float64, ldb int) bool {
+func Dspsv(ul byte, n, nrhs int, ap []float64, ipiv []Int, b []float64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18475,7 +19469,7 @@ func Dspsv(ul byte, n, nrhs int, ap []float64, ipiv []int32, b []float64, ldb in
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18487,7 +19481,7 @@ func Dspsv(ul byte, n, nrhs int, ap []float64, ipiv []int32, b []float64, ldb in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cspsv.f.
-func Cspsv(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ldb int) bool {
+func Cspsv(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18497,7 +19491,7 @@ func Cspsv(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ld
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18509,7 +19503,7 @@ func Cspsv(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zspsv.f.
-func Zspsv(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128, ldb int) bool {
+func Zspsv(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18519,7 +19513,7 @@ func Zspsv(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18531,7 +19525,7 @@ func Zspsv(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sspsvx.f.
-func Sspsvx(fact, ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []int32) bool {
+func Sspsvx(fact, ul byte, n, nrhs int, ap, afp []float32, ipiv []Int, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18545,7 +19539,7 @@ func Sspsvx(fact, ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []flo
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18573,7 +19567,7 @@ func Sspsvx(fact, ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []flo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -18581,7 +19575,7 @@ func Sspsvx(fact, ul byte, n, nrhs int, ap, afp []float32, ipiv []int32, b []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dspsvx.f.
-func Dspsvx(fact, ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []int32) bool {
+func Dspsvx(fact, ul byte, n, nrhs int, ap, afp []float64, ipiv []Int, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18595,7 +19589,7 @@ func Dspsvx(fact, ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []flo
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18623,7 +19617,7 @@ func Dspsvx(fact, ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []flo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -18631,7 +19625,7 @@ func Dspsvx(fact, ul byte, n, nrhs int, ap, afp []float64, ipiv []int32, b []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cspsvx.f.
-func Cspsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Cspsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18645,7 +19639,7 @@ func Cspsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []c
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18681,7 +19675,7 @@ func Cspsvx(fact, ul byte, n, nrhs int, ap, afp []complex64, ipiv []int32, b []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zspsvx.f.
-func Zspsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zspsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18695,7 +19689,7 @@ func Zspsvx(fact, ul byte, n, nrhs int, ap, afp []complex128, ipiv []int32, b []
 	if len(afp) > 0 {
 		_afp = &afp[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18783,7 +19777,7 @@ func Dsptrd(ul byte, n int, ap, d, e, tau []float64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssptrf.f.
-func Ssptrf(ul byte, n int, ap []float32, ipiv []int32) bool {
+func Ssptrf(ul byte, n int, ap []float32, ipiv []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18793,7 +19787,7 @@ func Ssptrf(ul byte, n int, ap []float32, ipiv []int32) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18801,7 +19795,7 @@ func Ssptrf(ul byte, n int, ap []float32, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsptrf.f.
-func Dsptrf(ul byte, n int, ap []float64, ipiv []int32) bool {
+func Dsptrf(ul byte, n int, ap []float64, ipiv []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18811,7 +19805,7 @@ func Dsptrf(ul byte, n int, ap []float64, ipiv []int32) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18819,7 +19813,7 @@ func Dsptrf(ul byte, n int, ap []float64, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csptrf.f.
-func Csptrf(ul byte, n int, ap []complex64, ipiv []int32) bool {
+func Csptrf(ul byte, n int, ap []complex64, ipiv []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18829,7 +19823,7 @@ func Csptrf(ul byte, n int, ap []complex64, ipiv []int32) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18837,7 +19831,7 @@ func Csptrf(ul byte, n int, ap []complex64, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsptrf.f.
-func Zsptrf(ul byte, n int, ap []complex128, ipiv []int32) bool {
+func Zsptrf(ul byte, n int, ap []complex128, ipiv []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18847,7 +19841,7 @@ func Zsptrf(ul byte, n int, ap []complex128, ipiv []int32) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18855,7 +19849,7 @@ func Zsptrf(ul byte, n int, ap []complex128, ipiv []int32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssptri.f.
-func Ssptri(ul byte, n int, ap []float32, ipiv []int32, work []float32) bool {
+func Ssptri(ul byte, n int, ap []float32, ipiv []Int, work []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18865,7 +19859,7 @@ func Ssptri(ul byte, n int, ap []float32, ipiv []int32, work []float32) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18877,7 +19871,7 @@ func Ssptri(ul byte, n int, ap []float32, ipiv []int32, work []float32) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsptri.f.
-func Dsptri(ul byte, n int, ap []float64, ipiv []int32, work []float64) bool {
+func Dsptri(ul byte, n int, ap []float64, ipiv []Int, work []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18887,7 +19881,7 @@ func Dsptri(ul byte, n int, ap []float64, ipiv []int32, work []float64) bool {
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18899,7 +19893,7 @@ func Dsptri(ul byte, n int, ap []float64, ipiv []int32, work []float64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csptri.f.
-func Csptri(ul byte, n int, ap []complex64, ipiv []int32, work []complex64) bool {
+func Csptri(ul byte, n int, ap []complex64, ipiv []Int, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18909,7 +19903,7 @@ func Csptri(ul byte, n int, ap []complex64, ipiv []int32, work []complex64) bool
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18921,7 +19915,7 @@ func Csptri(ul byte, n int, ap []complex64, ipiv []int32, work []complex64) bool
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsptri.f.
-func Zsptri(ul byte, n int, ap []complex128, ipiv []int32, work []complex128) bool {
+func Zsptri(ul byte, n int, ap []complex128, ipiv []Int, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18931,7 +19925,7 @@ func Zsptri(ul byte, n int, ap []complex128, ipiv []int32, work []complex128) bo
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18943,7 +19937,7 @@ func Zsptri(ul byte, n int, ap []complex128, ipiv []int32, work []complex128) bo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssptrs.f.
-func Ssptrs(ul byte, n, nrhs int, ap []float32, ipiv []int32, b []float32, ldb int) bool {
+func Ssptrs(ul byte, n, nrhs int, ap []float32, ipiv []Int, b []float32, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18953,7 +19947,7 @@ func Ssptrs(ul byte, n, nrhs int, ap []float32, ipiv []int32, b []float32, ldb i
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18965,7 +19959,7 @@ func Ssptrs(ul byte, n, nrhs int, ap []float32, ipiv []int32, b []float32, ldb i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsptrs.f.
-func Dsptrs(ul byte, n, nrhs int, ap []float64, ipiv []int32, b []float64, ldb int) bool {
+func Dsptrs(ul byte, n, nrhs int, ap []float64, ipiv []Int, b []float64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18975,7 +19969,7 @@ func Dsptrs(ul byte, n, nrhs int, ap []float64, ipiv []int32, b []float64, ldb i
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -18987,7 +19981,7 @@ func Dsptrs(ul byte, n, nrhs int, ap []float64, ipiv []int32, b []float64, ldb i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csptrs.f.
-func Csptrs(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, ldb int) bool {
+func Csptrs(ul byte, n, nrhs int, ap []complex64, ipiv []Int, b []complex64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -18997,7 +19991,7 @@ func Csptrs(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, l
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -19009,7 +20003,7 @@ func Csptrs(ul byte, n, nrhs int, ap []complex64, ipiv []int32, b []complex64, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsptrs.f.
-func Zsptrs(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128, ldb int) bool {
+func Zsptrs(ul byte, n, nrhs int, ap []complex128, ipiv []Int, b []complex128, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -19019,7 +20013,7 @@ func Zsptrs(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -19031,7 +20025,7 @@ func Zsptrs(ul byte, n, nrhs int, ap []complex128, ipiv []int32, b []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstebz.f.
-func Sstebz(rng, order byte, n int, vl, vu float32, il, iu int, abstol float32, d, e []float32, m, nsplit []int32, w []float32, iblock, isplit []int32, work []float32, iwork []int32) bool {
+func Sstebz(rng, order byte, n int, vl, vu float32, il, iu int, abstol float32, d, e []float32, m, nsplit []Int, w []float32, iblock, isplit []Int, work []float32, iwork []Int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19040,11 +20034,11 @@ func Sstebz(rng, order byte, n int, vl, vu float32, il, iu int, abstol float32,
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
-	var _nsplit *int32
+	var _nsplit *Int
 	if len(nsplit) > 0 {
 		_nsplit = &nsplit[0]
 	}
@@ -19052,11 +20046,11 @@ func Sstebz(rng, order byte, n int, vl, vu float32, il, iu int, abstol float32,
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _iblock *int32
+	var _iblock *Int
 	if len(iblock) > 0 {
 		_iblock = &iblock[0]
 	}
-	var _isplit *int32
+	var _isplit *Int
 	if len(isplit) > 0 {
 		_isplit = &isplit[0]
 	}
@@ -19064,7 +20058,7 @@ func Sstebz(rng, order byte, n int, vl, vu float32, il, iu int, abstol float32,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19072,7 +20066,7 @@ func Sstebz(rng, order byte, n int, vl, vu float32, il, iu int, abstol float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstebz.f.
-func Dstebz(rng, order byte, n int, vl, vu float64, il, iu int, abstol float64, d, e []float64, m, nsplit []int32, w []float64, iblock, isplit []int32, work []float64, iwork []int32) bool {
+func Dstebz(rng, order byte, n int, vl, vu float64, il, iu int, abstol float64, d, e []float64, m, nsplit []Int, w []float64, iblock, isplit []Int, work []float64, iwork []Int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19081,11 +20075,11 @@ func Dstebz(rng, order byte, n int, vl, vu float64, il, iu int, abstol float64,
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
-	var _nsplit *int32
+	var _nsplit *Int
 	if len(nsplit) > 0 {
 		_nsplit = &nsplit[0]
 	}
@@ -19093,11 +20087,11 @@ func Dstebz(rng, order byte, n int, vl, vu float64, il, iu int, abstol float64,
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _iblock *int32
+	var _iblock *Int
 	if len(iblock) > 0 {
 		_iblock = &iblock[0]
 	}
-	var _isplit *int32
+	var _isplit *Int
 	if len(isplit) > 0 {
 		_isplit = &isplit[0]
 	}
@@ -19105,7 +20099,7 @@ func Dstebz(rng, order byte, n int, vl, vu float64, il, iu int, abstol float64,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19113,7 +20107,7 @@ func Dstebz(rng, order byte, n int, vl, vu float64, il, iu int, abstol float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstedc.f.
-func Sstedc(compz byte, n int, d, e, z []float32, ldz int, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Sstedc(compz byte, n int, d, e, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19130,7 +20124,7 @@ func Sstedc(compz byte, n int, d, e, z []float32, ldz int, work []float32, lwork
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19138,7 +20132,7 @@ func Sstedc(compz byte, n int, d, e, z []float32, ldz int, work []float32, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstedc.f.
-func Dstedc(compz byte, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dstedc(compz byte, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19155,7 +20149,7 @@ func Dstedc(compz byte, n int, d, e, z []float64, ldz int, work []float64, lwork
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19163,7 +20157,7 @@ func Dstedc(compz byte, n int, d, e, z []float64, ldz int, work []float64, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstedc.f.
-func Cstedc(compz byte, n int, d, e []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32, liwork int) bool {
+func Cstedc(compz byte, n int, d, e []float32, z []complex64, ldz int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19184,7 +20178,7 @@ func Cstedc(compz byte, n int, d, e []float32, z []complex64, ldz int, work []co
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19192,7 +20186,7 @@ func Cstedc(compz byte, n int, d, e []float32, z []complex64, ldz int, work []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstedc.f.
-func Zstedc(compz byte, n int, d, e []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32, liwork int) bool {
+func Zstedc(compz byte, n int, d, e []float64, z []complex128, ldz int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19213,7 +20207,7 @@ func Zstedc(compz byte, n int, d, e []float64, z []complex128, ldz int, work []c
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19221,7 +20215,7 @@ func Zstedc(compz byte, n int, d, e []float64, z []complex128, ldz int, work []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstegr.f.
-func Sstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, isuppz []int32, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Sstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19230,7 +20224,7 @@ func Sstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19242,7 +20236,7 @@ func Sstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -19250,7 +20244,7 @@ func Sstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19258,7 +20252,7 @@ func Sstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstegr.f.
-func Dstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, isuppz []int32, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19267,7 +20261,7 @@ func Dstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19279,7 +20273,7 @@ func Dstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -19287,7 +20281,7 @@ func Dstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19295,7 +20289,7 @@ func Dstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstegr.f.
-func Cstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []int32, w []float32, z []complex64, ldz int, isuppz []int32, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Cstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w []float32, z []complex64, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19304,7 +20298,7 @@ func Cstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19316,7 +20310,7 @@ func Cstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -19324,7 +20318,7 @@ func Cstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19332,7 +20326,7 @@ func Cstegr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstegr.f.
-func Zstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []int32, w []float64, z []complex128, ldz int, isuppz []int32, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Zstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w []float64, z []complex128, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19341,7 +20335,7 @@ func Zstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19353,7 +20347,7 @@ func Zstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -19361,7 +20355,7 @@ func Zstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19369,7 +20363,7 @@ func Zstegr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstein.f.
-func Sstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z []float32, ldz int, work []float32, iwork, ifailv []int32) bool {
+func Sstein(n int, d, e []float32, m int, w []float32, iblock, isplit []Int, z []float32, ldz int, work []float32, iwork, ifailv []Int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19382,11 +20376,11 @@ func Sstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _iblock *int32
+	var _iblock *Int
 	if len(iblock) > 0 {
 		_iblock = &iblock[0]
 	}
-	var _isplit *int32
+	var _isplit *Int
 	if len(isplit) > 0 {
 		_isplit = &isplit[0]
 	}
@@ -19398,11 +20392,11 @@ func Sstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifailv *int32
+	var _ifailv *Int
 	if len(ifailv) > 0 {
 		_ifailv = &ifailv[0]
 	}
@@ -19410,7 +20404,7 @@ func Sstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstein.f.
-func Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z []float64, ldz int, work []float64, iwork, ifailv []int32) bool {
+func Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []Int, z []float64, ldz int, work []float64, iwork, ifailv []Int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19423,11 +20417,11 @@ func Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _iblock *int32
+	var _iblock *Int
 	if len(iblock) > 0 {
 		_iblock = &iblock[0]
 	}
-	var _isplit *int32
+	var _isplit *Int
 	if len(isplit) > 0 {
 		_isplit = &isplit[0]
 	}
@@ -19439,11 +20433,11 @@ func Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifailv *int32
+	var _ifailv *Int
 	if len(ifailv) > 0 {
 		_ifailv = &ifailv[0]
 	}
@@ -19451,7 +20445,7 @@ func Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstein.f.
-func Cstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z []complex64, ldz int, work []float32, iwork, ifailv []int32) bool {
+func Cstein(n int, d, e []float32, m int, w []float32, iblock, isplit []Int, z []complex64, ldz int, work []float32, iwork, ifailv []Int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19464,11 +20458,11 @@ func Cstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _iblock *int32
+	var _iblock *Int
 	if len(iblock) > 0 {
 		_iblock = &iblock[0]
 	}
-	var _isplit *int32
+	var _isplit *Int
 	if len(isplit) > 0 {
 		_isplit = &isplit[0]
 	}
@@ -19480,11 +20474,11 @@ func Cstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifailv *int32
+	var _ifailv *Int
 	if len(ifailv) > 0 {
 		_ifailv = &ifailv[0]
 	}
@@ -19492,7 +20486,7 @@ func Cstein(n int, d, e []float32, m int, w []float32, iblock, isplit []int32, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstein.f.
-func Zstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z []complex128, ldz int, work []float64, iwork, ifailv []int32) bool {
+func Zstein(n int, d, e []float64, m int, w []float64, iblock, isplit []Int, z []complex128, ldz int, work []float64, iwork, ifailv []Int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19505,11 +20499,11 @@ func Zstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _iblock *int32
+	var _iblock *Int
 	if len(iblock) > 0 {
 		_iblock = &iblock[0]
 	}
-	var _isplit *int32
+	var _isplit *Int
 	if len(isplit) > 0 {
 		_isplit = &isplit[0]
 	}
@@ -19521,11 +20515,11 @@ func Zstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifailv *int32
+	var _ifailv *Int
 	if len(ifailv) > 0 {
 		_ifailv = &ifailv[0]
 	}
@@ -19533,7 +20527,7 @@ func Zstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int32, z
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstemr.f.
-func Sstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m []int32, w, z []float32, ldz, nzc int, isuppz, tryrac []int32, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Sstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m []Int, w, z []float32, ldz, nzc int, isuppz, tryrac []Int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19542,7 +20536,7 @@ func Sstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19554,11 +20548,11 @@ func Sstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
-	var _tryrac *int32
+	var _tryrac *Int
 	if len(tryrac) > 0 {
 		_tryrac = &tryrac[0]
 	}
@@ -19566,7 +20560,7 @@ func Sstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19574,7 +20568,7 @@ func Sstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstemr.f.
-func Dstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m []int32, w, z []float64, ldz, nzc int, isuppz, tryrac []int32, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m []Int, w, z []float64, ldz, nzc int, isuppz, tryrac []Int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19583,7 +20577,7 @@ func Dstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19595,11 +20589,11 @@ func Dstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
-	var _tryrac *int32
+	var _tryrac *Int
 	if len(tryrac) > 0 {
 		_tryrac = &tryrac[0]
 	}
@@ -19607,7 +20601,7 @@ func Dstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19615,7 +20609,7 @@ func Dstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cstemr.f.
-func Cstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m []int32, w []float32, z []complex64, ldz, nzc int, isuppz, tryrac []int32, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Cstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m []Int, w []float32, z []complex64, ldz, nzc int, isuppz, tryrac []Int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19624,7 +20618,7 @@ func Cstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19636,11 +20630,11 @@ func Cstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
-	var _tryrac *int32
+	var _tryrac *Int
 	if len(tryrac) > 0 {
 		_tryrac = &tryrac[0]
 	}
@@ -19648,7 +20642,7 @@ func Cstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19656,7 +20650,7 @@ func Cstemr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, m
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zstemr.f.
-func Zstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m []int32, w []float64, z []complex128, ldz, nzc int, isuppz, tryrac []int32, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Zstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m []Int, w []float64, z []complex128, ldz, nzc int, isuppz, tryrac []Int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19665,7 +20659,7 @@ func Zstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19677,11 +20671,11 @@ func Zstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
-	var _tryrac *int32
+	var _tryrac *Int
 	if len(tryrac) > 0 {
 		_tryrac = &tryrac[0]
 	}
@@ -19689,7 +20683,7 @@ func Zstemr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, m
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19849,7 +20843,7 @@ func Dstev(jobz byte, n int, d, e, z []float64, ldz int, work []float64) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstevd.f.
-func Sstevd(jobz byte, n int, d, e, z []float32, ldz int, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Sstevd(jobz byte, n int, d, e, z []float32, ldz int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19866,7 +20860,7 @@ func Sstevd(jobz byte, n int, d, e, z []float32, ldz int, work []float32, lwork
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19874,7 +20868,7 @@ func Sstevd(jobz byte, n int, d, e, z []float32, ldz int, work []float32, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstevd.f.
-func Dstevd(jobz byte, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dstevd(jobz byte, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19891,7 +20885,7 @@ func Dstevd(jobz byte, n int, d, e, z []float64, ldz int, work []float64, lwork
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19899,7 +20893,7 @@ func Dstevd(jobz byte, n int, d, e, z []float64, ldz int, work []float64, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstevr.f.
-func Sstevr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, isuppz []int32, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Sstevr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19908,7 +20902,7 @@ func Sstevr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19920,7 +20914,7 @@ func Sstevr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -19928,7 +20922,7 @@ func Sstevr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19936,7 +20930,7 @@ func Sstevr(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstevr.f.
-func Dstevr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, isuppz []int32, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dstevr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19945,7 +20939,7 @@ func Dstevr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19957,7 +20951,7 @@ func Dstevr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -19965,7 +20959,7 @@ func Dstevr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -19973,7 +20967,7 @@ func Dstevr(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sstevx.f.
-func Sstevx(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, work []float32, iwork, ifail []int32) bool {
+func Sstevx(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, iwork, ifail []Int) bool {
 	var _d *float32
 	if len(d) > 0 {
 		_d = &d[0]
@@ -19982,7 +20976,7 @@ func Sstevx(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -19998,11 +20992,11 @@ func Sstevx(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -20010,7 +21004,7 @@ func Sstevx(jobz, rng byte, n int, d, e []float32, vl, vu float32, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dstevx.f.
-func Dstevx(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, work []float64, iwork, ifail []int32) bool {
+func Dstevx(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, iwork, ifail []Int) bool {
 	var _d *float64
 	if len(d) > 0 {
 		_d = &d[0]
@@ -20019,7 +21013,7 @@ func Dstevx(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(e) > 0 {
 		_e = &e[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -20035,11 +21029,11 @@ func Dstevx(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -20047,7 +21041,7 @@ func Dstevx(jobz, rng byte, n int, d, e []float64, vl, vu float64, il, iu int, a
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssycon.f.
-func Ssycon(ul byte, n int, a []float32, lda int, ipiv []int32, anorm float32, rcond, work []float32, iwork []int32) bool {
+func Ssycon(ul byte, n int, a []float32, lda int, ipiv []Int, anorm float32, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20057,7 +21051,7 @@ func Ssycon(ul byte, n int, a []float32, lda int, ipiv []int32, anorm float32, r
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20069,7 +21063,7 @@ func Ssycon(ul byte, n int, a []float32, lda int, ipiv []int32, anorm float32, r
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20077,7 +21071,7 @@ func Ssycon(ul byte, n int, a []float32, lda int, ipiv []int32, anorm float32, r
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsycon.f.
-func Dsycon(ul byte, n int, a []float64, lda int, ipiv []int32, anorm float64, rcond, work []float64, iwork []int32) bool {
+func Dsycon(ul byte, n int, a []float64, lda int, ipiv []Int, anorm float64, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20087,7 +21081,7 @@ func Dsycon(ul byte, n int, a []float64, lda int, ipiv []int32, anorm float64, r
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20099,7 +21093,7 @@ func Dsycon(ul byte, n int, a []float64, lda int, ipiv []int32, anorm float64, r
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20107,7 +21101,7 @@ func Dsycon(ul byte, n int, a []float64, lda int, ipiv []int32, anorm float64, r
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csycon.f.
-func Csycon(ul byte, n int, a []complex64, lda int, ipiv []int32, anorm float32, rcond []float32, work []complex64) bool {
+func Csycon(ul byte, n int, a []complex64, lda int, ipiv []Int, anorm float32, rcond []float32, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20117,7 +21111,7 @@ func Csycon(ul byte, n int, a []complex64, lda int, ipiv []int32, anorm float32,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20133,7 +21127,7 @@ func Csycon(ul byte, n int, a []complex64, lda int, ipiv []int32, anorm float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsycon.f.
-func Zsycon(ul byte, n int, a []complex128, lda int, ipiv []int32, anorm float64, rcond []float64, work []complex128) bool {
+func Zsycon(ul byte, n int, a []complex128, lda int, ipiv []Int, anorm float64, rcond []float64, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20143,7 +21137,7 @@ func Zsycon(ul byte, n int, a []complex128, lda int, ipiv []int32, anorm float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20323,7 +21317,7 @@ func Dsyev(jobz, ul byte, n int, a []float64, lda int, w, work []float64, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyevd.f.
-func Ssyevd(jobz, ul byte, n int, a []float32, lda int, w, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Ssyevd(jobz, ul byte, n int, a []float32, lda int, w, work []float32, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20341,7 +21335,7 @@ func Ssyevd(jobz, ul byte, n int, a []float32, lda int, w, work []float32, lwork
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20349,7 +21343,7 @@ func Ssyevd(jobz, ul byte, n int, a []float32, lda int, w, work []float32, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyevd.f.
-func Dsyevd(jobz, ul byte, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dsyevd(jobz, ul byte, n int, a []float64, lda int, w, work []float64, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20367,7 +21361,7 @@ func Dsyevd(jobz, ul byte, n int, a []float64, lda int, w, work []float64, lwork
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20375,7 +21369,7 @@ func Dsyevd(jobz, ul byte, n int, a []float64, lda int, w, work []float64, lwork
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyevr.f.
-func Ssyevr(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, isuppz []int32, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Ssyevr(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, isuppz []Int, work []float32, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20385,7 +21379,7 @@ func Ssyevr(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -20397,7 +21391,7 @@ func Ssyevr(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il,
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -20405,7 +21399,7 @@ func Ssyevr(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20413,7 +21407,7 @@ func Ssyevr(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyevr.f.
-func Dsyevr(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, isuppz []int32, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dsyevr(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, isuppz []Int, work []float64, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20423,7 +21417,7 @@ func Dsyevr(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -20435,7 +21429,7 @@ func Dsyevr(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il,
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _isuppz *int32
+	var _isuppz *Int
 	if len(isuppz) > 0 {
 		_isuppz = &isuppz[0]
 	}
@@ -20443,7 +21437,7 @@ func Dsyevr(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20451,7 +21445,7 @@ func Dsyevr(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyevx.f.
-func Ssyevx(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, work []float32, lwork int, iwork, ifail []int32) bool {
+func Ssyevx(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, lwork int, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20461,7 +21455,7 @@ func Ssyevx(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -20477,11 +21471,11 @@ func Ssyevx(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -20489,7 +21483,7 @@ func Ssyevx(jobz, rng, ul byte, n int, a []float32, lda int, vl, vu float32, il,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyevx.f.
-func Dsyevx(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []int32) bool {
+func Dsyevx(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20499,7 +21493,7 @@ func Dsyevx(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -20515,11 +21509,11 @@ func Dsyevx(jobz, rng, ul byte, n int, a []float64, lda int, vl, vu float64, il,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -20615,7 +21609,7 @@ func Dsygv(itype int, jobz, ul byte, n int, a []float64, lda int, b []float64, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssygvd.f.
-func Ssygvd(itype int, jobz, ul byte, n int, a []float32, lda int, b []float32, ldb int, w, work []float32, lwork int, iwork []int32, liwork int) bool {
+func Ssygvd(itype int, jobz, ul byte, n int, a []float32, lda int, b []float32, ldb int, w, work []float32, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20637,7 +21631,7 @@ func Ssygvd(itype int, jobz, ul byte, n int, a []float32, lda int, b []float32,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20645,7 +21639,7 @@ func Ssygvd(itype int, jobz, ul byte, n int, a []float32, lda int, b []float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsygvd.f.
-func Dsygvd(itype int, jobz, ul byte, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int, iwork []int32, liwork int) bool {
+func Dsygvd(itype int, jobz, ul byte, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int, iwork []Int, liwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20667,7 +21661,7 @@ func Dsygvd(itype int, jobz, ul byte, n int, a []float64, lda int, b []float64,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20675,7 +21669,7 @@ func Dsygvd(itype int, jobz, ul byte, n int, a []float64, lda int, b []float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssygvx.f.
-func Ssygvx(itype int, jobz, rng, ul byte, n int, a []float32, lda int, b []float32, ldb int, vl, vu float32, il, iu int, abstol float32, m []int32, w, z []float32, ldz int, work []float32, lwork int, iwork, ifail []int32) bool {
+func Ssygvx(itype int, jobz, rng, ul byte, n int, a []float32, lda int, b []float32, ldb int, vl, vu float32, il, iu int, abstol float32, m []Int, w, z []float32, ldz int, work []float32, lwork int, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20689,7 +21683,7 @@ func Ssygvx(itype int, jobz, rng, ul byte, n int, a []float32, lda int, b []floa
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -20705,11 +21699,11 @@ func Ssygvx(itype int, jobz, rng, ul byte, n int, a []float32, lda int, b []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -20717,7 +21711,7 @@ func Ssygvx(itype int, jobz, rng, ul byte, n int, a []float32, lda int, b []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsygvx.f.
-func Dsygvx(itype int, jobz, rng, ul byte, n int, a []float64, lda int, b []float64, ldb int, vl, vu float64, il, iu int, abstol float64, m []int32, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []int32) bool {
+func Dsygvx(itype int, jobz, rng, ul byte, n int, a []float64, lda int, b []float64, ldb int, vl, vu float64, il, iu int, abstol float64, m []Int, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20731,7 +21725,7 @@ func Dsygvx(itype int, jobz, rng, ul byte, n int, a []float64, lda int, b []floa
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -20747,11 +21741,11 @@ func Dsygvx(itype int, jobz, rng, ul byte, n int, a []float64, lda int, b []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
-	var _ifail *int32
+	var _ifail *Int
 	if len(ifail) > 0 {
 		_ifail = &ifail[0]
 	}
@@ -20759,7 +21753,7 @@ func Dsygvx(itype int, jobz, rng, ul byte, n int, a []float64, lda int, b []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyrfs.f.
-func Ssyrfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []int32, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Ssyrfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20773,7 +21767,7 @@ func Ssyrfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int,
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20797,7 +21791,7 @@ func Ssyrfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20805,7 +21799,7 @@ func Ssyrfs(ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyrfs.f.
-func Dsyrfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int32, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dsyrfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20819,7 +21813,7 @@ func Dsyrfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int,
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20843,7 +21837,7 @@ func Dsyrfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -20851,7 +21845,7 @@ func Dsyrfs(ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csyrfs.f.
-func Csyrfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
+func Csyrfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, ferr, berr []float32, work []complex64, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20865,7 +21859,7 @@ func Csyrfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf i
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20897,7 +21891,7 @@ func Csyrfs(ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf i
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsyrfs.f.
-func Zsyrfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
+func Zsyrfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, ferr, berr []float64, work []complex128, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20911,7 +21905,7 @@ func Zsyrfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20943,7 +21937,7 @@ func Zsyrfs(ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssysv.f.
-func Ssysv(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float32, ldb int, work []float32, lwork int) bool {
+func Ssysv(ul byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int, work []float32, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20953,7 +21947,7 @@ func Ssysv(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20969,7 +21963,7 @@ func Ssysv(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float32
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsysv.f.
-func Dsysv(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb int, work []float64, lwork int) bool {
+func Dsysv(ul byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int, work []float64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -20979,7 +21973,7 @@ func Dsysv(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -20995,7 +21989,7 @@ func Dsysv(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float64
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csysv.f.
-func Csysv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int, work []complex64, lwork int) bool {
+func Csysv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21005,7 +21999,7 @@ func Csysv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []compl
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21021,7 +22015,7 @@ func Csysv(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []compl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsysv.f.
-func Zsysv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int, work []complex128, lwork int) bool {
+func Zsysv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21031,7 +22025,7 @@ func Zsysv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []comp
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21047,7 +22041,7 @@ func Zsysv(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []comp
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssysvx.f.
-func Ssysvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []int32, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, lwork int, iwork []int32) bool {
+func Ssysvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf int, ipiv []Int, b []float32, ldb int, x []float32, ldx int, rcond, ferr, berr, work []float32, lwork int, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21061,7 +22055,7 @@ func Ssysvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21089,7 +22083,7 @@ func Ssysvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -21097,7 +22091,7 @@ func Ssysvx(fact, ul byte, n, nrhs int, a []float32, lda int, af []float32, ldaf
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsysvx.f.
-func Dsysvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int32, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, lwork int, iwork []int32) bool {
+func Dsysvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []Int, b []float64, ldb int, x []float64, ldx int, rcond, ferr, berr, work []float64, lwork int, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21111,7 +22105,7 @@ func Dsysvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21139,7 +22133,7 @@ func Dsysvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -21147,7 +22141,7 @@ func Dsysvx(fact, ul byte, n, nrhs int, a []float64, lda int, af []float64, ldaf
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csysvx.f.
-func Csysvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []int32, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, lwork int, rwork []float32) bool {
+func Csysvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64, ldaf int, ipiv []Int, b []complex64, ldb int, x []complex64, ldx int, rcond, ferr, berr []float32, work []complex64, lwork int, rwork []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21161,7 +22155,7 @@ func Csysvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64,
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21197,7 +22191,7 @@ func Csysvx(fact, ul byte, n, nrhs int, a []complex64, lda int, af []complex64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsysvx.f.
-func Zsysvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []int32, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, lwork int, rwork []float64) bool {
+func Zsysvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128, ldaf int, ipiv []Int, b []complex128, ldb int, x []complex128, ldx int, rcond, ferr, berr []float64, work []complex128, lwork int, rwork []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21211,7 +22205,7 @@ func Zsysvx(fact, ul byte, n, nrhs int, a []complex128, lda int, af []complex128
 	if len(af) > 0 {
 		_af = &af[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21307,7 +22301,7 @@ func Dsytrd(ul byte, n int, a []float64, lda int, d, e, tau, work []float64, lwo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytrf.f.
-func Ssytrf(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32, lwork int) bool {
+func Ssytrf(ul byte, n int, a []float32, lda int, ipiv []Int, work []float32, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21317,7 +22311,7 @@ func Ssytrf(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21329,7 +22323,7 @@ func Ssytrf(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytrf.f.
-func Dsytrf(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64, lwork int) bool {
+func Dsytrf(ul byte, n int, a []float64, lda int, ipiv []Int, work []float64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21339,7 +22333,7 @@ func Dsytrf(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21351,7 +22345,7 @@ func Dsytrf(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytrf.f.
-func Csytrf(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64, lwork int) bool {
+func Csytrf(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21361,7 +22355,7 @@ func Csytrf(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21373,7 +22367,7 @@ func Csytrf(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytrf.f.
-func Zsytrf(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128, lwork int) bool {
+func Zsytrf(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21383,7 +22377,7 @@ func Zsytrf(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21395,7 +22389,7 @@ func Zsytrf(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytri.f.
-func Ssytri(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32) bool {
+func Ssytri(ul byte, n int, a []float32, lda int, ipiv []Int, work []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21405,7 +22399,7 @@ func Ssytri(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32)
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21417,7 +22411,7 @@ func Ssytri(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32)
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytri.f.
-func Dsytri(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64) bool {
+func Dsytri(ul byte, n int, a []float64, lda int, ipiv []Int, work []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21427,7 +22421,7 @@ func Dsytri(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64)
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21439,7 +22433,7 @@ func Dsytri(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64)
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytri.f.
-func Csytri(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64) bool {
+func Csytri(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21449,7 +22443,7 @@ func Csytri(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21461,7 +22455,7 @@ func Csytri(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytri.f.
-func Zsytri(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128) bool {
+func Zsytri(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21471,7 +22465,7 @@ func Zsytri(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21483,7 +22477,7 @@ func Zsytri(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytrs.f.
-func Ssytrs(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float32, ldb int) bool {
+func Ssytrs(ul byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21493,7 +22487,7 @@ func Ssytrs(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float3
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21505,7 +22499,7 @@ func Ssytrs(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytrs.f.
-func Dsytrs(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb int) bool {
+func Dsytrs(ul byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21515,7 +22509,7 @@ func Dsytrs(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float6
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21527,7 +22521,7 @@ func Dsytrs(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float6
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytrs.f.
-func Csytrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int) bool {
+func Csytrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21537,7 +22531,7 @@ func Csytrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []comp
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21549,7 +22543,7 @@ func Csytrs(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []comp
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytrs.f.
-func Zsytrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int) bool {
+func Zsytrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21559,7 +22553,7 @@ func Zsytrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []com
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -21571,7 +22565,7 @@ func Zsytrs(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []com
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stbcon.f.
-func Stbcon(norm, ul, d byte, n, kd int, ab []float32, ldab int, rcond, work []float32, iwork []int32) bool {
+func Stbcon(norm, ul, d byte, n, kd int, ab []float32, ldab int, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21594,7 +22588,7 @@ func Stbcon(norm, ul, d byte, n, kd int, ab []float32, ldab int, rcond, work []f
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -21602,7 +22596,7 @@ func Stbcon(norm, ul, d byte, n, kd int, ab []float32, ldab int, rcond, work []f
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtbcon.f.
-func Dtbcon(norm, ul, d byte, n, kd int, ab []float64, ldab int, rcond, work []float64, iwork []int32) bool {
+func Dtbcon(norm, ul, d byte, n, kd int, ab []float64, ldab int, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21625,7 +22619,7 @@ func Dtbcon(norm, ul, d byte, n, kd int, ab []float64, ldab int, rcond, work []f
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -21695,7 +22689,7 @@ func Ztbcon(norm, ul, d byte, n, kd int, ab []complex128, ldab int, rcond []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stbrfs.f.
-func Stbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Stbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21735,7 +22729,7 @@ func Stbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float32, ldab int, b []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -21743,7 +22737,7 @@ func Stbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float32, ldab int, b []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtbrfs.f.
-func Dtbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dtbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -21783,7 +22777,7 @@ func Dtbrfs(ul, trans, d byte, n, kd, nrhs int, ab []float64, ldab int, b []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -22431,13 +23425,13 @@ func Ztfttr(transr, ul byte, n int, arf, a []complex128, lda int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgevc.f.
-func Stgevc(side, howmny byte, sel []int32, n int, s []float32, lds int, p []float32, ldp int, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []int32, work []float32) bool {
+func Stgevc(side, howmny byte, sel []Int, n int, s []float32, lds int, p []float32, ldp int, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []Int, work []float32) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22457,7 +23451,7 @@ func Stgevc(side, howmny byte, sel []int32, n int, s []float32, lds int, p []flo
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22469,13 +23463,13 @@ func Stgevc(side, howmny byte, sel []int32, n int, s []float32, lds int, p []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgevc.f.
-func Dtgevc(side, howmny byte, sel []int32, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []int32, work []float64) bool {
+func Dtgevc(side, howmny byte, sel []Int, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []Int, work []float64) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22495,7 +23489,7 @@ func Dtgevc(side, howmny byte, sel []int32, n int, s []float64, lds int, p []flo
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22507,13 +23501,13 @@ func Dtgevc(side, howmny byte, sel []int32, n int, s []float64, lds int, p []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgevc.f.
-func Ctgevc(side, howmny byte, sel []int32, n int, s []complex64, lds int, p []complex64, ldp int, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []int32, work []complex64, rwork []float32) bool {
+func Ctgevc(side, howmny byte, sel []Int, n int, s []complex64, lds int, p []complex64, ldp int, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []Int, work []complex64, rwork []float32) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22533,7 +23527,7 @@ func Ctgevc(side, howmny byte, sel []int32, n int, s []complex64, lds int, p []c
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22549,13 +23543,13 @@ func Ctgevc(side, howmny byte, sel []int32, n int, s []complex64, lds int, p []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgevc.f.
-func Ztgevc(side, howmny byte, sel []int32, n int, s []complex128, lds int, p []complex128, ldp int, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []int32, work []complex128, rwork []float64) bool {
+func Ztgevc(side, howmny byte, sel []Int, n int, s []complex128, lds int, p []complex128, ldp int, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []Int, work []complex128, rwork []float64) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22575,7 +23569,7 @@ func Ztgevc(side, howmny byte, sel []int32, n int, s []complex128, lds int, p []
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22591,7 +23585,7 @@ func Ztgevc(side, howmny byte, sel []int32, n int, s []complex128, lds int, p []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgexc.f.
-func Stgexc(wantq, wantz int32, n int, a []float32, lda int, b []float32, ldb int, q []float32, ldq int, z []float32, ldz int, ifst, ilst []int32, work []float32, lwork int) bool {
+func Stgexc(wantq, wantz Int, n int, a []float32, lda int, b []float32, ldb int, q []float32, ldq int, z []float32, ldz int, ifst, ilst []Int, work []float32, lwork int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -22608,11 +23602,11 @@ func Stgexc(wantq, wantz int32, n int, a []float32, lda int, b []float32, ldb in
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _ifst *int32
+	var _ifst *Int
 	if len(ifst) > 0 {
 		_ifst = &ifst[0]
 	}
-	var _ilst *int32
+	var _ilst *Int
 	if len(ilst) > 0 {
 		_ilst = &ilst[0]
 	}
@@ -22624,7 +23618,7 @@ func Stgexc(wantq, wantz int32, n int, a []float32, lda int, b []float32, ldb in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgexc.f.
-func Dtgexc(wantq, wantz int32, n int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int, ifst, ilst []int32, work []float64, lwork int) bool {
+func Dtgexc(wantq, wantz Int, n int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int, ifst, ilst []Int, work []float64, lwork int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -22641,11 +23635,11 @@ func Dtgexc(wantq, wantz int32, n int, a []float64, lda int, b []float64, ldb in
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _ifst *int32
+	var _ifst *Int
 	if len(ifst) > 0 {
 		_ifst = &ifst[0]
 	}
-	var _ilst *int32
+	var _ilst *Int
 	if len(ilst) > 0 {
 		_ilst = &ilst[0]
 	}
@@ -22657,7 +23651,7 @@ func Dtgexc(wantq, wantz int32, n int, a []float64, lda int, b []float64, ldb in
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgexc.f.
-func Ctgexc(wantq, wantz int32, n int, a []complex64, lda int, b []complex64, ldb int, q []complex64, ldq int, z []complex64, ldz, ifst, ilst int) bool {
+func Ctgexc(wantq, wantz Int, n int, a []complex64, lda int, b []complex64, ldb int, q []complex64, ldq int, z []complex64, ldz, ifst, ilst int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -22678,7 +23672,7 @@ func Ctgexc(wantq, wantz int32, n int, a []complex64, lda int, b []complex64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgexc.f.
-func Ztgexc(wantq, wantz int32, n int, a []complex128, lda int, b []complex128, ldb int, q []complex128, ldq int, z []complex128, ldz, ifst, ilst int) bool {
+func Ztgexc(wantq, wantz Int, n int, a []complex128, lda int, b []complex128, ldb int, q []complex128, ldq int, z []complex128, ldz, ifst, ilst int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -22699,8 +23693,8 @@ func Ztgexc(wantq, wantz int32, n int, a []complex128, lda int, b []complex128,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsen.f.
-func Stgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, q []float32, ldq int, z []float32, ldz int, m []int32, pl, pr, dif, work []float32, lwork int, iwork []int32, liwork int) bool {
-	var _sel *int32
+func Stgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, q []float32, ldq int, z []float32, ldz int, m []Int, pl, pr, dif, work []float32, lwork int, iwork []Int, liwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22732,7 +23726,7 @@ func Stgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float32, lda
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22752,7 +23746,7 @@ func Stgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float32, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -22760,8 +23754,8 @@ func Stgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float32, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsen.f.
-func Dtgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, m []int32, pl, pr, dif, work []float64, lwork int, iwork []int32, liwork int) bool {
-	var _sel *int32
+func Dtgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, m []Int, pl, pr, dif, work []float64, lwork int, iwork []Int, liwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22793,7 +23787,7 @@ func Dtgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float64, lda
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22813,7 +23807,7 @@ func Dtgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float64, lda
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -22821,8 +23815,8 @@ func Dtgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []float64, lda
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsen.f.
-func Ctgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, q []complex64, ldq int, z []complex64, ldz int, m []int32, pl, pr, dif []float32, work []complex64, lwork int, iwork []int32, liwork int) bool {
-	var _sel *int32
+func Ctgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []complex64, lda int, b []complex64, ldb int, alpha, beta, q []complex64, ldq int, z []complex64, ldz int, m []Int, pl, pr, dif []float32, work []complex64, lwork int, iwork []Int, liwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22850,7 +23844,7 @@ func Ctgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex64, ld
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22870,7 +23864,7 @@ func Ctgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex64, ld
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -22878,8 +23872,8 @@ func Ctgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex64, ld
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsen.f.
-func Ztgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, q []complex128, ldq int, z []complex128, ldz int, m []int32, pl, pr, dif []float64, work []complex128, lwork int, iwork []int32, liwork int) bool {
-	var _sel *int32
+func Ztgsen(ijob byte, wantq, wantz Int, sel []Int, n int, a []complex128, lda int, b []complex128, ldb int, alpha, beta, q []complex128, ldq int, z []complex128, ldz int, m []Int, pl, pr, dif []float64, work []complex128, lwork int, iwork []Int, liwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -22907,7 +23901,7 @@ func Ztgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex128, l
 	if len(z) > 0 {
 		_z = &z[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -22927,7 +23921,7 @@ func Ztgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex128, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -22935,7 +23929,7 @@ func Ztgsen(ijob byte, wantq, wantz int32, sel []int32, n int, a []complex128, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsja.f.
-func Stgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, ncycle []int32) bool {
+func Stgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, ncycle []Int) bool {
 	var _a *float32
 	if len(a) > 0 {
 		_a = &a[0]
@@ -22968,7 +23962,7 @@ func Stgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float32, lda int, b []
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _ncycle *int32
+	var _ncycle *Int
 	if len(ncycle) > 0 {
 		_ncycle = &ncycle[0]
 	}
@@ -22976,7 +23970,7 @@ func Stgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float32, lda int, b []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsja.f.
-func Dtgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, ncycle []int32) bool {
+func Dtgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, ncycle []Int) bool {
 	var _a *float64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -23009,7 +24003,7 @@ func Dtgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float64, lda int, b []
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _ncycle *int32
+	var _ncycle *Int
 	if len(ncycle) > 0 {
 		_ncycle = &ncycle[0]
 	}
@@ -23017,7 +24011,7 @@ func Dtgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []float64, lda int, b []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsja.f.
-func Ctgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex64, lda int, b []complex64, ldb int, tola, tolb float32, alpha, beta []float32, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, work []complex64, ncycle []int32) bool {
+func Ctgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex64, lda int, b []complex64, ldb int, tola, tolb float32, alpha, beta []float32, u []complex64, ldu int, v []complex64, ldv int, q []complex64, ldq int, work []complex64, ncycle []Int) bool {
 	var _a *complex64
 	if len(a) > 0 {
 		_a = &a[0]
@@ -23050,7 +24044,7 @@ func Ctgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex64, lda int, b
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _ncycle *int32
+	var _ncycle *Int
 	if len(ncycle) > 0 {
 		_ncycle = &ncycle[0]
 	}
@@ -23058,7 +24052,7 @@ func Ctgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex64, lda int, b
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsja.f.
-func Ztgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, ncycle []int32) bool {
+func Ztgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex128, lda int, b []complex128, ldb int, tola, tolb float64, alpha, beta []float64, u []complex128, ldu int, v []complex128, ldv int, q []complex128, ldq int, work []complex128, ncycle []Int) bool {
 	var _a *complex128
 	if len(a) > 0 {
 		_a = &a[0]
@@ -23091,7 +24085,7 @@ func Ztgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex128, lda int, b
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _ncycle *int32
+	var _ncycle *Int
 	if len(ncycle) > 0 {
 		_ncycle = &ncycle[0]
 	}
@@ -23099,8 +24093,8 @@ func Ztgsja(jobu, jobv, jobq byte, m, p, n, k, l int, a []complex128, lda int, b
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsna.f.
-func Stgsna(job, howmny byte, sel []int32, n int, a []float32, lda int, b []float32, ldb int, vl []float32, ldvl int, vr []float32, ldvr int, s, dif []float32, mm int, m []int32, work []float32, lwork int, iwork []int32) bool {
-	var _sel *int32
+func Stgsna(job, howmny byte, sel []Int, n int, a []float32, lda int, b []float32, ldb int, vl []float32, ldvl int, vr []float32, ldvr int, s, dif []float32, mm int, m []Int, work []float32, lwork int, iwork []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -23128,7 +24122,7 @@ func Stgsna(job, howmny byte, sel []int32, n int, a []float32, lda int, b []floa
 	if len(dif) > 0 {
 		_dif = &dif[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -23136,7 +24130,7 @@ func Stgsna(job, howmny byte, sel []int32, n int, a []float32, lda int, b []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23144,8 +24138,8 @@ func Stgsna(job, howmny byte, sel []int32, n int, a []float32, lda int, b []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsna.f.
-func Dtgsna(job, howmny byte, sel []int32, n int, a []float64, lda int, b []float64, ldb int, vl []float64, ldvl int, vr []float64, ldvr int, s, dif []float64, mm int, m []int32, work []float64, lwork int, iwork []int32) bool {
-	var _sel *int32
+func Dtgsna(job, howmny byte, sel []Int, n int, a []float64, lda int, b []float64, ldb int, vl []float64, ldvl int, vr []float64, ldvr int, s, dif []float64, mm int, m []Int, work []float64, lwork int, iwork []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -23173,7 +24167,7 @@ func Dtgsna(job, howmny byte, sel []int32, n int, a []float64, lda int, b []floa
 	if len(dif) > 0 {
 		_dif = &dif[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -23181,7 +24175,7 @@ func Dtgsna(job, howmny byte, sel []int32, n int, a []float64, lda int, b []floa
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23189,8 +24183,8 @@ func Dtgsna(job, howmny byte, sel []int32, n int, a []float64, lda int, b []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsna.f.
-func Ctgsna(job, howmny byte, sel []int32, n int, a []complex64, lda int, b []complex64, ldb int, vl []complex64, ldvl int, vr []complex64, ldvr int, s, dif []float32, mm int, m []int32, work []complex64, lwork int, iwork []int32) bool {
-	var _sel *int32
+func Ctgsna(job, howmny byte, sel []Int, n int, a []complex64, lda int, b []complex64, ldb int, vl []complex64, ldvl int, vr []complex64, ldvr int, s, dif []float32, mm int, m []Int, work []complex64, lwork int, iwork []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -23218,7 +24212,7 @@ func Ctgsna(job, howmny byte, sel []int32, n int, a []complex64, lda int, b []co
 	if len(dif) > 0 {
 		_dif = &dif[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -23226,7 +24220,7 @@ func Ctgsna(job, howmny byte, sel []int32, n int, a []complex64, lda int, b []co
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23234,8 +24228,8 @@ func Ctgsna(job, howmny byte, sel []int32, n int, a []complex64, lda int, b []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsna.f.
-func Ztgsna(job, howmny byte, sel []int32, n int, a []complex128, lda int, b []complex128, ldb int, vl []complex128, ldvl int, vr []complex128, ldvr int, s, dif []float64, mm int, m []int32, work []complex128, lwork int, iwork []int32) bool {
-	var _sel *int32
+func Ztgsna(job, howmny byte, sel []Int, n int, a []complex128, lda int, b []complex128, ldb int, vl []complex128, ldvl int, vr []complex128, ldvr int, s, dif []float64, mm int, m []Int, work []complex128, lwork int, iwork []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -23263,7 +24257,7 @@ func Ztgsna(job, howmny byte, sel []int32, n int, a []complex128, lda int, b []c
 	if len(dif) > 0 {
 		_dif = &dif[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -23271,7 +24265,7 @@ func Ztgsna(job, howmny byte, sel []int32, n int, a []complex128, lda int, b []c
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23279,7 +24273,7 @@ func Ztgsna(job, howmny byte, sel []int32, n int, a []complex128, lda int, b []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stgsyl.f.
-func Stgsyl(trans byte, ijob byte, m, n int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, d []float32, ldd int, e []float32, lde int, f []float32, ldf int, scale, dif, work []float32, lwork int, iwork []int32) bool {
+func Stgsyl(trans byte, ijob byte, m, n int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, d []float32, ldd int, e []float32, lde int, f []float32, ldf int, scale, dif, work []float32, lwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -23321,7 +24315,7 @@ func Stgsyl(trans byte, ijob byte, m, n int, a []float32, lda int, b []float32,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23329,7 +24323,7 @@ func Stgsyl(trans byte, ijob byte, m, n int, a []float32, lda int, b []float32,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtgsyl.f.
-func Dtgsyl(trans byte, ijob byte, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, d []float64, ldd int, e []float64, lde int, f []float64, ldf int, scale, dif, work []float64, lwork int, iwork []int32) bool {
+func Dtgsyl(trans byte, ijob byte, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, d []float64, ldd int, e []float64, lde int, f []float64, ldf int, scale, dif, work []float64, lwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -23371,7 +24365,7 @@ func Dtgsyl(trans byte, ijob byte, m, n int, a []float64, lda int, b []float64,
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23379,7 +24373,7 @@ func Dtgsyl(trans byte, ijob byte, m, n int, a []float64, lda int, b []float64,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctgsyl.f.
-func Ctgsyl(trans byte, ijob byte, m, n int, a []complex64, lda int, b []complex64, ldb int, c []complex64, ldc int, d []complex64, ldd int, e []complex64, lde int, f []complex64, ldf int, scale, dif []float32, work []complex64, lwork int, iwork []int32) bool {
+func Ctgsyl(trans byte, ijob byte, m, n int, a []complex64, lda int, b []complex64, ldb int, c []complex64, ldc int, d []complex64, ldd int, e []complex64, lde int, f []complex64, ldf int, scale, dif []float32, work []complex64, lwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -23421,7 +24415,7 @@ func Ctgsyl(trans byte, ijob byte, m, n int, a []complex64, lda int, b []complex
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23429,7 +24423,7 @@ func Ctgsyl(trans byte, ijob byte, m, n int, a []complex64, lda int, b []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztgsyl.f.
-func Ztgsyl(trans byte, ijob byte, m, n int, a []complex128, lda int, b []complex128, ldb int, c []complex128, ldc int, d []complex128, ldd int, e []complex128, lde int, f []complex128, ldf int, scale, dif []float64, work []complex128, lwork int, iwork []int32) bool {
+func Ztgsyl(trans byte, ijob byte, m, n int, a []complex128, lda int, b []complex128, ldb int, c []complex128, ldc int, d []complex128, ldd int, e []complex128, lde int, f []complex128, ldf int, scale, dif []float64, work []complex128, lwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -23471,7 +24465,7 @@ func Ztgsyl(trans byte, ijob byte, m, n int, a []complex128, lda int, b []comple
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23479,7 +24473,7 @@ func Ztgsyl(trans byte, ijob byte, m, n int, a []complex128, lda int, b []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stpcon.f.
-func Stpcon(norm, ul, d byte, n int, ap, rcond, work []float32, iwork []int32) bool {
+func Stpcon(norm, ul, d byte, n int, ap, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -23502,7 +24496,7 @@ func Stpcon(norm, ul, d byte, n int, ap, rcond, work []float32, iwork []int32) b
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23510,7 +24504,7 @@ func Stpcon(norm, ul, d byte, n int, ap, rcond, work []float32, iwork []int32) b
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtpcon.f.
-func Dtpcon(norm, ul, d byte, n int, ap, rcond, work []float64, iwork []int32) bool {
+func Dtpcon(norm, ul, d byte, n int, ap, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -23533,7 +24527,7 @@ func Dtpcon(norm, ul, d byte, n int, ap, rcond, work []float64, iwork []int32) b
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23603,7 +24597,7 @@ func Ztpcon(norm, ul, d byte, n int, ap []complex128, rcond []float64, work []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/stprfs.f.
-func Stprfs(ul, trans, d byte, n, nrhs int, ap, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Stprfs(ul, trans, d byte, n, nrhs int, ap, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -23643,7 +24637,7 @@ func Stprfs(ul, trans, d byte, n, nrhs int, ap, b []float32, ldb int, x []float3
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -23651,7 +24645,7 @@ func Stprfs(ul, trans, d byte, n, nrhs int, ap, b []float32, ldb int, x []float3
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtprfs.f.
-func Dtprfs(ul, trans, d byte, n, nrhs int, ap, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dtprfs(ul, trans, d byte, n, nrhs int, ap, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -23691,7 +24685,7 @@ func Dtprfs(ul, trans, d byte, n, nrhs int, ap, b []float64, ldb int, x []float6
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24147,7 +25141,7 @@ func Ztpttr(ul byte, n int, ap, a []complex128, lda int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strcon.f.
-func Strcon(norm, ul, d byte, n int, a []float32, lda int, rcond, work []float32, iwork []int32) bool {
+func Strcon(norm, ul, d byte, n int, a []float32, lda int, rcond, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -24170,7 +25164,7 @@ func Strcon(norm, ul, d byte, n int, a []float32, lda int, rcond, work []float32
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24178,7 +25172,7 @@ func Strcon(norm, ul, d byte, n int, a []float32, lda int, rcond, work []float32
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrcon.f.
-func Dtrcon(norm, ul, d byte, n int, a []float64, lda int, rcond, work []float64, iwork []int32) bool {
+func Dtrcon(norm, ul, d byte, n int, a []float64, lda int, rcond, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -24201,7 +25195,7 @@ func Dtrcon(norm, ul, d byte, n int, a []float64, lda int, rcond, work []float64
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24271,13 +25265,13 @@ func Ztrcon(norm, ul, d byte, n int, a []complex128, lda int, rcond []float64, w
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strevc.f.
-func Strevc(side, howmny byte, sel []int32, n int, t []float32, ldt int, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []int32, work []float32) bool {
+func Strevc(side, howmny byte, sel []Int, n int, t []float32, ldt int, vl []float32, ldvl int, vr []float32, ldvr, mm int, m []Int, work []float32) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24293,7 +25287,7 @@ func Strevc(side, howmny byte, sel []int32, n int, t []float32, ldt int, vl []fl
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24305,13 +25299,13 @@ func Strevc(side, howmny byte, sel []int32, n int, t []float32, ldt int, vl []fl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrevc.f.
-func Dtrevc(side, howmny byte, sel []int32, n int, t []float64, ldt int, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []int32, work []float64) bool {
+func Dtrevc(side, howmny byte, sel []Int, n int, t []float64, ldt int, vl []float64, ldvl int, vr []float64, ldvr, mm int, m []Int, work []float64) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24327,7 +25321,7 @@ func Dtrevc(side, howmny byte, sel []int32, n int, t []float64, ldt int, vl []fl
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24339,13 +25333,13 @@ func Dtrevc(side, howmny byte, sel []int32, n int, t []float64, ldt int, vl []fl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrevc.f.
-func Ctrevc(side, howmny byte, sel []int32, n int, t []complex64, ldt int, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []int32, work []complex64, rwork []float32) bool {
+func Ctrevc(side, howmny byte, sel []Int, n int, t []complex64, ldt int, vl []complex64, ldvl int, vr []complex64, ldvr, mm int, m []Int, work []complex64, rwork []float32) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24361,7 +25355,7 @@ func Ctrevc(side, howmny byte, sel []int32, n int, t []complex64, ldt int, vl []
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24377,13 +25371,13 @@ func Ctrevc(side, howmny byte, sel []int32, n int, t []complex64, ldt int, vl []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrevc.f.
-func Ztrevc(side, howmny byte, sel []int32, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []int32, work []complex128, rwork []float64) bool {
+func Ztrevc(side, howmny byte, sel []Int, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr, mm int, m []Int, work []complex128, rwork []float64) bool {
 	switch side {
 	case 'L', 'R':
 	default:
 		panic("lapack: bad side")
 	}
-	var _sel *int32
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24399,7 +25393,7 @@ func Ztrevc(side, howmny byte, sel []int32, n int, t []complex128, ldt int, vl [
 	if len(vr) > 0 {
 		_vr = &vr[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24415,7 +25409,7 @@ func Ztrevc(side, howmny byte, sel []int32, n int, t []complex128, ldt int, vl [
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strexc.f.
-func Strexc(compq byte, n int, t []float32, ldt int, q []float32, ldq int, ifst, ilst []int32, work []float32) bool {
+func Strexc(compq byte, n int, t []float32, ldt int, q []float32, ldq int, ifst, ilst []Int, work []float32) bool {
 	var _t *float32
 	if len(t) > 0 {
 		_t = &t[0]
@@ -24424,11 +25418,11 @@ func Strexc(compq byte, n int, t []float32, ldt int, q []float32, ldq int, ifst,
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _ifst *int32
+	var _ifst *Int
 	if len(ifst) > 0 {
 		_ifst = &ifst[0]
 	}
-	var _ilst *int32
+	var _ilst *Int
 	if len(ilst) > 0 {
 		_ilst = &ilst[0]
 	}
@@ -24440,7 +25434,7 @@ func Strexc(compq byte, n int, t []float32, ldt int, q []float32, ldq int, ifst,
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrexc.f.
-func Dtrexc(compq byte, n int, t []float64, ldt int, q []float64, ldq int, ifst, ilst []int32, work []float64) bool {
+func Dtrexc(compq byte, n int, t []float64, ldt int, q []float64, ldq int, ifst, ilst []Int, work []float64) bool {
 	var _t *float64
 	if len(t) > 0 {
 		_t = &t[0]
@@ -24449,11 +25443,11 @@ func Dtrexc(compq byte, n int, t []float64, ldt int, q []float64, ldq int, ifst,
 	if len(q) > 0 {
 		_q = &q[0]
 	}
-	var _ifst *int32
+	var _ifst *Int
 	if len(ifst) > 0 {
 		_ifst = &ifst[0]
 	}
-	var _ilst *int32
+	var _ilst *Int
 	if len(ilst) > 0 {
 		_ilst = &ilst[0]
 	}
@@ -24491,7 +25485,7 @@ func Ztrexc(compq byte, n int, t []complex128, ldt int, q []complex128, ldq, ifs
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strrfs.f.
-func Strrfs(ul, trans, d byte, n, nrhs int, a []float32, lda int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []int32) bool {
+func Strrfs(ul, trans, d byte, n, nrhs int, a []float32, lda int, b []float32, ldb int, x []float32, ldx int, ferr, berr, work []float32, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -24531,7 +25525,7 @@ func Strrfs(ul, trans, d byte, n, nrhs int, a []float32, lda int, b []float32, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24539,7 +25533,7 @@ func Strrfs(ul, trans, d byte, n, nrhs int, a []float32, lda int, b []float32, l
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrrfs.f.
-func Dtrrfs(ul, trans, d byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int32) bool {
+func Dtrrfs(ul, trans, d byte, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []Int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -24579,7 +25573,7 @@ func Dtrrfs(ul, trans, d byte, n, nrhs int, a []float64, lda int, b []float64, l
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24683,8 +25677,8 @@ func Ztrrfs(ul, trans, d byte, n, nrhs int, a []complex128, lda int, b []complex
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strsen.f.
-func Strsen(job, compq byte, sel []int32, n int, t []float32, ldt int, q []float32, ldq int, wr, wi []float32, m []int32, s, sep, work []float32, lwork int, iwork []int32, liwork int) bool {
-	var _sel *int32
+func Strsen(job, compq byte, sel []Int, n int, t []float32, ldt int, q []float32, ldq int, wr, wi []float32, m []Int, s, sep, work []float32, lwork int, iwork []Int, liwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24704,7 +25698,7 @@ func Strsen(job, compq byte, sel []int32, n int, t []float32, ldt int, q []float
 	if len(wi) > 0 {
 		_wi = &wi[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24720,7 +25714,7 @@ func Strsen(job, compq byte, sel []int32, n int, t []float32, ldt int, q []float
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24728,8 +25722,8 @@ func Strsen(job, compq byte, sel []int32, n int, t []float32, ldt int, q []float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrsen.f.
-func Dtrsen(job, compq byte, sel []int32, n int, t []float64, ldt int, q []float64, ldq int, wr, wi []float64, m []int32, s, sep, work []float64, lwork int, iwork []int32, liwork int) bool {
-	var _sel *int32
+func Dtrsen(job, compq byte, sel []Int, n int, t []float64, ldt int, q []float64, ldq int, wr, wi []float64, m []Int, s, sep, work []float64, lwork int, iwork []Int, liwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24749,7 +25743,7 @@ func Dtrsen(job, compq byte, sel []int32, n int, t []float64, ldt int, q []float
 	if len(wi) > 0 {
 		_wi = &wi[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24765,7 +25759,7 @@ func Dtrsen(job, compq byte, sel []int32, n int, t []float64, ldt int, q []float
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24773,8 +25767,8 @@ func Dtrsen(job, compq byte, sel []int32, n int, t []float64, ldt int, q []float
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrsen.f.
-func Ctrsen(job, compq byte, sel []int32, n int, t []complex64, ldt int, q []complex64, ldq int, w []complex64, m []int32, s, sep []float32, work []complex64, lwork int) bool {
-	var _sel *int32
+func Ctrsen(job, compq byte, sel []Int, n int, t []complex64, ldt int, q []complex64, ldq int, w []complex64, m []Int, s, sep []float32, work []complex64, lwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24790,7 +25784,7 @@ func Ctrsen(job, compq byte, sel []int32, n int, t []complex64, ldt int, q []com
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24810,8 +25804,8 @@ func Ctrsen(job, compq byte, sel []int32, n int, t []complex64, ldt int, q []com
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrsen.f.
-func Ztrsen(job, compq byte, sel []int32, n int, t []complex128, ldt int, q []complex128, ldq int, w []complex128, m []int32, s, sep []float64, work []complex128, lwork int) bool {
-	var _sel *int32
+func Ztrsen(job, compq byte, sel []Int, n int, t []complex128, ldt int, q []complex128, ldq int, w []complex128, m []Int, s, sep []float64, work []complex128, lwork int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24827,7 +25821,7 @@ func Ztrsen(job, compq byte, sel []int32, n int, t []complex128, ldt int, q []co
 	if len(w) > 0 {
 		_w = &w[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24847,8 +25841,8 @@ func Ztrsen(job, compq byte, sel []int32, n int, t []complex128, ldt int, q []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/strsna.f.
-func Strsna(job, howmny byte, sel []int32, n int, t []float32, ldt int, vl []float32, ldvl int, vr []float32, ldvr int, s, sep []float32, mm int, m []int32, work []float32, ldwork int, iwork []int32) bool {
-	var _sel *int32
+func Strsna(job, howmny byte, sel []Int, n int, t []float32, ldt int, vl []float32, ldvl int, vr []float32, ldvr int, s, sep []float32, mm int, m []Int, work []float32, ldwork int, iwork []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24872,7 +25866,7 @@ func Strsna(job, howmny byte, sel []int32, n int, t []float32, ldt int, vl []flo
 	if len(sep) > 0 {
 		_sep = &sep[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24880,7 +25874,7 @@ func Strsna(job, howmny byte, sel []int32, n int, t []float32, ldt int, vl []flo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24888,8 +25882,8 @@ func Strsna(job, howmny byte, sel []int32, n int, t []float32, ldt int, vl []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dtrsna.f.
-func Dtrsna(job, howmny byte, sel []int32, n int, t []float64, ldt int, vl []float64, ldvl int, vr []float64, ldvr int, s, sep []float64, mm int, m []int32, work []float64, ldwork int, iwork []int32) bool {
-	var _sel *int32
+func Dtrsna(job, howmny byte, sel []Int, n int, t []float64, ldt int, vl []float64, ldvl int, vr []float64, ldvr int, s, sep []float64, mm int, m []Int, work []float64, ldwork int, iwork []Int) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24913,7 +25907,7 @@ func Dtrsna(job, howmny byte, sel []int32, n int, t []float64, ldt int, vl []flo
 	if len(sep) > 0 {
 		_sep = &sep[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24921,7 +25915,7 @@ func Dtrsna(job, howmny byte, sel []int32, n int, t []float64, ldt int, vl []flo
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -24929,8 +25923,8 @@ func Dtrsna(job, howmny byte, sel []int32, n int, t []float64, ldt int, vl []flo
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ctrsna.f.
-func Ctrsna(job, howmny byte, sel []int32, n int, t []complex64, ldt int, vl []complex64, ldvl int, vr []complex64, ldvr int, s, sep []float32, mm int, m []int32, work []complex64, ldwork int, rwork []float32) bool {
-	var _sel *int32
+func Ctrsna(job, howmny byte, sel []Int, n int, t []complex64, ldt int, vl []complex64, ldvl int, vr []complex64, ldvr int, s, sep []float32, mm int, m []Int, work []complex64, ldwork int, rwork []float32) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24954,7 +25948,7 @@ func Ctrsna(job, howmny byte, sel []int32, n int, t []complex64, ldt int, vl []c
 	if len(sep) > 0 {
 		_sep = &sep[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -24970,8 +25964,8 @@ func Ctrsna(job, howmny byte, sel []int32, n int, t []complex64, ldt int, vl []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ztrsna.f.
-func Ztrsna(job, howmny byte, sel []int32, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr int, s, sep []float64, mm int, m []int32, work []complex128, ldwork int, rwork []float64) bool {
-	var _sel *int32
+func Ztrsna(job, howmny byte, sel []Int, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr int, s, sep []float64, mm int, m []Int, work []complex128, ldwork int, rwork []float64) bool {
+	var _sel *Int
 	if len(sel) > 0 {
 		_sel = &sel[0]
 	}
@@ -24995,7 +25989,7 @@ func Ztrsna(job, howmny byte, sel []int32, n int, t []complex128, ldt int, vl []
 	if len(sep) > 0 {
 		_sep = &sep[0]
 	}
-	var _m *int32
+	var _m *Int
 	if len(m) > 0 {
 		_m = &m[0]
 	}
@@ -26517,7 +27511,7 @@ func Cheswapr(ul byte, n int, a []complex64, i1, i2 int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetri2.f.
-func Chetri2(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64, lwork int) bool {
+func Chetri2(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -26527,7 +27521,7 @@ func Chetri2(ul byte, n int, a []complex64, lda int, ipiv []int32, work []comple
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -26539,7 +27533,7 @@ func Chetri2(ul byte, n int, a []complex64, lda int, ipiv []int32, work []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetri2x.f.
-func Chetri2x(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64, nb int) bool {
+func Chetri2x(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, nb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -26549,7 +27543,7 @@ func Chetri2x(ul byte, n int, a []complex64, lda int, ipiv []int32, work []compl
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -26561,7 +27555,7 @@ func Chetri2x(ul byte, n int, a []complex64, lda int, ipiv []int32, work []compl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/chetrs2.f.
-func Chetrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int, work []complex64) bool {
+func Chetrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -26571,7 +27565,7 @@ func Chetrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []com
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -26587,7 +27581,7 @@ func Chetrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []com
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csyconv.f.
-func Csyconv(ul, way byte, n int, a []complex64, lda int, ipiv []int32, work []complex64) bool {
+func Csyconv(ul, way byte, n int, a []complex64, lda int, ipiv []Int, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -26597,7 +27591,7 @@ func Csyconv(ul, way byte, n int, a []complex64, lda int, ipiv []int32, work []c
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -26623,7 +27617,7 @@ func Csyswapr(ul byte, n int, a []complex64, i1, i2 int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytri2.f.
-func Csytri2(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64, lwork int) bool {
+func Csytri2(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -26633,7 +27627,7 @@ func Csytri2(ul byte, n int, a []complex64, lda int, ipiv []int32, work []comple
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -26645,7 +27639,7 @@ func Csytri2(ul byte, n int, a []complex64, lda int, ipiv []int32, work []comple
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytri2x.f.
-func Csytri2x(ul byte, n int, a []complex64, lda int, ipiv []int32, work []complex64, nb int) bool {
+func Csytri2x(ul byte, n int, a []complex64, lda int, ipiv []Int, work []complex64, nb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -26655,7 +27649,7 @@ func Csytri2x(ul byte, n int, a []complex64, lda int, ipiv []int32, work []compl
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -26667,7 +27661,7 @@ func Csytri2x(ul byte, n int, a []complex64, lda int, ipiv []int32, work []compl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/csytrs2.f.
-func Csytrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []complex64, ldb int, work []complex64) bool {
+func Csytrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []Int, b []complex64, ldb int, work []complex64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -26677,7 +27671,7 @@ func Csytrs2(ul byte, n, nrhs int, a []complex64, lda int, ipiv []int32, b []com
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -26747,7 +27741,7 @@ func Cunbdb(trans, signs byte, m, p, q int, x11 []complex64, ldx11 int, x12 []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cuncsd.f.
-func Cuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []complex64, ldx11 int, x12 []complex64, ldx12 int, x21 []complex64, ldx21 int, x22 []complex64, ldx22 int, theta []float32, u1 []complex64, ldu1 int, u2 []complex64, ldu2 int, v1t []complex64, ldv1t int, v2t []complex64, ldv2t int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32) bool {
+func Cuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []complex64, ldx11 int, x12 []complex64, ldx12 int, x21 []complex64, ldx21 int, x22 []complex64, ldx22 int, theta []float32, u1 []complex64, ldu1 int, u2 []complex64, ldu2 int, v1t []complex64, ldv1t int, v2t []complex64, ldv2t int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -26797,7 +27791,7 @@ func Cuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -26805,7 +27799,7 @@ func Cuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cuncsd2by1.f.
-func Cuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex64, ldx11 int, x21 []complex64, ldx21 int, theta, u1 []complex64, ldu1 int, u2 []complex64, ldu2 int, v1t []complex64, ldv1t int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []int32) bool {
+func Cuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex64, ldx11 int, x21 []complex64, ldx21 int, theta, u1 []complex64, ldu1 int, u2 []complex64, ldu2 int, v1t []complex64, ldv1t int, work []complex64, lwork int, rwork []float32, lrwork int, iwork []Int) bool {
 	var _x11 *complex64
 	if len(x11) > 0 {
 		_x11 = &x11[0]
@@ -26838,7 +27832,7 @@ func Cuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex64, ldx11 i
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -26970,7 +27964,7 @@ func Dorbdb(trans, signs byte, m, p, q int, x11 []float64, ldx11 int, x12 []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorcsd.f.
-func Dorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []float64, ldx11 int, x12 []float64, ldx12 int, x21 []float64, ldx21 int, x22 []float64, ldx22 int, theta, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, v2t []float64, ldv2t int, work []float64, lwork int, iwork []int32) bool {
+func Dorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []float64, ldx11 int, x12 []float64, ldx12 int, x21 []float64, ldx21 int, x22 []float64, ldx22 int, theta, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, v2t []float64, ldv2t int, work []float64, lwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -27016,7 +28010,7 @@ func Dorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -27024,7 +28018,7 @@ func Dorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dorcsd2by1.f.
-func Dorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float64, ldx11 int, x21 []float64, ldx21 int, theta, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, work []float64, lwork int, iwork []int32) bool {
+func Dorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float64, ldx11 int, x21 []float64, ldx21 int, theta, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, work []float64, lwork int, iwork []Int) bool {
 	var _x11 *float64
 	if len(x11) > 0 {
 		_x11 = &x11[0]
@@ -27053,7 +28047,7 @@ func Dorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float64, ldx11 int
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -27061,7 +28055,7 @@ func Dorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float64, ldx11 int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsyconv.f.
-func Dsyconv(ul, way byte, n int, a []float64, lda int, ipiv []int32, work []float64) bool {
+func Dsyconv(ul, way byte, n int, a []float64, lda int, ipiv []Int, work []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27071,7 +28065,7 @@ func Dsyconv(ul, way byte, n int, a []float64, lda int, ipiv []int32, work []flo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27097,7 +28091,7 @@ func Dsyswapr(ul byte, n int, a []float64, i1, i2 int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytri2.f.
-func Dsytri2(ul byte, n int, a []float64, lda int, ipiv []int32, work []complex128, lwork int) bool {
+func Dsytri2(ul byte, n int, a []float64, lda int, ipiv []Int, work []complex128, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27107,7 +28101,7 @@ func Dsytri2(ul byte, n int, a []float64, lda int, ipiv []int32, work []complex1
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27119,7 +28113,7 @@ func Dsytri2(ul byte, n int, a []float64, lda int, ipiv []int32, work []complex1
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytri2x.f.
-func Dsytri2x(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64, nb int) bool {
+func Dsytri2x(ul byte, n int, a []float64, lda int, ipiv []Int, work []float64, nb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27129,7 +28123,7 @@ func Dsytri2x(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27141,7 +28135,7 @@ func Dsytri2x(ul byte, n int, a []float64, lda int, ipiv []int32, work []float64
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dsytrs2.f.
-func Dsytrs2(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float64, ldb int, work []float64) bool {
+func Dsytrs2(ul byte, n, nrhs int, a []float64, lda int, ipiv []Int, b []float64, ldb int, work []float64) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27151,7 +28145,7 @@ func Dsytrs2(ul byte, n, nrhs int, a []float64, lda int, ipiv []int32, b []float
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27291,7 +28285,7 @@ func Sorbdb(trans, signs byte, m, p, q int, x11 []float32, ldx11 int, x12 []floa
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorcsd.f.
-func Sorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []float32, ldx11 int, x12 []float32, ldx12 int, x21 []float32, ldx21 int, x22 []float32, ldx22 int, theta, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, v2t []float32, ldv2t int, work []float32, lwork int, iwork []int32) bool {
+func Sorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []float32, ldx11 int, x12 []float32, ldx12 int, x21 []float32, ldx21 int, x22 []float32, ldx22 int, theta, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, v2t []float32, ldv2t int, work []float32, lwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -27337,7 +28331,7 @@ func Sorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -27345,7 +28339,7 @@ func Sorcsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sorcsd2by1.f.
-func Sorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float32, ldx11 int, x21 []float32, ldx21 int, theta, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, work []float32, lwork int, iwork []int32) bool {
+func Sorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float32, ldx11 int, x21 []float32, ldx21 int, theta, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, work []float32, lwork int, iwork []Int) bool {
 	var _x11 *float32
 	if len(x11) > 0 {
 		_x11 = &x11[0]
@@ -27374,7 +28368,7 @@ func Sorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float32, ldx11 int
 	if len(work) > 0 {
 		_work = &work[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -27382,7 +28376,7 @@ func Sorcsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []float32, ldx11 int
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssyconv.f.
-func Ssyconv(ul, way byte, n int, a []float32, lda int, ipiv []int32, work []float32) bool {
+func Ssyconv(ul, way byte, n int, a []float32, lda int, ipiv []Int, work []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27392,7 +28386,7 @@ func Ssyconv(ul, way byte, n int, a []float32, lda int, ipiv []int32, work []flo
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27418,7 +28412,7 @@ func Ssyswapr(ul byte, n int, a []float32, i1, i2 int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytri2.f.
-func Ssytri2(ul byte, n int, a []float32, lda int, ipiv []int32, work []complex64, lwork int) bool {
+func Ssytri2(ul byte, n int, a []float32, lda int, ipiv []Int, work []complex64, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27428,7 +28422,7 @@ func Ssytri2(ul byte, n int, a []float32, lda int, ipiv []int32, work []complex6
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27440,7 +28434,7 @@ func Ssytri2(ul byte, n int, a []float32, lda int, ipiv []int32, work []complex6
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytri2x.f.
-func Ssytri2x(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32, nb int) bool {
+func Ssytri2x(ul byte, n int, a []float32, lda int, ipiv []Int, work []float32, nb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27450,7 +28444,7 @@ func Ssytri2x(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27462,7 +28456,7 @@ func Ssytri2x(ul byte, n int, a []float32, lda int, ipiv []int32, work []float32
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/ssytrs2.f.
-func Ssytrs2(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float32, ldb int, work []float32) bool {
+func Ssytrs2(ul byte, n, nrhs int, a []float32, lda int, ipiv []Int, b []float32, ldb int, work []float32) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27472,7 +28466,7 @@ func Ssytrs2(ul byte, n, nrhs int, a []float32, lda int, ipiv []int32, b []float
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27572,7 +28566,7 @@ func Zheswapr(ul byte, n int, a []complex128, i1, i2 int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetri2.f.
-func Zhetri2(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128, lwork int) bool {
+func Zhetri2(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27582,7 +28576,7 @@ func Zhetri2(ul byte, n int, a []complex128, lda int, ipiv []int32, work []compl
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27594,7 +28588,7 @@ func Zhetri2(ul byte, n int, a []complex128, lda int, ipiv []int32, work []compl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetri2x.f.
-func Zhetri2x(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128, nb int) bool {
+func Zhetri2x(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, nb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27604,7 +28598,7 @@ func Zhetri2x(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comp
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27616,7 +28610,7 @@ func Zhetri2x(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comp
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zhetrs2.f.
-func Zhetrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int, work []complex128) bool {
+func Zhetrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27626,7 +28620,7 @@ func Zhetrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []co
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27642,7 +28636,7 @@ func Zhetrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []co
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsyconv.f.
-func Zsyconv(ul, way byte, n int, a []complex128, lda int, ipiv []int32, work []complex128) bool {
+func Zsyconv(ul, way byte, n int, a []complex128, lda int, ipiv []Int, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27652,7 +28646,7 @@ func Zsyconv(ul, way byte, n int, a []complex128, lda int, ipiv []int32, work []
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27678,7 +28672,7 @@ func Zsyswapr(ul byte, n int, a []complex128, i1, i2 int) bool {
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytri2.f.
-func Zsytri2(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128, lwork int) bool {
+func Zsytri2(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, lwork int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27688,7 +28682,7 @@ func Zsytri2(ul byte, n int, a []complex128, lda int, ipiv []int32, work []compl
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27700,7 +28694,7 @@ func Zsytri2(ul byte, n int, a []complex128, lda int, ipiv []int32, work []compl
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytri2x.f.
-func Zsytri2x(ul byte, n int, a []complex128, lda int, ipiv []int32, work []complex128, nb int) bool {
+func Zsytri2x(ul byte, n int, a []complex128, lda int, ipiv []Int, work []complex128, nb int) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27710,7 +28704,7 @@ func Zsytri2x(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comp
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27722,7 +28716,7 @@ func Zsytri2x(ul byte, n int, a []complex128, lda int, ipiv []int32, work []comp
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zsytrs2.f.
-func Zsytrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []complex128, ldb int, work []complex128) bool {
+func Zsytrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []Int, b []complex128, ldb int, work []complex128) bool {
 	switch ul {
 	case 'U', 'L':
 	default:
@@ -27732,7 +28726,7 @@ func Zsytrs2(ul byte, n, nrhs int, a []complex128, lda int, ipiv []int32, b []co
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	var _ipiv *int32
+	var _ipiv *Int
 	if len(ipiv) > 0 {
 		_ipiv = &ipiv[0]
 	}
@@ -27802,7 +28796,7 @@ func Zunbdb(trans, signs byte, m, p, q int, x11 []complex128, ldx11 int, x12 []c
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zuncsd.f.
-func Zuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []complex128, ldx11 int, x12 []complex128, ldx12 int, x21 []complex128, ldx21 int, x22 []complex128, ldx22 int, theta []float64, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, v2t []complex128, ldv2t int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32) bool {
+func Zuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []complex128, ldx11 int, x12 []complex128, ldx12 int, x21 []complex128, ldx21 int, x22 []complex128, ldx22 int, theta []float64, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, v2t []complex128, ldv2t int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int) bool {
 	switch trans {
 	case 'N', 'T', 'C':
 	default:
@@ -27852,7 +28846,7 @@ func Zuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -27860,7 +28854,7 @@ func Zuncsd(jobu1, jobu2, jobv1t, jobv2t, trans, signs byte, m, p, q int, x11 []
 }
 
 // See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zuncsd2by1.f.
-func Zuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex128, ldx11 int, x21 []complex128, ldx21 int, theta, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int32) bool {
+func Zuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex128, ldx11 int, x21 []complex128, ldx21 int, theta, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []Int) bool {
 	var _x11 *complex128
 	if len(x11) > 0 {
 		_x11 = &x11[0]
@@ -27893,7 +28887,7 @@ func Zuncsd2by1(jobu1, jobu2, jobv1t byte, m, p, q int, x11 []complex128, ldx11
 	if len(rwork) > 0 {
 		_rwork = &rwork[0]
 	}
-	var _iwork *int32
+	var _iwork *Int
 	if len(iwork) > 0 {
 		_iwork = &iwork[0]
 	}
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !ilp64
+
+package lapacke
+
+// Int is the Go type corresponding to lapack_int. It is int32 unless the
+// package is built with the ilp64 build tag.
+type Int = int32
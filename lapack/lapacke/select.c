@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "lapacke.h"
+#include "select.h"
+#include "_cgo_export.h"
+
+// netlib_select is the handle of the selector function used by the LAPACKE
+// call in progress on the current thread.
+static _Thread_local uintptr_t netlib_select;
+
+uintptr_t netlib_select_swap(uintptr_t h) {
+	uintptr_t prev = netlib_select;
+	netlib_select = h;
+	return prev;
+}
+
+lapack_logical netlib_s_select2(const float *wr, const float *wi) {
+	return goSSelect2(netlib_select, *wr, *wi);
+}
+
+lapack_logical netlib_s_select3(const float *alphar, const float *alphai, const float *beta) {
+	return goSSelect3(netlib_select, *alphar, *alphai, *beta);
+}
+
+lapack_logical netlib_d_select2(const double *wr, const double *wi) {
+	return goDSelect2(netlib_select, *wr, *wi);
+}
+
+lapack_logical netlib_d_select3(const double *alphar, const double *alphai, const double *beta) {
+	return goDSelect3(netlib_select, *alphar, *alphai, *beta);
+}
+
+lapack_logical netlib_c_select1(const lapack_complex_float *w) {
+	return goCSelect1(netlib_select, *w);
+}
+
+lapack_logical netlib_c_select2(const lapack_complex_float *alpha, const lapack_complex_float *beta) {
+	return goCSelect2(netlib_select, *alpha, *beta);
+}
+
+lapack_logical netlib_z_select1(const lapack_complex_double *w) {
+	return goZSelect1(netlib_select, *w);
+}
+
+lapack_logical netlib_z_select2(const lapack_complex_double *alpha, const lapack_complex_double *beta) {
+	return goZSelect2(netlib_select, *alpha, *beta);
+}
@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include "suffix64.h"
+#include "lapacke.h"
+#include "select.h"
+*/
+import "C"
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Routines such as Dgees and Dgges take a Go selector function. LAPACK calls
+// selector functions without a user data argument, so the routines pass a C
+// trampoline to LAPACKE instead, and register the Go function in selectors.
+// The handle of the registered function is stored in a thread-local C
+// variable for the duration of the call, and the trampoline passes it back
+// to the exported Go functions below.
+//
+// A selector function must not panic.
+var selectors = struct {
+	sync.Mutex
+	next  uintptr
+	funcs map[uintptr]interface{}
+}{funcs: make(map[uintptr]interface{})}
+
+// useSelect registers sel as the selector function for the LAPACKE call made
+// next by the calling goroutine. The returned function must be called when
+// the LAPACKE call has returned.
+func useSelect(sel interface{}) (done func()) {
+	selectors.Lock()
+	selectors.next++
+	h := selectors.next
+	selectors.funcs[h] = sel
+	selectors.Unlock()
+
+	// The handle is only visible to the current thread.
+	runtime.LockOSThread()
+	prev := C.netlib_select_swap(C.uintptr_t(h))
+	return func() {
+		C.netlib_select_swap(prev)
+		runtime.UnlockOSThread()
+
+		selectors.Lock()
+		delete(selectors.funcs, h)
+		selectors.Unlock()
+	}
+}
+
+// selector returns the selector function registered with the handle h.
+func selector(h C.uintptr_t) interface{} {
+	selectors.Lock()
+	sel := selectors.funcs[uintptr(h)]
+	selectors.Unlock()
+	return sel
+}
+
+func logical(b bool) C.lapack_logical {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+//export goSSelect2
+func goSSelect2(h C.uintptr_t, wr, wi C.float) C.lapack_logical {
+	return logical(selector(h).(func(wr, wi float32) bool)(float32(wr), float32(wi)))
+}
+
+//export goSSelect3
+func goSSelect3(h C.uintptr_t, alphar, alphai, beta C.float) C.lapack_logical {
+	return logical(selector(h).(func(alphar, alphai, beta float32) bool)(float32(alphar), float32(alphai), float32(beta)))
+}
+
+//export goDSelect2
+func goDSelect2(h C.uintptr_t, wr, wi C.double) C.lapack_logical {
+	return logical(selector(h).(func(wr, wi float64) bool)(float64(wr), float64(wi)))
+}
+
+//export goDSelect3
+func goDSelect3(h C.uintptr_t, alphar, alphai, beta C.double) C.lapack_logical {
+	return logical(selector(h).(func(alphar, alphai, beta float64) bool)(float64(alphar), float64(alphai), float64(beta)))
+}
+
+//export goCSelect1
+func goCSelect1(h C.uintptr_t, w C.lapack_complex_float) C.lapack_logical {
+	return logical(selector(h).(func(w complex64) bool)(complex64(w)))
+}
+
+//export goCSelect2
+func goCSelect2(h C.uintptr_t, alpha, beta C.lapack_complex_float) C.lapack_logical {
+	return logical(selector(h).(func(alpha, beta complex64) bool)(complex64(alpha), complex64(beta)))
+}
+
+//export goZSelect1
+func goZSelect1(h C.uintptr_t, w C.lapack_complex_double) C.lapack_logical {
+	return logical(selector(h).(func(w complex128) bool)(complex128(w)))
+}
+
+//export goZSelect2
+func goZSelect2(h C.uintptr_t, alpha, beta C.lapack_complex_double) C.lapack_logical {
+	return logical(selector(h).(func(alpha, beta complex128) bool)(complex128(alpha), complex128(beta)))
+}
@@ -0,0 +1,19 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include <stdint.h>
+
+// netlib_select_swap sets the handle of the Go selector function called by
+// the trampolines below on the current thread and returns the previous one.
+uintptr_t netlib_select_swap(uintptr_t h);
+
+// The trampolines passed to LAPACKE routines that take a selector function.
+lapack_logical netlib_s_select2(const float *wr, const float *wi);
+lapack_logical netlib_s_select3(const float *alphar, const float *alphai, const float *beta);
+lapack_logical netlib_d_select2(const double *wr, const double *wi);
+lapack_logical netlib_d_select3(const double *alphar, const double *alphai, const double *beta);
+lapack_logical netlib_c_select1(const lapack_complex_float *w);
+lapack_logical netlib_c_select2(const lapack_complex_float *alpha, const lapack_complex_float *beta);
+lapack_logical netlib_z_select1(const lapack_complex_double *w);
+lapack_logical netlib_z_select2(const lapack_complex_double *alpha, const lapack_complex_double *beta);
@@ -0,0 +1,742 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+var _ lapack.Complex128 = Implementation{}
+
+// Zgetrf computes the LU decomposition of an m×n complex matrix A using
+// partial pivoting with row interchanges.
+//
+// The LU decomposition is a factorization of A into
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a lower triangular with unit diagonal
+// elements (lower trapezoidal if m > n), and U is upper triangular (upper
+// trapezoidal if m < n).
+//
+// On entry, a contains the matrix A. On return, L and U are stored in place
+// into a, and P is represented by ipiv.
+//
+// ipiv contains a sequence of row swaps. It indicates that row i of the matrix
+// was interchanged with ipiv[i]. ipiv must have length min(m,n), and Zgetrf
+// will panic otherwise. ipiv is zero-indexed.
+//
+// Zgetrf returns whether the matrix A is nonsingular. The LU decomposition will
+// be computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equation.
+func (impl Implementation) Zgetrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	ix := newIndices(ipiv)
+	ok = lapacke.Zgetrf(m, n, a, lda, ix.buf)
+	ix.store()
+	return ok
+}
+
+// Zgetrs solves a system of equations using an LU factorization.
+// The system that is solved depends on trans:
+//
+//	trans == blas.NoTrans:   A * X = B
+//	trans == blas.Trans:     Aᵀ * X = B
+//	trans == blas.ConjTrans: Aᴴ * X = B
+//
+// A is a general n×n matrix with stride lda. B is a general matrix of size
+// n×nrhs.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Zgetrf. ipiv is zero-indexed.
+func (impl Implementation) Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	lapacke.Zgetrs(byte(trans), n, nrhs, a, lda, ix.buf, b, ldb)
+	ix.release()
+}
+
+// Zgetri computes the inverse of the matrix A using the LU factorization
+// computed by Zgetrf. On entry, a contains the PLU decomposition of A as
+// computed by Zgetrf and on exit contains the inverse of the original matrix.
+//
+// Zgetri will not perform the inversion if the matrix is singular, and returns
+// a boolean indicating whether the inversion was successful.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= max(1,n) and this function will panic otherwise.
+// Zgetri is a blocked inversion, but the block size is limited by the
+// temporary space available. If lwork == -1, instead of performing Zgetri,
+// the optimal work length will be stored into work[0].
+func (impl Implementation) Zgetri(n int, a []complex128, lda int, ipiv []int, work []complex128, lwork int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Zgetri(n, a, lda, nil, work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	ok = lapacke.Zgetri(n, a, lda, ix.buf, work, lwork)
+	ix.release()
+	return ok
+}
+
+// Zpotrf computes the Cholesky decomposition of the Hermitian positive definite
+// matrix A. If ul == blas.Upper, then A is stored as an upper-triangular matrix,
+// and a = Uᴴ U is stored in place into a. If ul == blas.Lower, then a = L Lᴴ
+// is computed and stored in-place into a. If a is not positive definite, false
+// is returned. This is the blocked version of the algorithm.
+func (impl Implementation) Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(shortA)
+	}
+
+	return lapacke.Zpotrf(byte(ul), n, a, lda)
+}
+
+// Zpotrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix and B is an n×nrhs matrix. The matrix A
+// is represented by its Cholesky factorization
+//
+//	A = Uᴴ*U  if uplo == blas.Upper
+//	A = L*Lᴴ  if uplo == blas.Lower
+//
+// as computed by Zpotrf. On entry, B contains the right-hand side matrix B, on
+// return it contains the solution matrix X.
+func (impl Implementation) Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	lapacke.Zpotrs(byte(uplo), n, nrhs, a, lda, b, ldb)
+}
+
+// Zpotri computes the inverse of a Hermitian positive definite matrix A
+// using its Cholesky factorization.
+//
+// On entry, a contains the triangular factor U or L from the Cholesky
+// factorization A = Uᴴ*U or A = L*Lᴴ, as computed by Zpotrf.
+// On return, a contains the upper or lower triangle of the (Hermitian)
+// inverse of A, overwriting the input factor U or L.
+func (impl Implementation) Zpotri(uplo blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(shortA)
+	}
+
+	return lapacke.Zpotri(byte(uplo), n, a, lda)
+}
+
+// Zgeqrf computes the QR factorization of the m×n complex matrix A using a
+// blocked algorithm. See the documentation for Dgeqrf for a description of
+// the parameters and of the representation of Q, where the elementary
+// reflectors are H_i = I - tau[i] * v * vᴴ.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// The length of work must be at least max(1, lwork) and lwork must be -1
+// or at least n, otherwise this function will panic.
+// Zgeqrf is a blocked QR factorization, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Zgeqrf,
+// the optimal work length will be stored into work[0].
+//
+// tau must have length at least min(m,n), and this function will panic otherwise.
+func (impl Implementation) Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	k := min(m, n)
+	if k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Zgeqrf(m, n, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	}
+
+	lapacke.Zgeqrf(m, n, a, lda, tau, work, lwork)
+}
+
+// Zungqr generates an m×n complex matrix Q with orthonormal columns defined by
+// the product of elementary reflectors as computed by Zgeqrf.
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}
+//
+// The length of tau must be at least k, and the length of work must be at least n.
+// It also must be that 0 <= k <= n and 0 <= n <= m.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= n, and the amount of blocking is limited by the usable
+// length. If lwork == -1, instead of computing Zungqr the optimal work length
+// is stored into work[0].
+//
+// Zungqr will panic if the conditions on input values are not met.
+func (impl Implementation) Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case n > m:
+		panic(nGTM)
+	case k < 0:
+		panic(kLT0)
+	case k > n:
+		panic(kGTN)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Zungqr(m, n, k, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	}
+
+	lapacke.Zungqr(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Zunmqr multiplies an m×n complex matrix C by a unitary matrix Q as
+//
+//	C = Q * C   if side == blas.Left  and trans == blas.NoTrans,
+//	C = Qᴴ * C  if side == blas.Left  and trans == blas.ConjTrans,
+//	C = C * Q   if side == blas.Right and trans == blas.NoTrans,
+//	C = C * Qᴴ  if side == blas.Right and trans == blas.ConjTrans,
+//
+// where Q is defined as the product of k elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}.
+//
+// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
+// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
+// The ith column of A contains the vector which defines the elementary
+// reflector H_i and tau[i] contains its scalar factor. tau must have length k
+// and Zunmqr will panic otherwise. Zgeqrf returns A and tau in the required
+// form.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Zunmqr will
+// panic.
+//
+// If lwork is -1, instead of performing Zunmqr, the optimal workspace size will
+// be stored into work[0].
+func (impl Implementation) Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case lda < max(1, k):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Zunmqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+k:
+		panic(shortA)
+	case len(tau) != k:
+		panic(badLenTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	lapacke.Zunmqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Zheev computes all eigenvalues and, optionally, the eigenvectors of a
+// complex Hermitian matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Zheev will panic otherwise.
+//
+// On entry, a contains the elements of the Hermitian matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute a contains the
+// orthonormal eigenvectors of A on exit, otherwise on exit the specified
+// triangular region is overwritten.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= 2*n-1, and Zheev will panic otherwise. The amount of blocking is
+// limited by the usable length. If lwork == -1, instead of computing Zheev the
+// optimal work length is stored into work[0].
+func (impl Implementation) Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, 2*n-1) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Zheev(byte(jobz), byte(uplo), n, a, lda, w, work, -1, nil)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	rwork := make([]float64, max(1, 3*n-2))
+	return lapacke.Zheev(byte(jobz), byte(uplo), n, a, lda, w, work, lwork, rwork)
+}
+
+// Zgeev computes the eigenvalues and, optionally, the left and/or right
+// eigenvectors for an n×n complex nonsymmetric matrix A.
+//
+// The right eigenvector v_j of A corresponding to an eigenvalue λ_j
+// is defined by
+//
+//	A v_j = λ_j v_j,
+//
+// and the left eigenvector u_j corresponding to an eigenvalue λ_j is defined by
+//
+//	u_jᴴ A = λ_j u_jᴴ,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, A will be overwritten and the left and right eigenvectors will be
+// stored, respectively, in the columns of the n×n matrices VL and VR in the
+// same order as their eigenvalues. The computed eigenvectors are normalized to
+// have Euclidean norm equal to 1 and largest component real.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone. Right eigenvectors will be
+// computed only if jobvr == lapack.RightEVCompute, otherwise jobvr must be
+// lapack.RightEVNone. For other values of jobvl and jobvr Zgeev will panic.
+//
+// w contains the computed eigenvalues and must have length at least n, and
+// Zgeev will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,2*n).
+// For good performance, lwork must generally be larger. On return, optimal
+// value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Zgeev, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// On return, first is the index of the first valid eigenvalue. If first == 0,
+// all eigenvalues and eigenvectors have been computed. If first is positive,
+// Zgeev failed to compute all the eigenvalues, no eigenvectors have been
+// computed and w[first:] contains those eigenvalues which have converged.
+func (impl Implementation) Zgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []complex128, lda int, w []complex128, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int) (first int) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(badLeftEVJob)
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(badRightEVJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldvl < 1 || (ldvl < n && wantvl):
+		panic(badLdVL)
+	case ldvr < 1 || (ldvr < n && wantvr):
+		panic(badLdVR)
+	case lwork < max(1, 2*n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0
+	}
+
+	// The leading dimensions of VL and VR are raised to n for the same
+	// reason as in Dgeev.
+
+	if lwork == -1 {
+		lapacke.Zgeev(byte(jobvl), byte(jobvr), n, a, lda, w, vl, max(n, ldvl), vr, max(n, ldvr), work, -1, nil)
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case len(vl) < (n-1)*ldvl+n && wantvl:
+		panic(shortVL)
+	case len(vr) < (n-1)*ldvr+n && wantvr:
+		panic(shortVR)
+	}
+
+	rwork := make([]float64, 2*n)
+	return lapacke.Zgeev(byte(jobvl), byte(jobvr), n, a, lda, w, vl, max(n, ldvl), vr, max(n, ldvr), work, lwork, rwork)
+}
+
+// Zgesvd computes the singular value decomposition of the complex input matrix A.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᴴ
+//
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m unitary matrix and V is an n×n unitary matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU, jobVT and the layout of u and vt are as described for Dgesvd, with Vᴴ
+// in place of Vᵀ.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least max(1, 2*min(m,n)+max(m,n)).
+// If lwork == -1, instead of performing Zgesvd, the optimal work length will be
+// stored into work[0]. Zgesvd will panic if the working memory has insufficient
+// storage.
+//
+// Zgesvd returns whether the decomposition successfully completed.
+func (impl Implementation) Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int) (ok bool) {
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuo := jobU == lapack.SVDOverwrite
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantuo || wantun) {
+		panic(badSVDJob)
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvo := jobVT == lapack.SVDOverwrite
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvo || wantvn) {
+		panic(badSVDJob)
+	}
+
+	if wantuo && wantvo {
+		panic(bothSVDOver)
+	}
+
+	minmn := min(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = 2*minmn + max(m, n)
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(badLdU)
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Zgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, nil)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
+		panic(shortU)
+	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
+		panic(shortVT)
+	}
+
+	rwork := make([]float64, 5*minmn)
+	return lapacke.Zgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork, rwork)
+}
+
+// Zgels finds a minimum-norm solution based on the complex matrices A and B
+// using the QR or LQ factorization. Zgels returns false if the matrix A is
+// singular, and true if this solution was successfully found.
+//
+// The problem solved is as described for Dgels, with trans == blas.ConjTrans
+// in place of blas.Trans. trans must be blas.NoTrans or blas.ConjTrans, and
+// Zgels will panic otherwise.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= min(m,n) + max(min(m,n),nrhs), and this function will
+// panic otherwise. A longer work will enable blocked algorithms to be called.
+// In the special case that lwork == -1, work[0] will be set to the optimal
+// working length.
+func (impl Implementation) Zgels(trans blas.Transpose, m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, work []complex128, lwork int) bool {
+	mn := min(m, n)
+	minwrk := mn + max(mn, nrhs)
+	switch {
+	case trans != blas.NoTrans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case lwork < max(1, minwrk) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		if max(m, n) > 0 && nrhs > 0 {
+			if len(b) < (max(m, n)-1)*ldb+nrhs {
+				panic(shortB)
+			}
+			for i := 0; i < max(m, n); i++ {
+				for j := 0; j < nrhs; j++ {
+					b[i*ldb+j] = 0
+				}
+			}
+		}
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Zgels(byte(trans), m, n, nrhs, a, lda, b, ldb, work, -1)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	return lapacke.Zgels(byte(trans), m, n, nrhs, a, lda, b, ldb, work, lwork)
+}
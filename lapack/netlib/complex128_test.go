@@ -0,0 +1,531 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/lapack"
+)
+
+const ztol = 1e-12
+
+// zrandom returns a random m×n complex matrix with stride ld.
+func zrandom(m, n, ld int, rnd *rand.Rand) []complex128 {
+	a := make([]complex128, max(0, (m-1)*ld+n))
+	for i := range a {
+		a[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return a
+}
+
+// zhpd returns a random n×n Hermitian positive definite matrix with stride ld.
+func zhpd(n, ld int, rnd *rand.Rand) []complex128 {
+	b := zrandom(n, n, n, rnd)
+	a := make([]complex128, max(0, (n-1)*ld+n))
+	gonum.Implementation{}.Zgemm(blas.ConjTrans, blas.NoTrans, n, n, n, 1, b, max(1, n), b, max(1, n), 0, a, ld)
+	for i := 0; i < n; i++ {
+		a[i*ld+i] = complex(real(a[i*ld+i])+float64(n), 0)
+	}
+	return a
+}
+
+// zmul returns op(A)*op(B) as a dense matrix with stride n where op(A) is m×k
+// and op(B) is k×n.
+func zmul(tA, tB blas.Transpose, m, n, k int, a []complex128, lda int, b []complex128, ldb int) []complex128 {
+	c := make([]complex128, m*n)
+	if m == 0 || n == 0 {
+		return c
+	}
+	gonum.Implementation{}.Zgemm(tA, tB, m, n, k, 1, a, lda, b, ldb, 0, c, n)
+	return c
+}
+
+// zeye returns the n×n identity matrix with stride n.
+func zeye(n int) []complex128 {
+	a := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = 1
+	}
+	return a
+}
+
+// zequalApprox reports whether the m×n matrices A and B are equal within tol
+// relative to the largest element of B.
+func zequalApprox(m, n int, a []complex128, lda int, b []complex128, ldb int, tol float64) bool {
+	scale := 1.0
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			scale = math.Max(scale, cmplx.Abs(b[i*ldb+j]))
+		}
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if cmplx.Abs(a[i*lda+j]-b[i*ldb+j]) > tol*scale {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestZgetrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{0, 0}, {1, 1}, {5, 5}, {5, 3}, {3, 5}, {20, 20}, {30, 12}, {12, 30},
+	} {
+		m, n := test.m, test.n
+		mn := min(m, n)
+		for _, extra := range []int{0, 3} {
+			lda := max(1, n) + extra
+			a := zrandom(m, n, lda, rnd)
+			aCopy := make([]complex128, len(a))
+			copy(aCopy, a)
+			ipiv := make([]int, mn)
+			ok := impl.Zgetrf(m, n, a, lda, ipiv)
+			if !ok {
+				t.Errorf("m=%d,n=%d: unexpected singular matrix", m, n)
+				continue
+			}
+			if mn == 0 {
+				continue
+			}
+
+			// Reconstruct A from P, L and U.
+			l := make([]complex128, m*mn)
+			for i := 0; i < m; i++ {
+				for j := 0; j < min(i, mn); j++ {
+					l[i*mn+j] = a[i*lda+j]
+				}
+				if i < mn {
+					l[i*mn+i] = 1
+				}
+			}
+			u := make([]complex128, mn*n)
+			for i := 0; i < mn; i++ {
+				for j := i; j < n; j++ {
+					u[i*n+j] = a[i*lda+j]
+				}
+			}
+			lu := zmul(blas.NoTrans, blas.NoTrans, m, n, mn, l, mn, u, n)
+			for i := mn - 1; i >= 0; i-- {
+				p := ipiv[i]
+				if p < i || m <= p {
+					t.Fatalf("m=%d,n=%d: pivot out of range: ipiv[%d]=%d", m, n, i, p)
+				}
+				for j := 0; j < n; j++ {
+					lu[i*n+j], lu[p*n+j] = lu[p*n+j], lu[i*n+j]
+				}
+			}
+			if !zequalApprox(m, n, lu, n, aCopy, lda, ztol) {
+				t.Errorf("m=%d,n=%d,lda=%d: P*L*U != A", m, n, lda)
+			}
+		}
+	}
+}
+
+func TestZgetrsZgetri(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 10, 25} {
+		for _, nrhs := range []int{0, 1, 3} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+				name := fmt.Sprintf("n=%d,nrhs=%d,trans=%c", n, nrhs, trans)
+				lda, ldb := max(1, n)+2, max(1, nrhs)+1
+				a := zrandom(n, n, lda, rnd)
+				aCopy := make([]complex128, len(a))
+				copy(aCopy, a)
+				b := zrandom(n, nrhs, ldb, rnd)
+				x := make([]complex128, len(b))
+				copy(x, b)
+
+				ipiv := make([]int, n)
+				impl.Zgetrf(n, n, a, lda, ipiv)
+				impl.Zgetrs(trans, n, nrhs, a, lda, ipiv, x, ldb)
+				if n > 0 && nrhs > 0 {
+					ax := zmul(trans, blas.NoTrans, n, nrhs, n, aCopy, lda, x, ldb)
+					if !zequalApprox(n, nrhs, ax, nrhs, b, ldb, 1e-10) {
+						t.Errorf("%s: op(A)*X != B", name)
+					}
+				}
+
+				if nrhs != 0 || trans != blas.NoTrans {
+					continue
+				}
+				work := make([]complex128, 1)
+				impl.Zgetri(n, a, lda, ipiv, work, -1)
+				lwork := int(real(work[0]))
+				work = make([]complex128, max(1, lwork))
+				ok := impl.Zgetri(n, a, lda, ipiv, work, lwork)
+				if !ok {
+					t.Errorf("%s: unexpected singular matrix", name)
+				}
+				if n > 0 {
+					aainv := zmul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, lda, a, lda)
+					if !zequalApprox(n, n, aainv, n, zeye(n), n, 1e-10) {
+						t.Errorf("%s: A*inv(A) != I", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestZpotrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 10, 25} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			lda := max(1, n) + 3
+			a := zhpd(n, lda, rnd)
+			aCopy := make([]complex128, len(a))
+			copy(aCopy, a)
+
+			ok := impl.Zpotrf(uplo, n, a, lda)
+			if !ok {
+				t.Errorf("%s: unexpected failure for positive definite matrix", name)
+				continue
+			}
+			if n == 0 {
+				continue
+			}
+
+			// Reconstruct A from its Cholesky factor.
+			f := make([]complex128, n*n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+						f[i*n+j] = a[i*lda+j]
+					}
+				}
+			}
+			var got []complex128
+			if uplo == blas.Upper {
+				got = zmul(blas.ConjTrans, blas.NoTrans, n, n, n, f, n, f, n)
+			} else {
+				got = zmul(blas.NoTrans, blas.ConjTrans, n, n, n, f, n, f, n)
+			}
+			if !zequalApprox(n, n, got, n, aCopy, lda, ztol) {
+				t.Errorf("%s: factorization does not reconstruct A", name)
+			}
+
+			nrhs := 3
+			ldb := nrhs + 2
+			b := zrandom(n, nrhs, ldb, rnd)
+			x := make([]complex128, len(b))
+			copy(x, b)
+			impl.Zpotrs(uplo, n, nrhs, a, lda, x, ldb)
+			ax := zmul(blas.NoTrans, blas.NoTrans, n, nrhs, n, aCopy, lda, x, ldb)
+			if !zequalApprox(n, nrhs, ax, nrhs, b, ldb, 1e-10) {
+				t.Errorf("%s: A*X != B", name)
+			}
+
+			ok = impl.Zpotri(uplo, n, a, lda)
+			if !ok {
+				t.Errorf("%s: unexpected failure of Zpotri", name)
+				continue
+			}
+			ainv := make([]complex128, n*n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+						ainv[i*n+j] = a[i*lda+j]
+						ainv[j*n+i] = cmplx.Conj(a[i*lda+j])
+					}
+				}
+			}
+			aainv := zmul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, lda, ainv, n)
+			if !zequalApprox(n, n, aainv, n, zeye(n), n, 1e-10) {
+				t.Errorf("%s: A*inv(A) != I", name)
+			}
+		}
+	}
+}
+
+func TestZgeqrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{0, 0}, {1, 1}, {5, 5}, {8, 3}, {3, 8}, {30, 20}, {20, 30},
+	} {
+		m, n := test.m, test.n
+		k := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		lda := max(1, n) + 2
+		a := zrandom(m, n, lda, rnd)
+		aCopy := make([]complex128, len(a))
+		copy(aCopy, a)
+		tau := make([]complex128, k)
+
+		work := make([]complex128, 1)
+		impl.Zgeqrf(m, n, a, lda, tau, work, -1)
+		lwork := int(real(work[0]))
+		work = make([]complex128, max(1, lwork))
+		impl.Zgeqrf(m, n, a, lda, tau, work, lwork)
+		if k == 0 {
+			continue
+		}
+
+		// R is the upper triangle of a extended by zeros to m×n.
+		r := make([]complex128, m*n)
+		for i := 0; i < k; i++ {
+			for j := i; j < n; j++ {
+				r[i*n+j] = a[i*lda+j]
+			}
+		}
+
+		// Q*R must be equal to A.
+		qr := make([]complex128, len(r))
+		copy(qr, r)
+		work = make([]complex128, 1)
+		impl.Zunmqr(blas.Left, blas.NoTrans, m, n, k, a, lda, tau, qr, n, work, -1)
+		lwork = int(real(work[0]))
+		work = make([]complex128, max(1, lwork))
+		impl.Zunmqr(blas.Left, blas.NoTrans, m, n, k, a, lda, tau, qr, n, work, lwork)
+		if !zequalApprox(m, n, qr, n, aCopy, lda, ztol) {
+			t.Errorf("%s: Q*R != A", name)
+		}
+
+		// Qᴴ*A must be equal to R.
+		qha := make([]complex128, m*n)
+		for i := 0; i < m; i++ {
+			copy(qha[i*n:i*n+n], aCopy[i*lda:i*lda+n])
+		}
+		impl.Zunmqr(blas.Left, blas.ConjTrans, m, n, k, a, lda, tau, qha, n, work, lwork)
+		if !zequalApprox(m, n, qha, n, r, n, ztol) {
+			t.Errorf("%s: Qᴴ*A != R", name)
+		}
+
+		// The first k columns of Q are orthonormal.
+		if m < n {
+			continue
+		}
+		impl.Zungqr(m, n, k, a, lda, tau, work[:1], -1)
+		lwork = int(real(work[0]))
+		work = make([]complex128, max(1, lwork))
+		impl.Zungqr(m, n, k, a, lda, tau, work, lwork)
+		qhq := zmul(blas.ConjTrans, blas.NoTrans, n, n, m, a, lda, a, lda)
+		if !zequalApprox(n, n, qhq, n, zeye(n), n, ztol) {
+			t.Errorf("%s: Qᴴ*Q != I", name)
+		}
+		if !zequalApprox(m, n, zmul(blas.NoTrans, blas.NoTrans, m, n, n, a, lda, r, n), n, aCopy, lda, ztol) {
+			t.Errorf("%s: Q*R != A with Q from Zungqr", name)
+		}
+	}
+}
+
+func TestZheev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 10, 25} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			lda := max(1, n) + 1
+			a := zhpd(n, lda, rnd)
+			// Make A indefinite.
+			for i := 0; i < n; i++ {
+				a[i*lda+i] -= complex(float64(n), 0)
+			}
+			aCopy := make([]complex128, len(a))
+			copy(aCopy, a)
+			w := make([]float64, n)
+
+			work := make([]complex128, 1)
+			impl.Zheev(lapack.EVCompute, uplo, n, a, lda, w, work, -1)
+			lwork := int(real(work[0]))
+			work = make([]complex128, max(1, lwork))
+			ok := impl.Zheev(lapack.EVCompute, uplo, n, a, lda, w, work, lwork)
+			if !ok {
+				t.Errorf("%s: Zheev failed", name)
+				continue
+			}
+			if n == 0 {
+				continue
+			}
+			if !sort.Float64sAreSorted(w) {
+				t.Errorf("%s: eigenvalues not in ascending order", name)
+			}
+
+			// A*V must be equal to V*diag(w).
+			av := zmul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, lda, a, lda)
+			vw := make([]complex128, n*n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					vw[i*n+j] = a[i*lda+j] * complex(w[j], 0)
+				}
+			}
+			if !zequalApprox(n, n, av, n, vw, n, 1e-10) {
+				t.Errorf("%s: A*V != V*diag(w)", name)
+			}
+		}
+	}
+}
+
+func TestZgeev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 10, 25} {
+		name := fmt.Sprintf("n=%d", n)
+		lda, ldv := max(1, n)+2, max(1, n)+1
+		a := zrandom(n, n, lda, rnd)
+		aCopy := make([]complex128, len(a))
+		copy(aCopy, a)
+		w := make([]complex128, n)
+		vl := make([]complex128, max(0, (n-1)*ldv+n))
+		vr := make([]complex128, len(vl))
+
+		work := make([]complex128, 1)
+		impl.Zgeev(lapack.LeftEVCompute, lapack.RightEVCompute, n, a, lda, w, vl, ldv, vr, ldv, work, -1)
+		lwork := int(real(work[0]))
+		work = make([]complex128, max(1, lwork))
+		first := impl.Zgeev(lapack.LeftEVCompute, lapack.RightEVCompute, n, a, lda, w, vl, ldv, vr, ldv, work, lwork)
+		if first != 0 {
+			t.Errorf("%s: Zgeev failed to converge, first=%d", name, first)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+
+		// A*VR must be equal to VR*diag(w), and VLᴴ*A to diag(w)*VLᴴ.
+		avr := zmul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, lda, vr, ldv)
+		vlha := zmul(blas.ConjTrans, blas.NoTrans, n, n, n, vl, ldv, aCopy, lda)
+		vrw := make([]complex128, n*n)
+		wvlh := make([]complex128, n*n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				vrw[i*n+j] = vr[i*ldv+j] * w[j]
+				wvlh[i*n+j] = w[i] * cmplx.Conj(vl[j*ldv+i])
+			}
+		}
+		if !zequalApprox(n, n, avr, n, vrw, n, 1e-10) {
+			t.Errorf("%s: A*VR != VR*diag(w)", name)
+		}
+		if !zequalApprox(n, n, vlha, n, wvlh, n, 1e-10) {
+			t.Errorf("%s: VLᴴ*A != diag(w)*VLᴴ", name)
+		}
+	}
+}
+
+func TestZgesvd(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{0, 0}, {1, 1}, {5, 5}, {8, 3}, {3, 8}, {25, 15}, {15, 25},
+	} {
+		m, n := test.m, test.n
+		mn := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		lda, ldu, ldvt := max(1, n)+2, max(1, m)+1, max(1, n)+3
+		a := zrandom(m, n, lda, rnd)
+		aCopy := make([]complex128, len(a))
+		copy(aCopy, a)
+		s := make([]float64, mn)
+		u := make([]complex128, max(0, (m-1)*ldu+m))
+		vt := make([]complex128, max(0, (n-1)*ldvt+n))
+
+		work := make([]complex128, 1)
+		impl.Zgesvd(lapack.SVDAll, lapack.SVDAll, m, n, a, lda, s, u, ldu, vt, ldvt, work, -1)
+		lwork := int(real(work[0]))
+		work = make([]complex128, max(1, lwork))
+		ok := impl.Zgesvd(lapack.SVDAll, lapack.SVDAll, m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork)
+		if !ok {
+			t.Errorf("%s: Zgesvd failed", name)
+			continue
+		}
+		if mn == 0 {
+			continue
+		}
+		if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+			t.Errorf("%s: singular values not in decreasing order", name)
+		}
+
+		// U*Sigma*Vᴴ must be equal to A.
+		us := make([]complex128, m*n)
+		for i := 0; i < m; i++ {
+			for j := 0; j < mn; j++ {
+				us[i*n+j] = u[i*ldu+j] * complex(s[j], 0)
+			}
+		}
+		usvt := zmul(blas.NoTrans, blas.NoTrans, m, n, n, us, n, vt, ldvt)
+		if !zequalApprox(m, n, usvt, n, aCopy, lda, ztol) {
+			t.Errorf("%s: U*Sigma*Vᴴ != A", name)
+		}
+		if !zequalApprox(m, m, zmul(blas.ConjTrans, blas.NoTrans, m, m, m, u, ldu, u, ldu), m, zeye(m), m, ztol) {
+			t.Errorf("%s: U is not unitary", name)
+		}
+		if !zequalApprox(n, n, zmul(blas.NoTrans, blas.ConjTrans, n, n, n, vt, ldvt, vt, ldvt), n, zeye(n), n, ztol) {
+			t.Errorf("%s: Vᴴ is not unitary", name)
+		}
+	}
+}
+
+func TestZgels(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{0, 0}, {1, 1}, {5, 5}, {8, 3}, {3, 8}, {25, 15}, {15, 25},
+	} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+			m, n := test.m, test.n
+			nrhs := 2
+			name := fmt.Sprintf("m=%d,n=%d,trans=%c", m, n, trans)
+			lda, ldb := max(1, n)+1, nrhs+2
+			a := zrandom(m, n, lda, rnd)
+			aCopy := make([]complex128, len(a))
+			copy(aCopy, a)
+
+			// op(A) is rows×cols.
+			rows, cols := m, n
+			if trans == blas.ConjTrans {
+				rows, cols = n, m
+			}
+			b := make([]complex128, max(0, (max(m, n)-1)*ldb+nrhs))
+			copy(b, zrandom(rows, nrhs, ldb, rnd))
+			bCopy := make([]complex128, len(b))
+			copy(bCopy, b)
+
+			work := make([]complex128, 1)
+			impl.Zgels(trans, m, n, nrhs, a, lda, b, ldb, work, -1)
+			lwork := int(real(work[0]))
+			work = make([]complex128, max(1, lwork))
+			ok := impl.Zgels(trans, m, n, nrhs, a, lda, b, ldb, work, lwork)
+			if !ok {
+				t.Errorf("%s: Zgels failed", name)
+				continue
+			}
+			if min(m, n) == 0 {
+				continue
+			}
+
+			// The residual R = op(A)*X - B must be orthogonal to the range
+			// of op(A), that is op(A)ᴴ*R = 0. For underdetermined systems R
+			// is zero.
+			tA := blas.NoTrans
+			if trans == blas.ConjTrans {
+				tA = blas.ConjTrans
+			}
+			ax := zmul(tA, blas.NoTrans, rows, nrhs, cols, aCopy, lda, b, ldb)
+			for i := 0; i < rows; i++ {
+				for j := 0; j < nrhs; j++ {
+					ax[i*nrhs+j] -= bCopy[i*ldb+j]
+				}
+			}
+			var ahr []complex128
+			if trans == blas.NoTrans {
+				ahr = zmul(blas.ConjTrans, blas.NoTrans, cols, nrhs, rows, aCopy, lda, ax, nrhs)
+			} else {
+				ahr = zmul(blas.NoTrans, blas.NoTrans, cols, nrhs, rows, aCopy, lda, ax, nrhs)
+			}
+			if !zequalApprox(cols, nrhs, ahr, nrhs, make([]complex128, cols*nrhs), nrhs, 1e-10) {
+				t.Errorf("%s: residual not orthogonal to range of op(A)", name)
+			}
+		}
+	}
+}
@@ -42,7 +42,7 @@ import "gonum.org/v1/gonum/blas"
 //  b = [a00 a11 a22 a33 a44 a55 a10 a21 a32 a43 a54 * a20 a31 a42 a53 * * ]
 //
 // In these example elements marked as * are not referenced.
-func bandTriToLapacke(uplo blas.Uplo, n, kd int, a []float64, lda int, b []float64, ldb int) {
+func bandTriToLapacke[T float32 | float64](uplo blas.Uplo, n, kd int, a []T, lda int, b []T, ldb int) {
 	if uplo == blas.Upper {
 		for i := 0; i < n; i++ {
 			for jb := 0; jb < min(n-i, kd+1); jb++ {
@@ -63,7 +63,7 @@ func bandTriToLapacke(uplo blas.Uplo, n, kd int, a []float64, lda int, b []float
 // bandTriToGonum converts a triangular or symmetric band matrix A in LAPACKE
 // row-major layout to CBLAS row-major layout and stores the result in B. In
 // other words, it performs the inverse conversion to bandTriToLapacke.
-func bandTriToGonum(uplo blas.Uplo, n, kd int, a []float64, lda int, b []float64, ldb int) {
+func bandTriToGonum[T float32 | float64](uplo blas.Uplo, n, kd int, a []T, lda int, b []T, ldb int) {
 	if uplo == blas.Upper {
 		for j := 0; j < n; j++ {
 			for ib := max(0, kd-j); ib < kd+1; ib++ {
@@ -80,3 +80,44 @@ func bandTriToGonum(uplo blas.Uplo, n, kd int, a []float64, lda int, b []float64
 		}
 	}
 }
+
+// bandToLapacke converts a general m×n band matrix A with kl sub-diagonals and
+// ku super-diagonals in CBLAS row-major layout to LAPACKE row-major layout and
+// stores the result in B.
+//
+// For example, when m = n = 5, kl = 1 and ku = 2, bandToLapacke converts
+//  A =  *   a00  a01  a02
+//      a10  a11  a12  a13
+//      a21  a22  a23  a24
+//      a32  a33  a34   *
+//      a43  a44   *    *
+// stored in a slice as
+//  a = [* a00 a01 a02 a10 a11 a12 a13 a21 a22 a23 a24 a32 a33 a34 * a43 a44 * *]
+// to
+//  B =  *   *  a02 a13 a24
+//       *  a01 a12 a23 a34
+//      a00 a11 a22 a33 a44
+//      a10 a21 a32 a43  *
+// stored in a slice as
+//  b = [* * a02 a13 a24 * a01 a12 a23 a34 a00 a11 a22 a33 a44 a10 a21 a32 a43 *]
+//
+// In this example elements marked as * are not referenced.
+func bandToLapacke[T float32 | float64](m, n, kl, ku int, a []T, lda int, b []T, ldb int) {
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			b[(ku+i-j)*ldb+j] = a[i*lda+kl+j-i]
+		}
+	}
+}
+
+// bandToGonum converts a general m×n band matrix A with kl sub-diagonals and
+// ku super-diagonals in LAPACKE row-major layout to CBLAS row-major layout and
+// stores the result in B. In other words, it performs the inverse conversion
+// to bandToLapacke.
+func bandToGonum[T float32 | float64](m, n, kl, ku int, a []T, lda int, b []T, ldb int) {
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			b[i*ldb+kl+j-i] = a[(ku+i-j)*lda+j]
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// randomBand returns a random m×n band matrix with kl sub-diagonals and ku
+// super-diagonals as a dense matrix with stride n and in the band storage
+// layout used by Dgbtrf with stride ldab.
+func randomBand(m, n, kl, ku, ldab int, rnd *rand.Rand) (a, ab []float64) {
+	a = make([]float64, m*n)
+	ab = make([]float64, max(0, (m-1)*ldab+2*kl+ku+1))
+	for i := range ab {
+		ab[i] = math.NaN()
+	}
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			v := rnd.NormFloat64()
+			if i == j {
+				v += 2
+			}
+			a[i*n+j] = v
+			ab[i*ldab+kl+j-i] = v
+		}
+	}
+	return a, ab
+}
+
+func TestDgbtrf(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 5, 10, 13} {
+		for _, n := range []int{0, 1, 2, 5, 10, 13} {
+			for _, kl := range []int{0, 1, 2, 5} {
+				for _, ku := range []int{0, 1, 3} {
+					name := fmt.Sprintf("m=%d,n=%d,kl=%d,ku=%d", m, n, kl, ku)
+					ldab := 2*kl + ku + 1 + 2
+					a, ab := randomBand(m, n, kl, ku, ldab, rnd)
+					ipiv := make([]int, min(m, n))
+					ok := impl.Dgbtrf(m, n, kl, ku, ab, ldab, ipiv)
+
+					// Partial pivoting chooses the same pivots as the dense
+					// factorization and computes the same U.
+					ipivWant := make([]int, min(m, n))
+					okWant := impl.Dgetrf(m, n, a, max(1, n), ipivWant)
+					if ok != okWant {
+						t.Errorf("%s: unexpected ok; got %t, want %t", name, ok, okWant)
+					}
+					for i := range ipiv {
+						if ipiv[i] != ipivWant[i] {
+							t.Errorf("%s: unexpected ipiv; got %v, want %v", name, ipiv, ipivWant)
+							break
+						}
+					}
+					for i := 0; i < min(m, n); i++ {
+						for j := i; j < min(n, i+kl+ku+1); j++ {
+							got := ab[i*ldab+kl+j-i]
+							want := a[i*n+j]
+							if math.Abs(got-want) > tol*math.Max(1, math.Abs(want)) {
+								t.Errorf("%s: unexpected U[%d,%d]; got %v, want %v", name, i, j, got, want)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgbtrs(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30} {
+			for _, kl := range []int{0, 1, 4} {
+				for _, ku := range []int{0, 2, 3} {
+					for _, nrhs := range []int{0, 1, 3} {
+						name := fmt.Sprintf("trans=%c,n=%d,kl=%d,ku=%d,nrhs=%d", trans, n, kl, ku, nrhs)
+						ldab := 2*kl + ku + 1
+						a, ab := randomBand(n, n, kl, ku, ldab, rnd)
+
+						xTrue := make([]float64, n*nrhs)
+						for i := range xTrue {
+							xTrue[i] = rnd.NormFloat64()
+						}
+						ldb := max(1, nrhs) + 3
+						b := rhs(trans, n, nrhs, a, max(1, n), xTrue, ldb)
+
+						ipiv := make([]int, n)
+						if !impl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv) {
+							t.Errorf("%s: unexpected singular matrix", name)
+							continue
+						}
+						impl.Dgbtrs(trans, n, kl, ku, nrhs, ab, ldab, ipiv, b, ldb)
+						if !dequalApprox(n, nrhs, b, ldb, xTrue, max(1, nrhs), tol) {
+							t.Errorf("%s: unexpected solution", name)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgbcon(t *testing.T) {
+	const tol = 1e-8
+	rnd := rand.New(rand.NewSource(1))
+	for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30} {
+			for _, kl := range []int{0, 1, 4} {
+				for _, ku := range []int{0, 2, 3} {
+					name := fmt.Sprintf("norm=%c,n=%d,kl=%d,ku=%d", norm, n, kl, ku)
+					ldab := 2*kl + ku + 4
+					a, ab := randomBand(n, n, kl, ku, ldab, rnd)
+					lda := max(1, n)
+					anorm := impl.Dlange(norm, n, n, a, lda, make([]float64, n))
+
+					ipiv := make([]int, n)
+					impl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+					got := impl.Dgbcon(norm, n, kl, ku, ab, ldab, ipiv, anorm, make([]float64, 3*n), make([]int, n))
+
+					impl.Dgetrf(n, n, a, lda, ipiv)
+					want := impl.Dgecon(norm, n, a, lda, anorm, make([]float64, 4*n), make([]int, n))
+					if math.Abs(got-want) > tol*want {
+						t.Errorf("%s: unexpected rcond; got %v, want %v", name, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgbconNegANorm(t *testing.T) {
+	defer func() {
+		if r := recover(); r != negANorm {
+			t.Errorf("unexpected panic: got %v, want %q", r, negANorm)
+		}
+	}()
+	ab := []float64{0, 1, 0}
+	impl.Dgbcon(lapack.MaxColumnSum, 1, 1, 0, ab, 3, []int{0}, -1, make([]float64, 3), make([]int, 1))
+}
+
+func TestDgbsv(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10, 30} {
+		for _, kl := range []int{0, 1, 4} {
+			for _, ku := range []int{0, 2, 3} {
+				for _, nrhs := range []int{0, 1, 3} {
+					name := fmt.Sprintf("n=%d,kl=%d,ku=%d,nrhs=%d", n, kl, ku, nrhs)
+					ldab := 2*kl + ku + 2
+					a, ab := randomBand(n, n, kl, ku, ldab, rnd)
+					abFact := make([]float64, len(ab))
+					copy(abFact, ab)
+
+					xTrue := make([]float64, n*nrhs)
+					for i := range xTrue {
+						xTrue[i] = rnd.NormFloat64()
+					}
+					ldb := max(1, nrhs) + 1
+					b := rhs(blas.NoTrans, n, nrhs, a, max(1, n), xTrue, ldb)
+
+					ipiv := make([]int, n)
+					if !impl.Dgbsv(n, kl, ku, nrhs, ab, ldab, ipiv, b, ldb) {
+						t.Errorf("%s: unexpected singular matrix", name)
+						continue
+					}
+					if !dequalApprox(n, nrhs, b, ldb, xTrue, max(1, nrhs), tol) {
+						t.Errorf("%s: unexpected solution", name)
+					}
+
+					// Dgbsv returns the factorization computed by Dgbtrf.
+					ipivWant := make([]int, n)
+					impl.Dgbtrf(n, n, kl, ku, abFact, ldab, ipivWant)
+					for i := range ipiv {
+						if ipiv[i] != ipivWant[i] {
+							t.Errorf("%s: unexpected ipiv; got %v, want %v", name, ipiv, ipivWant)
+							break
+						}
+					}
+					for i := 0; i < n; i++ {
+						for j := max(0, i-kl); j < min(n, i+kl+ku+1); j++ {
+							got := ab[i*ldab+kl+j-i]
+							want := abFact[i*ldab+kl+j-i]
+							if got != want {
+								t.Errorf("%s: factorization mismatch between Dgbsv and Dgbtrf at [%d,%d]", name, i, j)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dmul returns op(A)*op(B) as a dense matrix with stride n where op(A) is m×k
+// and op(B) is k×n.
+func dmul(tA, tB blas.Transpose, m, n, k int, a []float64, lda int, b []float64, ldb int) []float64 {
+	c := make([]float64, m*n)
+	if m == 0 || n == 0 {
+		return c
+	}
+	gonum.Implementation{}.Dgemm(tA, tB, m, n, k, 1, a, lda, b, ldb, 0, c, n)
+	return c
+}
+
+// dequalApprox reports whether the m×n matrices A and B are equal within tol
+// relative to the largest element of B.
+func dequalApprox(m, n int, a []float64, lda int, b []float64, ldb int, tol float64) bool {
+	scale := 1.0
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			scale = math.Max(scale, math.Abs(b[i*ldb+j]))
+		}
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if math.Abs(a[i*lda+j]-b[i*ldb+j]) > tol*scale {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isOrthogonal reports whether the n×n matrix Q is orthogonal within tol.
+func isOrthogonal(n int, q []float64, ldq int, tol float64) bool {
+	qtq := dmul(blas.Trans, blas.NoTrans, n, n, n, q, ldq, q, ldq)
+	eye := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		eye[i*n+i] = 1
+	}
+	return dequalApprox(n, n, qtq, n, eye, n, tol)
+}
+
+// isQuasiTriangular reports whether the n×n matrix T is upper quasi-triangular
+// with 2×2 diagonal blocks only at the complex conjugate pairs of eigenvalues
+// given by wi.
+func isQuasiTriangular(n int, t []float64, ldt int, wi []float64) bool {
+	for i := 1; i < n; i++ {
+		for j := 0; j < i-1; j++ {
+			if t[i*ldt+j] != 0 {
+				return false
+			}
+		}
+		if t[i*ldt+i-1] != 0 && (wi[i-1] <= 0 || wi[i] >= 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDgees(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	stable := func(wr, wi float64) bool { return wr < 0 }
+	for _, n := range []int{0, 1, 2, 5, 10, 30} {
+		for _, jobvs := range []lapack.SchurComp{lapack.SchurOrig, lapack.SchurNone} {
+			for _, sorted := range []bool{false, true} {
+				var sel func(wr, wi float64) bool
+				if sorted {
+					sel = stable
+				}
+				name := fmt.Sprintf("n=%d,jobvs=%c,sorted=%t", n, jobvs, sorted)
+				lda := max(1, n) + 2
+				a := make([]float64, max(0, (n-1)*lda+n))
+				for i := range a {
+					a[i] = rnd.NormFloat64()
+				}
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+				wr := make([]float64, n)
+				wi := make([]float64, n)
+				ldvs := max(1, n) + 1
+				vs := make([]float64, max(1, (n-1)*ldvs+n))
+
+				work := make([]float64, 1)
+				impl.Dgees(jobvs, sel, n, a, lda, wr, wi, vs, ldvs, work, -1)
+				work = make([]float64, int(work[0]))
+				sdim, ok := impl.Dgees(jobvs, sel, n, a, lda, wr, wi, vs, ldvs, work, len(work))
+				if !ok {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+
+				if !isQuasiTriangular(n, a, lda, wi) {
+					t.Errorf("%s: T is not upper quasi-triangular", name)
+				}
+				for i := 0; i < n; i++ {
+					if wi[i] == 0 && a[i*lda+i] != wr[i] {
+						t.Errorf("%s: diagonal of T does not match eigenvalue %d", name, i)
+					}
+				}
+
+				var want int
+				if sorted {
+					for _, v := range wr {
+						if stable(v, 0) {
+							want++
+						}
+					}
+				}
+				if sdim != want {
+					t.Errorf("%s: unexpected sdim: got %d, want %d", name, sdim, want)
+				}
+				for i := 0; i < sdim; i++ {
+					if !stable(wr[i], wi[i]) {
+						t.Errorf("%s: unselected eigenvalue %d before selected", name, i)
+					}
+				}
+
+				if jobvs == lapack.SchurNone || n == 0 {
+					continue
+				}
+				if !isOrthogonal(n, vs, ldvs, tol*float64(n)) {
+					t.Errorf("%s: Z is not orthogonal", name)
+				}
+				zt := dmul(blas.NoTrans, blas.NoTrans, n, n, n, vs, ldvs, a, lda)
+				ztzt := dmul(blas.NoTrans, blas.Trans, n, n, n, zt, n, vs, ldvs)
+				if !dequalApprox(n, n, ztzt, n, aCopy, lda, tol*float64(n)) {
+					t.Errorf("%s: Z*T*Zᵀ != A", name)
+				}
+			}
+		}
+	}
+}
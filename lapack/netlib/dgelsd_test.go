@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// lstsqSolver solves the least squares problem with the m×n matrix A and the
+// max(m,n)×nrhs matrix B and returns the effective rank of A.
+type lstsqSolver func(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, rcond float64) int
+
+// rankDeficient returns a random m×n matrix of rank r with stride lda.
+func rankDeficient(m, n, r, lda int, rnd *rand.Rand) []float64 {
+	g1 := make([]float64, m*r)
+	for i := range g1 {
+		g1[i] = rnd.NormFloat64()
+	}
+	g2 := make([]float64, r*n)
+	for i := range g2 {
+		g2[i] = rnd.NormFloat64()
+	}
+	prod := dmul(blas.NoTrans, blas.NoTrans, m, n, r, g1, max(1, r), g2, max(1, n))
+	a := make([]float64, max(0, (m-1)*lda+n))
+	for i := 0; i < m; i++ {
+		copy(a[i*lda:i*lda+n], prod[i*n:i*n+n])
+	}
+	return a
+}
+
+// minNormSolution returns the n×nrhs minimum-norm least squares solution
+// pinv(A)*B computed from the singular value decomposition of the m×n matrix A
+// of rank r.
+func minNormSolution(m, n, r, nrhs int, a []float64, lda int, b []float64, ldb int) []float64 {
+	aCopy := make([]float64, m*n)
+	impl.Dlacpy(blas.All, m, n, a, lda, aCopy, max(1, n))
+	s := make([]float64, min(m, n))
+	u := make([]float64, m*m)
+	vt := make([]float64, n*n)
+	work := make([]float64, 1)
+	impl.Dgesvd(lapack.SVDAll, lapack.SVDAll, m, n, aCopy, max(1, n), s, u, max(1, m), vt, max(1, n), work, -1)
+	work = make([]float64, int(work[0]))
+	impl.Dgesvd(lapack.SVDAll, lapack.SVDAll, m, n, aCopy, max(1, n), s, u, max(1, m), vt, max(1, n), work, len(work))
+
+	// c = diag(1/s_k) * U_rᵀ * B.
+	c := make([]float64, r*nrhs)
+	for k := 0; k < r; k++ {
+		for j := 0; j < nrhs; j++ {
+			var sum float64
+			for i := 0; i < m; i++ {
+				sum += u[i*m+k] * b[i*ldb+j]
+			}
+			c[k*nrhs+j] = sum / s[k]
+		}
+	}
+	// x = V_r * c.
+	x := make([]float64, n*nrhs)
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			var sum float64
+			for k := 0; k < r; k++ {
+				sum += vt[k*n+i] * c[k*nrhs+j]
+			}
+			x[i*nrhs+j] = sum
+		}
+	}
+	return x
+}
+
+func testRankDeficientLstsq(t *testing.T, name string, solve lstsqSolver) {
+	const (
+		rcond = 1e-10
+		tol   = 1e-8
+	)
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 5, 10, 30} {
+		for _, n := range []int{0, 1, 5, 10, 30} {
+			for _, r := range []int{0, 1, min(m, n) / 2, min(m, n)} {
+				if r > min(m, n) {
+					continue
+				}
+				for _, nrhs := range []int{0, 1, 3} {
+					caseName := fmt.Sprintf("%s: m=%d,n=%d,rank=%d,nrhs=%d", name, m, n, r, nrhs)
+					lda := max(1, n) + 2
+					a := rankDeficient(m, n, r, lda, rnd)
+					ldb := max(1, nrhs) + 1
+					b := make([]float64, max(0, (max(m, n)-1)*ldb+nrhs))
+					for i := 0; i < m; i++ {
+						for j := 0; j < nrhs; j++ {
+							b[i*ldb+j] = rnd.NormFloat64()
+						}
+					}
+					want := minNormSolution(m, n, r, nrhs, a, lda, b, ldb)
+
+					rank := solve(m, n, nrhs, a, lda, b, ldb, rcond)
+					if rank != r {
+						t.Errorf("%s: unexpected rank; got %d, want %d", caseName, rank, r)
+						continue
+					}
+					if !dequalApprox(n, nrhs, b, ldb, want, max(1, nrhs), tol) {
+						t.Errorf("%s: unexpected minimum-norm solution", caseName)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDgelsd(t *testing.T) {
+	testRankDeficientLstsq(t, "Dgelsd", func(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, rcond float64) int {
+		s := make([]float64, min(m, n))
+		mn := max(1, min(m, n))
+		iwork := make([]int, 3*mn*max(1, mn/26+1)+11*mn)
+		work := make([]float64, 1)
+		impl.Dgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, work, -1, iwork)
+		work = make([]float64, int(work[0]))
+		rank, ok := impl.Dgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, work, len(work), iwork)
+		if !ok {
+			t.Errorf("Dgelsd: m=%d,n=%d: unexpected failure", m, n)
+		}
+		return rank
+	})
+}
+
+func TestDgelss(t *testing.T) {
+	testRankDeficientLstsq(t, "Dgelss", func(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, rcond float64) int {
+		s := make([]float64, min(m, n))
+		work := make([]float64, 1)
+		impl.Dgelss(m, n, nrhs, a, lda, b, ldb, s, rcond, work, -1)
+		work = make([]float64, int(work[0]))
+		rank, ok := impl.Dgelss(m, n, nrhs, a, lda, b, ldb, s, rcond, work, len(work))
+		if !ok {
+			t.Errorf("Dgelss: m=%d,n=%d: unexpected failure", m, n)
+		}
+		return rank
+	})
+}
+
+func TestDgelsy(t *testing.T) {
+	testRankDeficientLstsq(t, "Dgelsy", func(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, rcond float64) int {
+		jpvt := make([]int, n)
+		for i := range jpvt {
+			jpvt[i] = -1
+		}
+		work := make([]float64, 1)
+		impl.Dgelsy(m, n, nrhs, a, lda, b, ldb, jpvt, rcond, work, -1)
+		work = make([]float64, int(work[0]))
+		rank := impl.Dgelsy(m, n, nrhs, a, lda, b, ldb, jpvt, rcond, work, len(work))
+
+		// jpvt must be a zero-based permutation.
+		seen := make([]bool, n)
+		for _, p := range jpvt {
+			if p < 0 || n <= p || seen[p] {
+				t.Errorf("Dgelsy: m=%d,n=%d: jpvt is not a permutation: %v", m, n, jpvt)
+				break
+			}
+			seen[p] = true
+		}
+		return rank
+	})
+}
+
+func TestDgelsEmpty(t *testing.T) {
+	// The minimum workspace length for an empty matrix is 1.
+	const nrhs = 2
+	for _, mn := range [][2]int{{3, 0}, {0, 3}, {0, 0}} {
+		m, n := mn[0], mn[1]
+		for _, test := range []struct {
+			name  string
+			solve func(b []float64, work []float64) (rank int, ok bool)
+		}{
+			{"Dgelss", func(b, work []float64) (int, bool) {
+				return impl.Dgelss(m, n, nrhs, nil, max(1, n), b, nrhs, nil, -1, work, 1)
+			}},
+			{"Dgelsd", func(b, work []float64) (int, bool) {
+				return impl.Dgelsd(m, n, nrhs, nil, max(1, n), b, nrhs, nil, -1, work, 1, nil)
+			}},
+			{"Dgelsy", func(b, work []float64) (int, bool) {
+				return impl.Dgelsy(m, n, nrhs, nil, max(1, n), b, nrhs, make([]int, n), -1, work, 1), true
+			}},
+		} {
+			name := fmt.Sprintf("%s: m=%d,n=%d", test.name, m, n)
+			b := make([]float64, max(m, n)*nrhs)
+			for i := range b {
+				b[i] = 1
+			}
+			rank, ok := test.solve(b, make([]float64, 1))
+			if rank != 0 || !ok {
+				t.Errorf("%s: unexpected result; got rank=%d,ok=%t", name, rank, ok)
+			}
+			for _, v := range b {
+				if v != 0 {
+					t.Errorf("%s: b not set to zero: %v", name, b)
+					break
+				}
+			}
+		}
+	}
+}
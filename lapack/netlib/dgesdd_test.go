@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+func TestDgesdd(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 5, 10, 50} {
+		for _, n := range []int{0, 1, 2, 5, 10, 50} {
+			for _, job := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone} {
+				dgesddTest(t, m, n, job, tol, rnd)
+			}
+		}
+	}
+}
+
+func dgesddTest(t *testing.T, m, n int, job lapack.SVDJob, tol float64, rnd *rand.Rand) {
+	name := fmt.Sprintf("m=%d,n=%d,job=%c", m, n, job)
+	minmn := min(m, n)
+	lda := max(1, n) + 2
+	a := make([]float64, max(0, (m-1)*lda+n))
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+
+	// Compute the reference decomposition with Dgesvd.
+	aRef := make([]float64, len(a))
+	copy(aRef, a)
+	sRef := make([]float64, minmn)
+	uRef := make([]float64, m*m)
+	vtRef := make([]float64, n*n)
+	work := make([]float64, 1)
+	impl.Dgesvd(lapack.SVDAll, lapack.SVDAll, m, n, aRef, lda, sRef, uRef, max(1, m), vtRef, max(1, n), work, -1)
+	work = make([]float64, int(work[0]))
+	if !impl.Dgesvd(lapack.SVDAll, lapack.SVDAll, m, n, aRef, lda, sRef, uRef, max(1, m), vtRef, max(1, n), work, len(work)) {
+		t.Fatalf("%s: Dgesvd failed", name)
+	}
+
+	// Allocate the singular vectors as required by job.
+	var ldu, ucol, ldvt, vtrow int
+	switch job {
+	case lapack.SVDAll:
+		ucol, vtrow = m, n
+	case lapack.SVDStore:
+		ucol, vtrow = minmn, minmn
+	case lapack.SVDOverwrite:
+		if m < n {
+			ucol = m
+		} else {
+			vtrow = n
+		}
+	}
+	ldu = max(1, ucol) + 1
+	ldvt = max(1, n) + 1
+	u := make([]float64, max(1, m*ldu))
+	vt := make([]float64, max(1, vtrow*ldvt))
+	s := make([]float64, minmn)
+	iwork := make([]int, 8*minmn)
+
+	work = make([]float64, 1)
+	impl.Dgesdd(job, m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork)
+	work = make([]float64, int(work[0]))
+	if !impl.Dgesdd(job, m, n, a, lda, s, u, ldu, vt, ldvt, work, len(work), iwork) {
+		t.Errorf("%s: Dgesdd failed", name)
+		return
+	}
+	if minmn == 0 {
+		return
+	}
+
+	if !floats.EqualApprox(s, sRef, tol*math.Max(1, sRef[0])) {
+		t.Errorf("%s: singular values mismatch: got %v, want %v", name, s, sRef)
+	}
+	if job == lapack.SVDNone {
+		return
+	}
+
+	// The singular vectors are unique up to sign since the singular values
+	// of a random matrix are distinct.
+	if job == lapack.SVDOverwrite {
+		if m >= n {
+			u, ldu = a, lda
+		} else {
+			vt, ldvt = a, lda
+		}
+	}
+	for j := 0; j < minmn; j++ {
+		var dot float64
+		for i := 0; i < m; i++ {
+			dot += u[i*ldu+j] * uRef[i*m+j]
+		}
+		if math.Abs(math.Abs(dot)-1) > 1e-10 {
+			t.Errorf("%s: left singular vector %d mismatch: |dot|=%v", name, j, math.Abs(dot))
+		}
+		dot = 0
+		for i := 0; i < n; i++ {
+			dot += vt[j*ldvt+i] * vtRef[j*n+i]
+		}
+		if math.Abs(math.Abs(dot)-1) > 1e-10 {
+			t.Errorf("%s: right singular vector %d mismatch: |dot|=%v", name, j, math.Abs(dot))
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// forwardError returns the relative forward error of the j-th column of the
+// n×nrhs matrix X with respect to the j-th column of Xtrue in the ∞-norm.
+func forwardError(n, nrhs, j int, x []float64, ldx int, xTrue []float64) float64 {
+	var diff, norm float64
+	for i := 0; i < n; i++ {
+		diff = math.Max(diff, math.Abs(x[i*ldx+j]-xTrue[i*nrhs+j]))
+		norm = math.Max(norm, math.Abs(x[i*ldx+j]))
+	}
+	if norm == 0 {
+		return diff
+	}
+	return diff / norm
+}
+
+// checkErrorBounds checks the solution x of a system with the known
+// solution xTrue and its error bounds computed by an expert driver.
+func checkErrorBounds(t *testing.T, name string, n, nrhs int, x []float64, ldx int, xTrue, ferr, berr []float64) {
+	t.Helper()
+	const eps = 1.0 / (1 << 53)
+	for j := 0; j < nrhs; j++ {
+		if err := forwardError(n, nrhs, j, x, ldx, xTrue); err > math.Max(ferr[j], 1e-14) {
+			t.Errorf("%s: forward error of column %d not bounded: got %v, ferr=%v", name, j, err, ferr[j])
+		}
+		if berr[j] > float64(10*n)*eps {
+			t.Errorf("%s: unexpectedly large backward error of column %d: %v", name, j, berr[j])
+		}
+	}
+}
+
+func TestDgesvx(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10, 30} {
+		for _, nrhs := range []int{0, 1, 3} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, fact := range []FactJob{FactSupplied, FactCompute, FactEquilibrate} {
+					for _, scaled := range []bool{false, true} {
+						dgesvxTest(t, fact, trans, n, nrhs, scaled, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgesvxTest(t *testing.T, fact FactJob, trans blas.Transpose, n, nrhs int, scaled bool, rnd *rand.Rand) {
+	name := fmt.Sprintf("fact=%c,trans=%c,n=%d,nrhs=%d,scaled=%t", fact, trans, n, nrhs, scaled)
+	lda := max(1, n) + 3
+	a := make([]float64, max(0, (n-1)*lda+n))
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a[i*lda+j] = rnd.NormFloat64()
+		}
+		a[i*lda+i] += float64(n)
+	}
+	if scaled {
+		// Scale the rows badly so that equilibration is required.
+		for i := 0; i < n; i++ {
+			s := 1e-6
+			if i%2 == 0 {
+				s = 1e6
+			}
+			for j := 0; j < n; j++ {
+				a[i*lda+j] *= s
+			}
+		}
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	xTrue := make([]float64, n*nrhs)
+	for i := range xTrue {
+		xTrue[i] = rnd.NormFloat64()
+	}
+	ldb := max(1, nrhs) + 2
+	b := rhs(trans, n, nrhs, a, lda, xTrue, ldb)
+
+	ldaf := max(1, n) + 1
+	af := make([]float64, max(0, (n-1)*ldaf+n))
+	ipiv := make([]int, n)
+	if fact == FactSupplied {
+		impl.Dlacpy(blas.All, n, n, a, lda, af, ldaf)
+		if !impl.Dgetrf(n, n, af, ldaf, ipiv) {
+			t.Fatalf("%s: Dgetrf failed", name)
+		}
+	}
+	ldx := max(1, nrhs) + 1
+	x := make([]float64, max(0, (n-1)*ldx+nrhs))
+	r := make([]float64, n)
+	c := make([]float64, n)
+	ferr := make([]float64, nrhs)
+	berr := make([]float64, nrhs)
+	work := make([]float64, max(1, 4*n))
+	iwork := make([]int, n)
+
+	equed, rcond, rpvgrw, ok := impl.Dgesvx(fact, trans, n, nrhs, a, lda, af, ldaf, ipiv, EquilibrationNone, r, c, b, ldb, x, ldx, ferr, berr, work, iwork)
+	if !ok {
+		t.Fatalf("%s: unexpected failure, rcond=%v", name, rcond)
+	}
+	if fact != FactEquilibrate && equed != EquilibrationNone {
+		t.Errorf("%s: unexpected equilibration %c", name, equed)
+	}
+	if fact == FactEquilibrate && scaled && n > 1 && equed == EquilibrationNone {
+		t.Errorf("%s: badly scaled matrix not equilibrated", name)
+	}
+	if equed == EquilibrationNone && !floats.Equal(a, aCopy) {
+		t.Errorf("%s: a modified without equilibration", name)
+	}
+	if n > 0 && (rcond <= 0 || rcond > 1) {
+		t.Errorf("%s: rcond out of range: %v", name, rcond)
+	}
+	if n > 0 && (rpvgrw <= 0 || math.IsNaN(rpvgrw)) {
+		t.Errorf("%s: unexpected reciprocal pivot growth %v", name, rpvgrw)
+	}
+	checkErrorBounds(t, name, n, nrhs, x, ldx, xTrue, ferr, berr)
+
+	if fact != FactCompute || n == 0 {
+		return
+	}
+
+	// Check that the factorization can be passed back to Dgesvx.
+	x2 := make([]float64, len(x))
+	copy(a, aCopy)
+	b = rhs(trans, n, nrhs, a, lda, xTrue, ldb)
+	_, _, _, ok = impl.Dgesvx(FactSupplied, trans, n, nrhs, a, lda, af, ldaf, ipiv, EquilibrationNone, r, c, b, ldb, x2, ldx, ferr, berr, work, iwork)
+	if !ok {
+		t.Fatalf("%s: unexpected failure with supplied factorization", name)
+	}
+	if !dequalApprox(n, nrhs, x2, ldx, x, ldx, 1e-14) {
+		t.Errorf("%s: solutions with computed and supplied factorization differ", name)
+	}
+}
+
+func TestDgesvxSingular(t *testing.T) {
+	const n, nrhs = 5, 2
+	rnd := rand.New(rand.NewSource(1))
+	a := make([]float64, n*n)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	// Make the third column zero.
+	for i := 0; i < n; i++ {
+		a[i*n+2] = 0
+	}
+	b := make([]float64, n*nrhs)
+	for i := range b {
+		b[i] = rnd.NormFloat64()
+	}
+	af := make([]float64, n*n)
+	x := make([]float64, n*nrhs)
+	_, rcond, _, ok := impl.Dgesvx(FactCompute, blas.NoTrans, n, nrhs, a, n, af, n, make([]int, n), EquilibrationNone,
+		make([]float64, n), make([]float64, n), b, nrhs, x, nrhs, make([]float64, nrhs), make([]float64, nrhs), make([]float64, 4*n), make([]int, n))
+	if ok {
+		t.Errorf("singular matrix not detected")
+	}
+	if rcond != 0 {
+		t.Errorf("unexpected rcond for singular matrix: got %v, want 0", rcond)
+	}
+}
+
+func TestDgerfs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 30} {
+		for _, nrhs := range []int{1, 3} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				name := fmt.Sprintf("trans=%c,n=%d,nrhs=%d", trans, n, nrhs)
+				lda := n + 2
+				a := make([]float64, (n-1)*lda+n)
+				for i := range a {
+					a[i] = rnd.NormFloat64()
+				}
+				xTrue := make([]float64, n*nrhs)
+				for i := range xTrue {
+					xTrue[i] = rnd.NormFloat64()
+				}
+				b := rhs(trans, n, nrhs, a, lda, xTrue, nrhs)
+
+				af := make([]float64, len(a))
+				copy(af, a)
+				ipiv := make([]int, n)
+				if !impl.Dgetrf(n, n, af, lda, ipiv) {
+					t.Fatalf("%s: Dgetrf failed", name)
+				}
+				x := make([]float64, len(b))
+				copy(x, b)
+				impl.Dgetrs(trans, n, nrhs, af, lda, ipiv, x, nrhs)
+				// Perturb the solution so that it needs refinement.
+				for i := range x {
+					x[i] *= 1 + 1e-8*rnd.NormFloat64()
+				}
+
+				ferr := make([]float64, nrhs)
+				berr := make([]float64, nrhs)
+				impl.Dgerfs(trans, n, nrhs, a, lda, af, lda, ipiv, b, nrhs, x, nrhs, ferr, berr, make([]float64, 3*n), make([]int, n))
+				checkErrorBounds(t, name, n, nrhs, x, nrhs, xTrue, ferr, berr)
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+func TestDgges(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	// inside selects the generalized eigenvalues inside the unit circle.
+	inside := func(alphar, alphai, beta float64) bool {
+		return math.Hypot(alphar, alphai) < math.Abs(beta)
+	}
+	for _, n := range []int{0, 1, 2, 5, 10, 30} {
+		for _, jobvs := range []lapack.SchurComp{lapack.SchurOrig, lapack.SchurNone} {
+			for _, sorted := range []bool{false, true} {
+				var sel func(alphar, alphai, beta float64) bool
+				if sorted {
+					sel = inside
+				}
+				name := fmt.Sprintf("n=%d,jobvs=%c,sorted=%t", n, jobvs, sorted)
+				lda := max(1, n) + 2
+				ldb := max(1, n) + 3
+				a := make([]float64, max(0, (n-1)*lda+n))
+				for i := range a {
+					a[i] = rnd.NormFloat64()
+				}
+				b := make([]float64, max(0, (n-1)*ldb+n))
+				for i := range b {
+					b[i] = rnd.NormFloat64()
+				}
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+				bCopy := make([]float64, len(b))
+				copy(bCopy, b)
+				alphar := make([]float64, n)
+				alphai := make([]float64, n)
+				beta := make([]float64, n)
+				ldvs := max(1, n) + 1
+				vsl := make([]float64, max(1, (n-1)*ldvs+n))
+				vsr := make([]float64, max(1, (n-1)*ldvs+n))
+
+				work := make([]float64, 1)
+				impl.Dgges(jobvs, jobvs, sel, n, a, lda, b, ldb, alphar, alphai, beta, vsl, ldvs, vsr, ldvs, work, -1)
+				work = make([]float64, int(work[0]))
+				sdim, ok := impl.Dgges(jobvs, jobvs, sel, n, a, lda, b, ldb, alphar, alphai, beta, vsl, ldvs, vsr, ldvs, work, len(work))
+				if !ok {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+
+				if !isQuasiTriangular(n, a, lda, alphai) {
+					t.Errorf("%s: S is not upper quasi-triangular", name)
+				}
+				for i := 1; i < n; i++ {
+					for j := 0; j < i; j++ {
+						if b[i*ldb+j] != 0 {
+							t.Errorf("%s: T is not upper triangular", name)
+						}
+					}
+				}
+
+				var want int
+				if sorted {
+					for i := range beta {
+						if inside(alphar[i], alphai[i], beta[i]) {
+							want++
+						}
+					}
+				}
+				if sdim != want {
+					t.Errorf("%s: unexpected sdim: got %d, want %d", name, sdim, want)
+				}
+				for i := 0; i < sdim; i++ {
+					if !inside(alphar[i], alphai[i], beta[i]) {
+						t.Errorf("%s: unselected eigenvalue %d before selected", name, i)
+					}
+				}
+
+				if jobvs == lapack.SchurNone || n == 0 {
+					continue
+				}
+				if !isOrthogonal(n, vsl, ldvs, tol*float64(n)) {
+					t.Errorf("%s: Q is not orthogonal", name)
+				}
+				if !isOrthogonal(n, vsr, ldvs, tol*float64(n)) {
+					t.Errorf("%s: Z is not orthogonal", name)
+				}
+				qs := dmul(blas.NoTrans, blas.NoTrans, n, n, n, vsl, ldvs, a, lda)
+				qszt := dmul(blas.NoTrans, blas.Trans, n, n, n, qs, n, vsr, ldvs)
+				if !dequalApprox(n, n, qszt, n, aCopy, lda, tol*float64(n)) {
+					t.Errorf("%s: Q*S*Zᵀ != A", name)
+				}
+				qt := dmul(blas.NoTrans, blas.NoTrans, n, n, n, vsl, ldvs, b, ldb)
+				qtzt := dmul(blas.NoTrans, blas.Trans, n, n, n, qt, n, vsr, ldvs)
+				if !dequalApprox(n, n, qtzt, n, bCopy, ldb, tol*float64(n)) {
+					t.Errorf("%s: Q*T*Zᵀ != B", name)
+				}
+			}
+		}
+	}
+}
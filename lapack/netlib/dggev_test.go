@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type dggevFunc func(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) bool
+
+func TestDggev3(t *testing.T) {
+	testDggev(t, "Dggev3", impl.Dggev3)
+}
+
+func TestDggev(t *testing.T) {
+	testDggev(t, "Dggev", impl.Dggev)
+}
+
+func testDggev(t *testing.T, fname string, dggev dggevFunc) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 30} {
+		for _, ninf := range []int{0, 1, 3} {
+			if ninf > n {
+				continue
+			}
+			for _, jobvl := range []lapack.LeftEVJob{lapack.LeftEVCompute, lapack.LeftEVNone} {
+				for _, jobvr := range []lapack.RightEVJob{lapack.RightEVCompute, lapack.RightEVNone} {
+					a, b := randomPencil(n, ninf, n+2, rnd)
+					name := fmt.Sprintf("%s:n=%d,ninf=%d,jobvl=%c,jobvr=%c", fname, n, ninf, jobvl, jobvr)
+					dggevTest(t, name, dggev, jobvl, jobvr, n, ninf, a, b, n+2)
+				}
+			}
+		}
+	}
+
+	// A pencil with a known infinite eigenvalue.
+	a := []float64{
+		1, 0,
+		0, 1,
+	}
+	b := []float64{
+		1, 0,
+		0, 0,
+	}
+	dggevTest(t, fname+":diagonal", dggev, lapack.LeftEVCompute, lapack.RightEVCompute, 2, 1, a, b, 2)
+}
+
+// randomPencil returns a random n×n pencil (A,B) with stride ld where B has
+// rank n-ninf, so that the pencil generically has ninf infinite eigenvalues.
+func randomPencil(n, ninf, ld int, rnd *rand.Rand) (a, b []float64) {
+	a = make([]float64, max(0, (n-1)*ld+n))
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a[i*ld+j] = rnd.NormFloat64()
+		}
+	}
+	g := make([]float64, n*n)
+	h := make([]float64, n*n)
+	for i := range g {
+		g[i] = rnd.NormFloat64()
+		h[i] = rnd.NormFloat64()
+	}
+	// Zero the last ninf columns of G so that G*H has rank n-ninf.
+	for i := 0; i < n; i++ {
+		for j := n - ninf; j < n; j++ {
+			g[i*n+j] = 0
+		}
+	}
+	gh := dmul(blas.NoTrans, blas.NoTrans, n, n, n, g, n, h, n)
+	b = make([]float64, len(a))
+	for i := 0; i < n; i++ {
+		copy(b[i*ld:i*ld+n], gh[i*n:i*n+n])
+	}
+	return a, b
+}
+
+func dggevTest(t *testing.T, name string, dggev dggevFunc, jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n, ninf int, a, b []float64, ld int) {
+	const tol = 1e-10
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+	bCopy := make([]float64, len(b))
+	copy(bCopy, b)
+
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	ldv := max(1, n) + 1
+	vl := make([]float64, max(1, n*ldv))
+	vr := make([]float64, max(1, n*ldv))
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+
+	work := make([]float64, 1)
+	dggev(jobvl, jobvr, n, a, ld, b, ld, alphar, alphai, beta, vl, ldv, vr, ldv, work, -1)
+	lwork := int(work[0])
+	if lwork < max(1, 8*n) {
+		t.Errorf("%s: optimal lwork too small: %d", name, lwork)
+		lwork = max(1, 8*n)
+	}
+	work = make([]float64, lwork)
+	if !dggev(jobvl, jobvr, n, a, ld, b, ld, alphar, alphai, beta, vl, ldv, vr, ldv, work, lwork) {
+		t.Fatalf("%s: unexpected failure", name)
+	}
+	if n == 0 {
+		return
+	}
+
+	anorm := impl.Dlange(lapack.MaxColumnSum, n, n, aCopy, ld, make([]float64, n))
+	bnorm := impl.Dlange(lapack.MaxColumnSum, n, n, bCopy, ld, make([]float64, n))
+
+	// Count the infinite eigenvalues.
+	var gotInf int
+	for j := 0; j < n; j++ {
+		if math.Abs(beta[j]) <= tol*bnorm {
+			gotInf++
+		}
+	}
+	if gotInf != ninf {
+		t.Errorf("%s: unexpected number of infinite eigenvalues: got %d, want %d", name, gotInf, ninf)
+	}
+
+	// Check that the eigenvalues are ordered correctly.
+	for j := 0; j < n; j++ {
+		if alphai[j] > 0 {
+			if j == n-1 || alphai[j+1] != -alphai[j] || alphar[j+1] != alphar[j] || beta[j+1] != beta[j] {
+				t.Errorf("%s: complex eigenvalue %d not followed by its conjugate", name, j)
+			}
+			j++
+		} else if alphai[j] < 0 {
+			t.Errorf("%s: complex eigenvalue %d with negative imaginary part first", name, j)
+		}
+	}
+
+	// Check the residuals
+	//  beta_j*A*v_j - alpha_j*B*v_j = 0,
+	//  beta_j*A^T*u_j - conj(alpha_j)*B^T*u_j = 0,
+	// where the eigenvectors are scaled so that their largest component has
+	// |real part| + |imaginary part| equal to one.
+	for _, left := range []bool{false, true} {
+		if (left && !wantvl) || (!left && !wantvr) {
+			continue
+		}
+		v := vr
+		tr := blas.NoTrans
+		if left {
+			v = vl
+			tr = blas.Trans
+		}
+		av := dmul(tr, blas.NoTrans, n, n, n, aCopy, ld, v, ldv)
+		bv := dmul(tr, blas.NoTrans, n, n, n, bCopy, ld, v, ldv)
+		for j := 0; j < n; j++ {
+			ar, ai, bt := alphar[j], alphai[j], beta[j]
+			if left {
+				ai = -ai
+			}
+			scale := tol * (math.Abs(bt)*anorm + math.Hypot(ar, ai)*bnorm)
+			res := make([]float64, 2*n)
+			if alphai[j] == 0 {
+				for i := 0; i < n; i++ {
+					res[i] = bt*av[i*n+j] - ar*bv[i*n+j]
+				}
+			} else {
+				// The eigenvector of the eigenvalue with positive imaginary
+				// part is x + i*y with x and y in the columns j and j+1.
+				for i := 0; i < n; i++ {
+					res[i] = bt*av[i*n+j] - ar*bv[i*n+j] + ai*bv[i*n+j+1]
+					res[n+i] = bt*av[i*n+j+1] - ar*bv[i*n+j+1] - ai*bv[i*n+j]
+				}
+			}
+			if r := floats.Norm(res, math.Inf(1)); r > scale {
+				t.Errorf("%s: left=%t, residual of eigenpair %d too large: %v > %v", name, left, j, r, scale)
+			}
+			if alphai[j] != 0 {
+				j++
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+// randomMatrix returns a random m×n matrix with stride ld.
+func randomMatrix(m, n, ld int, rnd *rand.Rand) []float64 {
+	a := make([]float64, max(0, (m-1)*ld+n))
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	return a
+}
+
+// randomVector returns a random vector of length n.
+func randomVector(n int, rnd *rand.Rand) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = rnd.NormFloat64()
+	}
+	return v
+}
+
+func TestDgglse(t *testing.T) {
+	const tol = 1e-13
+	bi := blas64.Implementation()
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 3, 10} {
+		for _, n := range []int{0, 1, 4, 10} {
+			for _, p := range []int{0, 1, 3, 10} {
+				if p > n || n > m+p {
+					continue
+				}
+				name := fmt.Sprintf("m=%d,n=%d,p=%d", m, n, p)
+				lda := max(1, n) + 2
+				a := randomMatrix(m, n, lda, rnd)
+				ldb := max(1, n) + 3
+				b := randomMatrix(p, n, ldb, rnd)
+				c := randomVector(m, rnd)
+				d := randomVector(p, rnd)
+				bCopy := make([]float64, len(b))
+				copy(bCopy, b)
+				dCopy := make([]float64, len(d))
+				copy(dCopy, d)
+
+				x := make([]float64, n)
+				work := make([]float64, 1)
+				impl.Dgglse(m, n, p, a, lda, b, ldb, c, d, x, work, -1)
+				work = make([]float64, int(work[0]))
+				if !impl.Dgglse(m, n, p, a, lda, b, ldb, c, d, x, work, len(work)) {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+				if p == 0 {
+					continue
+				}
+
+				// The constraint B*x = d must be satisfied to machine precision.
+				r := make([]float64, p)
+				copy(r, dCopy)
+				bi.Dgemv(blas.NoTrans, p, n, -1, bCopy, ldb, x, 1, 1, r, 1)
+				scale := math.Max(1, floats.Norm(dCopy, math.Inf(1))) * math.Max(1, floats.Norm(x, math.Inf(1)))
+				if resid := floats.Norm(r, math.Inf(1)); resid > tol*float64(n)*scale {
+					t.Errorf("%s: constraint residual too large: %v", name, resid)
+				}
+			}
+		}
+	}
+}
+
+func TestDggglm(t *testing.T) {
+	const tol = 1e-13
+	bi := blas64.Implementation()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 10} {
+		for _, m := range []int{0, 1, 3, 10} {
+			for _, p := range []int{0, 1, 3, 10} {
+				if m > n || n > m+p {
+					continue
+				}
+				name := fmt.Sprintf("n=%d,m=%d,p=%d", n, m, p)
+				lda := max(1, m) + 2
+				a := randomMatrix(n, m, lda, rnd)
+				ldb := max(1, p) + 3
+				b := randomMatrix(n, p, ldb, rnd)
+				d := randomVector(n, rnd)
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+				bCopy := make([]float64, len(b))
+				copy(bCopy, b)
+				dCopy := make([]float64, len(d))
+				copy(dCopy, d)
+
+				x := make([]float64, m)
+				y := make([]float64, p)
+				work := make([]float64, 1)
+				impl.Dggglm(n, m, p, a, lda, b, ldb, d, x, y, work, -1)
+				work = make([]float64, int(work[0]))
+				if !impl.Dggglm(n, m, p, a, lda, b, ldb, d, x, y, work, len(work)) {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+				if n == 0 {
+					continue
+				}
+
+				// The constraint A*x + B*y = d must be satisfied to machine
+				// precision.
+				r := make([]float64, n)
+				copy(r, dCopy)
+				if m > 0 {
+					bi.Dgemv(blas.NoTrans, n, m, -1, aCopy, lda, x, 1, 1, r, 1)
+				}
+				if p > 0 {
+					bi.Dgemv(blas.NoTrans, n, p, -1, bCopy, ldb, y, 1, 1, r, 1)
+				}
+				scale := math.Max(1, floats.Norm(dCopy, math.Inf(1)))
+				if m > 0 {
+					scale = math.Max(scale, floats.Norm(x, math.Inf(1)))
+				}
+				if p > 0 {
+					scale = math.Max(scale, floats.Norm(y, math.Inf(1)))
+				}
+				if resid := floats.Norm(r, math.Inf(1)); resid > tol*float64(m+p)*scale {
+					t.Errorf("%s: constraint residual too large: %v", name, resid)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// tridiagToDense returns the n×n tridiagonal matrix with sub-diagonal dl,
+// diagonal d and super-diagonal du as a dense matrix with stride n.
+func tridiagToDense(n int, dl, d, du []float64) []float64 {
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = d[i]
+		if i > 0 {
+			a[i*n+i-1] = dl[i-1]
+		}
+		if i < n-1 {
+			a[i*n+i+1] = du[i]
+		}
+	}
+	return a
+}
+
+func TestDgttrs(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 30} {
+			for _, nrhs := range []int{0, 1, 3} {
+				name := fmt.Sprintf("trans=%c,n=%d,nrhs=%d", trans, n, nrhs)
+				dl := make([]float64, max(0, n-1))
+				d := make([]float64, n)
+				du := make([]float64, max(0, n-1))
+				for i := range dl {
+					dl[i] = rnd.NormFloat64()
+					du[i] = rnd.NormFloat64()
+				}
+				for i := range d {
+					d[i] = rnd.NormFloat64()
+				}
+				a := tridiagToDense(n, dl, d, du)
+
+				xTrue := make([]float64, n*nrhs)
+				for i := range xTrue {
+					xTrue[i] = rnd.NormFloat64()
+				}
+				ldb := max(1, nrhs) + 2
+				b := rhs(trans, n, nrhs, a, max(1, n), xTrue, ldb)
+
+				du2 := make([]float64, max(0, n-2))
+				ipiv := make([]int, n)
+				if !impl.Dgttrf(n, dl, d, du, du2, ipiv) {
+					t.Errorf("%s: unexpected singular matrix", name)
+					continue
+				}
+				for i, p := range ipiv {
+					if p != i && p != i+1 {
+						t.Errorf("%s: unexpected ipiv[%d]=%d", name, i, p)
+					}
+				}
+				impl.Dgttrs(trans, n, nrhs, dl, d, du, du2, ipiv, b, ldb)
+				if !dequalApprox(n, nrhs, b, ldb, xTrue, max(1, nrhs), tol) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+			}
+		}
+	}
+}
+
+func TestDptsv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 30} {
+		for _, nrhs := range []int{0, 1, 3} {
+			name := fmt.Sprintf("n=%d,nrhs=%d", n, nrhs)
+			d := make([]float64, n)
+			e := make([]float64, max(0, n-1))
+			for i := range e {
+				e[i] = rnd.NormFloat64()
+			}
+			for i := range d {
+				// Diagonal dominance guarantees positive definiteness.
+				d[i] = 2 + rnd.Float64()
+				if i > 0 {
+					d[i] += math.Abs(e[i-1])
+				}
+				if i < n-1 {
+					d[i] += math.Abs(e[i])
+				}
+			}
+			a := tridiagToDense(n, e, d, e)
+
+			xTrue := make([]float64, n*nrhs)
+			for i := range xTrue {
+				xTrue[i] = rnd.NormFloat64()
+			}
+			ldb := max(1, nrhs) + 2
+			b := rhs(blas.NoTrans, n, nrhs, a, max(1, n), xTrue, ldb)
+
+			df := make([]float64, n)
+			copy(df, d)
+			ef := make([]float64, len(e))
+			copy(ef, e)
+			if !impl.Dptsv(n, nrhs, d, e, b, ldb) {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			if !dequalApprox(n, nrhs, b, ldb, xTrue, max(1, nrhs), tol*float64(max(1, n))) {
+				t.Errorf("%s: unexpected solution", name)
+			}
+
+			// Dptsv returns the factorization computed by Dpttrf.
+			if !impl.Dpttrf(n, df, ef) {
+				t.Errorf("%s: unexpected Dpttrf failure", name)
+				continue
+			}
+			if !floats.EqualApprox(d, df, tol) || !floats.EqualApprox(e, ef, tol) {
+				t.Errorf("%s: factorization mismatch between Dptsv and Dpttrf", name)
+			}
+		}
+	}
+
+	d := []float64{1, -1, 1}
+	e := []float64{0, 0}
+	if impl.Dpttrf(len(d), d, e) {
+		t.Errorf("Dpttrf: unexpected success for indefinite matrix")
+	}
+}
+
+func TestDgtsvSingular(t *testing.T) {
+	dl := []float64{0}
+	d := []float64{1, 0}
+	du := []float64{0}
+	if impl.Dgtsv(len(d), 1, dl, d, du, []float64{1, 1}, 1) {
+		t.Errorf("Dgtsv: unexpected success for singular matrix")
+	}
+}
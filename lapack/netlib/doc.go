@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package netlib provides an interface to bindings for a C LAPACK library.
+//
+// When the package is built without cgo, Implementation forwards to the pure
+// Go implementation in gonum.org/v1/gonum/lapack/gonum instead, so that
+// importing the package does not prevent cross-compilation. Routines that
+// the pure Go implementation does not provide are only available with cgo
+// and panic otherwise.
+//
+// Float32Implementation provides the single precision counterparts of the
+// routines of Implementation. Its routines are only available with cgo and
+// panic otherwise.
+//
+// Built with the ilp64 build tag, the package uses the LAPACKE interface with
+// 64-bit integers, so that pivot indices and matrix dimensions are not limited
+// to 2^31-1. See gonum.org/v1/netlib/blas/netlib for the libraries that
+// provide this interface and for the suffix64 build tag.
+package netlib // import "gonum.org/v1/netlib/lapack/netlib"
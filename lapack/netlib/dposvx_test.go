@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// dspd returns a random n×n symmetric positive definite matrix with stride
+// ld.
+func dspd(n, ld int, rnd *rand.Rand) []float64 {
+	g := make([]float64, n*n)
+	for i := range g {
+		g[i] = rnd.NormFloat64()
+	}
+	gg := dmul(blas.Trans, blas.NoTrans, n, n, n, g, n, g, n)
+	a := make([]float64, max(0, (n-1)*ld+n))
+	for i := 0; i < n; i++ {
+		copy(a[i*ld:i*ld+n], gg[i*n:i*n+n])
+		a[i*ld+i] += float64(n)
+	}
+	return a
+}
+
+func TestDposvx(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30} {
+			for _, nrhs := range []int{0, 1, 3} {
+				for _, fact := range []FactJob{FactSupplied, FactCompute, FactEquilibrate} {
+					for _, scaled := range []bool{false, true} {
+						dposvxTest(t, fact, uplo, n, nrhs, scaled, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dposvxTest(t *testing.T, fact FactJob, uplo blas.Uplo, n, nrhs int, scaled bool, rnd *rand.Rand) {
+	name := fmt.Sprintf("fact=%c,uplo=%c,n=%d,nrhs=%d,scaled=%t", fact, uplo, n, nrhs, scaled)
+	lda := max(1, n) + 3
+	a := dspd(n, lda, rnd)
+	if scaled {
+		// Scale symmetrically so that equilibration is required.
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] *= float64(int(1)<<(10*(i%3))) * float64(int(1)<<(10*(j%3)))
+			}
+		}
+	}
+	xTrue := make([]float64, n*nrhs)
+	for i := range xTrue {
+		xTrue[i] = rnd.NormFloat64()
+	}
+	ldb := max(1, nrhs) + 2
+	b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, ldb)
+
+	ldaf := max(1, n) + 1
+	af := make([]float64, max(0, (n-1)*ldaf+n))
+	if fact == FactSupplied {
+		impl.Dlacpy(blas.All, n, n, a, lda, af, ldaf)
+		if !impl.Dpotrf(uplo, n, af, ldaf) {
+			t.Fatalf("%s: Dpotrf failed", name)
+		}
+	}
+	ldx := max(1, nrhs) + 1
+	x := make([]float64, max(0, (n-1)*ldx+nrhs))
+	s := make([]float64, n)
+	ferr := make([]float64, nrhs)
+	berr := make([]float64, nrhs)
+
+	equed, rcond, ok := impl.Dposvx(fact, uplo, n, nrhs, a, lda, af, ldaf, EquilibrationNone, s, b, ldb, x, ldx, ferr, berr, make([]float64, 3*n), make([]int, n))
+	if !ok {
+		t.Fatalf("%s: unexpected failure, rcond=%v", name, rcond)
+	}
+	if fact != FactEquilibrate && equed != EquilibrationNone {
+		t.Errorf("%s: unexpected equilibration %c", name, equed)
+	}
+	if fact == FactEquilibrate && scaled && n > 1 && equed != EquilibrationSym {
+		t.Errorf("%s: badly scaled matrix not equilibrated", name)
+	}
+	if n > 0 && (rcond <= 0 || rcond > 1) {
+		t.Errorf("%s: rcond out of range: %v", name, rcond)
+	}
+	checkErrorBounds(t, name, n, nrhs, x, ldx, xTrue, ferr, berr)
+}
+
+func TestDposvxNotPositiveDefinite(t *testing.T) {
+	const n, nrhs = 4, 1
+	a := []float64{
+		1, 2, 0, 0,
+		2, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}
+	af := make([]float64, n*n)
+	x := make([]float64, n*nrhs)
+	_, rcond, ok := impl.Dposvx(FactCompute, blas.Upper, n, nrhs, a, n, af, n, EquilibrationNone, make([]float64, n),
+		[]float64{1, 1, 1, 1}, nrhs, x, nrhs, make([]float64, nrhs), make([]float64, nrhs), make([]float64, 3*n), make([]int, n))
+	if ok {
+		t.Errorf("indefinite matrix not detected")
+	}
+	if rcond != 0 {
+		t.Errorf("unexpected rcond for indefinite matrix: got %v, want 0", rcond)
+	}
+}
+
+func TestDporfs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 2, 5, 10, 30} {
+			for _, nrhs := range []int{1, 3} {
+				name := fmt.Sprintf("uplo=%c,n=%d,nrhs=%d", uplo, n, nrhs)
+				lda := n + 2
+				a := dspd(n, lda, rnd)
+				xTrue := make([]float64, n*nrhs)
+				for i := range xTrue {
+					xTrue[i] = rnd.NormFloat64()
+				}
+				b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, nrhs)
+
+				af := make([]float64, len(a))
+				copy(af, a)
+				if !impl.Dpotrf(uplo, n, af, lda) {
+					t.Fatalf("%s: Dpotrf failed", name)
+				}
+				x := make([]float64, len(b))
+				copy(x, b)
+				impl.Dpotrs(uplo, n, nrhs, af, lda, x, nrhs)
+				// Perturb the solution so that it needs refinement.
+				for i := range x {
+					x[i] *= 1 + 1e-8*rnd.NormFloat64()
+				}
+
+				ferr := make([]float64, nrhs)
+				berr := make([]float64, nrhs)
+				impl.Dporfs(uplo, n, nrhs, a, lda, af, lda, b, nrhs, x, nrhs, ferr, berr, make([]float64, 3*n), make([]int, n))
+				checkErrorBounds(t, name, n, nrhs, x, nrhs, xTrue, ferr, berr)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// packSym packs the triangle of the n×n matrix A specified by uplo into the
+// row-major packed layout of blas64.SymmetricPacked.
+func packSym(uplo blas.Uplo, n int, a []float64, lda int) []float64 {
+	ap := make([]float64, 0, n*(n+1)/2)
+	for i := 0; i < n; i++ {
+		if uplo == blas.Upper {
+			ap = append(ap, a[i*lda+i:i*lda+n]...)
+		} else {
+			ap = append(ap, a[i*lda:i*lda+i+1]...)
+		}
+	}
+	return ap
+}
+
+func TestDpptrf(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30} {
+			for _, nrhs := range []int{0, 1, 3} {
+				name := fmt.Sprintf("uplo=%c,n=%d,nrhs=%d", uplo, n, nrhs)
+				lda := max(1, n)
+				a := dspd(n, lda, rnd)
+				ap := packSym(uplo, n, a, lda)
+
+				xTrue := make([]float64, n*nrhs)
+				for i := range xTrue {
+					xTrue[i] = rnd.NormFloat64()
+				}
+				ldb := max(1, nrhs) + 2
+				b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, ldb)
+
+				if !impl.Dpptrf(uplo, n, ap) {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+				if !impl.Dpotrf(uplo, n, a, lda) {
+					t.Fatalf("%s: Dpotrf failed", name)
+				}
+				want := packSym(uplo, n, a, lda)
+				if !dequalApprox(1, len(ap), ap, len(ap), want, len(want), tol) {
+					t.Errorf("%s: factorization mismatch between Dpptrf and Dpotrf", name)
+				}
+
+				impl.Dpptrs(uplo, n, nrhs, ap, b, ldb)
+				if !dequalApprox(n, nrhs, b, ldb, xTrue, max(1, nrhs), 1e-10) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+			}
+		}
+	}
+}
+
+func TestDppsv(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30} {
+			for _, nrhs := range []int{0, 1, 3} {
+				name := fmt.Sprintf("uplo=%c,n=%d,nrhs=%d", uplo, n, nrhs)
+				lda := max(1, n)
+				a := dspd(n, lda, rnd)
+				ap := packSym(uplo, n, a, lda)
+
+				xTrue := make([]float64, n*nrhs)
+				for i := range xTrue {
+					xTrue[i] = rnd.NormFloat64()
+				}
+				ldb := max(1, nrhs) + 1
+				b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, ldb)
+
+				if !impl.Dppsv(uplo, n, nrhs, ap, b, ldb) {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+				if !dequalApprox(n, nrhs, b, ldb, xTrue, max(1, nrhs), tol) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+
+				// Dppsv returns the factorization computed by Dpptrf.
+				want := packSym(uplo, n, a, lda)
+				impl.Dpptrf(uplo, n, want)
+				if !dequalApprox(1, len(ap), ap, len(ap), want, len(want), 1e-12) {
+					t.Errorf("%s: factorization mismatch between Dppsv and Dpptrf", name)
+				}
+			}
+		}
+	}
+}
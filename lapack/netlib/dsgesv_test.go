@@ -0,0 +1,189 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// hilbert returns the n×n Hilbert matrix with stride ld. It is symmetric
+// positive definite and extremely ill-conditioned.
+func hilbert(n, ld int) []float64 {
+	a := make([]float64, max(0, (n-1)*ld+n))
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a[i*ld+j] = 1 / float64(i+j+1)
+		}
+	}
+	return a
+}
+
+// rhs returns B = op(A)*X for the n×n matrix A and the n×nrhs matrix X,
+// stored with stride ldb, where op(A) is A or Aᵀ as specified by trans.
+func rhs(trans blas.Transpose, n, nrhs int, a []float64, lda int, x []float64, ldb int) []float64 {
+	b := make([]float64, max(0, (n-1)*ldb+nrhs))
+	tmp := dmul(trans, blas.NoTrans, n, nrhs, n, a, lda, x, max(1, nrhs))
+	for i := 0; i < n; i++ {
+		copy(b[i*ldb:i*ldb+nrhs], tmp[i*nrhs:i*nrhs+nrhs])
+	}
+	return b
+}
+
+func TestDsgesv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10, 50} {
+		for _, nrhs := range []int{1, 3} {
+			name := fmt.Sprintf("n=%d,nrhs=%d", n, nrhs)
+			lda := max(1, n) + 2
+			a := make([]float64, max(0, (n-1)*lda+n))
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+			for i := 0; i < n; i++ {
+				// Make A diagonally dominant and thus well-conditioned.
+				a[i*lda+i] += float64(2 * n)
+			}
+			aCopy := make([]float64, len(a))
+			copy(aCopy, a)
+			xTrue := make([]float64, n*nrhs)
+			for i := range xTrue {
+				xTrue[i] = rnd.NormFloat64()
+			}
+			ldb := max(1, nrhs) + 1
+			b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, ldb)
+			bCopy := make([]float64, len(b))
+			copy(bCopy, b)
+
+			ldx := max(1, nrhs) + 3
+			x := make([]float64, max(0, (n-1)*ldx+nrhs))
+			ipiv := make([]int, n)
+			iter, fallback, ok := impl.Dsgesv(n, nrhs, a, lda, ipiv, b, ldb, x, ldx, make([]float64, n*nrhs), make([]float32, n*(n+nrhs)))
+			if !ok {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			if fallback {
+				t.Errorf("%s: unexpected fallback to double precision", name)
+			}
+			if iter < 0 || 30 < iter {
+				t.Errorf("%s: unexpected iteration count %d", name, iter)
+			}
+			if !floats.Equal(a, aCopy) {
+				t.Errorf("%s: unexpected modification of A", name)
+			}
+			if !floats.Equal(b, bCopy) {
+				t.Errorf("%s: unexpected modification of B", name)
+			}
+			if !dequalApprox(n, nrhs, x, ldx, xTrue, max(1, nrhs), tol) {
+				t.Errorf("%s: unexpected solution", name)
+			}
+		}
+	}
+}
+
+func TestDsgesvFallback(t *testing.T) {
+	const n, nrhs = 12, 2
+	lda := n
+	a := hilbert(n, lda)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+	xTrue := make([]float64, n*nrhs)
+	for i := range xTrue {
+		xTrue[i] = 1
+	}
+	b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, nrhs)
+
+	x := make([]float64, n*nrhs)
+	ipiv := make([]int, n)
+	_, fallback, ok := impl.Dsgesv(n, nrhs, a, lda, ipiv, b, nrhs, x, nrhs, make([]float64, n*nrhs), make([]float32, n*(n+nrhs)))
+	if !ok {
+		t.Fatal("unexpected failure")
+	}
+	if !fallback {
+		t.Fatal("expected fallback to double precision for the Hilbert matrix")
+	}
+
+	// On fallback a and ipiv contain the double precision factorization.
+	ipivWant := make([]int, n)
+	impl.Dgetrf(n, n, aCopy, lda, ipivWant)
+	if !floats.EqualApprox(a, aCopy, 1e-12) {
+		t.Errorf("unexpected factorization of A")
+	}
+	for i := range ipiv {
+		if ipiv[i] != ipivWant[i] {
+			t.Errorf("unexpected ipiv; got %v, want %v", ipiv, ipivWant)
+			break
+		}
+	}
+}
+
+func TestDsposv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 10, 50} {
+			for _, nrhs := range []int{1, 3} {
+				name := fmt.Sprintf("uplo=%c,n=%d,nrhs=%d", uplo, n, nrhs)
+				lda := max(1, n) + 2
+				a := dspd(n, lda, rnd)
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+				xTrue := make([]float64, n*nrhs)
+				for i := range xTrue {
+					xTrue[i] = rnd.NormFloat64()
+				}
+				ldb := max(1, nrhs) + 1
+				b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, ldb)
+
+				ldx := max(1, nrhs) + 3
+				x := make([]float64, max(0, (n-1)*ldx+nrhs))
+				iter, fallback, ok := impl.Dsposv(uplo, n, nrhs, a, lda, b, ldb, x, ldx, make([]float64, n*nrhs), make([]float32, n*(n+nrhs)))
+				if !ok {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+				if fallback {
+					t.Errorf("%s: unexpected fallback to double precision", name)
+				}
+				if iter < 0 || 30 < iter {
+					t.Errorf("%s: unexpected iteration count %d", name, iter)
+				}
+				if !floats.Equal(a, aCopy) {
+					t.Errorf("%s: unexpected modification of A", name)
+				}
+				if !dequalApprox(n, nrhs, x, ldx, xTrue, max(1, nrhs), tol) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+			}
+		}
+	}
+}
+
+func TestDsposvNotPositiveDefinite(t *testing.T) {
+	const n, nrhs = 3, 1
+	a := []float64{
+		1, 2, 0,
+		2, 1, 0,
+		0, 0, 1,
+	}
+	b := []float64{1, 1, 1}
+	x := make([]float64, n)
+	_, fallback, ok := impl.Dsposv(blas.Upper, n, nrhs, a, n, b, nrhs, x, nrhs, make([]float64, n*nrhs), make([]float32, n*(n+nrhs)))
+	if ok {
+		t.Errorf("unexpected success for indefinite matrix")
+	}
+	if !fallback {
+		t.Errorf("expected fallback to double precision after failed single precision factorization")
+	}
+}
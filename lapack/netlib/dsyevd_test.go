@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dsymmetric returns a random n×n symmetric matrix with stride ld.
+func dsymmetric(n, ld int, rnd *rand.Rand) []float64 {
+	a := make([]float64, max(0, (n-1)*ld+n))
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a[i*ld+j] = rnd.NormFloat64()
+			a[j*ld+i] = a[i*ld+j]
+		}
+	}
+	return a
+}
+
+// eigenResidual returns max |A*z_j - w_j*z_j| over the first m columns of the
+// n×m matrix Z, relative to the largest absolute eigenvalue of A.
+func eigenResidual(n, m int, a []float64, lda int, w, z []float64, ldz int) float64 {
+	scale := 1.0
+	for _, v := range w[:m] {
+		scale = math.Max(scale, math.Abs(v))
+	}
+	var resid float64
+	for j := 0; j < m; j++ {
+		for i := 0; i < n; i++ {
+			var az float64
+			for k := 0; k < n; k++ {
+				az += a[i*lda+k] * z[k*ldz+j]
+			}
+			resid = math.Max(resid, math.Abs(az-w[j]*z[i*ldz+j]))
+		}
+	}
+	return resid / scale
+}
+
+func TestDsyevd(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10, 50} {
+		for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				name := fmt.Sprintf("n=%d,jobz=%c,uplo=%c", n, jobz, uplo)
+				lda := max(1, n) + 3
+				a := dsymmetric(n, lda, rnd)
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+
+				// Compute the reference eigenvalues with Dsyev.
+				aRef := make([]float64, len(a))
+				copy(aRef, a)
+				wRef := make([]float64, n)
+				work := make([]float64, max(1, 3*n-1))
+				impl.Dsyev(lapack.EVNone, uplo, n, aRef, lda, wRef, work, len(work))
+
+				w := make([]float64, n)
+				work = make([]float64, 1)
+				iwork := make([]int, 1)
+				impl.Dsyevd(jobz, uplo, n, a, lda, w, work, -1, iwork, -1)
+				work = make([]float64, int(work[0]))
+				iwork = make([]int, iwork[0])
+				if !impl.Dsyevd(jobz, uplo, n, a, lda, w, work, len(work), iwork, len(iwork)) {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+				if !floats.EqualApprox(w, wRef, tol*float64(max(1, n))) {
+					t.Errorf("%s: eigenvalue mismatch: got %v, want %v", name, w, wRef)
+				}
+				if jobz == lapack.EVCompute {
+					if resid := eigenResidual(n, n, aCopy, lda, w, a, lda); resid > tol*float64(max(1, n)) {
+						t.Errorf("%s: unexpected eigenvector residual %v", name, resid)
+					}
+				}
+			}
+		}
+	}
+}
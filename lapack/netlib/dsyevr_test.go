@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+func TestDsyevr(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		lda := n + 3
+		a := dsymmetric(n, lda, rnd)
+
+		// Compute the reference eigenvalues with Dsyev.
+		aRef := make([]float64, len(a))
+		copy(aRef, a)
+		wRef := make([]float64, n)
+		work := make([]float64, max(1, 3*n-1))
+		impl.Dsyev(lapack.EVNone, blas.Upper, n, aRef, lda, wRef, work, len(work))
+
+		for _, test := range []struct {
+			rng    EVRange
+			vl, vu float64
+			il, iu int
+		}{
+			{rng: EVRangeAll},
+			{rng: EVRangeIndex, il: 0, iu: n - 1},
+			{rng: EVRangeIndex, il: 0, iu: 0},
+			{rng: EVRangeIndex, il: n / 2, iu: n - 1},
+			{rng: EVRangeValue, vl: wRef[0] - 1, vu: wRef[n-1] + 1},
+			{rng: EVRangeValue, vl: wRef[0] - 1, vu: (wRef[0] + wRef[n-1]) / 2},
+		} {
+			// Determine the expected eigenvalues.
+			var want []float64
+			switch test.rng {
+			case EVRangeAll:
+				want = wRef
+			case EVRangeIndex:
+				want = wRef[test.il : test.iu+1]
+			case EVRangeValue:
+				for _, v := range wRef {
+					if test.vl < v && v <= test.vu {
+						want = append(want, v)
+					}
+				}
+			}
+			ncol := n
+			if test.rng == EVRangeIndex {
+				ncol = test.iu - test.il + 1
+			}
+
+			for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+				for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+					name := fmt.Sprintf("n=%d,rng=%c,vl=%v,vu=%v,il=%d,iu=%d,jobz=%c,uplo=%c",
+						n, test.rng, test.vl, test.vu, test.il, test.iu, jobz, uplo)
+					aCopy := make([]float64, len(a))
+					copy(aCopy, a)
+					w := make([]float64, n)
+					ldz := ncol + 2
+					if jobz == lapack.EVNone {
+						// ldz is only checked against ncol when
+						// eigenvectors are computed.
+						ldz = 1
+					}
+					z := make([]float64, (n-1)*ldz+ncol)
+					isuppz := make([]int, 2*ncol)
+
+					work := make([]float64, 1)
+					iwork := make([]int, 1)
+					impl.Dsyevr(jobz, test.rng, uplo, n, aCopy, lda, test.vl, test.vu, test.il, test.iu, 0, w, z, ldz, isuppz, work, -1, iwork, -1)
+					work = make([]float64, int(work[0]))
+					iwork = make([]int, iwork[0])
+					m, ok := impl.Dsyevr(jobz, test.rng, uplo, n, aCopy, lda, test.vl, test.vu, test.il, test.iu, 0, w, z, ldz, isuppz, work, len(work), iwork, len(iwork))
+					if !ok {
+						t.Errorf("%s: unexpected failure", name)
+						continue
+					}
+					if m != len(want) {
+						t.Errorf("%s: unexpected number of eigenvalues: got %d, want %d", name, m, len(want))
+						continue
+					}
+					if !floats.EqualApprox(w[:m], want, tol*float64(n)) {
+						t.Errorf("%s: eigenvalue mismatch: got %v, want %v", name, w[:m], want)
+					}
+					if jobz == lapack.EVNone {
+						continue
+					}
+					if resid := eigenResidual(n, m, a, lda, w, z, ldz); resid > tol*float64(n) {
+						t.Errorf("%s: unexpected eigenvector residual %v", name, resid)
+					}
+					if m != n || test.rng == EVRangeValue {
+						continue
+					}
+					for i := 0; i < m; i++ {
+						first, last := isuppz[2*i], isuppz[2*i+1]
+						if first < 0 || last < first || n <= last {
+							t.Errorf("%s: invalid support [%d,%d] of eigenvector %d", name, first, last, i)
+						}
+						for k := 0; k < n; k++ {
+							if (k < first || last < k) && z[k*ldz+i] != 0 {
+								t.Errorf("%s: eigenvector %d nonzero outside its support", name, i)
+								break
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
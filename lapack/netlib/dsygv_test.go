@@ -0,0 +1,239 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// genEVResidual returns the largest residual of the m eigenpairs in w and the
+// columns of Z of the generalized symmetric-definite eigenproblem of the
+// given type, relative to the norms of A and B. a and b must contain the full
+// symmetric matrices with stride n.
+func genEVResidual(itype GenEVType, n, m int, a, b, w, z []float64, ldz int) float64 {
+	var lhs, rhs []float64
+	switch itype {
+	case GenEVAxBx:
+		// A*Z - B*Z*diag(w)
+		lhs = dmul(blas.NoTrans, blas.NoTrans, n, m, n, a, n, z, ldz)
+		rhs = dmul(blas.NoTrans, blas.NoTrans, n, m, n, b, n, z, ldz)
+	case GenEVABx:
+		// A*B*Z - Z*diag(w)
+		bz := dmul(blas.NoTrans, blas.NoTrans, n, m, n, b, n, z, ldz)
+		lhs = dmul(blas.NoTrans, blas.NoTrans, n, m, n, a, n, bz, m)
+		rhs = make([]float64, n*m)
+		for i := 0; i < n; i++ {
+			copy(rhs[i*m:i*m+m], z[i*ldz:i*ldz+m])
+		}
+	case GenEVBAx:
+		// B*A*Z - Z*diag(w)
+		az := dmul(blas.NoTrans, blas.NoTrans, n, m, n, a, n, z, ldz)
+		lhs = dmul(blas.NoTrans, blas.NoTrans, n, m, n, b, n, az, m)
+		rhs = make([]float64, n*m)
+		for i := 0; i < n; i++ {
+			copy(rhs[i*m:i*m+m], z[i*ldz:i*ldz+m])
+		}
+	}
+	scale := floats.Norm(a, math.Inf(1)) * floats.Norm(b, math.Inf(1))
+	var res float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			res = math.Max(res, math.Abs(lhs[i*m+j]-w[j]*rhs[i*m+j]))
+		}
+	}
+	return res / math.Max(1, scale)
+}
+
+// checkGenEVNormalization reports whether the m columns of Z are normalized
+// as described in Dsygv.
+func checkGenEVNormalization(itype GenEVType, n, m int, b, z []float64, ldz int, tol float64) bool {
+	var bz []float64
+	if itype == GenEVBAx {
+		// Solve B*X = Z using a copy of B.
+		chol := make([]float64, len(b))
+		copy(chol, b)
+		if !impl.Dpotrf(blas.Upper, n, chol, n) {
+			return false
+		}
+		bz = make([]float64, n*m)
+		for i := 0; i < n; i++ {
+			copy(bz[i*m:i*m+m], z[i*ldz:i*ldz+m])
+		}
+		impl.Dpotrs(blas.Upper, n, m, chol, n, bz, m)
+	} else {
+		bz = dmul(blas.NoTrans, blas.NoTrans, n, m, n, b, n, z, ldz)
+	}
+	ztbz := dmul(blas.Trans, blas.NoTrans, m, m, n, z, ldz, bz, m)
+	eye := make([]float64, m*m)
+	for i := 0; i < m; i++ {
+		eye[i*m+i] = 1
+	}
+	return dequalApprox(m, m, ztbz, m, eye, m, tol)
+}
+
+func TestDsygv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, itype := range []GenEVType{GenEVAxBx, GenEVABx, GenEVBAx} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, n := range []int{0, 1, 2, 5, 10, 30} {
+				for _, jobz := range []lapack.EVJob{lapack.EVCompute, lapack.EVNone} {
+					name := fmt.Sprintf("itype=%d,uplo=%c,n=%d,jobz=%c", itype, uplo, n, jobz)
+					a := dsymmetric(n, max(1, n), rnd)
+					b := dspd(n, max(1, n), rnd)
+					wantz := jobz == lapack.EVCompute
+
+					// Dsygv.
+					aCopy := make([]float64, len(a))
+					copy(aCopy, a)
+					bCopy := make([]float64, len(b))
+					copy(bCopy, b)
+					w := make([]float64, n)
+					work := make([]float64, 1)
+					impl.Dsygv(itype, jobz, uplo, n, aCopy, max(1, n), bCopy, max(1, n), w, work, -1)
+					work = make([]float64, max(int(work[0]), max(1, 3*n-1)))
+					if !impl.Dsygv(itype, jobz, uplo, n, aCopy, max(1, n), bCopy, max(1, n), w, work, len(work)) {
+						t.Fatalf("%s: Dsygv failed", name)
+					}
+					if !floats.HasNaN(w) && !sortedAscending(w) {
+						t.Errorf("%s: Dsygv eigenvalues not sorted: %v", name, w)
+					}
+					if wantz && n > 0 {
+						if res := genEVResidual(itype, n, n, a, b, w, aCopy, n); res > tol*float64(n) {
+							t.Errorf("%s: Dsygv residual too large: %v", name, res)
+						}
+						if !checkGenEVNormalization(itype, n, n, b, aCopy, n, tol*float64(n)) {
+							t.Errorf("%s: Dsygv eigenvectors not normalized", name)
+						}
+					}
+
+					// Dsygvd.
+					copy(aCopy, a)
+					copy(bCopy, b)
+					wd := make([]float64, n)
+					work = make([]float64, 1)
+					iwork := make([]int, 1)
+					impl.Dsygvd(itype, jobz, uplo, n, aCopy, max(1, n), bCopy, max(1, n), wd, work, -1, iwork, -1)
+					work = make([]float64, int(work[0]))
+					iwork = make([]int, iwork[0])
+					if !impl.Dsygvd(itype, jobz, uplo, n, aCopy, max(1, n), bCopy, max(1, n), wd, work, len(work), iwork, len(iwork)) {
+						t.Fatalf("%s: Dsygvd failed", name)
+					}
+					if !floats.EqualApprox(wd, w, tol*math.Max(1, floats.Norm(w, math.Inf(1)))) {
+						t.Errorf("%s: Dsygvd eigenvalues differ from Dsygv: got %v, want %v", name, wd, w)
+					}
+					if wantz && n > 0 {
+						if res := genEVResidual(itype, n, n, a, b, wd, aCopy, n); res > tol*float64(n) {
+							t.Errorf("%s: Dsygvd residual too large: %v", name, res)
+						}
+						if !checkGenEVNormalization(itype, n, n, b, aCopy, n, tol*float64(n)) {
+							t.Errorf("%s: Dsygvd eigenvectors not normalized", name)
+						}
+					}
+
+					dsygvxTest(t, name, itype, jobz, uplo, n, a, b, w)
+				}
+			}
+		}
+	}
+}
+
+func sortedAscending(x []float64) bool {
+	for i := 1; i < len(x); i++ {
+		if x[i] < x[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// dsygvxTest checks Dsygvx for all ranges against the eigenvalues w computed
+// by Dsygv.
+func dsygvxTest(t *testing.T, name string, itype GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a, b, w []float64) {
+	const tol = 1e-12
+	wantz := jobz == lapack.EVCompute
+	type rangeTest struct {
+		rng    EVRange
+		vl, vu float64
+		il, iu int
+		want   []float64
+	}
+	tests := []rangeTest{{rng: EVRangeAll, want: w}}
+	if n > 0 {
+		il, iu := n/3, (2*n)/3
+		tests = append(tests, rangeTest{rng: EVRangeIndex, il: il, iu: iu, want: w[il : iu+1]})
+		// Choose the interval between eigenvalues to avoid ambiguity at its
+		// ends.
+		vl := w[0] - 1
+		if il > 0 {
+			vl = (w[il-1] + w[il]) / 2
+		}
+		vu := w[n-1] + 1
+		if iu < n-1 {
+			vu = (w[iu] + w[iu+1]) / 2
+		}
+		if vl < vu {
+			tests = append(tests, rangeTest{rng: EVRangeValue, vl: vl, vu: vu, want: w[il : iu+1]})
+		}
+	}
+	for _, test := range tests {
+		name := fmt.Sprintf("%s,rng=%c", name, test.rng)
+		aCopy := make([]float64, len(a))
+		copy(aCopy, a)
+		bCopy := make([]float64, len(b))
+		copy(bCopy, b)
+		ncol := n
+		if test.rng == EVRangeIndex {
+			ncol = test.iu - test.il + 1
+		}
+		ldz := max(1, ncol) + 1
+		if !wantz {
+			// ldz is only checked against ncol when eigenvectors are
+			// computed.
+			ldz = 1
+		}
+		z := make([]float64, max(1, n*ldz))
+		wx := make([]float64, n)
+		work := make([]float64, 1)
+		impl.Dsygvx(itype, jobz, test.rng, uplo, n, aCopy, max(1, n), bCopy, max(1, n), test.vl, test.vu, test.il, test.iu, 0, wx, z, ldz, work, -1, nil, nil)
+		work = make([]float64, max(int(work[0]), max(1, 8*n)))
+		ifail := make([]int, n)
+		m, ok := impl.Dsygvx(itype, jobz, test.rng, uplo, n, aCopy, max(1, n), bCopy, max(1, n), test.vl, test.vu, test.il, test.iu, 0, wx, z, ldz, work, len(work), make([]int, 5*n), ifail)
+		if !ok {
+			t.Fatalf("%s: Dsygvx failed", name)
+		}
+		if m != len(test.want) {
+			t.Errorf("%s: unexpected number of eigenvalues: got %d, want %d", name, m, len(test.want))
+			continue
+		}
+		if !floats.EqualApprox(wx[:m], test.want, tol*math.Max(1, floats.Norm(w, math.Inf(1)))) {
+			t.Errorf("%s: eigenvalues differ from Dsygv: got %v, want %v", name, wx[:m], test.want)
+		}
+		if !wantz || m == 0 {
+			continue
+		}
+		for i, v := range ifail[:m] {
+			if v != -1 {
+				t.Errorf("%s: unexpected ifail[%d] = %d for converged eigenvector", name, i, v)
+			}
+		}
+		if res := genEVResidual(itype, n, m, a, b, wx, z, ldz); res > tol*float64(n) {
+			t.Errorf("%s: residual too large: %v", name, res)
+		}
+		if !checkGenEVNormalization(itype, n, m, b, z, ldz, tol*float64(n)) {
+			t.Errorf("%s: eigenvectors not normalized", name)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestDsysvx(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30} {
+			for _, nrhs := range []int{0, 1, 3} {
+				for _, fact := range []FactJob{FactCompute, FactSupplied} {
+					dsysvxTest(t, fact, uplo, n, nrhs, rnd)
+				}
+			}
+		}
+	}
+}
+
+func dsysvxTest(t *testing.T, fact FactJob, uplo blas.Uplo, n, nrhs int, rnd *rand.Rand) {
+	name := fmt.Sprintf("fact=%c,uplo=%c,n=%d,nrhs=%d", fact, uplo, n, nrhs)
+	lda := max(1, n) + 3
+	a := dsymmetric(n, lda, rnd)
+	for i := 0; i < n; i++ {
+		// Keep A indefinite but well conditioned.
+		if i%2 == 0 {
+			a[i*lda+i] += float64(n)
+		} else {
+			a[i*lda+i] -= float64(n)
+		}
+	}
+	xTrue := make([]float64, n*nrhs)
+	for i := range xTrue {
+		xTrue[i] = rnd.NormFloat64()
+	}
+	ldb := max(1, nrhs) + 2
+	b := rhs(blas.NoTrans, n, nrhs, a, lda, xTrue, ldb)
+
+	ldaf := max(1, n) + 1
+	af := make([]float64, max(0, (n-1)*ldaf+n))
+	ipiv := make([]int, n)
+	work := make([]float64, 1)
+	impl.Dsysvx(fact, uplo, n, nrhs, a, lda, af, ldaf, ipiv, b, ldb, nil, max(1, nrhs), nil, nil, work, -1, nil)
+	lwork := int(work[0])
+	if lwork < max(1, 3*n) {
+		t.Errorf("%s: optimal lwork too small: %d", name, lwork)
+		lwork = max(1, 3*n)
+	}
+	work = make([]float64, lwork)
+
+	if fact == FactSupplied {
+		// Obtain the factorization from a first call.
+		x := make([]float64, max(0, (n-1)*max(1, nrhs)+nrhs))
+		_, ok := impl.Dsysvx(FactCompute, uplo, n, nrhs, a, lda, af, ldaf, ipiv, b, ldb, x, max(1, nrhs),
+			make([]float64, nrhs), make([]float64, nrhs), work, lwork, make([]int, n))
+		if !ok {
+			t.Fatalf("%s: factorization failed", name)
+		}
+		checkIpivSym(t, name, n, ipiv)
+	}
+
+	ldx := max(1, nrhs) + 1
+	x := make([]float64, max(0, (n-1)*ldx+nrhs))
+	ferr := make([]float64, nrhs)
+	berr := make([]float64, nrhs)
+	rcond, ok := impl.Dsysvx(fact, uplo, n, nrhs, a, lda, af, ldaf, ipiv, b, ldb, x, ldx, ferr, berr, work, lwork, make([]int, n))
+	if !ok {
+		t.Fatalf("%s: unexpected failure, rcond=%v", name, rcond)
+	}
+	if n > 0 && (rcond <= 0 || rcond > 1) {
+		t.Errorf("%s: rcond out of range: %v", name, rcond)
+	}
+	checkIpivSym(t, name, n, ipiv)
+	checkErrorBounds(t, name, n, nrhs, x, ldx, xTrue, ferr, berr)
+}
+
+// checkIpivSym checks that ipiv is a valid zero-based pivot description of
+// a symmetric indefinite factorization.
+func checkIpivSym(t *testing.T, name string, n int, ipiv []int) {
+	t.Helper()
+	for k := 0; k < n; k++ {
+		v := ipiv[k]
+		if v >= 0 {
+			if v >= n {
+				t.Errorf("%s: 1×1 pivot index ipiv[%d]=%d out of range", name, k, v)
+			}
+			continue
+		}
+		if p := -v - 1; p >= n {
+			t.Errorf("%s: 2×2 pivot index ipiv[%d]=%d out of range", name, k, v)
+		}
+		// The indices of a 2×2 block are equal, check the pairing and skip
+		// the second index.
+		if k == n-1 || ipiv[k+1] != v {
+			t.Errorf("%s: unpaired 2×2 pivot at %d", name, k)
+		}
+		k++
+	}
+}
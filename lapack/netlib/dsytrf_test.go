@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+func TestIpivSymConversion(t *testing.T) {
+	for _, ipiv := range [][]int{
+		{},
+		{0},
+		{2, 1, 2},
+		{-3, -3, 2},
+		{0, -4, -4, 3, ^0, ^0},
+	} {
+		_ipiv := make([]lapacke.Int, len(ipiv))
+		if !ipivSymToLapacke(_ipiv, ipiv) {
+			t.Fatalf("ipiv=%v: unexpected out of range index", ipiv)
+		}
+		for i, v := range ipiv {
+			want := v + 1
+			if v < 0 {
+				// The one-based index p+1 of a 2×2 block is stored as -(p+1)
+				// by LAPACK and as ^p in the zero-based form.
+				want = -(^v + 1)
+			}
+			if int(_ipiv[i]) != want {
+				t.Errorf("ipiv=%v: unexpected one-based index %d at %d, want %d", ipiv, _ipiv[i], i, want)
+			}
+		}
+		got := make([]int, len(ipiv))
+		ipivSymToGonum(got, _ipiv)
+		for i := range ipiv {
+			if got[i] != ipiv[i] {
+				t.Errorf("ipiv=%v: round trip mismatch, got %v", ipiv, got)
+				break
+			}
+		}
+	}
+}
+
+func TestDsytrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 31, 100} {
+			for _, nrhs := range []int{1, 4} {
+				dsytrfTest(t, uplo, n, nrhs, rnd)
+			}
+		}
+	}
+}
+
+func dsytrfTest(t *testing.T, uplo blas.Uplo, n, nrhs int, rnd *rand.Rand) {
+	const tol = 1e-10
+	name := fmt.Sprintf("uplo=%c,n=%d,nrhs=%d", uplo, n, nrhs)
+	lda := max(1, n) + 2
+	a := dsymmetric(n, lda, rnd)
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+	anorm := impl.Dlansy(lapack.MaxColumnSum, uplo, n, a, lda, make([]float64, n))
+
+	work := make([]float64, 1)
+	impl.Dsytrf(uplo, n, a, lda, nil, work, -1)
+	work = make([]float64, max(1, int(work[0])))
+	ipiv := make([]int, n)
+	if !impl.Dsytrf(uplo, n, a, lda, ipiv, work, len(work)) {
+		t.Fatalf("%s: unexpected singular D", name)
+	}
+	checkIpivSym(t, name, n, ipiv)
+	if n == 0 {
+		return
+	}
+
+	// Check the solution of a system.
+	xTrue := make([]float64, n*nrhs)
+	for i := range xTrue {
+		xTrue[i] = rnd.NormFloat64()
+	}
+	b := rhs(blas.NoTrans, n, nrhs, aCopy, lda, xTrue, nrhs)
+	impl.Dsytrs(uplo, n, nrhs, a, lda, ipiv, b, nrhs)
+	if !dequalApprox(n, nrhs, b, nrhs, xTrue, nrhs, tol*float64(n)) {
+		t.Errorf("%s: unexpected solution", name)
+	}
+
+	// Check the inverse.
+	inv := make([]float64, len(a))
+	copy(inv, a)
+	if !impl.Dsytri(uplo, n, inv, lda, ipiv, make([]float64, n)) {
+		t.Fatalf("%s: Dsytri failed", name)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if uplo == blas.Upper {
+				inv[i*lda+j] = inv[j*lda+i]
+			} else {
+				inv[j*lda+i] = inv[i*lda+j]
+			}
+		}
+	}
+	prod := dmul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, lda, inv, lda)
+	eye := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		eye[i*n+i] = 1
+	}
+	if !dequalApprox(n, n, prod, n, eye, n, tol*float64(n)) {
+		t.Errorf("%s: A * inv(A) is not the identity", name)
+	}
+
+	// Compare the condition number estimate with the exact condition number
+	// computed from the inverse.
+	invNorm := impl.Dlansy(lapack.MaxColumnSum, uplo, n, inv, lda, make([]float64, n))
+	want := 1 / (anorm * invNorm)
+	got := impl.Dsycon(uplo, n, a, lda, ipiv, anorm, make([]float64, 2*n), make([]int, n))
+	if got < want/10 || got > want*10*(1+1e-14) || math.IsNaN(got) {
+		t.Errorf("%s: unexpected rcond estimate: got %v, want %v", name, got, want)
+	}
+}
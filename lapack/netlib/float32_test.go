@@ -0,0 +1,484 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/testlapack"
+)
+
+// stol is the tolerance used to check single precision results. It is
+// relative to the magnitude of the expected values.
+const stol = 1e-4
+
+var simpl = Float32Implementation{}
+
+// float32Shim provides float64 routines by converting their arguments to
+// float32 and calling Float32Implementation. It allows testlapack to be used
+// for the routines whose results are exact in single precision. The checks
+// of testlapack for the other routines use tolerances that single precision
+// cannot meet, so these routines are tested below against residuals computed
+// in double precision.
+type float32Shim struct {
+	Float32Implementation
+}
+
+func (float32Shim) Dlaset(uplo blas.Uplo, m, n int, alpha, beta float64, a []float64, lda int) {
+	a32 := f32(a)
+	simpl.Slaset(uplo, m, n, float32(alpha), float32(beta), a32, lda)
+	copyF32(a, a32)
+}
+
+func (float32Shim) Dlapmt(forward bool, m, n int, x []float64, ldx int, k []int) {
+	x32 := f32(x)
+	simpl.Slapmt(forward, m, n, x32, ldx, k)
+	copyF32(x, x32)
+}
+
+func (float32Shim) Dlasrt(s lapack.Sort, n int, d []float64) {
+	d32 := f32(d)
+	simpl.Slasrt(s, n, d32)
+	copyF32(d, d32)
+}
+
+func (float32Shim) Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []int, incX int) {
+	a32 := f32(a)
+	simpl.Slaswp(n, a32, lda, k1, k2, ipiv, incX)
+	copyF32(a, a32)
+}
+
+func TestSlaset(t *testing.T) {
+	testlapack.DlasetTest(t, float32Shim{})
+}
+
+func TestSlapmt(t *testing.T) {
+	testlapack.DlapmtTest(t, float32Shim{})
+}
+
+func TestSlasrt(t *testing.T) {
+	testlapack.DlasrtTest(t, float32Shim{})
+}
+
+func TestSlaswp(t *testing.T) {
+	testlapack.DlaswpTest(t, float32Shim{})
+}
+
+// f32 returns x converted to float32.
+func f32(x []float64) []float32 {
+	y := make([]float32, len(x))
+	for i, v := range x {
+		y[i] = float32(v)
+	}
+	return y
+}
+
+// f64 returns x converted to float64.
+func f64(x []float32) []float64 {
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = float64(v)
+	}
+	return y
+}
+
+// copyF32 copies the float32 values in src to dst.
+func copyF32(dst []float64, src []float32) {
+	for i, v := range src {
+		dst[i] = float64(v)
+	}
+}
+
+// srandom returns a random m×n matrix with stride ld.
+func srandom(m, n, ld int, rnd *rand.Rand) []float32 {
+	a := make([]float32, max(0, (m-1)*ld+n))
+	for i := range a {
+		a[i] = float32(rnd.NormFloat64())
+	}
+	return a
+}
+
+// sspd returns a random n×n symmetric positive definite matrix with stride ld.
+func sspd(n, ld int, rnd *rand.Rand) []float32 {
+	b := srandom(n, n, n, rnd)
+	btb := smul(blas.Trans, blas.NoTrans, n, n, n, b, max(1, n), b, max(1, n))
+	a := make([]float32, max(0, (n-1)*ld+n))
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a[i*ld+j] = float32(btb[i*n+j])
+		}
+		a[i*ld+i] += float32(n)
+	}
+	return a
+}
+
+// smul returns op(A)*op(B) computed in double precision as a dense matrix
+// with stride n where op(A) is m×k and op(B) is k×n.
+func smul(tA, tB blas.Transpose, m, n, k int, a []float32, lda int, b []float32, ldb int) []float64 {
+	c := make([]float64, m*n)
+	if m == 0 || n == 0 {
+		return c
+	}
+	gonum.Implementation{}.Dgemm(tA, tB, m, n, k, 1, f64(a), lda, f64(b), ldb, 0, c, n)
+	return c
+}
+
+// sequalApprox reports whether the m×n matrices A and B are equal within tol
+// relative to the largest element of B.
+func sequalApprox(m, n int, a []float64, lda int, b []float32, ldb int, tol float64) bool {
+	scale := 1.0
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			scale = math.Max(scale, math.Abs(float64(b[i*ldb+j])))
+		}
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if math.Abs(a[i*lda+j]-float64(b[i*ldb+j])) > tol*scale {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestSgetrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{0, 0}, {1, 1}, {5, 5}, {5, 3}, {3, 5}, {20, 20}, {30, 12}, {12, 30},
+	} {
+		m, n := test.m, test.n
+		mn := min(m, n)
+		for _, extra := range []int{0, 3} {
+			lda := max(1, n) + extra
+			a := srandom(m, n, lda, rnd)
+			aCopy := make([]float32, len(a))
+			copy(aCopy, a)
+			ipiv := make([]int, mn)
+			ok := simpl.Sgetrf(m, n, a, lda, ipiv)
+			if !ok {
+				t.Errorf("m=%d,n=%d: unexpected singular matrix", m, n)
+				continue
+			}
+			if mn == 0 {
+				continue
+			}
+
+			// Reconstruct A from P, L and U.
+			l := make([]float32, m*mn)
+			for i := 0; i < m; i++ {
+				for j := 0; j < min(i, mn); j++ {
+					l[i*mn+j] = a[i*lda+j]
+				}
+				if i < mn {
+					l[i*mn+i] = 1
+				}
+			}
+			u := make([]float32, mn*n)
+			for i := 0; i < mn; i++ {
+				for j := i; j < n; j++ {
+					u[i*n+j] = a[i*lda+j]
+				}
+			}
+			lu := smul(blas.NoTrans, blas.NoTrans, m, n, mn, l, mn, u, n)
+			for i := mn - 1; i >= 0; i-- {
+				p := ipiv[i]
+				if p < i || m <= p {
+					t.Fatalf("m=%d,n=%d: pivot out of range: ipiv[%d]=%d", m, n, i, p)
+				}
+				for j := 0; j < n; j++ {
+					lu[i*n+j], lu[p*n+j] = lu[p*n+j], lu[i*n+j]
+				}
+			}
+			if !sequalApprox(m, n, lu, n, aCopy, lda, stol) {
+				t.Errorf("m=%d,n=%d,lda=%d: P*L*U != A", m, n, lda)
+			}
+		}
+	}
+}
+
+func TestSgetrs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 20} {
+		for _, nrhs := range []int{1, 3} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				name := fmt.Sprintf("n=%d,nrhs=%d,trans=%c", n, nrhs, trans)
+				lda := n + 2
+				ldb := nrhs + 1
+				a := srandom(n, n, lda, rnd)
+				for i := 0; i < n; i++ {
+					a[i*lda+i] += float32(n)
+				}
+				x := srandom(n, nrhs, ldb, rnd)
+				b64 := smul(trans, blas.NoTrans, n, nrhs, n, a, lda, x, ldb)
+				b := make([]float32, len(x))
+				for i := 0; i < n; i++ {
+					for j := 0; j < nrhs; j++ {
+						b[i*ldb+j] = float32(b64[i*nrhs+j])
+					}
+				}
+
+				ipiv := make([]int, n)
+				if !simpl.Sgetrf(n, n, a, lda, ipiv) {
+					t.Errorf("%s: unexpected singular matrix", name)
+					continue
+				}
+				simpl.Sgetrs(trans, n, nrhs, a, lda, ipiv, b, ldb)
+				if !sequalApprox(n, nrhs, f64(b), ldb, x, ldb, stol) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+			}
+		}
+	}
+}
+
+func TestSpotrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 20} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			lda := max(1, n) + 2
+			a := sspd(n, lda, rnd)
+			aCopy := make([]float32, len(a))
+			copy(aCopy, a)
+			if !simpl.Spotrf(uplo, n, a, lda) {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			if n == 0 {
+				continue
+			}
+
+			// Reconstruct A from its Cholesky factor.
+			f := make([]float32, n*n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+						f[i*n+j] = a[i*lda+j]
+					}
+				}
+			}
+			var ff []float64
+			if uplo == blas.Upper {
+				ff = smul(blas.Trans, blas.NoTrans, n, n, n, f, n, f, n)
+			} else {
+				ff = smul(blas.NoTrans, blas.Trans, n, n, n, f, n, f, n)
+			}
+			if !sequalApprox(n, n, ff, n, aCopy, lda, stol) {
+				t.Errorf("%s: reconstructed matrix not equal to A", name)
+			}
+		}
+	}
+}
+
+func TestSgeqrfSormqr(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{1, 1}, {5, 5}, {10, 4}, {4, 10}, {30, 20},
+	} {
+		m, n := test.m, test.n
+		k := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		lda := n + 3
+		a := srandom(m, n, lda, rnd)
+		aCopy := make([]float32, len(a))
+		copy(aCopy, a)
+		tau := make([]float32, k)
+
+		work := make([]float32, 1)
+		simpl.Sgeqrf(m, n, a, lda, tau, work, -1)
+		work = make([]float32, int(work[0]))
+		simpl.Sgeqrf(m, n, a, lda, tau, work, len(work))
+
+		// Qᵀ*A must be equal to R.
+		simpl.Sormqr(blas.Left, blas.Trans, m, n, k, a, lda, tau, aCopy, lda, work, -1)
+		work = make([]float32, int(work[0]))
+		simpl.Sormqr(blas.Left, blas.Trans, m, n, k, a, lda, tau, aCopy, lda, work, len(work))
+		r := make([]float64, m*n)
+		for i := 0; i < k; i++ {
+			for j := i; j < n; j++ {
+				r[i*n+j] = float64(a[i*lda+j])
+			}
+		}
+		if !sequalApprox(m, n, r, n, aCopy, lda, stol) {
+			t.Errorf("%s: Qᵀ*A != R", name)
+		}
+	}
+}
+
+func TestSgesvd(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{1, 1}, {5, 5}, {10, 4}, {4, 10}, {30, 20},
+	} {
+		m, n := test.m, test.n
+		k := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		lda := n + 1
+		a := srandom(m, n, lda, rnd)
+		aCopy := make([]float32, len(a))
+		copy(aCopy, a)
+		s := make([]float32, k)
+		u := make([]float32, m*m)
+		vt := make([]float32, n*n)
+
+		work := make([]float32, 1)
+		simpl.Sgesvd(lapack.SVDAll, lapack.SVDAll, m, n, a, lda, s, u, m, vt, n, work, -1)
+		work = make([]float32, int(work[0]))
+		ok := simpl.Sgesvd(lapack.SVDAll, lapack.SVDAll, m, n, a, lda, s, u, m, vt, n, work, len(work))
+		if !ok {
+			t.Errorf("%s: unexpected failure", name)
+			continue
+		}
+		for i := 1; i < k; i++ {
+			if s[i] > s[i-1] {
+				t.Errorf("%s: singular values not sorted", name)
+				break
+			}
+		}
+
+		// Reconstruct A from U, Σ and Vᵀ.
+		us := make([]float32, m*n)
+		for i := 0; i < m; i++ {
+			for j := 0; j < k; j++ {
+				us[i*n+j] = u[i*m+j] * s[j]
+			}
+		}
+		usvt := smul(blas.NoTrans, blas.NoTrans, m, n, n, us, n, vt, n)
+		if !sequalApprox(m, n, usvt, n, aCopy, lda, stol) {
+			t.Errorf("%s: U*Σ*Vᵀ != A", name)
+		}
+	}
+}
+
+func TestSsyev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 20} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			lda := n + 2
+			a := srandom(n, n, lda, rnd)
+			for i := 0; i < n; i++ {
+				for j := i + 1; j < n; j++ {
+					a[j*lda+i] = a[i*lda+j]
+				}
+			}
+			aCopy := make([]float32, len(a))
+			copy(aCopy, a)
+			w := make([]float32, n)
+
+			work := make([]float32, 1)
+			simpl.Ssyev(lapack.EVCompute, uplo, n, a, lda, w, work, -1)
+			work = make([]float32, int(work[0]))
+			if !simpl.Ssyev(lapack.EVCompute, uplo, n, a, lda, w, work, len(work)) {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+
+			// A*Z must be equal to Z*Λ.
+			az := smul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, lda, a, lda)
+			zw := make([]float32, n*n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					zw[i*n+j] = a[i*lda+j] * w[j]
+				}
+			}
+			if !sequalApprox(n, n, az, n, zw, n, stol) {
+				t.Errorf("%s: A*Z != Z*Λ", name)
+			}
+		}
+	}
+}
+
+func TestSgeev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 20} {
+		name := fmt.Sprintf("n=%d", n)
+		lda := n + 2
+		a := srandom(n, n, lda, rnd)
+		aCopy := make([]float32, len(a))
+		copy(aCopy, a)
+		wr := make([]float32, n)
+		wi := make([]float32, n)
+		vr := make([]float32, n*n)
+
+		work := make([]float32, 1)
+		simpl.Sgeev(lapack.LeftEVNone, lapack.RightEVCompute, n, a, lda, wr, wi, nil, 1, vr, n, work, -1)
+		work = make([]float32, int(work[0]))
+		first := simpl.Sgeev(lapack.LeftEVNone, lapack.RightEVCompute, n, a, lda, wr, wi, nil, 1, vr, n, work, len(work))
+		if first != 0 {
+			t.Errorf("%s: unexpected failure, first=%d", name, first)
+			continue
+		}
+
+		// A*V must be equal to V*Λ, where the columns of V corresponding
+		// to a complex conjugate pair hold its real and imaginary parts.
+		av := smul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, lda, vr, n)
+		vw := make([]float32, n*n)
+		for j := 0; j < n; j++ {
+			if wi[j] == 0 {
+				for i := 0; i < n; i++ {
+					vw[i*n+j] = vr[i*n+j] * wr[j]
+				}
+				continue
+			}
+			for i := 0; i < n; i++ {
+				re, im := vr[i*n+j], vr[i*n+j+1]
+				vw[i*n+j] = re*wr[j] - im*wi[j]
+				vw[i*n+j+1] = re*wi[j] + im*wr[j]
+			}
+			j++
+		}
+		if !sequalApprox(n, n, av, n, vw, n, stol) {
+			t.Errorf("%s: A*V != V*Λ", name)
+		}
+	}
+}
+
+func TestSgels(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{1, 1}, {5, 5}, {10, 4}, {30, 20},
+	} {
+		m, n := test.m, test.n
+		for _, nrhs := range []int{1, 3} {
+			name := fmt.Sprintf("m=%d,n=%d,nrhs=%d", m, n, nrhs)
+			lda := n + 1
+			ldb := nrhs + 2
+			a := srandom(m, n, lda, rnd)
+			x := srandom(n, nrhs, ldb, rnd)
+
+			// Build a consistent system so that the least squares solution
+			// is x.
+			b64 := smul(blas.NoTrans, blas.NoTrans, m, nrhs, n, a, lda, x, ldb)
+			b := make([]float32, (m-1)*ldb+nrhs)
+			for i := 0; i < m; i++ {
+				for j := 0; j < nrhs; j++ {
+					b[i*ldb+j] = float32(b64[i*nrhs+j])
+				}
+			}
+
+			work := make([]float32, 1)
+			simpl.Sgels(blas.NoTrans, m, n, nrhs, a, lda, b, ldb, work, -1)
+			work = make([]float32, int(work[0]))
+			if !simpl.Sgels(blas.NoTrans, m, n, nrhs, a, lda, b, ldb, work, len(work)) {
+				t.Errorf("%s: unexpected rank deficiency", name)
+				continue
+			}
+			if !sequalApprox(n, nrhs, f64(b), ldb, x, ldb, stol) {
+				t.Errorf("%s: unexpected solution", name)
+			}
+		}
+	}
+}
@@ -3,5 +3,7 @@
 // license that can be found in the LICENSE file.
 
 //go:generate go run generate_errors.go
+//go:generate go run generate_float32.go
+//go:generate go run generate_nocgo.go
 
 package netlib
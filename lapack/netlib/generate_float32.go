@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"regexp"
+)
+
+const (
+	src = "lapack.go"
+	dst = "float32.go"
+)
+
+// routines are the routines of Implementation in lapack.go for which
+// a single precision counterpart is generated.
+var routines = map[string]bool{
+	"Dbdsqr":  true,
+	"Dgebak":  true,
+	"Dgebal":  true,
+	"Dgebrd":  true,
+	"Dgecon":  true,
+	"Dgeev":   true,
+	"Dgehrd":  true,
+	"Dgelq2":  true,
+	"Dgelqf":  true,
+	"Dgels":   true,
+	"Dgeqp3":  true,
+	"Dgeqr2":  true,
+	"Dgeqrf":  true,
+	"Dgerqf":  true,
+	"Dgesvd":  true,
+	"Dgetf2":  true,
+	"Dgetrf":  true,
+	"Dgetri":  true,
+	"Dgetrs":  true,
+	"Dggsvd3": true,
+	"Dggsvp3": true,
+	"Dhseqr":  true,
+	"Dlacn2":  true,
+	"Dlacpy":  true,
+	"Dlange":  true,
+	"Dlansy":  true,
+	"Dlantr":  true,
+	"Dlapmr":  true,
+	"Dlapmt":  true,
+	"Dlapy2":  true,
+	"Dlarfb":  true,
+	"Dlarfg":  true,
+	"Dlarft":  true,
+	"Dlarfx":  true,
+	"Dlascl":  true,
+	"Dlaset":  true,
+	"Dlasrt":  true,
+	"Dlaswp":  true,
+	"Dorgbr":  true,
+	"Dorghr":  true,
+	"Dorglq":  true,
+	"Dorgql":  true,
+	"Dorgqr":  true,
+	"Dorgtr":  true,
+	"Dormbr":  true,
+	"Dormhr":  true,
+	"Dormlq":  true,
+	"Dormqr":  true,
+	"Dpbcon":  true,
+	"Dpbtrf":  true,
+	"Dpbtrs":  true,
+	"Dpocon":  true,
+	"Dpotrf":  true,
+	"Dpotri":  true,
+	"Dpotrs":  true,
+	"Dpstrf":  true,
+	"Dsteqr":  true,
+	"Dsterf":  true,
+	"Dsyev":   true,
+	"Dsytrd":  true,
+	"Dtbtrs":  true,
+	"Dtgsja":  true,
+	"Dtrcon":  true,
+	"Dtrexc":  true,
+	"Dtrtri":  true,
+	"Dtrtrs":  true,
+}
+
+var (
+	// routineName matches the names of double precision LAPACK routines.
+	// Words that are not the name of a routine are listed in notRoutines.
+	routineName = regexp.MustCompile(`\bD[a-z][a-z0-9]{3,5}\b`)
+
+	// internal matches the notes of the routines that are internal to
+	// gonum.org/v1/gonum/lapack/gonum, which do not apply to this package.
+	internal = regexp.MustCompile(`\n//\n// D[a-z0-9]+ is an internal routine. It is exported for testing purposes.`)
+
+	isNaN = regexp.MustCompile(`math\.IsNaN\((\w+)\)`)
+)
+
+// notRoutines are the words matched by routineName that are not the names
+// of LAPACK routines.
+var notRoutines = map[string]bool{
+	"Direct": true,
+	"During": true,
+}
+
+func main() {
+	fset := token.NewFileSet()
+	b, err := os.ReadFile(src)
+	if err != nil {
+		log.Fatalf("failed to read %q: %v", src, err)
+	}
+	f, err := parser.ParseFile(fset, src, b, parser.ParseComments)
+	if err != nil {
+		log.Fatalf("failed to parse %q: %v", src, err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, d := range f.Decls {
+		fn, ok := d.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || !routines[fn.Name.Name] {
+			continue
+		}
+		start := fn.Pos()
+		if fn.Doc != nil {
+			start = fn.Doc.Pos()
+		}
+		text := b[fset.Position(start).Offset:fset.Position(fn.End()).Offset]
+		buf.WriteString("\n")
+		buf.Write(single(text))
+		buf.WriteString("\n")
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format %q: %v", dst, err)
+	}
+	err = os.WriteFile(dst, out, 0o664)
+	if err != nil {
+		log.Fatalf("failed to write %q: %v", dst, err)
+	}
+}
+
+// single returns the single precision counterpart of the double precision
+// routine in text.
+func single(text []byte) []byte {
+	text = internal.ReplaceAll(text, nil)
+	text = bytes.Replace(text, []byte("Implementation)"), []byte("Float32Implementation)"), 1)
+	text = bytes.ReplaceAll(text, []byte("float64"), []byte("float32"))
+	text = isNaN.ReplaceAll(text, []byte("math.IsNaN(float64($1))"))
+	return routineName.ReplaceAllFunc(text, func(name []byte) []byte {
+		if notRoutines[string(name)] {
+			return name
+		}
+		return append([]byte("S"), name[1:]...)
+	})
+}
+
+const header = `// Code generated by "go generate gonum.org/v1/netlib/lapack/netlib"; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// Float32Implementation is the cgo-based C implementation of the single
+// precision LAPACK routines. It provides the float32 counterparts of a subset
+// of the routines of Implementation, named with an S instead of a D prefix,
+// and follows the same conventions for argument checking, workspace queries
+// and zero-based indices.
+type Float32Implementation struct{}
+`
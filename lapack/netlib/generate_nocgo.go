@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+// sources are the cgo files holding the methods that need a stub when the
+// package is built without cgo.
+var sources = []string{
+	"lapack.go",
+	"complex128.go",
+	"float32.go",
+}
+
+const dst = "nocgo.go"
+
+func main() {
+	fset := token.NewFileSet()
+	var buf bytes.Buffer
+	buf.WriteString(header)
+
+	// Methods that are provided by gonum.Implementation are inherited by
+	// the Implementation of gonum.go and do not need a stub.
+	inherited := make(map[string]bool)
+	typ := reflect.TypeOf(gonum.Implementation{})
+	for i := 0; i < typ.NumMethod(); i++ {
+		inherited[typ.Method(i).Name] = true
+	}
+
+	for _, src := range sources {
+		f, err := parser.ParseFile(fset, src, nil, 0)
+		if err != nil {
+			log.Fatalf("failed to parse %q: %v", src, err)
+		}
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			recv := typeName(fn.Recv.List[0].Type)
+			if recv == "Implementation" && inherited[fn.Name.Name] {
+				continue
+			}
+			var sig bytes.Buffer
+			err := printer.Fprint(&sig, fset, fn.Type)
+			if err != nil {
+				log.Fatalf("failed to print %s: %v", fn.Name.Name, err)
+			}
+			fmt.Fprintf(&buf, "\n// %[2]s panics because it requires cgo.\nfunc (%[1]s) %[2]s%[3]s {\n\tpanic(\"netlib: %[2]s requires cgo\")\n}\n",
+				recv, fn.Name.Name, bytes.TrimPrefix(sig.Bytes(), []byte("func")))
+		}
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format %q: %v", dst, err)
+	}
+	err = os.WriteFile(dst, b, 0o664)
+	if err != nil {
+		log.Fatalf("failed to write %q: %v", dst, err)
+	}
+}
+
+func typeName(e ast.Expr) string {
+	if s, ok := e.(*ast.StarExpr); ok {
+		e = s.X
+	}
+	return e.(*ast.Ident).Name
+}
+
+const header = `// Code generated by "go generate gonum.org/v1/netlib/lapack/netlib"; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !cgo
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+var _ lapack.Complex128 = Implementation{}
+
+// Float32Implementation is the single precision counterpart of
+// Implementation. Its routines call a C LAPACK library and panic when the
+// package is built without cgo.
+type Float32Implementation struct{}
+`
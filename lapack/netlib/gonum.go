@@ -0,0 +1,25 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !cgo
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+// Native reports whether the package calls a C LAPACK library. It is false
+// when the package is built without cgo.
+const Native = false
+
+// Implementation is the pure Go implementation of LAPACK routines that is
+// used when the package is built without cgo. All the routines are provided
+// by gonum.org/v1/gonum/lapack/gonum.
+type Implementation struct {
+	gonum.Implementation
+}
+
+var _ lapack.Float64 = Implementation{}
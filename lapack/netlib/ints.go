@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"sync"
+
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// intPool holds the buffers that are used to pass pivot and permutation
+// indices to LAPACKE, so that solving many small systems does not allocate
+// a new buffer for every call.
+var intPool = sync.Pool{
+	New: func() interface{} { return new([]lapacke.Int) },
+}
+
+// getInts returns a buffer of n lapacke.Int from intPool. The contents of the
+// buffer are undefined. The buffer must be returned with putInts.
+func getInts(n int) *[]lapacke.Int {
+	p := intPool.Get().(*[]lapacke.Int)
+	if cap(*p) < n {
+		*p = make([]lapacke.Int, n)
+	}
+	*p = (*p)[:n]
+	return p
+}
+
+// putInts returns a buffer obtained from getInts to intPool.
+func putInts(p *[]lapacke.Int) {
+	intPool.Put(p)
+}
+
+// indices holds the zero-based indices of a []int in the form passed to and
+// from LAPACKE, which expects one-based lapacke.Int indices.
+type indices struct {
+	// dst holds the zero-based indices.
+	dst []int
+	// buf is passed to LAPACKE. It is either a pooled buffer or,
+	// when lapacke.Int and int have the same size, dst itself.
+	buf []lapacke.Int
+	// p is the pooled buffer backing buf, or nil if buf aliases dst.
+	p *[]lapacke.Int
+}
+
+// pooledIndices returns indices for dst that use a pooled buffer. It is used
+// for indices that are only read by LAPACKE, since the elements of dst must
+// not be modified and may be read concurrently by other calls.
+func pooledIndices(dst []int) indices {
+	p := getInts(len(dst))
+	return indices{dst: dst, buf: *p, p: p}
+}
+
+// load stores the zero-based indices of ix.dst in ix.buf as one-based
+// indices. It returns false if an index cannot be represented by
+// lapacke.Int.
+func (ix indices) load() bool {
+	for i, v := range ix.dst {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			return false
+		}
+		ix.buf[i] = lapacke.Int(v)
+	}
+	return true
+}
+
+// store stores the one-based indices of ix.buf in ix.dst as zero-based
+// indices and releases the pooled buffer.
+func (ix indices) store() {
+	for i, v := range ix.buf {
+		ix.dst[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	ix.release()
+}
+
+// release returns the pooled buffer of ix, if any, to intPool.
+func (ix indices) release() {
+	if ix.p != nil {
+		putInts(ix.p)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && ilp64
+
+package netlib
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// The ilp64 build tag is only supported on platforms where int has 64 bits,
+// so that a []int can be passed to LAPACKE as a []lapacke.Int.
+var _ [unsafe.Sizeof(int(0))]byte = [unsafe.Sizeof(lapacke.Int(0))]byte{}
+
+// newIndices returns indices for dst that are written by LAPACKE. Since
+// lapacke.Int and int have the same size, the indices are passed in dst
+// itself and converted between zero-based and one-based in place.
+func newIndices(dst []int) indices {
+	if len(dst) == 0 {
+		return indices{dst: dst}
+	}
+	buf := unsafe.Slice((*lapacke.Int)(unsafe.Pointer(&dst[0])), len(dst))
+	return indices{dst: dst, buf: buf}
+}
@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && !ilp64
+
+package netlib
+
+// newIndices returns indices for dst that are written by LAPACKE. Since
+// lapacke.Int is int32, the indices are passed in a pooled buffer.
+func newIndices(dst []int) indices {
+	return pooledIndices(dst)
+}
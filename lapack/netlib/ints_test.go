@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+func TestIndices(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 20} {
+		for _, test := range []struct {
+			name string
+			new  func([]int) indices
+		}{
+			{name: "pooled", new: pooledIndices},
+			{name: "new", new: newIndices},
+		} {
+			dst := make([]int, n)
+			for i := range dst {
+				dst[i] = n - 1 - i
+			}
+			ix := test.new(dst)
+			if len(ix.buf) != n {
+				t.Fatalf("%s: unexpected buffer length for n=%d: got:%d want:%d", test.name, n, len(ix.buf), n)
+			}
+			if !ix.load() {
+				t.Fatalf("%s: unexpected load failure for n=%d", test.name, n)
+			}
+			for i, v := range ix.buf {
+				if want := lapacke.Int(n - i); v != want {
+					t.Errorf("%s: unexpected one-based index %d for n=%d: got:%d want:%d", test.name, i, n, v, want)
+				}
+			}
+			if ix.p != nil {
+				// A pooled buffer must not modify the indices that
+				// are only read by LAPACKE.
+				for i, v := range dst {
+					if want := n - 1 - i; v != want {
+						t.Errorf("%s: index %d modified by load for n=%d: got:%d want:%d", test.name, i, n, v, want)
+					}
+				}
+			}
+
+			// Write the indices as LAPACKE would.
+			for i := range ix.buf {
+				ix.buf[i] = lapacke.Int(i + 1)
+			}
+			ix.store()
+			for i, v := range dst {
+				if v != i {
+					t.Errorf("%s: unexpected zero-based index %d for n=%d: got:%d want:%d", test.name, i, n, v, i)
+				}
+			}
+		}
+	}
+}
+
+func TestIndicesAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("sync.Pool drops items when the race detector is enabled")
+	}
+	dst := make([]int, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		ix := pooledIndices(dst)
+		ix.load()
+		ix.release()
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations for pooled indices: got:%v want:0", allocs)
+	}
+	allocs = testing.AllocsPerRun(100, func() {
+		ix := newIndices(dst)
+		ix.store()
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected allocations for new indices: got:%v want:0", allocs)
+	}
+}
@@ -2,7 +2,8 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package netlib provides an interface to bindings for a C LAPACK library.
+//go:build cgo
+
 package netlib
 
 import (
@@ -13,6 +14,10 @@ import (
 	"gonum.org/v1/netlib/lapack/lapacke"
 )
 
+// Native reports whether the package calls a C LAPACK library. It is false
+// when the package is built without cgo.
+const Native = true
+
 // Implementation is the cgo-based C implementation of LAPACK routines.
 type Implementation struct{}
 
@@ -92,18 +97,18 @@ func (impl Implementation) Dgeqp3(m, n int, a []float64, lda int, jpvt []int, ta
 		panic(shortTau)
 	}
 
-	jpvt32 := make([]int32, n)
-	for i, v := range jpvt {
-		v++
-		if v != int(int32(v)) || v < 0 || n < v {
+	for _, v := range jpvt {
+		if v < -1 || n <= v {
 			panic(badJpvt)
 		}
-		jpvt32[i] = int32(v)
 	}
-	lapacke.Dgeqp3(m, n, a, lda, jpvt32, tau, work, lwork)
-	for i, v := range jpvt32 {
-		jpvt[i] = int(v - 1)
+	ix := newIndices(jpvt)
+	if !ix.load() {
+		ix.release()
+		panic(badJpvt)
 	}
+	lapacke.Dgeqp3(m, n, a, lda, ix.buf, tau, work, lwork)
+	ix.store()
 }
 
 // Dgerqf computes an RQ factorization of the m×n matrix A,
@@ -205,10 +210,19 @@ func (impl Implementation) Dlacn2(n int, v, x []float64, isgn []int, est float64
 		panic(badIsave)
 	}
 
-	isgn32 := make([]int32, n)
+	// isgn, isave and kase share a single pooled buffer. The elements of
+	// isgn are signs, not indices, and carry state between calls.
+	p := getInts(n + 4)
+	isgn32 := (*p)[:n]
+	isavekase := (*p)[n:]
+	for i, v := range isgn[:n] {
+		isgn32[i] = lapacke.Int(v)
+	}
+	isavekase[0] = lapacke.Int(isave[0])
+	isavekase[1] = lapacke.Int(isave[1])
+	isavekase[2] = lapacke.Int(isave[2])
+	isavekase[3] = lapacke.Int(kase)
 	pest := []float64{est}
-	// Save one allocation by putting isave and kase into the same slice.
-	isavekase := []int32{int32(isave[0]), int32(isave[1]), int32(isave[2]), int32(kase)}
 	lapacke.Dlacn2(n, v, x, isgn32, pest, isavekase[3:], isavekase[:3])
 	for i, v := range isgn32 {
 		isgn[i] = int(v)
@@ -216,8 +230,10 @@ func (impl Implementation) Dlacn2(n int, v, x []float64, isgn []int, est float64
 	isave[0] = int(isavekase[0])
 	isave[1] = int(isavekase[1])
 	isave[2] = int(isavekase[2])
+	kase = int(isavekase[3])
+	putInts(p)
 
-	return pest[0], int(isavekase[3])
+	return pest[0], kase
 }
 
 // Dlacpy copies the elements of A specified by uplo into B. Uplo can specify
@@ -290,19 +306,17 @@ func (impl Implementation) Dlapmr(forward bool, m, n int, x []float64, ldx int,
 		return
 	}
 
-	var forwrd int32
+	var forwrd lapacke.Int
 	if forward {
 		forwrd = 1
 	}
-	k32 := make([]int32, m)
-	for i, v := range k {
-		v++ // Convert to 1-based indexing.
-		if v != int(int32(v)) {
-			panic("lapack: k element out of range")
-		}
-		k32[i] = int32(v)
+	ix := pooledIndices(k)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: k element out of range")
 	}
-	lapacke.Dlapmr(forwrd, m, n, x, ldx, k32)
+	lapacke.Dlapmr(forwrd, m, n, x, ldx, ix.buf)
+	ix.release()
 }
 
 // Dlapmt rearranges the columns of the m×n matrix X as specified by the
@@ -344,19 +358,17 @@ func (impl Implementation) Dlapmt(forward bool, m, n int, x []float64, ldx int,
 		return
 	}
 
-	var forwrd int32
+	var forwrd lapacke.Int
 	if forward {
 		forwrd = 1
 	}
-	k32 := make([]int32, n)
-	for i, v := range k {
-		v++ // Convert to 1-based indexing.
-		if v != int(int32(v)) {
-			panic("lapack: k element out of range")
-		}
-		k32[i] = int32(v)
+	ix := pooledIndices(k)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: k element out of range")
 	}
-	lapacke.Dlapmt(forwrd, m, n, x, ldx, k32)
+	lapacke.Dlapmt(forwrd, m, n, x, ldx, ix.buf)
+	ix.release()
 }
 
 // Dlapy2 is the LAPACK version of math.Hypot.
@@ -872,15 +884,13 @@ func (impl Implementation) Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []in
 		return
 	}
 
-	ipiv32 := make([]int32, k2+1)
-	for i, v := range ipiv {
-		v++
-		if v != int(int32(v)) {
-			panic("lapack: ipiv element out of range")
-		}
-		ipiv32[i] = int32(v)
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
 	}
-	lapacke.Dlaswp(n, a, lda, k1+1, k2+1, ipiv32, incX)
+	lapacke.Dlaswp(n, a, lda, k1+1, k2+1, ix.buf, incX)
+	ix.release()
 }
 
 // Dpbcon returns an estimate of the reciprocal of the condition number (in the
@@ -929,8 +939,9 @@ func (impl Implementation) Dpbcon(uplo blas.Uplo, n, kd int, ab []float64, ldab
 	_ab := make([]float64, (kd+1)*_ldab)
 	bandTriToLapacke(uplo, n, kd, ab, ldab, _ab, _ldab)
 	_rcond := []float64{0}
-	_iwork := make([]int32, n)
-	lapacke.Dpbcon(byte(uplo), n, kd, _ab, _ldab, anorm, _rcond, work, _iwork)
+	_iwork := getInts(n)
+	lapacke.Dpbcon(byte(uplo), n, kd, _ab, _ldab, anorm, _rcond, work, *_iwork)
+	putInts(_iwork)
 	return _rcond[0]
 }
 
@@ -1037,6 +1048,201 @@ func (Implementation) Dpbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float64, ldab
 	lapacke.Dpbtrs(byte(uplo), n, kd, nrhs, abConv, ldabConv, b, ldb)
 }
 
+// Dpptrf computes the Cholesky factorization of an n×n symmetric positive
+// definite matrix A stored in packed format
+//
+//	A = Uᵀ * U  if uplo == blas.Upper
+//	A = L * Lᵀ  if uplo == blas.Lower
+//
+// where U is an upper triangular matrix and L is lower triangular.
+//
+// The packed storage scheme matches gonum's blas64.SymmetricPacked and
+// blas64.TriangularPacked. If uplo == blas.Upper, the rows of the upper
+// triangle of A are stored consecutively in ap, so that ap = [a00 a01 ... a0n-1
+// a11 a12 ...]. If uplo == blas.Lower, the rows of the lower triangle are
+// stored consecutively, so that ap = [a00 a10 a11 a20 a21 a22 ...]. On return,
+// ap contains U or L in the same format.
+//
+// Dpptrf returns whether A is positive definite. If ok is false, the
+// factorization could not be completed.
+func (impl Implementation) Dpptrf(uplo blas.Uplo, n int, ap []float64) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(ap) < n*(n+1)/2 {
+		panic(shortAP)
+	}
+
+	return lapacke.Dpptrf(byte(uplo), n, ap)
+}
+
+// Dpptrs solves a system of linear equations A*X = B with an n×n symmetric
+// positive definite matrix A in packed format using the Cholesky factorization
+//
+//	A = Uᵀ * U  if uplo == blas.Upper
+//	A = L * Lᵀ  if uplo == blas.Lower
+//
+// computed by Dpptrf. See the documentation for Dpptrf for a description of
+// the packed storage format.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func (impl Implementation) Dpptrs(uplo blas.Uplo, n, nrhs int, ap []float64, b []float64, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(shortAP)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	lapacke.Dpptrs(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Dppsv computes the solution to a system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n symmetric positive definite matrix stored in packed
+// format and X and B are n×nrhs matrices. The Cholesky factorization
+//
+//	A = Uᵀ * U  if uplo == blas.Upper
+//	A = L * Lᵀ  if uplo == blas.Lower
+//
+// is used to solve the system. See the documentation for Dpptrf for a
+// description of the packed storage format.
+//
+// On entry, ap contains A. On return, if ok is true, ap contains the factor U
+// or L in the same format.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, if ok
+// is true, it is overwritten with the solution matrix X.
+//
+// Dppsv returns whether A is positive definite. If ok is false, the
+// factorization could not be completed and the solution has not been
+// computed.
+func (impl Implementation) Dppsv(uplo blas.Uplo, n, nrhs int, ap, b []float64, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(shortAP)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	return lapacke.Dppsv(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Dpttrf computes the L*D*Lᵀ factorization of an n×n symmetric positive
+// definite tridiagonal matrix A.
+//
+// On entry, d contains the n diagonal elements of A and e contains the n-1
+// off-diagonal elements of A. On return, d contains the n diagonal elements of
+// the diagonal matrix D and e contains the n-1 sub-diagonal elements of the
+// unit bidiagonal matrix L.
+//
+// Dpttrf returns whether A is positive definite. If ok is false, the
+// factorization could not be completed.
+func (impl Implementation) Dpttrf(n int, d, e []float64) (ok bool) {
+	if n < 0 {
+		panic(nLT0)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	}
+
+	return lapacke.Dpttrf(n, d, e)
+}
+
+// Dptsv computes the solution to a system of linear equations A*X = B, where
+// A is an n×n symmetric positive definite tridiagonal matrix and X and B are
+// n×nrhs matrices. A is factored as A = L*D*Lᵀ using Dpttrf and the factored
+// form is used to solve the system.
+//
+// On entry, d contains the n diagonal elements of A and e contains the n-1
+// off-diagonal elements of A. On return, d and e contain the factorization of
+// A as computed by Dpttrf.
+//
+// On entry, b contains the right hand side matrix B. On return, if ok is true,
+// it is overwritten with the solution matrix X.
+//
+// Dptsv returns whether A is positive definite. If ok is false, the solution
+// has not been computed.
+func (impl Implementation) Dptsv(n, nrhs int, d, e, b []float64, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	return lapacke.Dptsv(n, nrhs, d, e, b, ldb)
+}
+
 // Dpotrf computes the Cholesky decomposition of the symmetric positive definite
 // matrix a. If ul == blas.Upper, then a is stored as an upper-triangular matrix,
 // and a = U U^T is stored in place into a. If ul == blas.Lower, then a = L L^T
@@ -1182,13 +1388,13 @@ func (impl Implementation) Dpstrf(uplo blas.Uplo, n int, a []float64, lda int, p
 		panic(shortWork)
 	}
 
-	piv32 := make([]int32, n)
-	rank32 := make([]int32, 1)
-	ok = lapacke.Dpstrf(byte(uplo), n, a, lda, piv32, rank32, tol, work)
-	for i, v := range piv32 {
-		piv[i] = int(v) - 1 // Transform to zero-based indices.
-	}
-	return int(rank32[0]), ok
+	ix := newIndices(piv)
+	rank32 := getInts(1)
+	ok = lapacke.Dpstrf(byte(uplo), n, a, lda, ix.buf, *rank32, tol, work)
+	ix.store()
+	rank = int((*rank32)[0])
+	putInts(rank32)
+	return rank, ok
 }
 
 // Dgebal balances an n×n matrix A. Balancing consists of two stages, permuting
@@ -1267,8 +1473,8 @@ func (impl Implementation) Dgebal(job lapack.BalanceJob, n int, a []float64, lda
 		panic(shortA)
 	}
 
-	ilo32 := []int32{0}
-	ihi32 := []int32{0}
+	ilo32 := []lapacke.Int{0}
+	ihi32 := []lapacke.Int{0}
 	lapacke.Dgebal(byte(job), n, a, lda, ilo32, ihi32, scale)
 	ilo = int(ilo32[0]) - 1
 	ihi = int(ihi32[0]) - 1
@@ -1560,8 +1766,9 @@ func (impl Implementation) Dgecon(norm lapack.MatrixNorm, n int, a []float64, ld
 	}
 
 	rcond := []float64{0}
-	_iwork := make([]int32, n)
-	lapacke.Dgecon(byte(norm), n, a, lda, anorm, rcond, work, _iwork)
+	_iwork := getInts(n)
+	lapacke.Dgecon(byte(norm), n, a, lda, anorm, rcond, work, *_iwork)
+	putInts(_iwork)
 	return rcond[0]
 }
 
@@ -1931,837 +2138,2985 @@ func (impl Implementation) Dgels(trans blas.Transpose, m, n, nrhs int, a []float
 	return lapacke.Dgels(byte(trans), m, n, nrhs, a, lda, b, ldb, work, lwork)
 }
 
-// Dgesvd computes the singular value decomposition of the input matrix A.
-//
-// The singular value decomposition is
-//
-//	A = U * Sigma * V^T
-//
-// where Sigma is an m×n diagonal matrix containing the singular values of A,
-// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
-// min(m,n) columns of U and V are the left and right singular vectors of A
-// respectively.
-//
-// jobU and jobVT are options for computing the singular vectors. The behavior
-// is as follows
+// Dgelss computes the minimum-norm solution to the linear least squares
+// problem
 //
-//	jobU == lapack.SVDAll       All m columns of U are returned in u
-//	jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
-//	jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
-//	jobU == lapack.SVDNone      The columns of U are not computed.
+//	minimize ‖B - A*X‖_2
 //
-// The behavior is the same for jobVT and the rows of V^T. At most one of jobU
-// and jobVT can equal lapack.SVDOverwrite, and Dgesvd will panic otherwise.
+// using the singular value decomposition of the m×n matrix A, which may be
+// rank-deficient.
 //
-// On entry, a contains the data for the m×n matrix A. During the call to Dgesvd
-// the data is overwritten. On exit, A contains the appropriate singular vectors
-// if either job is lapack.SVDOverwrite.
+// On entry, b contains the m×nrhs right hand side matrix B and must have
+// max(m,n) rows. On return, the leading n×nrhs submatrix of b contains the
+// solution matrix X. If m >= n and rank == n, the residual sum of squares for
+// the solution in column j is given by the sum of squares of elements n:m in
+// that column.
 //
-// s is a slice of length at least min(m,n) and on exit contains the singular
-// values in decreasing order.
+// On return, a is overwritten with the first min(m,n) right singular vectors
+// of A stored row-wise.
 //
-// u contains the left singular vectors on exit, stored column-wise. If
-// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
-// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
-// not used.
+// s must have length at least min(m,n) and on return contains the singular
+// values of A in decreasing order. The condition number of A in the 2-norm is
+// s[0]/s[min(m,n)-1].
 //
-// vt contains the left singular vectors on exit, stored rowwise. If
-// jobV == lapack.SVDAll, vt is of size n×m. If jobVT == lapack.SVDStore vt is
-// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
-// not used.
+// rcond is used to determine the effective rank of A. Singular values
+// s[i] <= rcond*s[0] are treated as zero. If rcond < 0, machine precision is
+// used instead.
 //
-// work is a slice for storing temporary memory, and lwork is the usable size of
-// the slice. lwork must be at least max(5*min(m,n), 3*min(m,n)+max(m,n)).
-// If lwork == -1, instead of performing Dgesvd, the optimal work length will be
-// stored into work[0]. Dgesvd will panic if the working memory has insufficient
-// storage.
+// work must have length at least max(1,lwork), and lwork must be at least
+// 3*min(m,n) + max(2*min(m,n), max(m,n), nrhs), or 1 if min(m,n) == 0,
+// otherwise Dgelss will panic. If lwork == -1, instead of performing Dgelss, the optimal work length will be
+// stored into work[0].
 //
-// Dgesvd returns whether the decomposition successfully completed.
-func (impl Implementation) Dgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool) {
-	wantua := jobU == lapack.SVDAll
-	wantus := jobU == lapack.SVDStore
-	wantuo := jobU == lapack.SVDOverwrite
-	wantun := jobU == lapack.SVDNone
-	if !(wantua || wantus || wantuo || wantun) {
-		panic(badSVDJob)
-	}
-
-	wantva := jobVT == lapack.SVDAll
-	wantvs := jobVT == lapack.SVDStore
-	wantvas := wantva || wantvs
-	wantvo := jobVT == lapack.SVDOverwrite
-	wantvn := jobVT == lapack.SVDNone
-	if !(wantva || wantvs || wantvo || wantvn) {
-		panic(badSVDJob)
-	}
-
-	if wantuo && wantvo {
-		panic(bothSVDOver)
-	}
-
-	minmn := min(m, n)
-	minwork := 1
-	if minmn > 0 {
-		minwork = max(3*minmn+max(m, n), 5*minmn)
+// Dgelss returns the effective rank of A and whether the computation of the
+// singular value decomposition converged.
+func (impl Implementation) Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool) {
+	mn := min(m, n)
+	minwrk := 3*mn + max(max(2*mn, max(m, n)), nrhs)
+	if mn == 0 {
+		minwrk = 1
 	}
 	switch {
 	case m < 0:
 		panic(mLT0)
 	case n < 0:
 		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
 	case lda < max(1, n):
 		panic(badLdA)
-	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
-		panic(badLdU)
-	case ldvt < 1 || (wantvas && ldvt < n):
-		panic(badLdVT)
-	case lwork < minwork && lwork != -1:
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case lwork < max(1, minwrk) && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
 	// Quick return if possible.
-	if minmn == 0 {
+	if mn == 0 {
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
 		work[0] = 1
-		return true
+		return 0, true
 	}
 
+	_rank := []lapacke.Int{0}
 	if lwork == -1 {
-		return lapacke.Dgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, -1)
+		ok = lapacke.Dgelss(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, -1)
+		return 0, ok
 	}
 
 	switch {
 	case len(a) < (m-1)*lda+n:
 		panic(shortA)
-	case len(s) < minmn:
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(shortB)
+	case len(s) < mn:
 		panic(shortS)
-	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
-		panic(shortU)
-	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
-		panic(shortVT)
 	}
 
-	return lapacke.Dgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork)
+	ok = lapacke.Dgelss(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, lwork)
+	return int(_rank[0]), ok
 }
 
-// Dgetf2 computes the LU decomposition of the m×n matrix A.
-// The LU decomposition is a factorization of a into
+// Dgelsd computes the minimum-norm solution to the linear least squares
+// problem
 //
-//	A = P * L * U
+//	minimize ‖B - A*X‖_2
 //
-// where P is a permutation matrix, L is a unit lower triangular matrix, and
-// U is a (usually) non-unit upper triangular matrix. On exit, L and U are stored
-// in place into a.
+// using the singular value decomposition of the m×n matrix A, which may be
+// rank-deficient. Dgelsd uses a divide and conquer algorithm and is usually
+// significantly faster than Dgelss for large matrices.
 //
-// ipiv is a permutation vector. It indicates that row i of the matrix was
-// changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
-// otherwise. ipiv is zero-indexed.
+// On entry, b contains the m×nrhs right hand side matrix B and must have
+// max(m,n) rows. On return, the leading n×nrhs submatrix of b contains the
+// solution matrix X. If m >= n and rank == n, the residual sum of squares for
+// the solution in column j is given by the sum of squares of elements n:m in
+// that column. On return, a is overwritten.
 //
-// Dgetf2 returns whether the matrix A is singular. The LU decomposition will
-// be computed regardless of the singularity of A, but division by zero
-// will occur if the false is returned and the result is used to solve a
-// system of equations.
-func (Implementation) Dgetf2(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
-	mn := min(m, n)
+// s must have length at least min(m,n) and on return contains the singular
+// values of A in decreasing order. The condition number of A in the 2-norm is
+// s[0]/s[min(m,n)-1].
+//
+// rcond is used to determine the effective rank of A. Singular values
+// s[i] <= rcond*s[0] are treated as zero. If rcond < 0, machine precision is
+// used instead.
+//
+// With mn = max(1,min(m,n)) and nlvl = max(0, int(log₂(mn/26))+1), work must
+// have length at least max(1,lwork), and lwork must be at least
+//
+//	3*mn + max(max(m,n), nrhs, 59*mn + 8*mn*nlvl + mn*nrhs + 676),
+//
+// or 1 if min(m,n) == 0, otherwise Dgelsd will panic. If lwork == -1, instead of performing Dgelsd,
+// the optimal work length will be stored into work[0].
+//
+// iwork must have length at least 3*mn*nlvl + 11*mn, otherwise Dgelsd will
+// panic.
+//
+// Dgelsd returns the effective rank of A and whether the computation of the
+// singular value decomposition converged.
+func (impl Implementation) Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int, iwork []int) (rank int, ok bool) {
+	// smlsiz is the maximum size of the subproblems at the bottom of the
+	// computation tree as returned by Ilaenv with ispec = 9.
+	const smlsiz = 25
+	mn := max(1, min(m, n))
+	nlvl := max(0, int(math.Log2(float64(mn)/(smlsiz+1)))+1)
+	wlalsd := 9*mn + 2*mn*smlsiz + 8*mn*nlvl + mn*nrhs + (smlsiz+1)*(smlsiz+1)
+	minwrk := 3*mn + max(max(max(m, n), nrhs), wlalsd)
+	if min(m, n) == 0 {
+		minwrk = 1
+	}
+	liwork := 3*mn*nlvl + 11*mn
 	switch {
 	case m < 0:
 		panic(mLT0)
 	case n < 0:
 		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
 	case lda < max(1, n):
 		panic(badLdA)
-	}
-
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
 	// Quick return if possible.
-	if mn == 0 {
-		return true
+	if min(m, n) == 0 {
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		work[0] = 1
+		return 0, true
+	}
+
+	_rank := []lapacke.Int{0}
+	if lwork == -1 {
+		// The workspace query stores the minimum length of iwork in iwork[0].
+		_iwork := []lapacke.Int{0}
+		ok = lapacke.Dgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, -1, _iwork)
+		return 0, ok
 	}
 
 	switch {
 	case len(a) < (m-1)*lda+n:
 		panic(shortA)
-	case len(ipiv) != mn:
-		panic(badLenIpiv)
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(shortB)
+	case len(s) < mn:
+		panic(shortS)
+	case len(iwork) < liwork:
+		panic(shortIWork)
 	}
 
-	ipiv32 := make([]int32, mn)
-	ok = lapacke.Dgetf2(m, n, a, lda, ipiv32)
-	for i, v := range ipiv32 {
-		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
-	}
-	return ok
+	_iwork := getInts(liwork)
+	ok = lapacke.Dgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, lwork, *_iwork)
+	putInts(_iwork)
+	return int(_rank[0]), ok
 }
 
-// Dgetrf computes the LU decomposition of the m×n matrix A.
-// The LU decomposition is a factorization of A into
+// Dgelsy computes the minimum-norm solution to the linear least squares
+// problem
 //
-//	A = P * L * U
+//	minimize ‖B - A*X‖_2
 //
-// where P is a permutation matrix, L is a unit lower triangular matrix, and
-// U is a (usually) non-unit upper triangular matrix. On exit, L and U are stored
-// in place into a.
+// using a complete orthogonal factorization of the m×n matrix A, which may be
+// rank-deficient. A is first factorized using QR with column pivoting as
+// computed by Dgeqp3, A*P = Q*R, and the effective rank of A is determined as
+// the order of the largest leading triangular submatrix R_11 of R with an
+// estimated condition number less than 1/rcond. R is then reduced to a
+// complete orthogonal factorization from which the solution is computed.
 //
-// ipiv is a permutation vector. It indicates that row i of the matrix was
-// changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
-// otherwise. ipiv is zero-indexed.
+// On entry, b contains the m×nrhs right hand side matrix B and must have
+// max(m,n) rows. On return, the leading n×nrhs submatrix of b contains the
+// solution matrix X. On return, a is overwritten with the complete
+// orthogonal factorization of A.
 //
-// Dgetrf is the blocked version of the algorithm.
+// jpvt has the same meaning as in Dgeqp3. On entry, if jpvt[j] is at least
+// zero, the jth column of A is permuted to the front of A*P, and if jpvt[j] is
+// -1 the jth column of A is a free column. On return, the jth column of A*P
+// was the jpvt[j] column of A. jpvt must have length n, otherwise Dgelsy will
+// panic.
 //
-// Dgetrf returns whether the matrix A is singular. The LU decomposition will
-// be computed regardless of the singularity of A, but division by zero
-// will occur if the false is returned and the result is used to solve a
-// system of equations.
-func (impl Implementation) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
+// If rcond < 0, machine precision is used instead.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(min(m,n)+3*n+1, 2*min(m,n)+nrhs), otherwise Dgelsy will panic. If
+// lwork == -1, instead of performing Dgelsy, the optimal work length will be
+// stored into work[0].
+//
+// Dgelsy returns the effective rank of A.
+func (impl Implementation) Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []int, rcond float64, work []float64, lwork int) (rank int) {
 	mn := min(m, n)
+	minwrk := max(mn+3*n+1, 2*mn+nrhs)
+	if mn == 0 {
+		minwrk = 1
+	}
 	switch {
 	case m < 0:
 		panic(mLT0)
 	case n < 0:
 		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
 	case lda < max(1, n):
 		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
 	}
 
 	// Quick return if possible.
 	if mn == 0 {
-		return true
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		work[0] = 1
+		return 0
+	}
+
+	_rank := []lapacke.Int{0}
+	// Don't update jpvt if querying lwkopt.
+	if lwork == -1 {
+		lapacke.Dgelsy(m, n, nrhs, a, lda, b, ldb, nil, rcond, _rank, work, -1)
+		return 0
 	}
 
 	switch {
 	case len(a) < (m-1)*lda+n:
 		panic(shortA)
-	case len(ipiv) != mn:
-		panic(badLenIpiv)
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(shortB)
+	case len(jpvt) != n:
+		panic(badLenJpvt)
 	}
 
-	ipiv32 := make([]int32, mn)
-	ok = lapacke.Dgetrf(m, n, a, lda, ipiv32)
-	for i, v := range ipiv32 {
-		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	for _, v := range jpvt {
+		if v < -1 || n <= v {
+			panic(badJpvt)
+		}
 	}
-	return ok
+	ix := newIndices(jpvt)
+	if !ix.load() {
+		ix.release()
+		panic(badJpvt)
+	}
+	lapacke.Dgelsy(m, n, nrhs, a, lda, b, ldb, ix.buf, rcond, _rank, work, lwork)
+	ix.store()
+	return int(_rank[0])
 }
 
-// Dgetri computes the inverse of the matrix A using the LU factorization computed
-// by Dgetrf. On entry, a contains the PLU decomposition of A as computed by
-// Dgetrf and on exit contains the reciprocal of the original matrix.
+// Dgglse solves the linear equality-constrained least squares problem
 //
-// Dgetri will not perform the inversion if the matrix is singular, and returns
-// a boolean indicating whether the inversion was successful.
+//	minimize ‖c - A*x‖_2 subject to B*x = d
 //
-// work is temporary storage, and lwork specifies the usable memory length.
-// At minimum, lwork >= n and this function will panic otherwise.
-// Dgetri is a blocked inversion, but the block size is limited
-// by the temporary space available. If lwork == -1, instead of performing Dgetri,
-// the optimal work length will be stored into work[0].
-func (impl Implementation) Dgetri(n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
-	iws := max(1, n)
+// where A is an m×n matrix, B is a p×n matrix, c is an m-vector and d is a
+// p-vector. It is assumed that
+//
+//	p <= n <= m+p,
+//
+// that B has full row rank p and that the (m+p)×n matrix [A; B] has full
+// column rank n, which ensures that the problem has a unique solution.
+// Dgglse will panic if the dimensions do not satisfy the above inequalities.
+//
+// On return, a and b are overwritten and d is destroyed. On entry, c contains
+// the right hand side vector c of the least squares part. On return, the
+// residual sum of squares for the solution is given by the sum of squares of
+// elements n-p:m of c.
+//
+// x must have length at least n and on return contains the solution x.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,m+n+p), otherwise Dgglse will panic. If lwork == -1, instead of
+// performing Dgglse, the optimal work length will be stored into work[0].
+//
+// Dgglse returns whether the solution was computed. It returns false if B does
+// not have full row rank or if [A; B] does not have full column rank.
+func (impl Implementation) Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) (ok bool) {
 	switch {
+	case m < 0:
+		panic(mLT0)
 	case n < 0:
 		panic(nLT0)
+	case p < 0:
+		panic(pLT0)
+	case p > n:
+		panic(pGTN)
+	case n > m+p:
+		panic(nGTMP)
 	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < iws && lwork != -1:
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < max(1, m+n+p) && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
+	// Quick return if possible.
 	if n == 0 {
 		work[0] = 1
 		return true
 	}
 
 	if lwork == -1 {
-		return lapacke.Dgetri(n, a, lda, nil, work, -1)
+		return lapacke.Dgglse(m, n, p, a, lda, b, ldb, c, d, x, work, -1)
 	}
 
 	switch {
-	case len(a) < (n-1)*lda+n:
+	case len(a) < max(0, (m-1)*lda+n):
 		panic(shortA)
-	case len(ipiv) != n:
-		panic(badLenIpiv)
+	case len(b) < max(0, (p-1)*ldb+n):
+		panic(shortB)
+	case len(c) < m:
+		panic(shortC)
+	case len(d) < p:
+		panic(shortD)
+	case len(x) < n:
+		panic(shortX)
 	}
 
-	ipiv32 := make([]int32, n)
-	for i, v := range ipiv {
-		v++ // Transform to one-indexed.
-		if v != int(int32(v)) {
-			panic("lapack: ipiv element out of range")
-		}
-		ipiv32[i] = int32(v)
-	}
-	return lapacke.Dgetri(n, a, lda, ipiv32, work, lwork)
+	return lapacke.Dgglse(m, n, p, a, lda, b, ldb, c, d, x, work, lwork)
 }
 
-// Dgetrs solves a system of equations using an LU factorization.
-// The system of equations solved is
+// Dggglm solves the general Gauss-Markov linear model problem
 //
-//	A * X = B if trans == blas.Trans
-//	A^T * X = B if trans == blas.NoTrans
+//	minimize ‖y‖_2 subject to d = A*x + B*y
 //
-// A is a general n×n matrix with stride lda. B is a general matrix of size n×nrhs.
+// where A is an n×m matrix, B is an n×p matrix and d is an n-vector. It is
+// assumed that
 //
-// On entry b contains the elements of the matrix B. On exit, b contains the
-// elements of X, the solution to the system of equations.
+//	m <= n <= m+p,
 //
-// a and ipiv contain the LU factorization of A and the permutation indices as
-// computed by Dgetrf. ipiv is zero-indexed.
-func (impl Implementation) Dgetrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+// that A has full column rank m and that the n×(m+p) matrix [A B] has full row
+// rank n, which ensures that the problem has a unique solution. Dggglm will
+// panic if the dimensions do not satisfy the above inequalities.
+//
+// When B is square and non-singular, the problem is equivalent to the weighted
+// linear least squares problem
+//
+//	minimize ‖inv(B)*(d - A*x)‖_2.
+//
+// On return, a and b are overwritten and d is destroyed.
+//
+// x must have length at least m and y must have length at least p. On return
+// they contain the solution x and y.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n+m+p), otherwise Dggglm will panic. If lwork == -1, instead of
+// performing Dggglm, the optimal work length will be stored into work[0].
+//
+// Dggglm returns whether the solution was computed. It returns false if A does
+// not have full column rank or if [A B] does not have full row rank.
+func (impl Implementation) Dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) (ok bool) {
 	switch {
-	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
-		panic(badTrans)
 	case n < 0:
 		panic(nLT0)
-	case nrhs < 0:
-		panic(nrhsLT0)
-	case lda < max(1, n):
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case m > n:
+		panic(mGTN)
+	case n > m+p:
+		panic(nGTMP)
+	case lda < max(1, m):
 		panic(badLdA)
-	case ldb < max(1, nrhs):
+	case ldb < max(1, p):
 		panic(badLdB)
+	case lwork < max(1, n+m+p) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
 	}
 
 	// Quick return if possible.
-	if n == 0 || nrhs == 0 {
-		return
+	if n == 0 {
+		// m == 0 and the minimum-norm y is zero.
+		if len(y) < p {
+			panic(shortY)
+		}
+		for i := range y[:p] {
+			y[i] = 0
+		}
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Dggglm(n, m, p, a, lda, b, ldb, d, x, y, work, -1)
 	}
 
 	switch {
-	case len(a) < (n-1)*lda+n:
+	case len(a) < (n-1)*lda+m:
 		panic(shortA)
-	case len(b) < (n-1)*ldb+nrhs:
+	case len(b) < (n-1)*ldb+p:
 		panic(shortB)
-	case len(ipiv) != n:
-		panic(badLenIpiv)
+	case len(d) < n:
+		panic(shortD)
+	case len(x) < m:
+		panic(shortX)
+	case len(y) < p:
+		panic(shortY)
 	}
 
-	ipiv32 := make([]int32, n)
-	for i, v := range ipiv {
-		v++ // Transform to one-indexed.
-		if v != int(int32(v)) {
-			panic("lapack: ipiv element out of range")
-		}
-		ipiv32[i] = int32(v)
-	}
-	lapacke.Dgetrs(byte(trans), n, nrhs, a, lda, ipiv32, b, ldb)
+	return lapacke.Dggglm(n, m, p, a, lda, b, ldb, d, x, y, work, lwork)
 }
 
-// Dggsvd3 computes the generalized singular value decomposition (GSVD)
-// of an m×n matrix A and p×n matrix B:
-//
-//	U^T*A*Q = D1*[ 0 R ]
-//
-//	V^T*B*Q = D2*[ 0 R ]
-//
-// where U, V and Q are orthogonal matrices.
-//
-// Dggsvd3 returns k and l, the dimensions of the sub-blocks. k+l
-// is the effective numerical rank of the (m+p)×n matrix [ A^T B^T ]^T.
-// R is a (k+l)×(k+l) nonsingular upper triangular matrix, D1 and
-// D2 are m×(k+l) and p×(k+l) diagonal matrices and of the following
-// structures, respectively:
-//
-// If m-k-l >= 0,
-//
-//	                  k  l
-//	     D1 =     k [ I  0 ]
-//	              l [ 0  C ]
-//	          m-k-l [ 0  0 ]
-//
-//	                k  l
-//	     D2 = l   [ 0  S ]
-//	          p-l [ 0  0 ]
-//
-//	             n-k-l  k    l
-//	[ 0 R ] = k [  0   R11  R12 ] k
-//	          l [  0    0   R22 ] l
-//
-// where
-//
-//	C = diag( alpha_k, ... , alpha_{k+l} ),
-//	S = diag( beta_k,  ... , beta_{k+l} ),
-//	C^2 + S^2 = I.
-//
-// R is stored in
+// Dgesvd computes the singular value decomposition of the input matrix A.
 //
-//	A[0:k+l, n-k-l:n]
+// The singular value decomposition is
 //
-// on exit.
+//	A = U * Sigma * V^T
 //
-// If m-k-l < 0,
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
 //
-//	               k m-k k+l-m
-//	    D1 =   k [ I  0    0  ]
-//	         m-k [ 0  C    0  ]
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
 //
-//	                 k m-k k+l-m
-//	    D2 =   m-k [ 0  S    0  ]
-//	         k+l-m [ 0  0    I  ]
-//	           p-l [ 0  0    0  ]
+//	jobU == lapack.SVDAll       All m columns of U are returned in u
+//	jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//	jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
+//	jobU == lapack.SVDNone      The columns of U are not computed.
 //
-//	               n-k-l  k   m-k  k+l-m
-//	[ 0 R ] =    k [ 0    R11  R12  R13 ]
-//	           m-k [ 0     0   R22  R23 ]
-//	         k+l-m [ 0     0    0   R33 ]
+// The behavior is the same for jobVT and the rows of V^T. At most one of jobU
+// and jobVT can equal lapack.SVDOverwrite, and Dgesvd will panic otherwise.
 //
-// where
+// On entry, a contains the data for the m×n matrix A. During the call to Dgesvd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if either job is lapack.SVDOverwrite.
 //
-//	C = diag( alpha_k, ... , alpha_m ),
-//	S = diag( beta_k,  ... , beta_m ),
-//	C^2 + S^2 = I.
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
 //
-//	R = [ R11 R12 R13 ] is stored in A[1:m, n-k-l+1:n]
-//	    [  0  R22 R23 ]
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
+// not used.
 //
-// and R33 is stored in
+// vt contains the left singular vectors on exit, stored rowwise. If
+// jobV == lapack.SVDAll, vt is of size n×m. If jobVT == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
+// not used.
 //
-//	B[m-k:l, n+m-k-l:n] on exit.
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least max(5*min(m,n), 3*min(m,n)+max(m,n)).
+// If lwork == -1, instead of performing Dgesvd, the optimal work length will be
+// stored into work[0]. Dgesvd will panic if the working memory has insufficient
+// storage.
 //
-// Dggsvd3 computes C, S, R, and optionally the orthogonal transformation
-// matrices U, V and Q.
-//
-// jobU, jobV and jobQ are options for computing the orthogonal matrices. The behavior
-// is as follows
-//
-//	jobU == lapack.GSVDU        Compute orthogonal matrix U
-//	jobU == lapack.GSVDNone     Do not compute orthogonal matrix.
-//
-// The behavior is the same for jobV and jobQ with the exception that instead of
-// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
-// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
-// relevant job parameter is lapack.GSVDNone.
-//
-// alpha and beta must have length n or Dggsvd3 will panic. On exit, alpha and
-// beta contain the generalized singular value pairs of A and B
-//
-//	alpha[0:k] = 1,
-//	beta[0:k]  = 0,
-//
-// if m-k-l >= 0,
-//
-//	alpha[k:k+l] = diag(C),
-//	beta[k:k+l]  = diag(S),
-//
-// if m-k-l < 0,
-//
-//	alpha[k:m]= C, alpha[m:k+l]= 0
-//	beta[k:m] = S, beta[m:k+l] = 1.
-//
-// if k+l < n,
-//
-//	alpha[k+l:n] = 0 and
-//	beta[k+l:n]  = 0.
-//
-// On exit, iwork contains the permutation required to sort alpha descending.
-//
-// iwork must have length n, work must have length at least max(1, lwork), and
-// lwork must be -1 or greater than n, otherwise Dggsvd3 will panic. If
-// lwork is -1, work[0] holds the optimal lwork on return, but Dggsvd3 does
-// not perform the GSVD.
-func (impl Implementation) Dggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []int) (k, l int, ok bool) {
-	wantu := jobU == lapack.GSVDU
-	wantv := jobV == lapack.GSVDV
-	wantq := jobQ == lapack.GSVDQ
+// Dgesvd returns whether the decomposition successfully completed.
+func (impl Implementation) Dgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool) {
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuo := jobU == lapack.SVDOverwrite
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantuo || wantun) {
+		panic(badSVDJob)
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvo := jobVT == lapack.SVDOverwrite
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvo || wantvn) {
+		panic(badSVDJob)
+	}
+
+	if wantuo && wantvo {
+		panic(bothSVDOver)
+	}
+
+	minmn := min(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = max(3*minmn+max(m, n), 5*minmn)
+	}
 	switch {
-	case !wantu && jobU != lapack.GSVDNone:
-		panic(badGSVDJob + "U")
-	case !wantv && jobV != lapack.GSVDNone:
-		panic(badGSVDJob + "V")
-	case !wantq && jobQ != lapack.GSVDNone:
-		panic(badGSVDJob + "Q")
 	case m < 0:
 		panic(mLT0)
 	case n < 0:
 		panic(nLT0)
-	case p < 0:
-		panic(pLT0)
 	case lda < max(1, n):
 		panic(badLdA)
-	case ldb < max(1, n):
-		panic(badLdB)
-	case ldu < 1, wantu && ldu < m:
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
 		panic(badLdU)
-	case ldv < 1, wantv && ldv < p:
-		panic(badLdV)
-	case ldq < 1, wantq && ldq < n:
-		panic(badLdQ)
-	case len(iwork) < n:
-		panic(shortWork)
-	case lwork < 1 && lwork != -1:
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
-	// Determine optimal work length.
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
 	if lwork == -1 {
-		lapacke.Dggsvd3(byte(jobU), byte(jobV), byte(jobQ), m, n, p, nil, nil, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, -1, nil)
-		return 0, 0, true
+		return lapacke.Dgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, -1)
 	}
 
 	switch {
 	case len(a) < (m-1)*lda+n:
 		panic(shortA)
-	case len(b) < (p-1)*ldb+n:
-		panic(shortB)
-	case wantu && len(u) < (m-1)*ldu+m:
+	case len(s) < minmn:
+		panic(shortS)
+	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
 		panic(shortU)
-	case wantv && len(v) < (p-1)*ldv+p:
-		panic(shortV)
-	case wantq && len(q) < (n-1)*ldq+n:
-		panic(shortQ)
-	case len(alpha) != n:
-		panic(badLenAlpha)
-	case len(beta) != n:
-		panic(badLenBeta)
-	}
-
-	_k := []int32{0}
-	_l := []int32{0}
-	_iwork := make([]int32, n)
-	ok = lapacke.Dggsvd3(byte(jobU), byte(jobV), byte(jobQ), m, n, p, _k, _l, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, lwork, _iwork)
-	for i, v := range _iwork {
-		iwork[i] = int(v - 1)
+	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
+		panic(shortVT)
 	}
 
-	return int(_k[0]), int(_l[0]), ok
+	return lapacke.Dgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork)
 }
 
-// Dggsvp3 computes orthogonal matrices U, V and Q such that
-//
-//	                n-k-l  k    l
-//	U^T*A*Q =    k [ 0    A12  A13 ] if m-k-l >= 0;
-//	             l [ 0     0   A23 ]
-//	         m-k-l [ 0     0    0  ]
-//
-//	                n-k-l  k    l
-//	U^T*A*Q =    k [ 0    A12  A13 ] if m-k-l < 0;
-//	           m-k [ 0     0   A23 ]
+// Dgesdd computes the singular value decomposition of the input matrix A
+// using a divide and conquer algorithm.
 //
-//	                n-k-l  k    l
-//	V^T*B*Q =    l [ 0     0   B13 ]
-//	           p-l [ 0     0    0  ]
+// The singular value decomposition is
 //
-// where the k×k matrix A12 and l×l matrix B13 are non-singular
-// upper triangular. A23 is l×l upper triangular if m-k-l >= 0,
-// otherwise A23 is (m-k)×l upper trapezoidal.
+//	A = U * Sigma * V^T
 //
-// Dggsvp3 returns k and l, the dimensions of the sub-blocks. k+l
-// is the effective numerical rank of the (m+p)×n matrix [ A^T B^T ]^T.
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively. Dgesdd is usually significantly faster than Dgesvd for large
+// matrices when the singular vectors are requested.
+//
+// jobz specifies which singular vectors are computed. The behavior is as
+// follows
+//
+//	jobz == lapack.SVDAll       All m columns of U and all n rows of V^T are
+//	                            returned in u and vt
+//	jobz == lapack.SVDStore     The first min(m,n) columns of U and rows of V^T
+//	                            are returned in u and vt
+//	jobz == lapack.SVDOverwrite If m >= n, the first n columns of U are written
+//	                            into a and all rows of V^T are returned in vt.
+//	                            Otherwise, all columns of U are returned in u
+//	                            and the first m rows of V^T are written into a
+//	jobz == lapack.SVDNone      The singular vectors are not computed.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Dgesdd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if jobz is lapack.SVDOverwrite.
 //
-// jobU, jobV and jobQ are options for computing the orthogonal matrices. The behavior
-// is as follows
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
 //
-//	jobU == lapack.GSVDU        Compute orthogonal matrix U
-//	jobU == lapack.GSVDNone     Do not compute orthogonal matrix.
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobz == lapack.SVDAll, or jobz == lapack.SVDOverwrite and m < n, u is of
+// size m×m. If jobz == lapack.SVDStore u is of size m×min(m,n). Otherwise u
+// is not used.
 //
-// The behavior is the same for jobV and jobQ with the exception that instead of
-// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
-// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
-// relevant job parameter is lapack.GSVDNone.
+// vt contains the right singular vectors on exit, stored row-wise. If
+// jobz == lapack.SVDAll, or jobz == lapack.SVDOverwrite and m >= n, vt is of
+// size n×n. If jobz == lapack.SVDStore vt is of size min(m,n)×n. Otherwise vt
+// is not used.
 //
-// tola and tolb are the convergence criteria for the Jacobi-Kogbetliantz
-// iteration procedure. Generally, they are the same as used in the preprocessing
-// step, for example,
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. With mn = min(m,n) and mx = max(m,n), lwork must be at least
 //
-//	tola = max(m, n)*norm(A)*eps,
-//	tolb = max(p, n)*norm(B)*eps.
+//	3*mn + max(mx, 7*mn)           if jobz == lapack.SVDNone,
+//	3*mn + max(mx, 5*mn*mn + 4*mn) if jobz == lapack.SVDOverwrite,
+//	4*mn*mn + 7*mn                 if jobz == lapack.SVDStore,
+//	4*mn*mn + 6*mn + mx            if jobz == lapack.SVDAll.
 //
-// Where eps is the machine epsilon.
+// If lwork == -1, instead of performing Dgesdd, the optimal work length will be
+// stored into work[0]. Dgesdd will panic if the working memory has insufficient
+// storage.
 //
-// iwork must have length n, work must have length at least max(1, lwork), and
-// lwork must be -1 or greater than zero, otherwise Dggsvp3 will panic.
+// iwork must have length at least 8*min(m,n), otherwise Dgesdd will panic.
 //
-// Dggsvp3 is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dggsvp3(jobU, jobV, jobQ lapack.GSVDJob, m, p, n int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, iwork []int, tau, work []float64, lwork int) (k, l int) {
-	wantu := jobU == lapack.GSVDU
-	wantv := jobV == lapack.GSVDV
-	wantq := jobQ == lapack.GSVDQ
+// Dgesdd returns whether the decomposition successfully completed.
+func (impl Implementation) Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool) {
+	minmn := min(m, n)
+	maxmn := max(m, n)
+	var wantu, wantvt bool
+	var ucol, vtrow, minwork int
+	switch jobz {
+	default:
+		panic(badSVDJob)
+	case lapack.SVDAll:
+		wantu, wantvt = true, true
+		ucol, vtrow = m, n
+		minwork = 4*minmn*minmn + 6*minmn + maxmn
+	case lapack.SVDStore:
+		wantu, wantvt = true, true
+		ucol, vtrow = minmn, minmn
+		minwork = 4*minmn*minmn + 7*minmn
+	case lapack.SVDOverwrite:
+		wantu, wantvt = m < n, m >= n
+		ucol, vtrow = m, n
+		minwork = 3*minmn + max(maxmn, 5*minmn*minmn+4*minmn)
+	case lapack.SVDNone:
+		minwork = 3*minmn + max(maxmn, 7*minmn)
+	}
+	if minmn == 0 {
+		minwork = 1
+	}
 	switch {
-	case !wantu && jobU != lapack.GSVDNone:
-		panic(badGSVDJob + "U")
-	case !wantv && jobV != lapack.GSVDNone:
-		panic(badGSVDJob + "V")
-	case !wantq && jobQ != lapack.GSVDNone:
-		panic(badGSVDJob + "Q")
 	case m < 0:
 		panic(mLT0)
-	case p < 0:
-		panic(pLT0)
 	case n < 0:
 		panic(nLT0)
 	case lda < max(1, n):
 		panic(badLdA)
-	case ldb < max(1, n):
-		panic(badLdB)
-	case ldu < 1, wantu && ldu < m:
+	case ldu < 1 || (wantu && ldu < ucol):
 		panic(badLdU)
-	case ldv < 1, wantv && ldv < p:
-		panic(badLdV)
-	case ldq < 1, wantq && ldq < n:
-		panic(badLdQ)
-	case len(iwork) != n:
-		panic(shortWork)
-	case lwork < 1 && lwork != -1:
+	case ldvt < 1 || (wantvt && ldvt < n):
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
 	if lwork == -1 {
-		lapacke.Dggsvp3(byte(jobU), byte(jobV), byte(jobQ), m, p, n, a, lda, b, ldb, tola, tolb, nil, nil, u, ldu, v, ldv, q, ldq, nil, tau, work, -1)
-		return 0, 0
+		return lapacke.Dgesdd(byte(jobz), m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, nil)
 	}
 
 	switch {
 	case len(a) < (m-1)*lda+n:
 		panic(shortA)
-	case len(b) < (p-1)*ldb+n:
-		panic(shortB)
-	case wantu && len(u) < (m-1)*ldu+m:
+	case len(s) < minmn:
+		panic(shortS)
+	case wantu && len(u) < (m-1)*ldu+ucol:
 		panic(shortU)
-	case wantv && len(v) < (p-1)*ldv+p:
-		panic(shortV)
-	case wantq && len(q) < (n-1)*ldq+n:
-		panic(shortQ)
-	case len(tau) < n:
-		// tau check must come after lwkopt query since
-		// the Dggsvd3 call for lwkopt query may have
-		// lwork == -1, and tau is provided by work.
-		panic(shortTau)
+	case wantvt && len(vt) < (vtrow-1)*ldvt+n:
+		panic(shortVT)
+	case len(iwork) < 8*minmn:
+		panic(shortIWork)
 	}
 
-	_k := []int32{0}
-	_l := []int32{0}
-	_iwork := make([]int32, n)
-	lapacke.Dggsvp3(byte(jobU), byte(jobV), byte(jobQ), m, p, n, a, lda, b, ldb, tola, tolb, _k, _l, u, ldu, v, ldv, q, ldq, _iwork, tau, work, lwork)
-	return int(_k[0]), int(_l[0])
+	_iwork := getInts(8 * minmn)
+	ok = lapacke.Dgesdd(byte(jobz), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork, *_iwork)
+	putInts(_iwork)
+	return ok
 }
 
-// Dorgbr generates one of the matrices Q or P^T computed by Dgebrd.
-// See Dgebrd for the description of Q and P^T.
+// Dgetf2 computes the LU decomposition of the m×n matrix A.
+// The LU decomposition is a factorization of a into
 //
-// If vect == lapack.ApplyQ, then a is assumed to have been an m×k matrix and
-// Q is of order m. If m >= k, then Dorgbr returns the first n columns of Q
-// where m >= n >= k. If m < k, then Dorgbr returns Q as an m×m matrix.
+//	A = P * L * U
 //
-// If vect == lapack.ApplyP, then A is assumed to have been a k×n matrix, and
-// P^T is of order n. If k < n, then Dorgbr returns the first m rows of P^T,
-// where n >= m >= k. If k >= n, then Dorgbr returns P^T as an n×n matrix.
-func (impl Implementation) Dorgbr(vect lapack.GenOrtho, m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
-	wantq := vect == lapack.GenerateQ
+// where P is a permutation matrix, L is a unit lower triangular matrix, and
+// U is a (usually) non-unit upper triangular matrix. On exit, L and U are stored
+// in place into a.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Dgetf2 returns whether the matrix A is singular. The LU decomposition will
+// be computed regardless of the singularity of A, but division by zero
+// will occur if the false is returned and the result is used to solve a
+// system of equations.
+func (Implementation) Dgetf2(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
 	mn := min(m, n)
 	switch {
-	case vect != lapack.GenerateQ && vect != lapack.GeneratePT:
-		panic(badGenOrtho)
 	case m < 0:
 		panic(mLT0)
 	case n < 0:
 		panic(nLT0)
-	case k < 0:
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	ix := newIndices(ipiv)
+	ok = lapacke.Dgetf2(m, n, a, lda, ix.buf)
+	ix.store()
+	return ok
+}
+
+// Dgetrf computes the LU decomposition of the m×n matrix A.
+// The LU decomposition is a factorization of A into
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a unit lower triangular matrix, and
+// U is a (usually) non-unit upper triangular matrix. On exit, L and U are stored
+// in place into a.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Dgetrf is the blocked version of the algorithm.
+//
+// Dgetrf returns whether the matrix A is singular. The LU decomposition will
+// be computed regardless of the singularity of A, but division by zero
+// will occur if the false is returned and the result is used to solve a
+// system of equations.
+func (impl Implementation) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	ix := newIndices(ipiv)
+	ok = lapacke.Dgetrf(m, n, a, lda, ix.buf)
+	ix.store()
+	return ok
+}
+
+// Dgetri computes the inverse of the matrix A using the LU factorization computed
+// by Dgetrf. On entry, a contains the PLU decomposition of A as computed by
+// Dgetrf and on exit contains the reciprocal of the original matrix.
+//
+// Dgetri will not perform the inversion if the matrix is singular, and returns
+// a boolean indicating whether the inversion was successful.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= n and this function will panic otherwise.
+// Dgetri is a blocked inversion, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Dgetri,
+// the optimal work length will be stored into work[0].
+func (impl Implementation) Dgetri(n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
+	iws := max(1, n)
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < iws && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Dgetri(n, a, lda, nil, work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	ok = lapacke.Dgetri(n, a, lda, ix.buf, work, lwork)
+	ix.release()
+	return ok
+}
+
+// Dgetrs solves a system of equations using an LU factorization.
+// The system of equations solved is
+//
+//	A * X = B if trans == blas.Trans
+//	A^T * X = B if trans == blas.NoTrans
+//
+// A is a general n×n matrix with stride lda. B is a general matrix of size n×nrhs.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Dgetrf. ipiv is zero-indexed.
+func (impl Implementation) Dgetrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	lapacke.Dgetrs(byte(trans), n, nrhs, a, lda, ix.buf, b, ldb)
+	ix.release()
+}
+
+// Dgbtrf computes an LU factorization of a real m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges.
+//
+// A is stored in the layout of gonum's blas64.Band with kl sub-diagonals and
+// kl+ku super-diagonals, so ldab must be at least 2*kl+ku+1. On entry, the
+// first kl+ku+1 columns of ab contain A and the remaining kl columns need not
+// be set. On return, ab contains the upper triangular band matrix U with kl+ku
+// super-diagonals and the multipliers of the unit lower triangular matrix L.
+//
+// The band storage scheme is illustrated below when m = n = 5, kl = 1 and
+// ku = 1. Elements marked * are not used by the function and elements marked +
+// need not be set on entry.
+//
+//	On entry:             On return:
+//	  *   a00  a01   +      *   u00  u01  u02
+//	 a10  a11  a12   +     m10  u11  u12  u13
+//	 a21  a22  a23   +     m21  u22  u23  u24
+//	 a32  a33  a34   *     m32  u33  u34   *
+//	 a43  a44   *    *     m43  u44   *    *
+//
+// ipiv contains the zero-indexed pivot indices; for 0 <= i < min(m,n), row i
+// of the matrix was interchanged with row ipiv[i]. ipiv must have length
+// min(m,n), and Dgbtrf will panic otherwise.
+//
+// Dgbtrf returns whether U is non-singular. If it is singular, the
+// factorization has been completed but U has an exact zero on the diagonal
+// and it must not be used to solve a system of equations.
+func (impl Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (m-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	ldabConv := n
+	abConv := make([]float64, (2*kl+ku+1)*ldabConv)
+	bandToLapacke(m, n, kl, ku, ab, ldab, abConv[kl*ldabConv:], ldabConv)
+	ix := newIndices(ipiv)
+	ok = lapacke.Dgbtrf(m, n, kl, ku, abConv, ldabConv, ix.buf)
+	bandToGonum(m, n, kl, kl+ku, abConv, ldabConv, ab, ldab)
+	ix.store()
+	return ok
+}
+
+// Dgbtrs solves a system of linear equations
+//
+//	A * X = B    if trans == blas.NoTrans,
+//	Aᵀ * X = B   if trans == blas.Trans or blas.ConjTrans,
+//
+// with an n×n band matrix A with kl sub-diagonals and ku super-diagonals using
+// the LU factorization computed by Dgbtrf. See the documentation for Dgbtrf
+// for a description of the band storage format of the factorization.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func (impl Implementation) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	ldabConv := n
+	abConv := make([]float64, (2*kl+ku+1)*ldabConv)
+	bandToLapacke(n, n, kl, kl+ku, ab, ldab, abConv, ldabConv)
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	lapacke.Dgbtrs(byte(trans), n, kl, ku, nrhs, abConv, ldabConv, ix.buf, b, ldb)
+	ix.release()
+}
+
+// Dgbsv computes the solution to a system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n band matrix with kl sub-diagonals and ku super-diagonals
+// and X and B are n×nrhs matrices. The LU decomposition with partial pivoting
+// and row interchanges is used to factor A as
+//
+//	A = P * L * U,
+//
+// where P is a permutation matrix, L is a product of permutation and unit
+// lower triangular matrices with kl sub-diagonals and U is upper triangular
+// with kl+ku super-diagonals. The factored form of A is then used to solve the
+// system of equations.
+//
+// On entry, ab contains A in the band storage format described in the
+// documentation for Dgbtrf. On return, ab contains the factorization of A as
+// computed by Dgbtrf, and ipiv contains the zero-indexed pivot indices. ipiv
+// must have length n, and Dgbsv will panic otherwise.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, if ok
+// is true, it is overwritten with the solution matrix X.
+//
+// Dgbsv returns whether U is non-singular. If it is singular, the
+// factorization has been completed but the solution has not been computed.
+func (impl Implementation) Dgbsv(n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	ldabConv := n
+	abConv := make([]float64, (2*kl+ku+1)*ldabConv)
+	bandToLapacke(n, n, kl, ku, ab, ldab, abConv[kl*ldabConv:], ldabConv)
+	ix := newIndices(ipiv)
+	ok = lapacke.Dgbsv(n, kl, ku, nrhs, abConv, ldabConv, ix.buf, b, ldb)
+	bandToGonum(n, n, kl, kl+ku, abConv, ldabConv, ab, ldab)
+	ix.store()
+	return ok
+}
+
+// Dgbcon estimates the reciprocal of the condition number of an n×n band
+// matrix A with kl sub-diagonals and ku super-diagonals, in either the 1-norm
+// or the ∞-norm, using the LU factorization computed by Dgbtrf. See the
+// documentation for Dgbtrf for a description of the band storage format of the
+// factorization.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// The length of work must be at least 3*n and the length of iwork must be at
+// least n.
+func (impl Implementation) Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) (rcond float64) {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case anorm < 0:
+		panic(negANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	ldabConv := n
+	abConv := make([]float64, (2*kl+ku+1)*ldabConv)
+	bandToLapacke(n, n, kl, kl+ku, ab, ldab, abConv, ldabConv)
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	_rcond := []float64{0}
+	_iwork := getInts(n)
+	lapacke.Dgbcon(byte(norm), n, kl, ku, abConv, ldabConv, ix.buf, anorm, _rcond, work, *_iwork)
+	putInts(_iwork)
+	ix.release()
+	return _rcond[0]
+}
+
+// Dgttrf computes an LU factorization of an n×n tridiagonal matrix A using
+// elimination with partial pivoting and row interchanges. The factorization
+// has the form
+//
+//	A = L * U,
+//
+// where L is a product of permutation and unit lower bidiagonal matrices and U
+// is upper triangular with non-zeros in only the main diagonal and first two
+// super-diagonals.
+//
+// On entry, dl, d and du contain the sub-diagonal, diagonal and
+// super-diagonal of A, respectively. On return, dl contains the n-1
+// multipliers that define L, d contains the diagonal of U, du contains the
+// first super-diagonal of U and du2 contains the n-2 elements of the second
+// super-diagonal of U.
+//
+// ipiv contains the zero-indexed pivot indices; for 0 <= i < n, row i of the
+// matrix was interchanged with row ipiv[i]. ipiv must have length n, and
+// Dgttrf will panic otherwise.
+//
+// Dgttrf returns whether U is non-singular.
+func (impl Implementation) Dgttrf(n int, dl, d, du, du2 []float64, ipiv []int) (ok bool) {
+	if n < 0 {
+		panic(nLT0)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(shortDL)
+	case len(d) < n:
+		panic(shortD)
+	case len(du) < n-1:
+		panic(shortDU)
+	case len(du2) < max(0, n-2):
+		panic(shortDU2)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	ix := newIndices(ipiv)
+	ok = lapacke.Dgttrf(n, dl, d, du, du2, ix.buf)
+	ix.store()
+	return ok
+}
+
+// Dgttrs solves a system of linear equations
+//
+//	A * X = B    if trans == blas.NoTrans,
+//	Aᵀ * X = B   if trans == blas.Trans or blas.ConjTrans,
+//
+// with an n×n tridiagonal matrix A using the LU factorization computed by
+// Dgttrf.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func (impl Implementation) Dgttrs(trans blas.Transpose, n, nrhs int, dl, d, du, du2 []float64, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(shortDL)
+	case len(d) < n:
+		panic(shortD)
+	case len(du) < n-1:
+		panic(shortDU)
+	case len(du2) < max(0, n-2):
+		panic(shortDU2)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	lapacke.Dgttrs(byte(trans), n, nrhs, dl, d, du, du2, ix.buf, b, ldb)
+	ix.release()
+}
+
+// Dgtsv computes the solution to a system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n tridiagonal matrix and X and B are n×nrhs matrices, using
+// Gaussian elimination with partial pivoting.
+//
+// On entry, dl, d and du contain the sub-diagonal, diagonal and
+// super-diagonal of A, respectively. On return, dl contains the n-2 elements
+// of the second super-diagonal of the upper triangular matrix U of the LU
+// factorization of A, d contains the diagonal of U and du contains the first
+// super-diagonal of U.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, if ok
+// is true, it is overwritten with the solution matrix X.
+//
+// Dgtsv returns whether U is non-singular. If it is singular, the solution
+// has not been computed.
+func (impl Implementation) Dgtsv(n, nrhs int, dl, d, du, b []float64, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(shortDL)
+	case len(d) < n:
+		panic(shortD)
+	case len(du) < n-1:
+		panic(shortDU)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	return lapacke.Dgtsv(n, nrhs, dl, d, du, b, ldb)
+}
+
+// Dgerfs improves the computed solution to a system of linear equations
+//
+//	A * X = B    if trans == blas.NoTrans,
+//	A^T * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n general matrix and B and X are n×nrhs matrices, and
+// provides error bounds and backward error estimates for the solution.
+//
+// a contains the original matrix A. af and ipiv contain the LU factorization
+// of A and the zero-based pivot indices as computed by Dgetrf. b contains the
+// right hand side matrix B. On entry, x contains the solution matrix X as
+// computed by Dgetrs, and on exit x contains the improved solution.
+//
+// On return, ferr[j] is an estimated bound of the relative forward error of
+// the j-th column of X,
+//
+//	‖x_j - xtrue_j‖_∞ / ‖x_j‖_∞ ≤ ferr[j],
+//
+// where xtrue_j is the j-th column of the true solution, and berr[j] is the
+// componentwise relative backward error of the j-th column of X, that is the
+// smallest relative change in any element of A or B that makes x_j an exact
+// solution. ferr and berr must have length at least nrhs.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dgerfs will panic.
+func (impl Implementation) Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	// Quick return if possible.
+	if nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	ix := pooledIndices(ipiv)
+	if !ix.load() {
+		ix.release()
+		panic("lapack: ipiv element out of range")
+	}
+	_iwork := getInts(n)
+	lapacke.Dgerfs(byte(trans), n, nrhs, a, lda, af, ldaf, ix.buf, b, ldb, x, ldx, ferr, berr, work, *_iwork)
+	putInts(_iwork)
+	ix.release()
+}
+
+// Dgesvx solves a system of linear equations
+//
+//	A * X = B    if trans == blas.NoTrans,
+//	A^T * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n general matrix and B and X are n×nrhs matrices, using the
+// LU factorization of A. In addition to the solution, Dgesvx equilibrates the
+// system if requested, estimates the condition number of A, improves the
+// solution by iterative refinement and computes error bounds for it.
+//
+// fact specifies how the factorization of A is obtained:
+//   - fact == FactSupplied: af and ipiv contain on entry the LU factorization
+//     of A as computed by Dgetrf. If equed is not EquilibrationNone, A has
+//     been equilibrated with the scale factors in r and c, and af and ipiv
+//     contain the factorization of the equilibrated matrix.
+//   - fact == FactCompute: A is factorized into af and ipiv.
+//   - fact == FactEquilibrate: the system is equilibrated if necessary, then
+//     the equilibrated A is factorized into af and ipiv.
+//
+// equed is only used when fact == FactSupplied and specifies the
+// equilibration that has been done. It must be one of EquilibrationNone,
+// EquilibrationRow, EquilibrationCol or EquilibrationBoth. The returned
+// equedOut specifies the equilibration that is in effect on return. If it is
+// EquilibrationRow or EquilibrationBoth, A has been replaced by diag(r)*A,
+// and if it is EquilibrationCol or EquilibrationBoth, by A*diag(c). B is
+// scaled accordingly, by diag(r) or diag(c) depending on trans, and on
+// return a and b contain the equilibrated matrices. r and c must have length
+// at least n, they contain the row and column scale factors on return, and
+// when fact == FactSupplied those selected by equed must be positive.
+//
+// On return, x contains the solution of the original system. ferr[j] is an
+// estimated bound of the relative forward error of the j-th column of X and
+// berr[j] is its componentwise relative backward error, as described in
+// Dgerfs. ferr and berr must have length at least nrhs.
+//
+// rcond is the estimate of the reciprocal of the condition number of the
+// equilibrated matrix A in the 1-norm if trans == blas.NoTrans and in the
+// ∞-norm otherwise. rpvgrw is the reciprocal pivot growth factor
+// ‖A‖_max / ‖U‖_max. If it is much less than one, the LU factorization and
+// therefore the solution may be unstable.
+//
+// ok is false if A is singular to working precision. If U[i,i] is exactly
+// zero for some i, the solution and the error bounds have not been computed
+// and rcond is zero. Otherwise rcond is less than the machine precision and
+// the solution and the error bounds have been computed.
+//
+// work must have length at least max(1,4*n) and iwork must have length at
+// least n, otherwise Dgesvx will panic.
+func (impl Implementation) Dgesvx(fact FactJob, trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, equed Equilibration, r, c, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equedOut Equilibration, rcond, rpvgrw float64, ok bool) {
+	switch {
+	case fact != FactSupplied && fact != FactCompute && fact != FactEquilibrate:
+		panic(badFact)
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	case fact == FactSupplied && equed != EquilibrationNone && equed != EquilibrationRow &&
+		equed != EquilibrationCol && equed != EquilibrationBoth:
+		panic(badEquilibration)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(r) < n:
+		panic(shortR)
+	case len(c) < n:
+		panic(shortC)
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	case len(work) < max(1, 4*n):
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// ipiv is only read when the factorization is supplied.
+	var ix indices
+	if fact == FactSupplied {
+		if equed == EquilibrationRow || equed == EquilibrationBoth {
+			for _, v := range r[:n] {
+				if v <= 0 {
+					panic(badScaling)
+				}
+			}
+		}
+		if equed == EquilibrationCol || equed == EquilibrationBoth {
+			for _, v := range c[:n] {
+				if v <= 0 {
+					panic(badScaling)
+				}
+			}
+		}
+		ix = pooledIndices(ipiv)
+		if !ix.load() {
+			ix.release()
+			panic("lapack: ipiv element out of range")
+		}
+	} else {
+		ix = newIndices(ipiv)
+	}
+
+	_equed := []byte{byte(equed)}
+	_rcond := []float64{0}
+	_iwork := getInts(n)
+	ok = lapacke.Dgesvx(byte(fact), byte(trans), n, nrhs, a, lda, af, ldaf, ix.buf, _equed, r, c, b, ldb, x, ldx, _rcond, ferr, berr, work, *_iwork)
+	putInts(_iwork)
+	if fact == FactSupplied {
+		ix.release()
+	} else {
+		ix.store()
+	}
+	return Equilibration(_equed[0]), _rcond[0], work[0], ok
+}
+
+// Dsgesv computes the solution to a system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n matrix and X and B are n×nrhs matrices, using mixed
+// precision iterative refinement.
+//
+// Dsgesv first computes the LU factorization of A with partial pivoting in
+// single precision and uses the factorization within an iterative refinement
+// procedure to produce a solution with double precision normwise backward error
+// quality. If the iterative refinement does not converge, Dsgesv falls back to
+// computing the factorization and the solution in double precision. For
+// well-conditioned matrices the single precision factorization is usually
+// significantly faster than a double precision solve.
+//
+// On entry, a contains the matrix A. On return, if fallback is false, a is
+// unchanged and otherwise it contains the L and U factors of the double
+// precision factorization A = P*L*U as computed by Dgetrf.
+//
+// ipiv must have length n and on return contains the zero-indexed pivot
+// indices of the factorization that was used; for 0 <= i < n, row i of the
+// matrix was interchanged with row ipiv[i].
+//
+// b contains the right hand side matrix B and is not modified. On return, if
+// ok is true, x contains the solution matrix X.
+//
+// work must have length at least n*nrhs and swork must have length at least
+// n*(n+nrhs), otherwise Dsgesv will panic.
+//
+// iter is the number of refinement iterations performed by the single
+// precision solve. fallback reports whether Dsgesv fell back to the double
+// precision factorization, either because the iterative refinement did not
+// converge within 30 iterations or because the single precision factorization
+// could not be computed. If fallback is true, iter is the number of
+// refinement iterations that were attempted before falling back.
+//
+// Dsgesv returns ok equal to false if the double precision factor U is exactly
+// singular, in which case the solution has not been computed.
+func (impl Implementation) Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, fallback, ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, false, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < n*nrhs:
+		panic(shortWork)
+	case len(swork) < n*(n+nrhs):
+		panic(shortSWork)
+	}
+
+	ix := newIndices(ipiv)
+	_iter := getInts(1)
+	ok = lapacke.Dsgesv(n, nrhs, a, lda, ix.buf, b, ldb, x, ldx, work, swork, *_iter)
+	ix.store()
+	iter, fallback = mixedIter((*_iter)[0])
+	putInts(_iter)
+	return iter, fallback, ok
+}
+
+// mixedIter converts the iteration count returned by the mixed precision
+// solvers Dsgesv and Dsposv to the number of refinement iterations and
+// whether the solver fell back to double precision.
+func mixedIter(iter lapacke.Int) (int, bool) {
+	switch {
+	case iter >= 0:
+		return int(iter), false
+	case iter == -31:
+		// The iterative refinement did not converge after 30 iterations.
+		return 30, true
+	default:
+		// The single precision solve was not attempted or failed.
+		return 0, true
+	}
+}
+
+// Dggsvd3 computes the generalized singular value decomposition (GSVD)
+// of an m×n matrix A and p×n matrix B:
+//
+//	U^T*A*Q = D1*[ 0 R ]
+//
+//	V^T*B*Q = D2*[ 0 R ]
+//
+// where U, V and Q are orthogonal matrices.
+//
+// Dggsvd3 returns k and l, the dimensions of the sub-blocks. k+l
+// is the effective numerical rank of the (m+p)×n matrix [ A^T B^T ]^T.
+// R is a (k+l)×(k+l) nonsingular upper triangular matrix, D1 and
+// D2 are m×(k+l) and p×(k+l) diagonal matrices and of the following
+// structures, respectively:
+//
+// If m-k-l >= 0,
+//
+//	                  k  l
+//	     D1 =     k [ I  0 ]
+//	              l [ 0  C ]
+//	          m-k-l [ 0  0 ]
+//
+//	                k  l
+//	     D2 = l   [ 0  S ]
+//	          p-l [ 0  0 ]
+//
+//	             n-k-l  k    l
+//	[ 0 R ] = k [  0   R11  R12 ] k
+//	          l [  0    0   R22 ] l
+//
+// where
+//
+//	C = diag( alpha_k, ... , alpha_{k+l} ),
+//	S = diag( beta_k,  ... , beta_{k+l} ),
+//	C^2 + S^2 = I.
+//
+// R is stored in
+//
+//	A[0:k+l, n-k-l:n]
+//
+// on exit.
+//
+// If m-k-l < 0,
+//
+//	               k m-k k+l-m
+//	    D1 =   k [ I  0    0  ]
+//	         m-k [ 0  C    0  ]
+//
+//	                 k m-k k+l-m
+//	    D2 =   m-k [ 0  S    0  ]
+//	         k+l-m [ 0  0    I  ]
+//	           p-l [ 0  0    0  ]
+//
+//	               n-k-l  k   m-k  k+l-m
+//	[ 0 R ] =    k [ 0    R11  R12  R13 ]
+//	           m-k [ 0     0   R22  R23 ]
+//	         k+l-m [ 0     0    0   R33 ]
+//
+// where
+//
+//	C = diag( alpha_k, ... , alpha_m ),
+//	S = diag( beta_k,  ... , beta_m ),
+//	C^2 + S^2 = I.
+//
+//	R = [ R11 R12 R13 ] is stored in A[1:m, n-k-l+1:n]
+//	    [  0  R22 R23 ]
+//
+// and R33 is stored in
+//
+//	B[m-k:l, n+m-k-l:n] on exit.
+//
+// Dggsvd3 computes C, S, R, and optionally the orthogonal transformation
+// matrices U, V and Q.
+//
+// jobU, jobV and jobQ are options for computing the orthogonal matrices. The behavior
+// is as follows
+//
+//	jobU == lapack.GSVDU        Compute orthogonal matrix U
+//	jobU == lapack.GSVDNone     Do not compute orthogonal matrix.
+//
+// The behavior is the same for jobV and jobQ with the exception that instead of
+// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
+// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
+// relevant job parameter is lapack.GSVDNone.
+//
+// alpha and beta must have length n or Dggsvd3 will panic. On exit, alpha and
+// beta contain the generalized singular value pairs of A and B
+//
+//	alpha[0:k] = 1,
+//	beta[0:k]  = 0,
+//
+// if m-k-l >= 0,
+//
+//	alpha[k:k+l] = diag(C),
+//	beta[k:k+l]  = diag(S),
+//
+// if m-k-l < 0,
+//
+//	alpha[k:m]= C, alpha[m:k+l]= 0
+//	beta[k:m] = S, beta[m:k+l] = 1.
+//
+// if k+l < n,
+//
+//	alpha[k+l:n] = 0 and
+//	beta[k+l:n]  = 0.
+//
+// On exit, iwork contains the permutation required to sort alpha descending.
+//
+// iwork must have length n, work must have length at least max(1, lwork), and
+// lwork must be -1 or greater than n, otherwise Dggsvd3 will panic. If
+// lwork is -1, work[0] holds the optimal lwork on return, but Dggsvd3 does
+// not perform the GSVD.
+func (impl Implementation) Dggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []int) (k, l int, ok bool) {
+	wantu := jobU == lapack.GSVDU
+	wantv := jobV == lapack.GSVDV
+	wantq := jobQ == lapack.GSVDQ
+	switch {
+	case !wantu && jobU != lapack.GSVDNone:
+		panic(badGSVDJob + "U")
+	case !wantv && jobV != lapack.GSVDNone:
+		panic(badGSVDJob + "V")
+	case !wantq && jobQ != lapack.GSVDNone:
+		panic(badGSVDJob + "Q")
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case p < 0:
+		panic(pLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldu < 1, wantu && ldu < m:
+		panic(badLdU)
+	case ldv < 1, wantv && ldv < p:
+		panic(badLdV)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case len(iwork) < n:
+		panic(shortWork)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Determine optimal work length.
+	if lwork == -1 {
+		lapacke.Dggsvd3(byte(jobU), byte(jobV), byte(jobQ), m, n, p, nil, nil, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, -1, nil)
+		return 0, 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case wantu && len(u) < (m-1)*ldu+m:
+		panic(shortU)
+	case wantv && len(v) < (p-1)*ldv+p:
+		panic(shortV)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(alpha) != n:
+		panic(badLenAlpha)
+	case len(beta) != n:
+		panic(badLenBeta)
+	}
+
+	_k := []lapacke.Int{0}
+	_l := []lapacke.Int{0}
+	ix := newIndices(iwork[:n])
+	ok = lapacke.Dggsvd3(byte(jobU), byte(jobV), byte(jobQ), m, n, p, _k, _l, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, lwork, ix.buf)
+	ix.store()
+
+	return int(_k[0]), int(_l[0]), ok
+}
+
+// Dggsvp3 computes orthogonal matrices U, V and Q such that
+//
+//	                n-k-l  k    l
+//	U^T*A*Q =    k [ 0    A12  A13 ] if m-k-l >= 0;
+//	             l [ 0     0   A23 ]
+//	         m-k-l [ 0     0    0  ]
+//
+//	                n-k-l  k    l
+//	U^T*A*Q =    k [ 0    A12  A13 ] if m-k-l < 0;
+//	           m-k [ 0     0   A23 ]
+//
+//	                n-k-l  k    l
+//	V^T*B*Q =    l [ 0     0   B13 ]
+//	           p-l [ 0     0    0  ]
+//
+// where the k×k matrix A12 and l×l matrix B13 are non-singular
+// upper triangular. A23 is l×l upper triangular if m-k-l >= 0,
+// otherwise A23 is (m-k)×l upper trapezoidal.
+//
+// Dggsvp3 returns k and l, the dimensions of the sub-blocks. k+l
+// is the effective numerical rank of the (m+p)×n matrix [ A^T B^T ]^T.
+//
+// jobU, jobV and jobQ are options for computing the orthogonal matrices. The behavior
+// is as follows
+//
+//	jobU == lapack.GSVDU        Compute orthogonal matrix U
+//	jobU == lapack.GSVDNone     Do not compute orthogonal matrix.
+//
+// The behavior is the same for jobV and jobQ with the exception that instead of
+// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
+// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
+// relevant job parameter is lapack.GSVDNone.
+//
+// tola and tolb are the convergence criteria for the Jacobi-Kogbetliantz
+// iteration procedure. Generally, they are the same as used in the preprocessing
+// step, for example,
+//
+//	tola = max(m, n)*norm(A)*eps,
+//	tolb = max(p, n)*norm(B)*eps.
+//
+// Where eps is the machine epsilon.
+//
+// iwork must have length n, work must have length at least max(1, lwork), and
+// lwork must be -1 or greater than zero, otherwise Dggsvp3 will panic.
+//
+// Dggsvp3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggsvp3(jobU, jobV, jobQ lapack.GSVDJob, m, p, n int, a []float64, lda int, b []float64, ldb int, tola, tolb float64, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, iwork []int, tau, work []float64, lwork int) (k, l int) {
+	wantu := jobU == lapack.GSVDU
+	wantv := jobV == lapack.GSVDV
+	wantq := jobQ == lapack.GSVDQ
+	switch {
+	case !wantu && jobU != lapack.GSVDNone:
+		panic(badGSVDJob + "U")
+	case !wantv && jobV != lapack.GSVDNone:
+		panic(badGSVDJob + "V")
+	case !wantq && jobQ != lapack.GSVDNone:
+		panic(badGSVDJob + "Q")
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldu < 1, wantu && ldu < m:
+		panic(badLdU)
+	case ldv < 1, wantv && ldv < p:
+		panic(badLdV)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case len(iwork) != n:
+		panic(shortWork)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if lwork == -1 {
+		lapacke.Dggsvp3(byte(jobU), byte(jobV), byte(jobQ), m, p, n, a, lda, b, ldb, tola, tolb, nil, nil, u, ldu, v, ldv, q, ldq, nil, tau, work, -1)
+		return 0, 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case wantu && len(u) < (m-1)*ldu+m:
+		panic(shortU)
+	case wantv && len(v) < (p-1)*ldv+p:
+		panic(shortV)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(tau) < n:
+		// tau check must come after lwkopt query since
+		// the Dggsvd3 call for lwkopt query may have
+		// lwork == -1, and tau is provided by work.
+		panic(shortTau)
+	}
+
+	_k := []lapacke.Int{0}
+	_l := []lapacke.Int{0}
+	_iwork := getInts(n)
+	lapacke.Dggsvp3(byte(jobU), byte(jobV), byte(jobQ), m, p, n, a, lda, b, ldb, tola, tolb, _k, _l, u, ldu, v, ldv, q, ldq, *_iwork, tau, work, lwork)
+	putInts(_iwork)
+	return int(_k[0]), int(_l[0])
+}
+
+// Dorgbr generates one of the matrices Q or P^T computed by Dgebrd.
+// See Dgebrd for the description of Q and P^T.
+//
+// If vect == lapack.ApplyQ, then a is assumed to have been an m×k matrix and
+// Q is of order m. If m >= k, then Dorgbr returns the first n columns of Q
+// where m >= n >= k. If m < k, then Dorgbr returns Q as an m×m matrix.
+//
+// If vect == lapack.ApplyP, then A is assumed to have been a k×n matrix, and
+// P^T is of order n. If k < n, then Dorgbr returns the first m rows of P^T,
+// where n >= m >= k. If k >= n, then Dorgbr returns P^T as an n×n matrix.
+func (impl Implementation) Dorgbr(vect lapack.GenOrtho, m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	wantq := vect == lapack.GenerateQ
+	mn := min(m, n)
+	switch {
+	case vect != lapack.GenerateQ && vect != lapack.GeneratePT:
+		panic(badGenOrtho)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case wantq && n > m:
+		panic(nGTM)
+	case wantq && n < min(m, k):
+		panic("lapack: n < min(m,k)")
+	case !wantq && m > n:
+		panic(mGTN)
+	case !wantq && m < min(n, k):
+		panic("lapack: m < min(n,k)")
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, mn) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dorgbr(byte(vect), m, n, k, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case wantq && len(tau) < min(m, k):
+		panic(shortTau)
+	case !wantq && len(tau) < min(n, k):
+		panic(shortTau)
+	}
+
+	lapacke.Dorgbr(byte(vect), m, n, k, a, lda, tau, work, lwork)
+}
+
+// Dorghr generates an n×n orthogonal matrix Q which is defined as the product
+// of ihi-ilo elementary reflectors:
+//
+//	Q = H_{ilo} H_{ilo+1} ... H_{ihi-1}.
+//
+// a and lda represent an n×n matrix that contains the elementary reflectors, as
+// returned by Dgehrd. On return, a is overwritten by the n×n orthogonal matrix
+// Q. Q will be equal to the identity matrix except in the submatrix
+// Q[ilo+1:ihi+1,ilo+1:ihi+1].
+//
+// ilo and ihi must have the same values as in the previous call of Dgehrd. It
+// must hold that
+//
+//	0 <= ilo <= ihi < n,  if n > 0,
+//	ilo = 0, ihi = -1,    if n == 0.
+//
+// tau contains the scalar factors of the elementary reflectors, as returned by
+// Dgehrd. tau must have length n-1.
+//
+// work must have length at least max(1,lwork) and lwork must be at least
+// ihi-ilo. For optimum performance lwork must be at least (ihi-ilo)*nb where nb
+// is the optimal blocksize. On return, work[0] will contain the optimal value
+// of lwork.
+//
+// If lwork == -1, instead of performing Dorghr, only the optimal value of lwork
+// will be stored into work[0].
+//
+// If any requirement on input sizes is not met, Dorghr will panic.
+//
+// Dorghr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dorghr(n, ilo, ihi int, a []float64, lda int, tau, work []float64, lwork int) {
+	nh := ihi - ilo
+	switch {
+	case ilo < 0 || max(1, n) <= ilo:
+		panic(badIlo)
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(badIhi)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, nh) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dorghr(n, ilo+1, ihi+1, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(tau) < n-1:
+		panic(shortTau)
+	}
+
+	lapacke.Dorghr(n, ilo+1, ihi+1, a, lda, tau, work, lwork)
+}
+
+// Dorglq generates an m×n matrix Q with orthonormal rows defined by the product
+// of elementary reflectors
+//
+//	Q = H_{k-1} * ... * H_1 * H_0
+//
+// as computed by Dgelqf. Dorglq is the blocked version of Dorgl2 that makes
+// greater use of level-3 BLAS routines.
+//
+// len(tau) >= k, 0 <= k <= n, and 0 <= m <= n.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= m, and the amount of blocking is limited by the usable length.
+// If lwork == -1, instead of computing Dorglq the optimal work length is stored
+// into work[0].
+//
+// Dorglq will panic if the conditions on input values are not met.
+//
+// Dorglq is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dorglq(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < m:
+		panic(nLTM)
+	case k < 0:
+		panic(kLT0)
+	case k > m:
+		panic(kGTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, m) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if m == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dorglq(m, n, k, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	}
+
+	lapacke.Dorglq(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Dorgql generates the m×n matrix Q with orthonormal columns defined as the
+// last n columns of a product of k elementary reflectors of order m
+//
+//	Q = H_{k-1} * ... * H_1 * H_0.
+//
+// It must hold that
+//
+//	0 <= k <= n <= m,
+//
+// and Dorgql will panic otherwise.
+//
+// On entry, the (n-k+i)-th column of A must contain the vector which defines
+// the elementary reflector H_i, for i=0,...,k-1, and tau[i] must contain its
+// scalar factor. On return, a contains the m×n matrix Q.
+//
+// tau must have length at least k, and Dorgql will panic otherwise.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n), otherwise Dorgql will panic. For optimum performance lwork must
+// be a sufficiently large multiple of n.
+//
+// If lwork == -1, instead of computing Dorgql the optimal work length is stored
+// into work[0].
+//
+// Dorgql is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dorgql(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case n > m:
+		panic(nGTM)
+	case k < 0:
+		panic(kLT0)
+	case k > n:
+		panic(kGTN)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dorgql(m, n, k, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	}
+
+	lapacke.Dorgql(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Dorgqr generates an m×n matrix Q with orthonormal columns defined by the
+// product of elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}
+//
+// as computed by Dgeqrf. Dorgqr is the blocked version of Dorg2r that makes
+// greater use of level-3 BLAS routines.
+//
+// The length of tau must be at least k, and the length of work must be at least n.
+// It also must be that 0 <= k <= n and 0 <= n <= m.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= n, and the amount of blocking is limited by the usable
+// length. If lwork == -1, instead of computing Dorgqr the optimal work length
+// is stored into work[0].
+//
+// Dorgqr will panic if the conditions on input values are not met.
+//
+// Dorgqr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dorgqr(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case n > m:
+		panic(nGTM)
+	case k < 0:
+		panic(kLT0)
+	case k > n:
+		panic(kGTN)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dorgqr(m, n, k, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	}
+
+	lapacke.Dorgqr(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Dorgtr generates a real orthogonal matrix Q which is defined as the product
+// of n-1 elementary reflectors of order n as returned by Dsytrd.
+//
+// The construction of Q depends on the value of uplo:
+//
+//	Q = H_{n-1} * ... * H_1 * H_0  if uplo == blas.Upper
+//	Q = H_0 * H_1 * ... * H_{n-1}  if uplo == blas.Lower
+//
+// where H_i is constructed from the elementary reflectors as computed by Dsytrd.
+// See the documentation for Dsytrd for more information.
+//
+// tau must have length at least n-1, and Dorgtr will panic otherwise.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1,n-1), and Dorgtr will panic otherwise. The amount of blocking
+// is limited by the usable length.
+// If lwork == -1, instead of computing Dorgtr the optimal work length is stored
+// into work[0].
+//
+// Dorgtr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dorgtr(uplo blas.Uplo, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n-1) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dorgtr(byte(uplo), n, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(tau) < n-1:
+		panic(shortTau)
+	}
+
+	lapacke.Dorgtr(byte(uplo), n, a, lda, tau, work, lwork)
+}
+
+// Dormbr applies a multiplicative update to the matrix C based on a
+// decomposition computed by Dgebrd.
+//
+// Dormbr overwrites the m×n matrix C with
+//
+//	Q * C   if vect == lapack.ApplyQ, side == blas.Left, and trans == blas.NoTrans
+//	C * Q   if vect == lapack.ApplyQ, side == blas.Right, and trans == blas.NoTrans
+//	Q^T * C if vect == lapack.ApplyQ, side == blas.Left, and trans == blas.Trans
+//	C * Q^T if vect == lapack.ApplyQ, side == blas.Right, and trans == blas.Trans
+//
+//	P * C   if vect == lapack.ApplyP, side == blas.Left, and trans == blas.NoTrans
+//	C * P   if vect == lapack.ApplyP, side == blas.Right, and trans == blas.NoTrans
+//	P^T * C if vect == lapack.ApplyP, side == blas.Left, and trans == blas.Trans
+//	C * P^T if vect == lapack.ApplyP, side == blas.Right, and trans == blas.Trans
+//
+// where P and Q are the orthogonal matrices determined by Dgebrd when reducing
+// a matrix A to bidiagonal form: A = Q * B * P^T. See Dgebrd for the
+// definitions of Q and P.
+//
+// If vect == lapack.ApplyQ, A is assumed to have been an nq×k matrix, while if
+// vect == lapack.ApplyP, A is assumed to have been a k×nq matrix. nq = m if
+// side == blas.Left, while nq = n if side == blas.Right.
+//
+// tau must have length min(nq,k), and Dormbr will panic otherwise. tau contains
+// the elementary reflectors to construct Q or P depending on the value of
+// vect.
+//
+// work must have length at least max(1,lwork), and lwork must be either -1 or
+// at least max(1,n) if side == blas.Left, and at least max(1,m) if side ==
+// blas.Right. For optimum performance lwork should be at least n*nb if side ==
+// blas.Left, and at least m*nb if side == blas.Right, where nb is the optimal
+// block size. On return, work[0] will contain the optimal value of lwork.
+//
+// If lwork == -1, the function only calculates the optimal value of lwork and
+// returns it in work[0].
+//
+// Dormbr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormbr(vect lapack.ApplyOrtho, side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	nq := n
+	nw := m
+	if side == blas.Left {
+		nq = m
+		nw = n
+	}
+	applyQ := vect == lapack.ApplyQ
+	switch {
+	case !applyQ && vect != lapack.ApplyP:
+		panic(badApplyOrtho)
+	case side != blas.Left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case applyQ && lda < max(1, min(nq, k)):
+		panic(badLdA)
+	case !applyQ && lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dormbr(byte(vect), byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
+		return
+	}
+
+	minnqk := min(nq, k)
+	switch {
+	case applyQ && len(a) < (nq-1)*lda+minnqk:
+		panic(shortA)
+	case !applyQ && len(a) < (minnqk-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < minnqk:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	lapacke.Dormbr(byte(vect), byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Dormhr multiplies an m×n general matrix C with an nq×nq orthogonal matrix Q
+//
+//	Q * C,    if side == blas.Left and trans == blas.NoTrans,
+//	Q^T * C,  if side == blas.Left and trans == blas.Trans,
+//	C * Q,    if side == blas.Right and trans == blas.NoTrans,
+//	C * Q^T,  if side == blas.Right and trans == blas.Trans,
+//
+// where nq == m if side == blas.Left and nq == n if side == blas.Right.
+//
+// Q is defined implicitly as the product of ihi-ilo elementary reflectors, as
+// returned by Dgehrd:
+//
+//	Q = H_{ilo} H_{ilo+1} ... H_{ihi-1}.
+//
+// Q is equal to the identity matrix except in the submatrix
+// Q[ilo+1:ihi+1,ilo+1:ihi+1].
+//
+// ilo and ihi must have the same values as in the previous call of Dgehrd. It
+// must hold that
+//
+//	0 <= ilo <= ihi < m,   if m > 0 and side == blas.Left,
+//	ilo = 0 and ihi = -1,  if m = 0 and side == blas.Left,
+//	0 <= ilo <= ihi < n,   if n > 0 and side == blas.Right,
+//	ilo = 0 and ihi = -1,  if n = 0 and side == blas.Right.
+//
+// a and lda represent an m×m matrix if side == blas.Left and an n×n matrix if
+// side == blas.Right. The matrix contains vectors which define the elementary
+// reflectors, as returned by Dgehrd.
+//
+// tau contains the scalar factors of the elementary reflectors, as returned by
+// Dgehrd. tau must have length m-1 if side == blas.Left and n-1 if side ==
+// blas.Right.
+//
+// c and ldc represent the m×n matrix C. On return, c is overwritten by the
+// product with Q.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n), if side == blas.Left, and max(1,m), if side == blas.Right. For
+// optimum performance lwork should be at least n*nb if side == blas.Left and
+// m*nb if side == blas.Right, where nb is the optimal block size. On return,
+// work[0] will contain the optimal value of lwork.
+//
+// If lwork == -1, instead of performing Dormhr, only the optimal value of lwork
+// will be stored in work[0].
+//
+// If any requirement on input sizes is not met, Dormhr will panic.
+//
+// Dormhr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormhr(side blas.Side, trans blas.Transpose, m, n, ilo, ihi int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	nq := n // The order of Q.
+	nw := m // The minimum length of work.
+	if side == blas.Left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case side != blas.Left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case ilo < 0 || max(1, nq) <= ilo:
+		panic(badIlo)
+	case ihi < min(ilo, nq-1) || nq <= ihi:
+		panic(badIhi)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dormhr(byte(side), byte(trans), m, n, ilo+1, ihi+1, a, lda, tau, c, ldc, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+nq:
+		panic(shortA)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(tau) != nq-1:
+		panic(badLenTau)
+	}
+
+	lapacke.Dormhr(byte(side), byte(trans), m, n, ilo+1, ihi+1, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Dormlq multiplies the matrix C by the orthogonal matrix Q defined by the
+// slices a and tau. A and tau are as returned from Dgelqf.
+//
+//	C = Q * C    if side == blas.Left and trans == blas.NoTrans
+//	C = Q^T * C  if side == blas.Left and trans == blas.Trans
+//	C = C * Q    if side == blas.Right and trans == blas.NoTrans
+//	C = C * Q^T  if side == blas.Right and trans == blas.Trans
+//
+// If side == blas.Left, A is a matrix of side k×m, and if side == blas.Right
+// A is of size k×n. This uses a blocked algorithm.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= m if side == blas.Left and lwork >= n if side == blas.Right,
+// and this function will panic otherwise.
+// Dormlq uses a block algorithm, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Dormlq,
+// the optimal work length will be stored into work[0].
+//
+// tau contains the Householder scales and must have length at least k, and
+// this function will panic otherwise.
+func (impl Implementation) Dormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nw := m
+	if left {
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.Trans && trans != blas.NoTrans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case left && lda < max(1, m):
+		panic(badLdA)
+	case !left && lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Dormlq(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
+		return
+	}
+
+	switch {
+	case left && len(a) < (k-1)*lda+m:
+		panic(shortA)
+	case !left && len(a) < (k-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	lapacke.Dormlq(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Dormqr multiplies an m×n matrix C by an orthogonal matrix Q as
+//
+//	C = Q * C,    if side == blas.Left  and trans == blas.NoTrans,
+//	C = Q^T * C,  if side == blas.Left  and trans == blas.Trans,
+//	C = C * Q,    if side == blas.Right and trans == blas.NoTrans,
+//	C = C * Q^T,  if side == blas.Right and trans == blas.Trans,
+//
+// where Q is defined as the product of k elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}.
+//
+// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
+// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
+// The ith column of A contains the vector which defines the elementary
+// reflector H_i and tau[i] contains its scalar factor. tau must have length k
+// and Dormqr will panic otherwise. Dgeqrf returns A and tau in the required
+// form.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Dormqr will
+// panic.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= m if side == blas.Left and lwork >= n if side ==
+// blas.Right, and this function will panic otherwise. Larger values of lwork
+// will generally give better performance. On return, work[0] will contain the
+// optimal value of lwork.
+//
+// If lwork is -1, instead of performing Dormqr, the optimal workspace size will
+// be stored into work[0].
+func (impl Implementation) Dormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
 		panic(kLT0)
-	case wantq && n > m:
-		panic(nGTM)
-	case wantq && n < min(m, k):
-		panic("lapack: n < min(m,k)")
-	case !wantq && m > n:
-		panic(mGTN)
-	case !wantq && m < min(n, k):
-		panic("lapack: m < min(n,k)")
-	case lda < max(1, n):
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case lda < max(1, k):
 		panic(badLdA)
-	case lwork < max(1, mn) && lwork != -1:
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
 	// Quick return if possible.
-	if m == 0 || n == 0 {
+	if m == 0 || n == 0 || k == 0 {
 		work[0] = 1
 		return
 	}
 
 	if lwork == -1 {
-		lapacke.Dorgbr(byte(vect), m, n, k, a, lda, tau, work, -1)
+		lapacke.Dormqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
 		return
 	}
 
 	switch {
-	case len(a) < (m-1)*lda+n:
+	case len(a) < (nq-1)*lda+k:
 		panic(shortA)
-	case wantq && len(tau) < min(m, k):
-		panic(shortTau)
-	case !wantq && len(tau) < min(n, k):
-		panic(shortTau)
+	case len(tau) != k:
+		panic(badLenTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
 	}
 
-	lapacke.Dorgbr(byte(vect), m, n, k, a, lda, tau, work, lwork)
+	lapacke.Dormqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
 }
 
-// Dorghr generates an n×n orthogonal matrix Q which is defined as the product
-// of ihi-ilo elementary reflectors:
+// Dpocon estimates the reciprocal of the condition number of a positive-definite
+// matrix A given the Cholesky decomposition of A. The condition number computed
+// is based on the 1-norm and the ∞-norm.
 //
-//	Q = H_{ilo} H_{ilo+1} ... H_{ihi-1}.
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
 //
-// a and lda represent an n×n matrix that contains the elementary reflectors, as
-// returned by Dgehrd. On return, a is overwritten by the n×n orthogonal matrix
-// Q. Q will be equal to the identity matrix except in the submatrix
-// Q[ilo+1:ihi+1,ilo+1:ihi+1].
+// work is a temporary data slice of length at least 3*n and Dpocon will panic otherwise.
 //
-// ilo and ihi must have the same values as in the previous call of Dgehrd. It
-// must hold that
+// iwork is a temporary data slice of length at least n and Dpocon will panic otherwise.
+func (impl Implementation) Dpocon(uplo blas.Uplo, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64 {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case anorm < 0:
+		panic(negANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	rcond := []float64{0}
+	_iwork := getInts(n)
+	lapacke.Dpocon(byte(uplo), n, a, lda, anorm, rcond, work, *_iwork)
+	putInts(_iwork)
+	return rcond[0]
+}
+
+// Dporfs improves the computed solution to a system of linear equations
 //
-//	0 <= ilo <= ihi < n,  if n > 0,
-//	ilo = 0, ihi = -1,    if n == 0.
+//	A * X = B,
 //
-// tau contains the scalar factors of the elementary reflectors, as returned by
-// Dgehrd. tau must have length n-1.
+// where A is an n×n symmetric positive definite matrix and B and X are n×nrhs
+// matrices, and provides error bounds and backward error estimates for the
+// solution.
 //
-// work must have length at least max(1,lwork) and lwork must be at least
-// ihi-ilo. For optimum performance lwork must be at least (ihi-ilo)*nb where nb
-// is the optimal blocksize. On return, work[0] will contain the optimal value
-// of lwork.
+// a contains the original matrix A and af contains its Cholesky factorization
+// as computed by Dpotrf. Only the triangle of a and af specified by uplo is
+// referenced. b contains the right hand side matrix B. On entry, x contains
+// the solution matrix X as computed by Dpotrs, and on exit x contains the
+// improved solution.
 //
-// If lwork == -1, instead of performing Dorghr, only the optimal value of lwork
-// will be stored into work[0].
+// On return, ferr and berr contain the forward error bounds and the backward
+// errors of the columns of X as described in Dgerfs. ferr and berr must have
+// length at least nrhs.
 //
-// If any requirement on input sizes is not met, Dorghr will panic.
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dporfs will panic.
+func (impl Implementation) Dporfs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	// Quick return if possible.
+	if nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	_iwork := getInts(n)
+	lapacke.Dporfs(byte(uplo), n, nrhs, a, lda, af, ldaf, b, ldb, x, ldx, ferr, berr, work, *_iwork)
+	putInts(_iwork)
+}
+
+// Dposvx solves a system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n symmetric positive definite matrix and B and X are n×nrhs
+// matrices, using the Cholesky factorization of A. In addition to the
+// solution, Dposvx equilibrates the system if requested, estimates the
+// condition number of A, improves the solution by iterative refinement and
+// computes error bounds for it.
+//
+// Only the triangle of a and af specified by uplo is referenced.
+//
+// fact specifies how the factorization of A is obtained:
+//   - fact == FactSupplied: af contains on entry the Cholesky factorization
+//     of A as computed by Dpotrf. If equed is EquilibrationSym, A has been
+//     equilibrated with the scale factors in s, and af contains the
+//     factorization of the equilibrated matrix.
+//   - fact == FactCompute: A is factorized into af.
+//   - fact == FactEquilibrate: the system is equilibrated if necessary, then
+//     the equilibrated A is factorized into af.
+//
+// equed is only used when fact == FactSupplied and specifies the
+// equilibration that has been done. It must be EquilibrationNone or
+// EquilibrationSym. The returned equedOut specifies the equilibration that
+// is in effect on return. If it is EquilibrationSym, A has been replaced by
+// diag(s)*A*diag(s) and B by diag(s)*B, and on return a and b contain the
+// equilibrated matrices. s must have length at least n, it contains the scale
+// factors on return, and when fact == FactSupplied and equed is
+// EquilibrationSym they must be positive.
+//
+// On return, x contains the solution of the original system. ferr and berr
+// contain the forward error bounds and the backward errors of the columns of
+// X as described in Dgerfs. ferr and berr must have length at least nrhs.
+//
+// rcond is the estimate of the reciprocal of the condition number of the
+// equilibrated matrix A in the 1-norm.
+//
+// ok is false if A is not positive definite or is singular to working
+// precision. If the leading minor of some order is not positive definite,
+// the solution and the error bounds have not been computed and rcond is
+// zero. Otherwise rcond is less than the machine precision and the solution
+// and the error bounds have been computed.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dposvx will panic.
+func (impl Implementation) Dposvx(fact FactJob, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed Equilibration, s, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equedOut Equilibration, rcond float64, ok bool) {
+	switch {
+	case fact != FactSupplied && fact != FactCompute && fact != FactEquilibrate:
+		panic(badFact)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	case fact == FactSupplied && equed != EquilibrationNone && equed != EquilibrationSym:
+		panic(badEquilibration)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(s) < n:
+		panic(shortS)
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	if fact == FactSupplied && equed == EquilibrationSym {
+		for _, v := range s[:n] {
+			if v <= 0 {
+				panic(badScaling)
+			}
+		}
+	}
+
+	_equed := []byte{byte(equed)}
+	_rcond := []float64{0}
+	_iwork := getInts(n)
+	ok = lapacke.Dposvx(byte(fact), byte(uplo), n, nrhs, a, lda, af, ldaf, _equed, s, b, ldb, x, ldx, _rcond, ferr, berr, work, *_iwork)
+	putInts(_iwork)
+	return Equilibration(_equed[0]), _rcond[0], ok
+}
+
+// Dsposv computes the solution to a system of linear equations
+//
+//	A * X = B,
+//
+// where A is an n×n symmetric positive definite matrix and X and B are n×nrhs
+// matrices, using mixed precision iterative refinement.
+//
+// Dsposv first computes the Cholesky factorization of A in single precision
+// and uses the factorization within an iterative refinement procedure to
+// produce a solution with double precision normwise backward error quality. If
+// the iterative refinement does not converge, Dsposv falls back to computing
+// the factorization and the solution in double precision. For
+// well-conditioned matrices the single precision factorization is usually
+// significantly faster than a double precision solve.
+//
+// On entry, a contains the upper or lower triangle of A as specified by uplo.
+// On return, if fallback is false, a is unchanged and otherwise the triangle
+// contains the Cholesky factor of A as computed by Dpotrf.
+//
+// b contains the right hand side matrix B and is not modified. On return, if
+// ok is true, x contains the solution matrix X.
+//
+// work must have length at least n*nrhs and swork must have length at least
+// n*(n+nrhs), otherwise Dsposv will panic.
+//
+// iter is the number of refinement iterations performed by the single
+// precision solve. fallback reports whether Dsposv fell back to the double
+// precision factorization, either because the iterative refinement did not
+// converge within 30 iterations or because the single precision factorization
+// could not be computed. If fallback is true, iter is the number of
+// refinement iterations that were attempted before falling back.
+//
+// Dsposv returns ok equal to false if A is not positive definite, in which
+// case the solution has not been computed.
+func (impl Implementation) Dsposv(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, fallback, ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, false, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < n*nrhs:
+		panic(shortWork)
+	case len(swork) < n*(n+nrhs):
+		panic(shortSWork)
+	}
+
+	_iter := getInts(1)
+	ok = lapacke.Dsposv(byte(uplo), n, nrhs, a, lda, b, ldb, x, ldx, work, swork, *_iter)
+	iter, fallback = mixedIter((*_iter)[0])
+	putInts(_iter)
+	return iter, fallback, ok
+}
+
+// Dsteqr computes the eigenvalues and optionally the eigenvectors of a symmetric
+// tridiagonal matrix using the implicit QL or QR method. The eigenvectors of a
+// full or band symmetric matrix can also be found if Dsytrd, Dsptrd, or Dsbtrd
+// have been used to reduce this matrix to tridiagonal form.
+//
+// d, on entry, contains the diagonal elements of the tridiagonal matrix. On exit,
+// d contains the eigenvalues in ascending order. d must have length n and
+// Dsteqr will panic otherwise.
+//
+// e, on entry, contains the off-diagonal elements of the tridiagonal matrix on
+// entry, and is overwritten during the call to Dsteqr. e must have length n-1 and
+// Dsteqr will panic otherwise.
+//
+// z, on entry, contains the n×n orthogonal matrix used in the reduction to
+// tridiagonal form if compz == lapack.OriginalEV. On exit, if
+// compz == lapack.OriginalEV, z contains the orthonormal eigenvectors of the
+// original symmetric matrix, and if compz == lapack.TridiagEV, z contains the
+// orthonormal eigenvectors of the symmetric tridiagonal matrix. z is not used
+// if compz == lapack.None.
+//
+// work must have length at least max(1, 2*n-2) if the eigenvectors are computed,
+// and Dsteqr will panic otherwise.
 //
-// Dorghr is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dorghr(n, ilo, ihi int, a []float64, lda int, tau, work []float64, lwork int) {
-	nh := ihi - ilo
+// Dsteqr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dsteqr(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64) (ok bool) {
 	switch {
-	case ilo < 0 || max(1, n) <= ilo:
-		panic(badIlo)
-	case ihi < min(ilo, n-1) || n <= ihi:
-		panic(badIhi)
-	case lda < max(1, n):
-		panic(badLdA)
-	case lwork < max(1, nh) && lwork != -1:
-		panic(badLWork)
-	case len(work) < max(1, lwork):
-		panic(shortWork)
+	case compz != lapack.EVCompNone && compz != lapack.EVTridiag && compz != lapack.EVOrig:
+		panic(badEVComp)
+	case n < 0:
+		panic(nLT0)
+	case ldz < 1, compz != lapack.EVCompNone && ldz < n:
+		panic(badLdZ)
 	}
 
 	// Quick return if possible.
 	if n == 0 {
-		work[0] = 1
-		return
-	}
-
-	if lwork == -1 {
-		lapacke.Dorghr(n, ilo+1, ihi+1, a, lda, tau, work, -1)
-		return
+		return true
 	}
 
 	switch {
-	case len(a) < (n-1)*lda+n:
-		panic(shortA)
-	case len(tau) < n-1:
-		panic(shortTau)
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case compz != lapack.EVCompNone && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	case compz != lapack.EVCompNone && len(work) < max(1, 2*n-2):
+		panic(shortWork)
 	}
 
-	lapacke.Dorghr(n, ilo+1, ihi+1, a, lda, tau, work, lwork)
+	return lapacke.Dsteqr(byte(compz), n, d, e, z, ldz, work)
 }
 
-// Dorglq generates an m×n matrix Q with orthonormal rows defined by the product
-// of elementary reflectors
-//
-//	Q = H_{k-1} * ... * H_1 * H_0
-//
-// as computed by Dgelqf. Dorglq is the blocked version of Dorgl2 that makes
-// greater use of level-3 BLAS routines.
-//
-// len(tau) >= k, 0 <= k <= n, and 0 <= m <= n.
+// Dsterf computes all eigenvalues of a symmetric tridiagonal matrix using the
+// Pal-Walker-Kahan variant of the QL or QR algorithm.
 //
-// work is temporary storage, and lwork specifies the usable memory length. At minimum,
-// lwork >= m, and the amount of blocking is limited by the usable length.
-// If lwork == -1, instead of computing Dorglq the optimal work length is stored
-// into work[0].
+// d contains the diagonal elements of the tridiagonal matrix on entry, and
+// contains the eigenvalues in ascending order on exit. d must have length at
+// least n, or Dsterf will panic.
 //
-// Dorglq will panic if the conditions on input values are not met.
+// e contains the off-diagonal elements of the tridiagonal matrix on entry, and is
+// overwritten during the call to Dsterf. e must have length of at least n-1 or
+// Dsterf will panic.
 //
-// Dorglq is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dorglq(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
-	switch {
-	case m < 0:
-		panic(mLT0)
-	case n < m:
-		panic(nLTM)
-	case k < 0:
-		panic(kLT0)
-	case k > m:
-		panic(kGTM)
-	case lda < max(1, n):
-		panic(badLdA)
-	case lwork < max(1, m) && lwork != -1:
-		panic(badLWork)
-	case len(work) < max(1, lwork):
-		panic(shortWork)
-	}
-
-	if m == 0 {
-		work[0] = 1
-		return
+// Dsterf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dsterf(n int, d, e []float64) (ok bool) {
+	if n < 0 {
+		panic(nLT0)
 	}
 
-	if lwork == -1 {
-		lapacke.Dorglq(m, n, k, a, lda, tau, work, -1)
-		return
+	// Quick return if possible.
+	if n == 0 {
+		return true
 	}
 
 	switch {
-	case len(a) < (m-1)*lda+n:
-		panic(shortA)
-	case len(tau) < k:
-		panic(shortTau)
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
 	}
 
-	lapacke.Dorglq(m, n, k, a, lda, tau, work, lwork)
+	return lapacke.Dsterf(n, d, e)
 }
 
-// Dorgql generates the m×n matrix Q with orthonormal columns defined as the
-// last n columns of a product of k elementary reflectors of order m
-//
-//	Q = H_{k-1} * ... * H_1 * H_0.
-//
-// It must hold that
-//
-//	0 <= k <= n <= m,
-//
-// and Dorgql will panic otherwise.
-//
-// On entry, the (n-k+i)-th column of A must contain the vector which defines
-// the elementary reflector H_i, for i=0,...,k-1, and tau[i] must contain its
-// scalar factor. On return, a contains the m×n matrix Q.
-//
-// tau must have length at least k, and Dorgql will panic otherwise.
+// Dsyev computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A.
 //
-// work must have length at least max(1,lwork), and lwork must be at least
-// max(1,n), otherwise Dorgql will panic. For optimum performance lwork must
-// be a sufficiently large multiple of n.
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Dsyev will panic otherwise.
 //
-// If lwork == -1, instead of computing Dorgql the optimal work length is stored
-// into work[0].
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.ComputeEV a contains the
+// orthonormal eigenvectors of A on exit, otherwise on exit the specified
+// triangular region is overwritten.
 //
-// Dorgql is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dorgql(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+// work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= 3*n-1, and Dsyev will panic otherwise. The amount of blocking is
+// limited by the usable length. If lwork == -1, instead of computing Dsyev the
+// optimal work length is stored into work[0].
+func (impl Implementation) Dsyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool) {
 	switch {
-	case m < 0:
-		panic(mLT0)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
-	case n > m:
-		panic(nGTM)
-	case k < 0:
-		panic(kLT0)
-	case k > n:
-		panic(kGTN)
 	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < max(1, n) && lwork != -1:
+	case lwork < max(1, 3*n-1) && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
@@ -2769,505 +5124,654 @@ func (impl Implementation) Dorgql(m, n, k int, a []float64, lda int, tau, work [
 
 	// Quick return if possible.
 	if n == 0 {
-		work[0] = 1
-		return
+		return true
 	}
 
 	if lwork == -1 {
-		lapacke.Dorgql(m, n, k, a, lda, tau, work, -1)
-		return
+		return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, -1)
 	}
 
 	switch {
-	case len(a) < (m-1)*lda+n:
+	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(tau) < k:
-		panic(shortTau)
+	case len(w) < n:
+		panic(shortW)
 	}
 
-	lapacke.Dorgql(m, n, k, a, lda, tau, work, lwork)
+	return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, lwork)
 }
 
-// Dorgqr generates an m×n matrix Q with orthonormal columns defined by the
-// product of elementary reflectors
-//
-//	Q = H_0 * H_1 * ... * H_{k-1}
-//
-// as computed by Dgeqrf. Dorgqr is the blocked version of Dorg2r that makes
-// greater use of level-3 BLAS routines.
-//
-// The length of tau must be at least k, and the length of work must be at least n.
-// It also must be that 0 <= k <= n and 0 <= n <= m.
+// Dsyevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A using a divide and conquer algorithm. When the
+// eigenvectors are requested, Dsyevd is usually much faster than Dsyev for
+// large matrices.
 //
-// work is temporary storage, and lwork specifies the usable memory length. At
-// minimum, lwork >= n, and the amount of blocking is limited by the usable
-// length. If lwork == -1, instead of computing Dorgqr the optimal work length
-// is stored into work[0].
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Dsyevd will panic otherwise.
 //
-// Dorgqr will panic if the conditions on input values are not met.
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute a contains the
+// orthonormal eigenvectors of A on exit, otherwise on exit the specified
+// triangular region is overwritten.
 //
-// Dorgqr is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dorgqr(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+// work and iwork are temporary storage, and lwork and liwork specify their
+// usable lengths. If n <= 1, lwork and liwork must be at least 1. Otherwise,
+// lwork must be at least 2*n+1 and liwork at least 1 if jobz == lapack.EVNone,
+// and lwork must be at least 1+6*n+2*n*n and liwork at least 3+5*n if
+// jobz == lapack.EVCompute. Dsyevd will panic otherwise. If lwork == -1 or
+// liwork == -1, instead of computing Dsyevd the optimal work lengths are stored
+// into work[0] and iwork[0].
+func (impl Implementation) Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	minwork, miniwork := 1, 1
+	if n > 1 {
+		if jobz == lapack.EVCompute {
+			minwork = 1 + 6*n + 2*n*n
+			miniwork = 3 + 5*n
+		} else {
+			minwork = 2*n + 1
+		}
+	}
+	query := lwork == -1 || liwork == -1
 	switch {
-	case m < 0:
-		panic(mLT0)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
-	case n > m:
-		panic(nGTM)
-	case k < 0:
-		panic(kLT0)
-	case k > n:
-		panic(kGTN)
 	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < max(1, n) && lwork != -1:
+	case lwork < minwork && !query:
 		panic(badLWork)
+	case liwork < miniwork && !query:
+		panic(badLIWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
 	}
 
+	// Quick return if possible.
 	if n == 0 {
 		work[0] = 1
-		return
+		iwork[0] = 1
+		return true
 	}
 
-	if lwork == -1 {
-		lapacke.Dorgqr(m, n, k, a, lda, tau, work, -1)
-		return
+	if query {
+		_iwork := []lapacke.Int{0}
+		ok = lapacke.Dsyevd(byte(jobz), byte(uplo), n, a, lda, w, work, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return ok
 	}
 
 	switch {
-	case len(a) < (m-1)*lda+n:
+	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(tau) < k:
-		panic(shortTau)
+	case len(w) < n:
+		panic(shortW)
 	}
 
-	lapacke.Dorgqr(m, n, k, a, lda, tau, work, lwork)
+	_iwork := getInts(liwork)
+	ok = lapacke.Dsyevd(byte(jobz), byte(uplo), n, a, lda, w, work, lwork, *_iwork, liwork)
+	putInts(_iwork)
+	return ok
 }
 
-// Dorgtr generates a real orthogonal matrix Q which is defined as the product
-// of n-1 elementary reflectors of order n as returned by Dsytrd.
+// Dsyevr computes selected eigenvalues and, optionally, the eigenvectors of a
+// real symmetric matrix A using the method of Multiple Relatively Robust
+// Representations. It is usually the fastest of the symmetric eigensolvers
+// and can compute a subset of the eigenpairs at a proportionally lower cost.
 //
-// The construction of Q depends on the value of uplo:
-//
-//	Q = H_{n-1} * ... * H_1 * H_0  if uplo == blas.Upper
-//	Q = H_0 * H_1 * ... * H_{n-1}  if uplo == blas.Lower
+// rng specifies which eigenvalues are computed:
 //
-// where H_i is constructed from the elementary reflectors as computed by Dsytrd.
-// See the documentation for Dsytrd for more information.
-//
-// tau must have length at least n-1, and Dorgtr will panic otherwise.
+//	rng == EVRangeAll   all eigenvalues are computed,
+//	rng == EVRangeValue the eigenvalues in the half-open interval (vl,vu] are
+//	                    computed. vl must be less than vu,
+//	rng == EVRangeIndex the eigenvalues with zero-based indices il through iu
+//	                    in ascending order are computed. il and iu must
+//	                    satisfy 0 <= il <= iu < n.
 //
-// work is temporary storage, and lwork specifies the usable memory length. At
-// minimum, lwork >= max(1,n-1), and Dorgtr will panic otherwise. The amount of blocking
-// is limited by the usable length.
-// If lwork == -1, instead of computing Dorgtr the optimal work length is stored
-// into work[0].
+// vl and vu are only referenced if rng == EVRangeValue, and il and iu are only
+// referenced if rng == EVRangeIndex.
 //
-// Dorgtr is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dorgtr(uplo blas.Uplo, n int, a []float64, lda int, tau, work []float64, lwork int) {
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On exit, the specified triangular region of A,
+// including the diagonal, is overwritten.
+//
+// abstol is the absolute error tolerance for the eigenvalues. If abstol is not
+// positive, a default tolerance is used.
+//
+// Dsyevr returns the number of eigenvalues found in m. On return, the first m
+// elements of w contain the selected eigenvalues in ascending order. w must
+// have length at least n, and Dsyevr will panic otherwise.
+//
+// If jobz == lapack.EVCompute, the first m columns of the n×ncol matrix Z
+// contain the orthonormal eigenvectors corresponding to the selected
+// eigenvalues, where ncol is iu-il+1 if rng == EVRangeIndex and n otherwise.
+// ldz must be at least ncol and z must have length at least (n-1)*ldz+ncol,
+// otherwise Dsyevr will panic. isuppz must have length at least 2*ncol. On
+// return, the ith eigenvector is nonzero only in the elements isuppz[2*i]
+// through isuppz[2*i+1], which are zero-based row indices of Z. isuppz is only
+// set if rng == EVRangeAll, or if rng == EVRangeIndex with il == 0 and
+// iu == n-1. If jobz == lapack.EVNone, z and isuppz are not referenced.
+//
+// work and iwork are temporary storage, and lwork and liwork specify their
+// usable lengths. lwork must be at least max(1,26*n) and liwork at least
+// max(1,10*n), otherwise Dsyevr will panic. If lwork == -1 or liwork == -1,
+// instead of computing Dsyevr the optimal work lengths are stored into work[0]
+// and iwork[0].
+//
+// Dsyevr returns whether the computation successfully completed.
+func (impl Implementation) Dsyevr(jobz lapack.EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, isuppz []int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncol := n
+	if rng == EVRangeIndex {
+		ncol = iu - il + 1
+	}
+	query := lwork == -1 || liwork == -1
 	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != EVRangeAll && rng != EVRangeValue && rng != EVRangeIndex:
+		panic(badEVRange)
 	case uplo != blas.Upper && uplo != blas.Lower:
 		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
 	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < max(1, n-1) && lwork != -1:
+	case rng == EVRangeValue && n > 0 && vu <= vl:
+		panic(badInterval)
+	case rng == EVRangeIndex && n > 0 && (il < 0 || n <= il):
+		panic(badIl)
+	case rng == EVRangeIndex && n > 0 && (iu < il || n <= iu):
+		panic(badIu)
+	case ldz < 1 || (wantz && ldz < ncol):
+		panic(badLdZ)
+	case lwork < max(1, 26*n) && !query:
 		panic(badLWork)
+	case liwork < max(1, 10*n) && !query:
+		panic(badLIWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
 	}
 
+	// Quick return if possible.
 	if n == 0 {
 		work[0] = 1
-		return
+		iwork[0] = 1
+		return 0, true
 	}
 
-	if lwork == -1 {
-		lapacke.Dorgtr(byte(uplo), n, a, lda, tau, work, -1)
-		return
+	// Some versions of LAPACKE_dsyevr_work require ldz to be at least ncol
+	// even when z is not referenced, so max(ncol,ldz) is passed as in
+	// Dgeev.
+
+	_m := []lapacke.Int{0}
+	if query {
+		_iwork := []lapacke.Int{0}
+		ok = lapacke.Dsyevr(byte(jobz), byte(rng), byte(uplo), n, a, lda, vl, vu, il+1, iu+1, abstol, _m, w, z, max(ncol, ldz), nil, work, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return 0, ok
 	}
 
 	switch {
 	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(tau) < n-1:
-		panic(shortTau)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+ncol:
+		panic(shortZ)
+	case wantz && len(isuppz) < 2*ncol:
+		panic(shortIsuppz)
+	}
+
+	if rng != EVRangeIndex {
+		// The index range must be valid even when it is not referenced.
+		il, iu = 0, n-1
+	}
+	var nsuppz int
+	if wantz {
+		nsuppz = 2 * ncol
+	}
+	_isuppz := getInts(nsuppz)
+	_iwork := getInts(liwork)
+	ok = lapacke.Dsyevr(byte(jobz), byte(rng), byte(uplo), n, a, lda, vl, vu, il+1, iu+1, abstol, _m, w, z, max(ncol, ldz), *_isuppz, work, lwork, *_iwork, liwork)
+	putInts(_iwork)
+	m = int(_m[0])
+	if wantz && (rng == EVRangeAll || (rng == EVRangeIndex && m == n)) {
+		for i, v := range (*_isuppz)[:2*m] {
+			isuppz[i] = int(v) - 1
+		}
 	}
-
-	lapacke.Dorgtr(byte(uplo), n, a, lda, tau, work, lwork)
+	putInts(_isuppz)
+	return m, ok
 }
 
-// Dormbr applies a multiplicative update to the matrix C based on a
-// decomposition computed by Dgebrd.
-//
-// Dormbr overwrites the m×n matrix C with
+// Dsygv computes all eigenvalues and, optionally, the eigenvectors of a real
+// generalized symmetric-definite eigenproblem
 //
-//	Q * C   if vect == lapack.ApplyQ, side == blas.Left, and trans == blas.NoTrans
-//	C * Q   if vect == lapack.ApplyQ, side == blas.Right, and trans == blas.NoTrans
-//	Q^T * C if vect == lapack.ApplyQ, side == blas.Left, and trans == blas.Trans
-//	C * Q^T if vect == lapack.ApplyQ, side == blas.Right, and trans == blas.Trans
+//	A * x = λ * B * x  if itype == GenEVAxBx,
+//	A * B * x = λ * x  if itype == GenEVABx,
+//	B * A * x = λ * x  if itype == GenEVBAx,
 //
-//	P * C   if vect == lapack.ApplyP, side == blas.Left, and trans == blas.NoTrans
-//	C * P   if vect == lapack.ApplyP, side == blas.Right, and trans == blas.NoTrans
-//	P^T * C if vect == lapack.ApplyP, side == blas.Left, and trans == blas.Trans
-//	C * P^T if vect == lapack.ApplyP, side == blas.Right, and trans == blas.Trans
+// where A and B are n×n symmetric matrices and B is positive definite.
 //
-// where P and Q are the orthogonal matrices determined by Dgebrd when reducing
-// a matrix A to bidiagonal form: A = Q * B * P^T. See Dgebrd for the
-// definitions of Q and P.
+// On entry, a and b contain the elements of A and B in the triangular portion
+// specified by uplo. If jobz == lapack.EVCompute, a contains on exit the
+// eigenvectors Z of the problem, normalized so that
 //
-// If vect == lapack.ApplyQ, A is assumed to have been an nq×k matrix, while if
-// vect == lapack.ApplyP, A is assumed to have been a k×nq matrix. nq = m if
-// side == blas.Left, while nq = n if side == blas.Right.
+//	Z^T * B * Z = I       if itype == GenEVAxBx or GenEVABx,
+//	Z^T * inv(B) * Z = I  if itype == GenEVBAx,
 //
-// tau must have length min(nq,k), and Dormbr will panic otherwise. tau contains
-// the elementary reflectors to construct Q or P depending on the value of
-// vect.
+// otherwise on exit the specified triangular region of a is overwritten. On
+// exit, b contains the Cholesky factor of B as computed by Dpotrf.
 //
-// work must have length at least max(1,lwork), and lwork must be either -1 or
-// at least max(1,n) if side == blas.Left, and at least max(1,m) if side ==
-// blas.Right. For optimum performance lwork should be at least n*nb if side ==
-// blas.Left, and at least m*nb if side == blas.Right, where nb is the optimal
-// block size. On return, work[0] will contain the optimal value of lwork.
+// w contains the eigenvalues in ascending order upon return. w must have
+// length at least n, and Dsygv will panic otherwise.
 //
-// If lwork == -1, the function only calculates the optimal value of lwork and
-// returns it in work[0].
+// work is temporary storage, and lwork specifies the usable memory length.
+// lwork must be at least max(1,3*n-1), and Dsygv will panic otherwise. For
+// good performance, lwork should generally be larger. If lwork == -1, instead
+// of computing Dsygv the optimal work length is stored into work[0].
 //
-// Dormbr is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dormbr(vect lapack.ApplyOrtho, side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
-	nq := n
-	nw := m
-	if side == blas.Left {
-		nq = m
-		nw = n
-	}
-	applyQ := vect == lapack.ApplyQ
+// Dsygv returns whether the computation successfully completed. It fails if B
+// is not positive definite or if the eigensolver did not converge.
+func (impl Implementation) Dsygv(itype GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool) {
 	switch {
-	case !applyQ && vect != lapack.ApplyP:
-		panic(badApplyOrtho)
-	case side != blas.Left && side != blas.Right:
-		panic(badSide)
-	case trans != blas.NoTrans && trans != blas.Trans:
-		panic(badTrans)
-	case m < 0:
-		panic(mLT0)
+	case itype != GenEVAxBx && itype != GenEVABx && itype != GenEVBAx:
+		panic(badGenEVType)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
-	case k < 0:
-		panic(kLT0)
-	case applyQ && lda < max(1, min(nq, k)):
-		panic(badLdA)
-	case !applyQ && lda < max(1, nq):
+	case lda < max(1, n):
 		panic(badLdA)
-	case ldc < max(1, n):
-		panic(badLdC)
-	case lwork < max(1, nw) && lwork != -1:
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < max(1, 3*n-1) && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
 	// Quick return if possible.
-	if m == 0 || n == 0 {
+	if n == 0 {
 		work[0] = 1
-		return
+		return true
 	}
 
 	if lwork == -1 {
-		lapacke.Dormbr(byte(vect), byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
-		return
+		return lapacke.Dsygv(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, -1)
 	}
 
-	minnqk := min(nq, k)
 	switch {
-	case applyQ && len(a) < (nq-1)*lda+minnqk:
-		panic(shortA)
-	case !applyQ && len(a) < (minnqk-1)*lda+nq:
+	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(tau) < minnqk:
-		panic(shortTau)
-	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(w) < n:
+		panic(shortW)
 	}
 
-	lapacke.Dormbr(byte(vect), byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+	return lapacke.Dsygv(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, lwork)
 }
 
-// Dormhr multiplies an m×n general matrix C with an nq×nq orthogonal matrix Q
-//
-//	Q * C,    if side == blas.Left and trans == blas.NoTrans,
-//	Q^T * C,  if side == blas.Left and trans == blas.Trans,
-//	C * Q,    if side == blas.Right and trans == blas.NoTrans,
-//	C * Q^T,  if side == blas.Right and trans == blas.Trans,
-//
-// where nq == m if side == blas.Left and nq == n if side == blas.Right.
-//
-// Q is defined implicitly as the product of ihi-ilo elementary reflectors, as
-// returned by Dgehrd:
-//
-//	Q = H_{ilo} H_{ilo+1} ... H_{ihi-1}.
-//
-// Q is equal to the identity matrix except in the submatrix
-// Q[ilo+1:ihi+1,ilo+1:ihi+1].
-//
-// ilo and ihi must have the same values as in the previous call of Dgehrd. It
-// must hold that
-//
-//	0 <= ilo <= ihi < m,   if m > 0 and side == blas.Left,
-//	ilo = 0 and ihi = -1,  if m = 0 and side == blas.Left,
-//	0 <= ilo <= ihi < n,   if n > 0 and side == blas.Right,
-//	ilo = 0 and ihi = -1,  if n = 0 and side == blas.Right.
-//
-// a and lda represent an m×m matrix if side == blas.Left and an n×n matrix if
-// side == blas.Right. The matrix contains vectors which define the elementary
-// reflectors, as returned by Dgehrd.
-//
-// tau contains the scalar factors of the elementary reflectors, as returned by
-// Dgehrd. tau must have length m-1 if side == blas.Left and n-1 if side ==
-// blas.Right.
-//
-// c and ldc represent the m×n matrix C. On return, c is overwritten by the
-// product with Q.
-//
-// work must have length at least max(1,lwork), and lwork must be at least
-// max(1,n), if side == blas.Left, and max(1,m), if side == blas.Right. For
-// optimum performance lwork should be at least n*nb if side == blas.Left and
-// m*nb if side == blas.Right, where nb is the optimal block size. On return,
-// work[0] will contain the optimal value of lwork.
-//
-// If lwork == -1, instead of performing Dormhr, only the optimal value of lwork
-// will be stored in work[0].
-//
-// If any requirement on input sizes is not met, Dormhr will panic.
-//
-// Dormhr is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dormhr(side blas.Side, trans blas.Transpose, m, n, ilo, ihi int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
-	nq := n // The order of Q.
-	nw := m // The minimum length of work.
-	if side == blas.Left {
-		nq = m
-		nw = n
+// Dsygvd computes all eigenvalues and, optionally, the eigenvectors of a
+// real generalized symmetric-definite eigenproblem as described in Dsygv. If
+// the eigenvectors are requested, it uses a divide and conquer algorithm and
+// is usually much faster than Dsygv for large matrices.
+//
+// The arguments itype, jobz, uplo, n, a, lda, b, ldb and w are as described
+// in Dsygv.
+//
+// work and iwork are temporary storage, and lwork and liwork specify their
+// usable lengths. If n <= 1, lwork and liwork must be at least 1. Otherwise,
+// lwork must be at least 2*n+1 and liwork at least 1 if jobz == lapack.EVNone,
+// and lwork must be at least 1+6*n+2*n*n and liwork at least 3+5*n if
+// jobz == lapack.EVCompute. Dsygvd will panic otherwise. If lwork == -1 or
+// liwork == -1, instead of computing Dsygvd the optimal work lengths are
+// stored into work[0] and iwork[0].
+//
+// Dsygvd returns whether the computation successfully completed. It fails if
+// B is not positive definite or if the eigensolver did not converge.
+func (impl Implementation) Dsygvd(itype GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	minwork, miniwork := 1, 1
+	if n > 1 {
+		if jobz == lapack.EVCompute {
+			minwork = 1 + 6*n + 2*n*n
+			miniwork = 3 + 5*n
+		} else {
+			minwork = 2*n + 1
+		}
 	}
+	query := lwork == -1 || liwork == -1
 	switch {
-	case side != blas.Left && side != blas.Right:
-		panic(badSide)
-	case trans != blas.NoTrans && trans != blas.Trans:
-		panic(badTrans)
-	case m < 0:
-		panic(mLT0)
+	case itype != GenEVAxBx && itype != GenEVABx && itype != GenEVBAx:
+		panic(badGenEVType)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
-	case ilo < 0 || max(1, nq) <= ilo:
-		panic(badIlo)
-	case ihi < min(ilo, nq-1) || nq <= ihi:
-		panic(badIhi)
-	case lda < max(1, nq):
+	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < max(1, nw) && lwork != -1:
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < minwork && !query:
 		panic(badLWork)
+	case liwork < miniwork && !query:
+		panic(badLIWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
 	}
 
 	// Quick return if possible.
-	if m == 0 || n == 0 {
+	if n == 0 {
 		work[0] = 1
-		return
+		iwork[0] = 1
+		return true
 	}
 
-	if lwork == -1 {
-		lapacke.Dormhr(byte(side), byte(trans), m, n, ilo+1, ihi+1, a, lda, tau, c, ldc, work, -1)
-		return
+	if query {
+		_iwork := []lapacke.Int{0}
+		ok = lapacke.Dsygvd(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return ok
 	}
 
 	switch {
-	case len(a) < (nq-1)*lda+nq:
+	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
-	case len(tau) != nq-1:
-		panic(badLenTau)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(w) < n:
+		panic(shortW)
 	}
 
-	lapacke.Dormhr(byte(side), byte(trans), m, n, ilo+1, ihi+1, a, lda, tau, c, ldc, work, lwork)
+	_iwork := getInts(liwork)
+	ok = lapacke.Dsygvd(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, lwork, *_iwork, liwork)
+	putInts(_iwork)
+	return ok
 }
 
-// Dormlq multiplies the matrix C by the orthogonal matrix Q defined by the
-// slices a and tau. A and tau are as returned from Dgelqf.
-//
-//	C = Q * C    if side == blas.Left and trans == blas.NoTrans
-//	C = Q^T * C  if side == blas.Left and trans == blas.Trans
-//	C = C * Q    if side == blas.Right and trans == blas.NoTrans
-//	C = C * Q^T  if side == blas.Right and trans == blas.Trans
-//
-// If side == blas.Left, A is a matrix of side k×m, and if side == blas.Right
-// A is of size k×n. This uses a blocked algorithm.
+// Dsygvx computes selected eigenvalues and, optionally, the eigenvectors of
+// a real generalized symmetric-definite eigenproblem as described in Dsygv.
 //
-// Work is temporary storage, and lwork specifies the usable memory length.
-// At minimum, lwork >= m if side == blas.Left and lwork >= n if side == blas.Right,
-// and this function will panic otherwise.
-// Dormlq uses a block algorithm, but the block size is limited
-// by the temporary space available. If lwork == -1, instead of performing Dormlq,
-// the optimal work length will be stored into work[0].
+// The arguments itype, jobz, uplo, n, a, lda, b and ldb are as described in
+// Dsygv, except that on exit a is overwritten also when the eigenvectors are
+// computed.
 //
-// tau contains the Householder scales and must have length at least k, and
-// this function will panic otherwise.
-func (impl Implementation) Dormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
-	left := side == blas.Left
-	nw := m
-	if left {
-		nw = n
+// rng specifies which eigenvalues are computed:
+//
+//	rng == EVRangeAll   all eigenvalues are computed,
+//	rng == EVRangeValue the eigenvalues in the half-open interval (vl,vu] are
+//	                    computed. vl must be less than vu,
+//	rng == EVRangeIndex the eigenvalues with zero-based indices il through iu
+//	                    in ascending order are computed. il and iu must
+//	                    satisfy 0 <= il <= iu < n.
+//
+// vl and vu are only referenced if rng == EVRangeValue, and il and iu are only
+// referenced if rng == EVRangeIndex.
+//
+// abstol is the absolute error tolerance for the eigenvalues. If abstol is not
+// positive, a default tolerance is used.
+//
+// Dsygvx returns the number of eigenvalues found in m. On return, the first m
+// elements of w contain the selected eigenvalues in ascending order. w must
+// have length at least n, and Dsygvx will panic otherwise.
+//
+// If jobz == lapack.EVCompute, the first m columns of the n×ncol matrix Z
+// contain the eigenvectors corresponding to the selected eigenvalues,
+// normalized as described in Dsygv, where ncol is iu-il+1 if
+// rng == EVRangeIndex and n otherwise. ldz must be at least ncol and z must
+// have length at least (n-1)*ldz+ncol, otherwise Dsygvx will panic. ifail
+// must have length at least n. On return, the first m elements of ifail are
+// -1 for the eigenvectors that converged, and the zero-based indices of the
+// eigenvectors that failed to converge otherwise. If jobz == lapack.EVNone, z
+// and ifail are not referenced.
+//
+// work and iwork are temporary storage. lwork must be at least max(1,8*n) and
+// iwork must have length at least 5*n, otherwise Dsygvx will panic. For good
+// performance, lwork should generally be larger. If lwork == -1, instead of
+// computing Dsygvx the optimal work length is stored into work[0].
+//
+// Dsygvx returns whether the computation successfully completed. It fails if
+// B is not positive definite or if some eigenvectors failed to converge.
+func (impl Implementation) Dsygvx(itype GenEVType, jobz lapack.EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncol := n
+	if rng == EVRangeIndex {
+		ncol = iu - il + 1
 	}
 	switch {
-	case !left && side != blas.Right:
-		panic(badSide)
-	case trans != blas.Trans && trans != blas.NoTrans:
-		panic(badTrans)
-	case m < 0:
-		panic(mLT0)
+	case itype != GenEVAxBx && itype != GenEVABx && itype != GenEVBAx:
+		panic(badGenEVType)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != EVRangeAll && rng != EVRangeValue && rng != EVRangeIndex:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
-	case k < 0:
-		panic(kLT0)
-	case left && k > m:
-		panic(kGTM)
-	case !left && k > n:
-		panic(kGTN)
-	case left && lda < max(1, m):
-		panic(badLdA)
-	case !left && lda < max(1, n):
+	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < max(1, nw) && lwork != -1:
+	case ldb < max(1, n):
+		panic(badLdB)
+	case rng == EVRangeValue && n > 0 && vu <= vl:
+		panic(badInterval)
+	case rng == EVRangeIndex && n > 0 && (il < 0 || n <= il):
+		panic(badIl)
+	case rng == EVRangeIndex && n > 0 && (iu < il || n <= iu):
+		panic(badIu)
+	case ldz < 1 || (wantz && ldz < ncol):
+		panic(badLdZ)
+	case lwork < max(1, 8*n) && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
 	// Quick return if possible.
-	if m == 0 || n == 0 || k == 0 {
+	if n == 0 {
 		work[0] = 1
-		return
+		return 0, true
+	}
+
+	if rng != EVRangeIndex {
+		// The index range must be valid even when it is not referenced.
+		il, iu = 0, n-1
 	}
+	// Some versions of LAPACKE_dsygvx_work require ldz to be at least ncol
+	// even when z is not referenced, so max(ncol,ldz) is passed as in
+	// Dgeev.
 
+	_m := []lapacke.Int{0}
 	if lwork == -1 {
-		lapacke.Dormlq(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
-		return
+		ok = lapacke.Dsygvx(int(itype), byte(jobz), byte(rng), byte(uplo), n, a, lda, b, ldb, vl, vu, il+1, iu+1, abstol, _m, w, z, max(ncol, ldz), work, -1, nil, nil)
+		return 0, ok
 	}
 
 	switch {
-	case left && len(a) < (k-1)*lda+m:
-		panic(shortA)
-	case !left && len(a) < (k-1)*lda+n:
+	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(tau) < k:
-		panic(shortTau)
-	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+ncol:
+		panic(shortZ)
+	case len(iwork) < 5*n:
+		panic(shortIWork)
+	case wantz && len(ifail) < n:
+		panic(shortIfail)
+	}
+
+	var nfail int
+	if wantz {
+		nfail = n
+	}
+	_ifail := getInts(nfail)
+	_iwork := getInts(5 * n)
+	ok = lapacke.Dsygvx(int(itype), byte(jobz), byte(rng), byte(uplo), n, a, lda, b, ldb, vl, vu, il+1, iu+1, abstol, _m, w, z, max(ncol, ldz), work, lwork, *_iwork, *_ifail)
+	putInts(_iwork)
+	m = int(_m[0])
+	if wantz {
+		for i, v := range (*_ifail)[:m] {
+			ifail[i] = int(v) - 1 // Transform to zero-indexed, converged eigenvectors become -1.
+		}
 	}
-
-	lapacke.Dormlq(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+	putInts(_ifail)
+	return m, ok
 }
 
-// Dormqr multiplies an m×n matrix C by an orthogonal matrix Q as
+// Dsytrd reduces a symmetric n×n matrix A to symmetric tridiagonal form by an
+// orthogonal similarity transformation
+//
+//	Q^T * A * Q = T
+//
+// where Q is an orthonormal matrix and T is symmetric and tridiagonal.
 //
-//	C = Q * C,    if side == blas.Left  and trans == blas.NoTrans,
-//	C = Q^T * C,  if side == blas.Left  and trans == blas.Trans,
-//	C = C * Q,    if side == blas.Right and trans == blas.NoTrans,
-//	C = C * Q^T,  if side == blas.Right and trans == blas.Trans,
+// On entry, a contains the elements of the input matrix in the triangle specified
+// by uplo. On exit, the diagonal and sub/super-diagonal are overwritten by the
+// corresponding elements of the tridiagonal matrix T. The remaining elements in
+// the triangle, along with the array tau, contain the data to construct Q as
+// the product of elementary reflectors.
 //
-// where Q is defined as the product of k elementary reflectors
+// If uplo == blas.Upper, Q is constructed with
 //
-//	Q = H_0 * H_1 * ... * H_{k-1}.
+//	Q = H_{n-2} * ... * H_1 * H_0
 //
-// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
-// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
-// The ith column of A contains the vector which defines the elementary
-// reflector H_i and tau[i] contains its scalar factor. tau must have length k
-// and Dormqr will panic otherwise. Dgeqrf returns A and tau in the required
-// form.
+// where
 //
-// work must have length at least max(1,lwork), and lwork must be at least n if
-// side == blas.Left and at least m if side == blas.Right, otherwise Dormqr will
-// panic.
+//	H_i = I - tau_i * v * v^T
 //
-// work is temporary storage, and lwork specifies the usable memory length. At
-// minimum, lwork >= m if side == blas.Left and lwork >= n if side ==
-// blas.Right, and this function will panic otherwise. Larger values of lwork
-// will generally give better performance. On return, work[0] will contain the
-// optimal value of lwork.
+// v is constructed as v[i+1:n] = 0, v[i] = 1, v[0:i-1] is stored in A[0:i-1, i+1].
+// The elements of A are
 //
-// If lwork is -1, instead of performing Dormqr, the optimal workspace size will
-// be stored into work[0].
-func (impl Implementation) Dormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
-	left := side == blas.Left
-	nq := n
-	nw := m
-	if left {
-		nq = m
-		nw = n
-	}
+//	[ d   e  v1  v2  v3]
+//	[     d   e  v2  v3]
+//	[         d   e  v3]
+//	[             d   e]
+//	[                 e]
+//
+// If uplo == blas.Lower, Q is constructed with
+//
+//	Q = H_0 * H_1 * ... * H_{n-2}
+//
+// where
+//
+//	H_i = I - tau_i * v * v^T
+//
+// v is constructed as v[0:i+1] = 0, v[i+1] = 1, v[i+2:n] is stored in A[i+2:n, i].
+// The elements of A are
+//
+//	[ d                ]
+//	[ e   d            ]
+//	[v0   e   d        ]
+//	[v0  v1   e   d    ]
+//	[v0  v1  v2   e   d]
+//
+// d must have length n, and e and tau must have length n-1. Dsytrd will panic if
+// these conditions are not met.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= 1, and Dsytrd will panic otherwise. The amount of blocking is
+// limited by the usable length.
+// If lwork == -1, instead of computing Dsytrd the optimal work length is stored
+// into work[0].
+//
+// Dsytrd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dsytrd(uplo blas.Uplo, n int, a []float64, lda int, d, e, tau, work []float64, lwork int) {
 	switch {
-	case !left && side != blas.Right:
-		panic(badSide)
-	case trans != blas.NoTrans && trans != blas.Trans:
-		panic(badTrans)
-	case m < 0:
-		panic(mLT0)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
-	case k < 0:
-		panic(kLT0)
-	case left && k > m:
-		panic(kGTM)
-	case !left && k > n:
-		panic(kGTN)
-	case lda < max(1, k):
+	case lda < max(1, n):
 		panic(badLdA)
-	case ldc < max(1, n):
-		panic(badLdC)
-	case lwork < max(1, nw) && lwork != -1:
+	case lwork < 1 && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
 	// Quick return if possible.
-	if m == 0 || n == 0 || k == 0 {
+	if n == 0 {
 		work[0] = 1
 		return
 	}
 
 	if lwork == -1 {
-		lapacke.Dormqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
+		lapacke.Dsytrd(byte(uplo), n, a, lda, d, e, tau, work, -1)
 		return
 	}
 
 	switch {
-	case len(a) < (nq-1)*lda+k:
+	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(tau) != k:
-		panic(badLenTau)
-	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(tau) < n-1:
+		panic(shortTau)
 	}
 
-	lapacke.Dormqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+	lapacke.Dsytrd(byte(uplo), n, a, lda, d, e, tau, work, lwork)
 }
 
-// Dpocon estimates the reciprocal of the condition number of a positive-definite
-// matrix A given the Cholesky decomposition of A. The condition number computed
-// is based on the 1-norm and the ∞-norm.
+// ipivSymToLapacke converts the zero-based pivot indices of a symmetric
+// indefinite factorization to the one-based form used by LAPACK and stores
+// the result in dst. Non-negative indices are incremented, the negative
+// indices of 2×2 pivot blocks are the same in both forms. It returns false
+// if an index cannot be represented by lapacke.Int.
+func ipivSymToLapacke(dst []lapacke.Int, ipiv []int) bool {
+	for i, v := range ipiv {
+		if v >= 0 {
+			v++ // Transform to one-indexed.
+		}
+		if v != int(lapacke.Int(v)) {
+			return false
+		}
+		dst[i] = lapacke.Int(v)
+	}
+	return true
+}
+
+// ipivSymToGonum converts the one-based pivot indices of a symmetric
+// indefinite factorization computed by LAPACK to the zero-based form and
+// stores the result in dst.
+func ipivSymToGonum(dst []int, ipiv []lapacke.Int) {
+	for i, v := range ipiv {
+		if v > 0 {
+			v-- // Transform to zero-indexed.
+		}
+		dst[i] = int(v)
+	}
+}
+
+// Dsycon estimates the reciprocal of the condition number in the 1-norm of
+// an n×n symmetric matrix A given its factorization
 //
-// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//	A = U * D * U^T  if uplo == blas.Upper,
+//	A = L * D * L^T  if uplo == blas.Lower,
 //
-// work is a temporary data slice of length at least 3*n and Dpocon will panic otherwise.
+// as computed by Dsytrf. a and ipiv contain the factorization of A, and
+// anorm is the 1-norm of the original matrix A.
 //
-// iwork is a temporary data slice of length at least n and Dpocon will panic otherwise.
-func (impl Implementation) Dpocon(uplo blas.Uplo, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64 {
+// work must have length at least 2*n and iwork must have length at least n,
+// otherwise Dsycon will panic.
+func (impl Implementation) Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
 		panic(badUplo)
@@ -3287,50 +5791,80 @@ func (impl Implementation) Dpocon(uplo blas.Uplo, n int, a []float64, lda int, a
 	switch {
 	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(work) < 3*n:
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < 2*n:
 		panic(shortWork)
 	case len(iwork) < n:
 		panic(shortIWork)
 	}
 
+	_ipiv := getInts(n)
+	if !ipivSymToLapacke(*_ipiv, ipiv) {
+		putInts(_ipiv)
+		panic("lapack: ipiv element out of range")
+	}
 	rcond := []float64{0}
-	_iwork := make([]int32, n)
-	lapacke.Dpocon(byte(uplo), n, a, lda, anorm, rcond, work, _iwork)
+	_iwork := getInts(n)
+	lapacke.Dsycon(byte(uplo), n, a, lda, *_ipiv, anorm, rcond, work, *_iwork)
+	putInts(_iwork)
+	putInts(_ipiv)
 	return rcond[0]
 }
 
-// Dsteqr computes the eigenvalues and optionally the eigenvectors of a symmetric
-// tridiagonal matrix using the implicit QL or QR method. The eigenvectors of a
-// full or band symmetric matrix can also be found if Dsytrd, Dsptrd, or Dsbtrd
-// have been used to reduce this matrix to tridiagonal form.
-//
-// d, on entry, contains the diagonal elements of the tridiagonal matrix. On exit,
-// d contains the eigenvalues in ascending order. d must have length n and
-// Dsteqr will panic otherwise.
-//
-// e, on entry, contains the off-diagonal elements of the tridiagonal matrix on
-// entry, and is overwritten during the call to Dsteqr. e must have length n-1 and
-// Dsteqr will panic otherwise.
-//
-// z, on entry, contains the n×n orthogonal matrix used in the reduction to
-// tridiagonal form if compz == lapack.OriginalEV. On exit, if
-// compz == lapack.OriginalEV, z contains the orthonormal eigenvectors of the
-// original symmetric matrix, and if compz == lapack.TridiagEV, z contains the
-// orthonormal eigenvectors of the symmetric tridiagonal matrix. z is not used
-// if compz == lapack.None.
-//
-// work must have length at least max(1, 2*n-2) if the eigenvectors are computed,
-// and Dsteqr will panic otherwise.
+// Dsytrf computes the factorization of an n×n symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The form of the factorization is
+//
+//	A = U * D * U^T  if uplo == blas.Upper,
+//	A = L * D * L^T  if uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower)
+// triangular matrices, and D is symmetric and block diagonal with 1×1 and
+// 2×2 diagonal blocks. Only the triangle of A specified by uplo is
+// referenced, and on return it contains D and the multipliers used to obtain
+// U or L.
+//
+// ipiv must have length n. On return it describes the interchanges and the
+// block structure of D with zero-based indices:
+//   - if ipiv[k] >= 0, D[k,k] is a 1×1 diagonal block, and rows and columns
+//     k and ipiv[k] of A were interchanged;
+//   - if uplo == blas.Upper and ipiv[k] = ipiv[k-1] < 0, D[k-1:k+1,k-1:k+1]
+//     is a 2×2 diagonal block, and rows and columns k-1 and -ipiv[k]-1 were
+//     interchanged;
+//   - if uplo == blas.Lower and ipiv[k] = ipiv[k+1] < 0, D[k:k+2,k:k+2] is a
+//     2×2 diagonal block, and rows and columns k+1 and -ipiv[k]-1 were
+//     interchanged.
+//
+// That is, the index p of a 2×2 block is stored as its bitwise complement ^p.
+// The one-based indices used by LAPACK, where the index of a 2×2 block is
+// stored negated, are converted accordingly. Dsytrs, Dsytri, Dsycon and
+// Dsysvx take ipiv in the same form.
+//
+// ok is false if D[i,i] is exactly zero for some i. The factorization has
+// been completed, but D is singular and it must not be used to solve a
+// system of equations.
 //
-// Dsteqr is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dsteqr(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64) (ok bool) {
+// work must have length at least max(1,lwork), and lwork must be at least
+// 1, otherwise Dsytrf will panic. For good performance, lwork should be at
+// least n*nb, where nb is the optimal block size. If lwork == -1, instead of
+// computing the factorization, Dsytrf only computes the optimal work length
+// and stores it into work[0].
+func (impl Implementation) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
 	switch {
-	case compz != lapack.EVCompNone && compz != lapack.EVTridiag && compz != lapack.EVOrig:
-		panic(badEVComp)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
-	case ldz < 1, compz != lapack.EVCompNone && ldz < n:
-		panic(badLdZ)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if lwork == -1 {
+		return lapacke.Dsytrf(byte(uplo), n, nil, lda, nil, work, -1)
 	}
 
 	// Quick return if possible.
@@ -3339,34 +5873,42 @@ func (impl Implementation) Dsteqr(compz lapack.EVComp, n int, d, e, z []float64,
 	}
 
 	switch {
-	case len(d) < n:
-		panic(shortD)
-	case len(e) < n-1:
-		panic(shortE)
-	case compz != lapack.EVCompNone && len(z) < (n-1)*ldz+n:
-		panic(shortZ)
-	case compz != lapack.EVCompNone && len(work) < max(1, 2*n-2):
-		panic(shortWork)
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
 	}
 
-	return lapacke.Dsteqr(byte(compz), n, d, e, z, ldz, work)
+	_ipiv := getInts(n)
+	ok = lapacke.Dsytrf(byte(uplo), n, a, lda, *_ipiv, work, lwork)
+	ipivSymToGonum(ipiv, *_ipiv)
+	putInts(_ipiv)
+	return ok
 }
 
-// Dsterf computes all eigenvalues of a symmetric tridiagonal matrix using the
-// Pal-Walker-Kahan variant of the QL or QR algorithm.
+// Dsytri computes the inverse of an n×n symmetric matrix A given its
+// factorization
 //
-// d contains the diagonal elements of the tridiagonal matrix on entry, and
-// contains the eigenvalues in ascending order on exit. d must have length at
-// least n, or Dsterf will panic.
+//	A = U * D * U^T  if uplo == blas.Upper,
+//	A = L * D * L^T  if uplo == blas.Lower,
 //
-// e contains the off-diagonal elements of the tridiagonal matrix on entry, and is
-// overwritten during the call to Dsterf. e must have length of at least n-1 or
-// Dsterf will panic.
+// as computed by Dsytrf. On entry, a and ipiv contain the factorization of
+// A. On return, the triangle of a specified by uplo contains the inverse of
+// A.
 //
-// Dsterf is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dsterf(n int, d, e []float64) (ok bool) {
-	if n < 0 {
+// Dsytri returns whether the inversion was successful. It is not successful
+// if D[i,i] is exactly zero for some i, in which case A is singular and the
+// content of a is undefined.
+//
+// work must have length at least n, otherwise Dsytri will panic.
+func (impl Implementation) Dsytri(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
 		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
 	}
 
 	// Quick return if possible.
@@ -3375,159 +5917,178 @@ func (impl Implementation) Dsterf(n int, d, e []float64) (ok bool) {
 	}
 
 	switch {
-	case len(d) < n:
-		panic(shortD)
-	case len(e) < n-1:
-		panic(shortE)
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < n:
+		panic(shortWork)
 	}
 
-	return lapacke.Dsterf(n, d, e)
+	_ipiv := getInts(n)
+	if !ipivSymToLapacke(*_ipiv, ipiv) {
+		putInts(_ipiv)
+		panic("lapack: ipiv element out of range")
+	}
+	ok = lapacke.Dsytri(byte(uplo), n, a, lda, *_ipiv, work)
+	putInts(_ipiv)
+	return ok
 }
 
-// Dsyev computes all eigenvalues and, optionally, the eigenvectors of a real
-// symmetric matrix A.
+// Dsytrs solves a system of linear equations
 //
-// w contains the eigenvalues in ascending order upon return. w must have length
-// at least n, and Dsyev will panic otherwise.
+//	A * X = B,
 //
-// On entry, a contains the elements of the symmetric matrix A in the triangular
-// portion specified by uplo. If jobz == lapack.ComputeEV a contains the
-// orthonormal eigenvectors of A on exit, otherwise on exit the specified
-// triangular region is overwritten.
+// where A is an n×n symmetric matrix and B and X are n×nrhs matrices, using
+// the factorization
 //
-// work is temporary storage, and lwork specifies the usable memory length. At minimum,
-// lwork >= 3*n-1, and Dsyev will panic otherwise. The amount of blocking is
-// limited by the usable length. If lwork == -1, instead of computing Dsyev the
-// optimal work length is stored into work[0].
-func (impl Implementation) Dsyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool) {
+//	A = U * D * U^T  if uplo == blas.Upper,
+//	A = L * D * L^T  if uplo == blas.Lower,
+//
+// as computed by Dsytrf. a and ipiv contain the factorization of A. On entry
+// b contains the matrix B, and on return it contains the solution X.
+func (impl Implementation) Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
 	switch {
-	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
-		panic(badEVJob)
 	case uplo != blas.Upper && uplo != blas.Lower:
 		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
 	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < max(1, 3*n-1) && lwork != -1:
-		panic(badLWork)
-	case len(work) < max(1, lwork):
-		panic(shortWork)
-	}
-
-	// Quick return if possible.
-	if n == 0 {
-		return true
+	case ldb < max(1, nrhs):
+		panic(badLdB)
 	}
-
-	if lwork == -1 {
-		return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, -1)
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
 	}
 
 	switch {
 	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(w) < n:
-		panic(shortW)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
 	}
 
-	return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, lwork)
+	_ipiv := getInts(n)
+	if !ipivSymToLapacke(*_ipiv, ipiv) {
+		putInts(_ipiv)
+		panic("lapack: ipiv element out of range")
+	}
+	lapacke.Dsytrs(byte(uplo), n, nrhs, a, lda, *_ipiv, b, ldb)
+	putInts(_ipiv)
 }
 
-// Dsytrd reduces a symmetric n×n matrix A to symmetric tridiagonal form by an
-// orthogonal similarity transformation
-//
-//	Q^T * A * Q = T
+// Dsysvx solves a system of linear equations
 //
-// where Q is an orthonormal matrix and T is symmetric and tridiagonal.
-//
-// On entry, a contains the elements of the input matrix in the triangle specified
-// by uplo. On exit, the diagonal and sub/super-diagonal are overwritten by the
-// corresponding elements of the tridiagonal matrix T. The remaining elements in
-// the triangle, along with the array tau, contain the data to construct Q as
-// the product of elementary reflectors.
-//
-// If uplo == blas.Upper, Q is constructed with
-//
-//	Q = H_{n-2} * ... * H_1 * H_0
-//
-// where
-//
-//	H_i = I - tau_i * v * v^T
+//	A * X = B,
 //
-// v is constructed as v[i+1:n] = 0, v[i] = 1, v[0:i-1] is stored in A[0:i-1, i+1].
-// The elements of A are
+// where A is an n×n symmetric matrix and B and X are n×nrhs matrices, using
+// the diagonal pivoting factorization
 //
-//	[ d   e  v1  v2  v3]
-//	[     d   e  v2  v3]
-//	[         d   e  v3]
-//	[             d   e]
-//	[                 e]
+//	A = U * D * U^T  if uplo == blas.Upper,
+//	A = L * D * L^T  if uplo == blas.Lower,
 //
-// If uplo == blas.Lower, Q is constructed with
+// where U (or L) is a product of permutation and unit upper (lower)
+// triangular matrices and D is symmetric and block diagonal with 1×1 and 2×2
+// diagonal blocks. In addition to the solution, Dsysvx estimates the
+// condition number of A, improves the solution by iterative refinement and
+// computes error bounds for it.
 //
-//	Q = H_0 * H_1 * ... * H_{n-2}
+// Only the triangle of a and af specified by uplo is referenced.
 //
-// where
+// If fact == FactSupplied, af and ipiv contain on entry the factorization of
+// A as computed by Dsytrf. If fact == FactCompute, A is factorized into af
+// and ipiv. fact must not be FactEquilibrate.
 //
-//	H_i = I - tau_i * v * v^T
+// ipiv must have length n. It describes the interchanges and the block
+// structure of D with zero-based indices as documented in Dsytrf.
 //
-// v is constructed as v[0:i+1] = 0, v[i+1] = 1, v[i+2:n] is stored in A[i+2:n, i].
-// The elements of A are
+// On return, x contains the solution. ferr and berr contain the forward
+// error bounds and the backward errors of the columns of X as described in
+// Dgerfs. ferr and berr must have length at least nrhs.
 //
-//	[ d                ]
-//	[ e   d            ]
-//	[v0   e   d        ]
-//	[v0  v1   e   d    ]
-//	[v0  v1  v2   e   d]
+// rcond is the estimate of the reciprocal of the condition number of A in
+// the 1-norm.
 //
-// d must have length n, and e and tau must have length n-1. Dsytrd will panic if
-// these conditions are not met.
+// ok is false if A is singular to working precision. If D[i,i] is exactly
+// zero for some i, the solution and the error bounds have not been computed
+// and rcond is zero. Otherwise rcond is less than the machine precision and
+// the solution and the error bounds have been computed.
 //
-// work is temporary storage, and lwork specifies the usable memory length. At minimum,
-// lwork >= 1, and Dsytrd will panic otherwise. The amount of blocking is
-// limited by the usable length.
-// If lwork == -1, instead of computing Dsytrd the optimal work length is stored
-// into work[0].
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,3*n), otherwise Dsysvx will panic. For good performance, lwork
+// should be larger. If lwork == -1, instead of solving the system, Dsysvx
+// only computes the optimal work length and stores it into work[0].
 //
-// Dsytrd is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dsytrd(uplo blas.Uplo, n int, a []float64, lda int, d, e, tau, work []float64, lwork int) {
+// iwork must have length at least n, otherwise Dsysvx will panic.
+func (impl Implementation) Dsysvx(fact FactJob, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, lwork int, iwork []int) (rcond float64, ok bool) {
 	switch {
+	case fact != FactSupplied && fact != FactCompute:
+		panic(badFact)
 	case uplo != blas.Upper && uplo != blas.Lower:
 		panic(badUplo)
 	case n < 0:
 		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
 	case lda < max(1, n):
 		panic(badLdA)
-	case lwork < 1 && lwork != -1:
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	case lwork < max(1, 3*n) && lwork != -1:
 		panic(badLWork)
 	case len(work) < max(1, lwork):
 		panic(shortWork)
 	}
 
-	// Quick return if possible.
-	if n == 0 {
-		work[0] = 1
-		return
-	}
-
 	if lwork == -1 {
-		lapacke.Dsytrd(byte(uplo), n, a, lda, d, e, tau, work, -1)
-		return
+		lapacke.Dsysvx(byte(fact), byte(uplo), n, nrhs, nil, lda, nil, ldaf, nil, nil, ldb, nil, ldx, nil, nil, nil, work, -1, nil)
+		return 0, true
 	}
 
 	switch {
 	case len(a) < (n-1)*lda+n:
 		panic(shortA)
-	case len(d) < n:
-		panic(shortD)
-	case len(e) < n-1:
-		panic(shortE)
-	case len(tau) < n-1:
-		panic(shortTau)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	case len(iwork) < n:
+		panic(shortIWork)
 	}
 
-	lapacke.Dsytrd(byte(uplo), n, a, lda, d, e, tau, work, lwork)
+	_ipiv := getInts(n)
+	if fact == FactSupplied && !ipivSymToLapacke(*_ipiv, ipiv) {
+		putInts(_ipiv)
+		panic("lapack: ipiv element out of range")
+	}
+	_rcond := []float64{0}
+	_iwork := getInts(n)
+	ok = lapacke.Dsysvx(byte(fact), byte(uplo), n, nrhs, a, lda, af, ldaf, *_ipiv, b, ldb, x, ldx, _rcond, ferr, berr, work, lwork, *_iwork)
+	putInts(_iwork)
+	if fact == FactCompute {
+		ipivSymToGonum(ipiv, *_ipiv)
+	}
+	putInts(_ipiv)
+	return _rcond[0], ok
 }
 
 // Dtbtrs solves a triangular system of the form
@@ -3612,8 +6173,9 @@ func (impl Implementation) Dtrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 	}
 
 	rcond := []float64{0}
-	_iwork := make([]int32, n)
-	lapacke.Dtrcon(byte(norm), byte(uplo), byte(diag), n, a, lda, rcond, work, _iwork)
+	_iwork := getInts(n)
+	lapacke.Dtrcon(byte(norm), byte(uplo), byte(diag), n, a, lda, rcond, work, *_iwork)
+	putInts(_iwork)
 	return rcond[0]
 }
 
@@ -3695,8 +6257,8 @@ func (impl Implementation) Dtrexc(compq lapack.UpdateSchurComp, n int, t []float
 		return ifst, ilst, true
 	}
 
-	ifst32 := []int32{int32(ifst + 1)}
-	ilst32 := []int32{int32(ilst + 1)}
+	ifst32 := []lapacke.Int{lapacke.Int(ifst + 1)}
+	ilst32 := []lapacke.Int{lapacke.Int(ilst + 1)}
 	ok = lapacke.Dtrexc(byte(compq), n, t, ldt, q, ldq, ifst32, ilst32, work)
 	ifst = int(ifst32[0] - 1)
 	ilst = int(ilst32[0] - 1)
@@ -4059,6 +6621,396 @@ func (impl Implementation) Dgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob
 	return lapacke.Dgeev(byte(jobvl), byte(jobvr), n, a, lda, wr, wi, vl, max(n, ldvl), vr, max(n, ldvr), work, lwork)
 }
 
+// Dgees computes the eigenvalues, the real Schur form T and, optionally, the
+// matrix of Schur vectors Z of an n×n real nonsymmetric matrix A, giving the
+// Schur factorization
+//
+//	A = Z * T * Z^T.
+//
+// T is upper quasi-triangular, that is, upper triangular with 1×1 and 2×2
+// blocks on the diagonal. The 2×2 blocks correspond to complex conjugate pairs
+// of eigenvalues and are in standardized form.
+//
+// If sel is not nil, the eigenvalues are reordered so that the eigenvalues
+// for which sel(wr, wi) returns true are at the top left of T, and the leading
+// sdim columns of Z then form an orthonormal basis of the corresponding
+// invariant subspace. A complex conjugate pair of eigenvalues is selected if
+// either of them is selected. If sel is nil, the eigenvalues are not
+// reordered and sdim is zero. sel must not panic.
+//
+// On entry, a contains the n×n matrix A. On return, a contains T.
+//
+// wr and wi contain the real and imaginary parts, respectively, of the computed
+// eigenvalues in the order in which they appear on the diagonal of T. Complex
+// conjugate pairs of eigenvalues appear consecutively with the eigenvalue
+// having the positive imaginary part first. wr and wi must have length n, and
+// Dgees will panic otherwise.
+//
+// The Schur vectors will be computed only if jobvs == lapack.SchurOrig, in
+// which case they are stored in the columns of the n×n matrix VS, otherwise
+// jobvs must be lapack.SchurNone and vs is not referenced.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n),
+// otherwise Dgees will panic. For good performance, lwork must generally be
+// larger. If lwork == -1, instead of performing Dgees, the optimal value of
+// lwork is stored into work[0].
+//
+// Dgees returns whether the computation successfully completed. ok is false if
+// the QR algorithm failed to compute all the eigenvalues, or if the selected
+// eigenvalues could not be reordered, for example because they are too close
+// to the other eigenvalues.
+func (impl Implementation) Dgees(jobvs lapack.SchurComp, sel func(wr, wi float64) bool, n int, a []float64, lda int, wr, wi, vs []float64, ldvs int, work []float64, lwork int) (sdim int, ok bool) {
+	wantvs := jobvs == lapack.SchurOrig
+	switch {
+	case jobvs != lapack.SchurOrig && jobvs != lapack.SchurNone:
+		panic(badSchurComp)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldvs < 1 || (wantvs && ldvs < n):
+		panic(badLdVS)
+	case lwork < max(1, 3*n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0, true
+	}
+
+	sort := byte('N')
+	if sel != nil {
+		sort = 'S'
+	}
+
+	// The calls to lapacke.Dgees below require max(n,ldvs) because the leading
+	// dimension check in LAPACKE_dgees_work does not depend on jobvs.
+
+	if lwork == -1 {
+		return 0, lapacke.Dgees(byte(jobvs), sort, sel, n, a, lda, []lapacke.Int{0}, wr, wi, vs, max(n, ldvs), work, -1, nil)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(wr) != n:
+		panic(badLenWr)
+	case len(wi) != n:
+		panic(badLenWi)
+	case wantvs && len(vs) < (n-1)*ldvs+n:
+		panic(shortVS)
+	}
+
+	sdim32 := []lapacke.Int{0}
+	// bwork is only referenced when the eigenvalues are sorted.
+	var nbwork int
+	if sel != nil {
+		nbwork = n
+	}
+	bwork := getInts(nbwork)
+	ok = lapacke.Dgees(byte(jobvs), sort, sel, n, a, lda, sdim32, wr, wi, vs, max(n, ldvs), work, lwork, *bwork)
+	putInts(bwork)
+	return int(sdim32[0]), ok
+}
+
+// Dgges computes the generalized eigenvalues, the generalized real Schur form
+// (S,T) and, optionally, the left and right matrices of Schur vectors Q and Z
+// of a pair of n×n real nonsymmetric matrices (A,B), giving the generalized
+// Schur factorization
+//
+//	A = Q * S * Z^T,
+//	B = Q * T * Z^T.
+//
+// S is upper quasi-triangular with 1×1 and 2×2 blocks on the diagonal, and T
+// is upper triangular. The 2×2 blocks of S correspond to complex conjugate
+// pairs of generalized eigenvalues, and the corresponding 2×2 blocks of T are
+// reduced to positive diagonal form.
+//
+// The generalized eigenvalues are the ratios alpha_j/beta_j where
+//
+//	alpha_j = alphar[j] + i*alphai[j],
+//
+// and beta_j = beta[j]. The ratios may easily over- or underflow, and beta_j
+// may even be zero, so they are not computed by Dgges. Complex conjugate pairs
+// of eigenvalues appear consecutively with the eigenvalue having the positive
+// imaginary part first. alphar, alphai and beta must have length at least n,
+// and Dgges will panic otherwise.
+//
+// If sel is not nil, the eigenvalues are reordered so that the eigenvalues
+// for which sel(alphar, alphai, beta) returns true are at the top left of S
+// and T, and sdim is the number of selected eigenvalues. A complex conjugate
+// pair of eigenvalues is selected if either of them is selected. If sel is
+// nil, the eigenvalues are not reordered and sdim is zero. sel must not panic.
+//
+// On entry, a and b contain the n×n matrices A and B. On return, a contains S
+// and b contains T.
+//
+// The left Schur vectors will be computed only if jobvsl == lapack.SchurOrig,
+// in which case they are stored in the columns of the n×n matrix VSL,
+// otherwise jobvsl must be lapack.SchurNone and vsl is not referenced. The
+// same holds for jobvsr, the right Schur vectors and vsr.
+//
+// work must have length at least lwork and lwork must be at least 1 if n is
+// zero and max(8*n,6*n+16) otherwise, or Dgges will panic. For good
+// performance, lwork must generally be larger. If lwork == -1, instead of
+// performing Dgges, the optimal value of lwork is stored into work[0].
+//
+// Dgges returns whether the computation successfully completed. ok is false if
+// the QZ algorithm failed, or if the selected eigenvalues could not be
+// reordered.
+func (impl Implementation) Dgges(jobvsl, jobvsr lapack.SchurComp, sel func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int) (sdim int, ok bool) {
+	wantvsl := jobvsl == lapack.SchurOrig
+	wantvsr := jobvsr == lapack.SchurOrig
+	minwork := 1
+	if n > 0 {
+		minwork = max(8*n, 6*n+16)
+	}
+	switch {
+	case jobvsl != lapack.SchurOrig && jobvsl != lapack.SchurNone:
+		panic(badSchurComp)
+	case jobvsr != lapack.SchurOrig && jobvsr != lapack.SchurNone:
+		panic(badSchurComp)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldvsl < 1 || (wantvsl && ldvsl < n):
+		panic(badLdVSL)
+	case ldvsr < 1 || (wantvsr && ldvsr < n):
+		panic(badLdVSR)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0, true
+	}
+
+	sort := byte('N')
+	if sel != nil {
+		sort = 'S'
+	}
+
+	// The calls to lapacke.Dgges below require max(n,ldvsl) and max(n,ldvsr)
+	// because the leading dimension checks in LAPACKE_dgges_work do not
+	// depend on jobvsl and jobvsr.
+
+	if lwork == -1 {
+		return 0, lapacke.Dgges(byte(jobvsl), byte(jobvsr), sort, sel, n, a, lda, b, ldb, []lapacke.Int{0}, alphar, alphai, beta, vsl, max(n, ldvsl), vsr, max(n, ldvsr), work, -1, nil)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(alphar) < n:
+		panic(shortAlphaR)
+	case len(alphai) < n:
+		panic(shortAlphaI)
+	case len(beta) < n:
+		panic(shortBeta)
+	case wantvsl && len(vsl) < (n-1)*ldvsl+n:
+		panic(shortVSL)
+	case wantvsr && len(vsr) < (n-1)*ldvsr+n:
+		panic(shortVSR)
+	}
+
+	sdim32 := []lapacke.Int{0}
+	// bwork is only referenced when the eigenvalues are sorted.
+	var nbwork int
+	if sel != nil {
+		nbwork = n
+	}
+	bwork := getInts(nbwork)
+	ok = lapacke.Dgges(byte(jobvsl), byte(jobvsr), sort, sel, n, a, lda, b, ldb, sdim32, alphar, alphai, beta, vsl, max(n, ldvsl), vsr, max(n, ldvsr), work, lwork, *bwork)
+	putInts(bwork)
+	return int(sdim32[0]), ok
+}
+
+// Dggev3 computes the generalized eigenvalues and, optionally, the left
+// and/or right generalized eigenvectors of a pair of n×n real nonsymmetric
+// matrices (A,B).
+//
+// A generalized eigenvalue for a pair of matrices (A,B) is a scalar λ or a
+// ratio alpha/beta = λ, such that A - λ*B is singular. It is usually
+// represented as the pair (alpha,beta), as there is a reasonable
+// interpretation for beta == 0, and even for both being zero. If beta is
+// zero, the eigenvalue is infinite.
+//
+// The right generalized eigenvector v_j corresponding to the generalized
+// eigenvalue λ_j of (A,B) satisfies
+//
+//	A * v_j = λ_j * B * v_j,
+//
+// and the left generalized eigenvector u_j corresponding to λ_j satisfies
+//
+//	u_j^H * A = λ_j * u_j^H * B,
+//
+// where u_j^H is the conjugate transpose of u_j.
+//
+// On return, alphar[j] + i*alphai[j] and beta[j] for j = 0, ..., n-1 contain
+// the generalized eigenvalues, where i is the imaginary unit. If alphai[j]
+// is zero, the j-th eigenvalue is real. If alphai[j] is positive, the j-th
+// and (j+1)-st eigenvalues are a complex conjugate pair with alphai[j+1]
+// equal to -alphai[j]. The quotients alphar[j]/beta[j] and alphai[j]/beta[j]
+// may easily overflow or underflow, and beta[j] may even be zero, so they
+// should not be computed naively. alphar, alphai and beta must have length
+// at least n, and Dggev3 will panic otherwise.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone. Right eigenvectors will be
+// computed only if jobvr == lapack.RightEVCompute, otherwise jobvr must be
+// lapack.RightEVNone. The eigenvectors are stored in the columns of the n×n
+// matrices VL and VR in the same order as their eigenvalues, and complex
+// eigenvectors are stored in two consecutive columns as described in Dgeev.
+// Each eigenvector is scaled so that the largest component has
+// abs(real part) + abs(imaginary part) equal to one.
+//
+// On return, a and b are overwritten.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,8*n), otherwise Dggev3 will panic. For good performance, lwork must
+// generally be larger. If lwork == -1, instead of performing Dggev3, the
+// function only calculates the optimal value of lwork and stores it into
+// work[0].
+//
+// Dggev3 returns whether the computation was successful. If it was not, the
+// QZ iteration failed to compute all eigenvalues, or the computation of the
+// eigenvectors failed, and the content of the output arguments is not
+// reliable.
+func (impl Implementation) Dggev3(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(badLeftEVJob)
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(badRightEVJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldvl < 1 || (wantvl && ldvl < n):
+		panic(badLdVL)
+	case ldvr < 1 || (wantvr && ldvr < n):
+		panic(badLdVR)
+	case lwork < max(1, 8*n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// The calls to lapacke.Dggev3 below require max(n,ldvl) and max(n,ldvr)
+	// because the leading dimension checks in LAPACKE_dggev3_work do not
+	// depend on jobvl and jobvr.
+
+	if lwork == -1 {
+		return lapacke.Dggev3(byte(jobvl), byte(jobvr), n, a, lda, b, ldb, alphar, alphai, beta, vl, max(n, ldvl), vr, max(n, ldvr), work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(alphar) < n:
+		panic(shortAlphaR)
+	case len(alphai) < n:
+		panic(shortAlphaI)
+	case len(beta) < n:
+		panic(shortBeta)
+	case wantvl && len(vl) < (n-1)*ldvl+n:
+		panic(shortVL)
+	case wantvr && len(vr) < (n-1)*ldvr+n:
+		panic(shortVR)
+	}
+
+	return lapacke.Dggev3(byte(jobvl), byte(jobvr), n, a, lda, b, ldb, alphar, alphai, beta, vl, max(n, ldvl), vr, max(n, ldvr), work, lwork)
+}
+
+// Dggev computes the generalized eigenvalues and, optionally, the left
+// and/or right generalized eigenvectors of a pair of n×n real nonsymmetric
+// matrices (A,B).
+//
+// Dggev is the unblocked version of Dggev3 and takes the same arguments.
+// See the documentation of Dggev3 for their description. Dggev3 is usually
+// faster for large matrices.
+func (impl Implementation) Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(badLeftEVJob)
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(badRightEVJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldvl < 1 || (wantvl && ldvl < n):
+		panic(badLdVL)
+	case ldvr < 1 || (wantvr && ldvr < n):
+		panic(badLdVR)
+	case lwork < max(1, 8*n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// The calls to lapacke.Dggev below require max(n,ldvl) and max(n,ldvr)
+	// for the same reason as in Dggev3.
+
+	if lwork == -1 {
+		return lapacke.Dggev(byte(jobvl), byte(jobvr), n, a, lda, b, ldb, alphar, alphai, beta, vl, max(n, ldvl), vr, max(n, ldvr), work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(alphar) < n:
+		panic(shortAlphaR)
+	case len(alphai) < n:
+		panic(shortAlphaI)
+	case len(beta) < n:
+		panic(shortBeta)
+	case wantvl && len(vl) < (n-1)*ldvl+n:
+		panic(shortVL)
+	case wantvr && len(vr) < (n-1)*ldvr+n:
+		panic(shortVR)
+	}
+
+	return lapacke.Dggev(byte(jobvl), byte(jobvr), n, a, lda, b, ldb, alphar, alphai, beta, vl, max(n, ldvl), vr, max(n, ldvr), work, lwork)
+}
+
 // Dtgsja computes the generalized singular value decomposition (GSVD)
 // of two real upper triangular or trapezoidal matrices A and B.
 //
@@ -4279,7 +7231,7 @@ func (impl Implementation) Dtgsja(jobU, jobV, jobQ lapack.GSVDJob, m, p, n, k, l
 		panic(shortWork)
 	}
 
-	ncycle := []int32{0}
+	ncycle := []lapacke.Int{0}
 	ok = lapacke.Dtgsja(byte(jobU), byte(jobV), byte(jobQ), m, p, n, k, l, a, lda, b, ldb, tola, tolb, alpha, beta, u, ldu, v, ldv, q, ldq, work, ncycle)
 	return int(ncycle[0]), ok
 }
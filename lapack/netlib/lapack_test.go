@@ -209,6 +209,10 @@ func TestDgetrs(t *testing.T) {
 	testlapack.DgetrsTest(t, impl)
 }
 
+func TestDgtsv(t *testing.T) {
+	testlapack.DgtsvTest(t, impl)
+}
+
 func TestDggsvd3(t *testing.T) {
 	testlapack.Dggsvd3Test(t, impl)
 }
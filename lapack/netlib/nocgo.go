@@ -0,0 +1,601 @@
+// Code generated by "go generate gonum.org/v1/netlib/lapack/netlib"; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !cgo
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+var _ lapack.Complex128 = Implementation{}
+
+// Float32Implementation is the single precision counterpart of
+// Implementation. Its routines call a C LAPACK library and panic when the
+// package is built without cgo.
+type Float32Implementation struct{}
+
+// Dpptrf panics because it requires cgo.
+func (Implementation) Dpptrf(uplo blas.Uplo, n int, ap []float64) (ok bool) {
+	panic("netlib: Dpptrf requires cgo")
+}
+
+// Dpptrs panics because it requires cgo.
+func (Implementation) Dpptrs(uplo blas.Uplo, n, nrhs int, ap []float64, b []float64, ldb int) {
+	panic("netlib: Dpptrs requires cgo")
+}
+
+// Dppsv panics because it requires cgo.
+func (Implementation) Dppsv(uplo blas.Uplo, n, nrhs int, ap, b []float64, ldb int) (ok bool) {
+	panic("netlib: Dppsv requires cgo")
+}
+
+// Dpttrf panics because it requires cgo.
+func (Implementation) Dpttrf(n int, d, e []float64) (ok bool) {
+	panic("netlib: Dpttrf requires cgo")
+}
+
+// Dptsv panics because it requires cgo.
+func (Implementation) Dptsv(n, nrhs int, d, e, b []float64, ldb int) (ok bool) {
+	panic("netlib: Dptsv requires cgo")
+}
+
+// Dgelss panics because it requires cgo.
+func (Implementation) Dgelss(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int) (rank int, ok bool) {
+	panic("netlib: Dgelss requires cgo")
+}
+
+// Dgelsd panics because it requires cgo.
+func (Implementation) Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int, iwork []int) (rank int, ok bool) {
+	panic("netlib: Dgelsd requires cgo")
+}
+
+// Dgelsy panics because it requires cgo.
+func (Implementation) Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []int, rcond float64, work []float64, lwork int) (rank int) {
+	panic("netlib: Dgelsy requires cgo")
+}
+
+// Dgglse panics because it requires cgo.
+func (Implementation) Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) (ok bool) {
+	panic("netlib: Dgglse requires cgo")
+}
+
+// Dggglm panics because it requires cgo.
+func (Implementation) Dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) (ok bool) {
+	panic("netlib: Dggglm requires cgo")
+}
+
+// Dgesdd panics because it requires cgo.
+func (Implementation) Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool) {
+	panic("netlib: Dgesdd requires cgo")
+}
+
+// Dgbtrf panics because it requires cgo.
+func (Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	panic("netlib: Dgbtrf requires cgo")
+}
+
+// Dgbtrs panics because it requires cgo.
+func (Implementation) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	panic("netlib: Dgbtrs requires cgo")
+}
+
+// Dgbsv panics because it requires cgo.
+func (Implementation) Dgbsv(n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) (ok bool) {
+	panic("netlib: Dgbsv requires cgo")
+}
+
+// Dgbcon panics because it requires cgo.
+func (Implementation) Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) (rcond float64) {
+	panic("netlib: Dgbcon requires cgo")
+}
+
+// Dgttrf panics because it requires cgo.
+func (Implementation) Dgttrf(n int, dl, d, du, du2 []float64, ipiv []int) (ok bool) {
+	panic("netlib: Dgttrf requires cgo")
+}
+
+// Dgttrs panics because it requires cgo.
+func (Implementation) Dgttrs(trans blas.Transpose, n, nrhs int, dl, d, du, du2 []float64, ipiv []int, b []float64, ldb int) {
+	panic("netlib: Dgttrs requires cgo")
+}
+
+// Dgerfs panics because it requires cgo.
+func (Implementation) Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	panic("netlib: Dgerfs requires cgo")
+}
+
+// Dgesvx panics because it requires cgo.
+func (Implementation) Dgesvx(fact FactJob, trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, equed Equilibration, r, c, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equedOut Equilibration, rcond, rpvgrw float64, ok bool) {
+	panic("netlib: Dgesvx requires cgo")
+}
+
+// Dsgesv panics because it requires cgo.
+func (Implementation) Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, fallback, ok bool) {
+	panic("netlib: Dsgesv requires cgo")
+}
+
+// Dporfs panics because it requires cgo.
+func (Implementation) Dporfs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	panic("netlib: Dporfs requires cgo")
+}
+
+// Dposvx panics because it requires cgo.
+func (Implementation) Dposvx(fact FactJob, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed Equilibration, s, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (equedOut Equilibration, rcond float64, ok bool) {
+	panic("netlib: Dposvx requires cgo")
+}
+
+// Dsposv panics because it requires cgo.
+func (Implementation) Dsposv(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, fallback, ok bool) {
+	panic("netlib: Dsposv requires cgo")
+}
+
+// Dsyevd panics because it requires cgo.
+func (Implementation) Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	panic("netlib: Dsyevd requires cgo")
+}
+
+// Dsyevr panics because it requires cgo.
+func (Implementation) Dsyevr(jobz lapack.EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, isuppz []int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	panic("netlib: Dsyevr requires cgo")
+}
+
+// Dsygv panics because it requires cgo.
+func (Implementation) Dsygv(itype GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool) {
+	panic("netlib: Dsygv requires cgo")
+}
+
+// Dsygvd panics because it requires cgo.
+func (Implementation) Dsygvd(itype GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	panic("netlib: Dsygvd requires cgo")
+}
+
+// Dsygvx panics because it requires cgo.
+func (Implementation) Dsygvx(itype GenEVType, jobz lapack.EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork, ifail []int) (m int, ok bool) {
+	panic("netlib: Dsygvx requires cgo")
+}
+
+// Dsycon panics because it requires cgo.
+func (Implementation) Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	panic("netlib: Dsycon requires cgo")
+}
+
+// Dsytrf panics because it requires cgo.
+func (Implementation) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
+	panic("netlib: Dsytrf requires cgo")
+}
+
+// Dsytri panics because it requires cgo.
+func (Implementation) Dsytri(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64) (ok bool) {
+	panic("netlib: Dsytri requires cgo")
+}
+
+// Dsytrs panics because it requires cgo.
+func (Implementation) Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+	panic("netlib: Dsytrs requires cgo")
+}
+
+// Dsysvx panics because it requires cgo.
+func (Implementation) Dsysvx(fact FactJob, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, lwork int, iwork []int) (rcond float64, ok bool) {
+	panic("netlib: Dsysvx requires cgo")
+}
+
+// Dgees panics because it requires cgo.
+func (Implementation) Dgees(jobvs lapack.SchurComp, sel func(wr, wi float64) bool, n int, a []float64, lda int, wr, wi, vs []float64, ldvs int, work []float64, lwork int) (sdim int, ok bool) {
+	panic("netlib: Dgees requires cgo")
+}
+
+// Dgges panics because it requires cgo.
+func (Implementation) Dgges(jobvsl, jobvsr lapack.SchurComp, sel func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int) (sdim int, ok bool) {
+	panic("netlib: Dgges requires cgo")
+}
+
+// Dggev3 panics because it requires cgo.
+func (Implementation) Dggev3(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	panic("netlib: Dggev3 requires cgo")
+}
+
+// Dggev panics because it requires cgo.
+func (Implementation) Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	panic("netlib: Dggev requires cgo")
+}
+
+// Zgetrf panics because it requires cgo.
+func (Implementation) Zgetrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool) {
+	panic("netlib: Zgetrf requires cgo")
+}
+
+// Zgetrs panics because it requires cgo.
+func (Implementation) Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int) {
+	panic("netlib: Zgetrs requires cgo")
+}
+
+// Zgetri panics because it requires cgo.
+func (Implementation) Zgetri(n int, a []complex128, lda int, ipiv []int, work []complex128, lwork int) (ok bool) {
+	panic("netlib: Zgetri requires cgo")
+}
+
+// Zpotrf panics because it requires cgo.
+func (Implementation) Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	panic("netlib: Zpotrf requires cgo")
+}
+
+// Zpotrs panics because it requires cgo.
+func (Implementation) Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) {
+	panic("netlib: Zpotrs requires cgo")
+}
+
+// Zpotri panics because it requires cgo.
+func (Implementation) Zpotri(uplo blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	panic("netlib: Zpotri requires cgo")
+}
+
+// Zgeqrf panics because it requires cgo.
+func (Implementation) Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	panic("netlib: Zgeqrf requires cgo")
+}
+
+// Zungqr panics because it requires cgo.
+func (Implementation) Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	panic("netlib: Zungqr requires cgo")
+}
+
+// Zunmqr panics because it requires cgo.
+func (Implementation) Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) {
+	panic("netlib: Zunmqr requires cgo")
+}
+
+// Zheev panics because it requires cgo.
+func (Implementation) Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int) (ok bool) {
+	panic("netlib: Zheev requires cgo")
+}
+
+// Zgeev panics because it requires cgo.
+func (Implementation) Zgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []complex128, lda int, w []complex128, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int) (first int) {
+	panic("netlib: Zgeev requires cgo")
+}
+
+// Zgesvd panics because it requires cgo.
+func (Implementation) Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int) (ok bool) {
+	panic("netlib: Zgesvd requires cgo")
+}
+
+// Zgels panics because it requires cgo.
+func (Implementation) Zgels(trans blas.Transpose, m, n, nrhs int, a []complex128, lda int, b []complex128, ldb int, work []complex128, lwork int) bool {
+	panic("netlib: Zgels requires cgo")
+}
+
+// Sgeqp3 panics because it requires cgo.
+func (Float32Implementation) Sgeqp3(m, n int, a []float32, lda int, jpvt []int, tau, work []float32, lwork int) {
+	panic("netlib: Sgeqp3 requires cgo")
+}
+
+// Sgerqf panics because it requires cgo.
+func (Float32Implementation) Sgerqf(m, n int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sgerqf requires cgo")
+}
+
+// Slacn2 panics because it requires cgo.
+func (Float32Implementation) Slacn2(n int, v, x []float32, isgn []int, est float32, kase int, isave *[3]int) (float32, int) {
+	panic("netlib: Slacn2 requires cgo")
+}
+
+// Slacpy panics because it requires cgo.
+func (Float32Implementation) Slacpy(uplo blas.Uplo, m, n int, a []float32, lda int, b []float32, ldb int) {
+	panic("netlib: Slacpy requires cgo")
+}
+
+// Slapmr panics because it requires cgo.
+func (Float32Implementation) Slapmr(forward bool, m, n int, x []float32, ldx int, k []int) {
+	panic("netlib: Slapmr requires cgo")
+}
+
+// Slapmt panics because it requires cgo.
+func (Float32Implementation) Slapmt(forward bool, m, n int, x []float32, ldx int, k []int) {
+	panic("netlib: Slapmt requires cgo")
+}
+
+// Slapy2 panics because it requires cgo.
+func (Float32Implementation) Slapy2(x, y float32) float32 {
+	panic("netlib: Slapy2 requires cgo")
+}
+
+// Slarfb panics because it requires cgo.
+func (Float32Implementation) Slarfb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k int, v []float32, ldv int, t []float32, ldt int, c []float32, ldc int, work []float32, ldwork int) {
+	panic("netlib: Slarfb requires cgo")
+}
+
+// Slarfg panics because it requires cgo.
+func (Float32Implementation) Slarfg(n int, alpha float32, x []float32, incX int) (beta, tau float32) {
+	panic("netlib: Slarfg requires cgo")
+}
+
+// Slarft panics because it requires cgo.
+func (Float32Implementation) Slarft(direct lapack.Direct, store lapack.StoreV, n, k int, v []float32, ldv int, tau []float32, t []float32, ldt int) {
+	panic("netlib: Slarft requires cgo")
+}
+
+// Slange panics because it requires cgo.
+func (Float32Implementation) Slange(norm lapack.MatrixNorm, m, n int, a []float32, lda int, work []float32) float32 {
+	panic("netlib: Slange requires cgo")
+}
+
+// Slansy panics because it requires cgo.
+func (Float32Implementation) Slansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []float32, lda int, work []float32) float32 {
+	panic("netlib: Slansy requires cgo")
+}
+
+// Slantr panics because it requires cgo.
+func (Float32Implementation) Slantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float32, lda int, work []float32) float32 {
+	panic("netlib: Slantr requires cgo")
+}
+
+// Slarfx panics because it requires cgo.
+func (Float32Implementation) Slarfx(side blas.Side, m, n int, v []float32, tau float32, c []float32, ldc int, work []float32) {
+	panic("netlib: Slarfx requires cgo")
+}
+
+// Slascl panics because it requires cgo.
+func (Float32Implementation) Slascl(kind lapack.MatrixType, kl, ku int, cfrom, cto float32, m, n int, a []float32, lda int) {
+	panic("netlib: Slascl requires cgo")
+}
+
+// Slaset panics because it requires cgo.
+func (Float32Implementation) Slaset(uplo blas.Uplo, m, n int, alpha, beta float32, a []float32, lda int) {
+	panic("netlib: Slaset requires cgo")
+}
+
+// Slasrt panics because it requires cgo.
+func (Float32Implementation) Slasrt(s lapack.Sort, n int, d []float32) {
+	panic("netlib: Slasrt requires cgo")
+}
+
+// Slaswp panics because it requires cgo.
+func (Float32Implementation) Slaswp(n int, a []float32, lda, k1, k2 int, ipiv []int, incX int) {
+	panic("netlib: Slaswp requires cgo")
+}
+
+// Spbcon panics because it requires cgo.
+func (Float32Implementation) Spbcon(uplo blas.Uplo, n, kd int, ab []float32, ldab int, anorm float32, work []float32, iwork []int) (rcond float32) {
+	panic("netlib: Spbcon requires cgo")
+}
+
+// Spbtrf panics because it requires cgo.
+func (Float32Implementation) Spbtrf(uplo blas.Uplo, n, kd int, ab []float32, ldab int) (ok bool) {
+	panic("netlib: Spbtrf requires cgo")
+}
+
+// Spbtrs panics because it requires cgo.
+func (Float32Implementation) Spbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int) {
+	panic("netlib: Spbtrs requires cgo")
+}
+
+// Spotrf panics because it requires cgo.
+func (Float32Implementation) Spotrf(ul blas.Uplo, n int, a []float32, lda int) (ok bool) {
+	panic("netlib: Spotrf requires cgo")
+}
+
+// Spotri panics because it requires cgo.
+func (Float32Implementation) Spotri(uplo blas.Uplo, n int, a []float32, lda int) (ok bool) {
+	panic("netlib: Spotri requires cgo")
+}
+
+// Spotrs panics because it requires cgo.
+func (Float32Implementation) Spotrs(uplo blas.Uplo, n, nrhs int, a []float32, lda int, b []float32, ldb int) {
+	panic("netlib: Spotrs requires cgo")
+}
+
+// Spstrf panics because it requires cgo.
+func (Float32Implementation) Spstrf(uplo blas.Uplo, n int, a []float32, lda int, piv []int, tol float32, work []float32) (rank int, ok bool) {
+	panic("netlib: Spstrf requires cgo")
+}
+
+// Sgebal panics because it requires cgo.
+func (Float32Implementation) Sgebal(job lapack.BalanceJob, n int, a []float32, lda int, scale []float32) (ilo, ihi int) {
+	panic("netlib: Sgebal requires cgo")
+}
+
+// Sgebak panics because it requires cgo.
+func (Float32Implementation) Sgebak(job lapack.BalanceJob, side lapack.EVSide, n, ilo, ihi int, scale []float32, m int, v []float32, ldv int) {
+	panic("netlib: Sgebak requires cgo")
+}
+
+// Sbdsqr panics because it requires cgo.
+func (Float32Implementation) Sbdsqr(uplo blas.Uplo, n, ncvt, nru, ncc int, d, e, vt []float32, ldvt int, u []float32, ldu int, c []float32, ldc int, work []float32) (ok bool) {
+	panic("netlib: Sbdsqr requires cgo")
+}
+
+// Sgebrd panics because it requires cgo.
+func (Float32Implementation) Sgebrd(m, n int, a []float32, lda int, d, e, tauQ, tauP, work []float32, lwork int) {
+	panic("netlib: Sgebrd requires cgo")
+}
+
+// Sgecon panics because it requires cgo.
+func (Float32Implementation) Sgecon(norm lapack.MatrixNorm, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32 {
+	panic("netlib: Sgecon requires cgo")
+}
+
+// Sgelq2 panics because it requires cgo.
+func (Float32Implementation) Sgelq2(m, n int, a []float32, lda int, tau, work []float32) {
+	panic("netlib: Sgelq2 requires cgo")
+}
+
+// Sgelqf panics because it requires cgo.
+func (Float32Implementation) Sgelqf(m, n int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sgelqf requires cgo")
+}
+
+// Sgeqr2 panics because it requires cgo.
+func (Float32Implementation) Sgeqr2(m, n int, a []float32, lda int, tau, work []float32) {
+	panic("netlib: Sgeqr2 requires cgo")
+}
+
+// Sgeqrf panics because it requires cgo.
+func (Float32Implementation) Sgeqrf(m, n int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sgeqrf requires cgo")
+}
+
+// Sgehrd panics because it requires cgo.
+func (Float32Implementation) Sgehrd(n, ilo, ihi int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sgehrd requires cgo")
+}
+
+// Sgels panics because it requires cgo.
+func (Float32Implementation) Sgels(trans blas.Transpose, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool {
+	panic("netlib: Sgels requires cgo")
+}
+
+// Sgesvd panics because it requires cgo.
+func (Float32Implementation) Sgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int) (ok bool) {
+	panic("netlib: Sgesvd requires cgo")
+}
+
+// Sgetf2 panics because it requires cgo.
+func (Float32Implementation) Sgetf2(m, n int, a []float32, lda int, ipiv []int) (ok bool) {
+	panic("netlib: Sgetf2 requires cgo")
+}
+
+// Sgetrf panics because it requires cgo.
+func (Float32Implementation) Sgetrf(m, n int, a []float32, lda int, ipiv []int) (ok bool) {
+	panic("netlib: Sgetrf requires cgo")
+}
+
+// Sgetri panics because it requires cgo.
+func (Float32Implementation) Sgetri(n int, a []float32, lda int, ipiv []int, work []float32, lwork int) (ok bool) {
+	panic("netlib: Sgetri requires cgo")
+}
+
+// Sgetrs panics because it requires cgo.
+func (Float32Implementation) Sgetrs(trans blas.Transpose, n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int) {
+	panic("netlib: Sgetrs requires cgo")
+}
+
+// Sggsvd3 panics because it requires cgo.
+func (Float32Implementation) Sggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int, a []float32, lda int, b []float32, ldb int, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, lwork int, iwork []int) (k, l int, ok bool) {
+	panic("netlib: Sggsvd3 requires cgo")
+}
+
+// Sggsvp3 panics because it requires cgo.
+func (Float32Implementation) Sggsvp3(jobU, jobV, jobQ lapack.GSVDJob, m, p, n int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, iwork []int, tau, work []float32, lwork int) (k, l int) {
+	panic("netlib: Sggsvp3 requires cgo")
+}
+
+// Sorgbr panics because it requires cgo.
+func (Float32Implementation) Sorgbr(vect lapack.GenOrtho, m, n, k int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sorgbr requires cgo")
+}
+
+// Sorghr panics because it requires cgo.
+func (Float32Implementation) Sorghr(n, ilo, ihi int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sorghr requires cgo")
+}
+
+// Sorglq panics because it requires cgo.
+func (Float32Implementation) Sorglq(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sorglq requires cgo")
+}
+
+// Sorgql panics because it requires cgo.
+func (Float32Implementation) Sorgql(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sorgql requires cgo")
+}
+
+// Sorgqr panics because it requires cgo.
+func (Float32Implementation) Sorgqr(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sorgqr requires cgo")
+}
+
+// Sorgtr panics because it requires cgo.
+func (Float32Implementation) Sorgtr(uplo blas.Uplo, n int, a []float32, lda int, tau, work []float32, lwork int) {
+	panic("netlib: Sorgtr requires cgo")
+}
+
+// Sormbr panics because it requires cgo.
+func (Float32Implementation) Sormbr(vect lapack.ApplyOrtho, side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) {
+	panic("netlib: Sormbr requires cgo")
+}
+
+// Sormhr panics because it requires cgo.
+func (Float32Implementation) Sormhr(side blas.Side, trans blas.Transpose, m, n, ilo, ihi int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) {
+	panic("netlib: Sormhr requires cgo")
+}
+
+// Sormlq panics because it requires cgo.
+func (Float32Implementation) Sormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) {
+	panic("netlib: Sormlq requires cgo")
+}
+
+// Sormqr panics because it requires cgo.
+func (Float32Implementation) Sormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) {
+	panic("netlib: Sormqr requires cgo")
+}
+
+// Spocon panics because it requires cgo.
+func (Float32Implementation) Spocon(uplo blas.Uplo, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32 {
+	panic("netlib: Spocon requires cgo")
+}
+
+// Ssteqr panics because it requires cgo.
+func (Float32Implementation) Ssteqr(compz lapack.EVComp, n int, d, e, z []float32, ldz int, work []float32) (ok bool) {
+	panic("netlib: Ssteqr requires cgo")
+}
+
+// Ssterf panics because it requires cgo.
+func (Float32Implementation) Ssterf(n int, d, e []float32) (ok bool) {
+	panic("netlib: Ssterf requires cgo")
+}
+
+// Ssyev panics because it requires cgo.
+func (Float32Implementation) Ssyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float32, lda int, w, work []float32, lwork int) (ok bool) {
+	panic("netlib: Ssyev requires cgo")
+}
+
+// Ssytrd panics because it requires cgo.
+func (Float32Implementation) Ssytrd(uplo blas.Uplo, n int, a []float32, lda int, d, e, tau, work []float32, lwork int) {
+	panic("netlib: Ssytrd requires cgo")
+}
+
+// Stbtrs panics because it requires cgo.
+func (Float32Implementation) Stbtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, kd, nrhs int, a []float32, lda int, b []float32, ldb int) (ok bool) {
+	panic("netlib: Stbtrs requires cgo")
+}
+
+// Strcon panics because it requires cgo.
+func (Float32Implementation) Strcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int, work []float32, iwork []int) float32 {
+	panic("netlib: Strcon requires cgo")
+}
+
+// Strexc panics because it requires cgo.
+func (Float32Implementation) Strexc(compq lapack.UpdateSchurComp, n int, t []float32, ldt int, q []float32, ldq int, ifst, ilst int, work []float32) (ifstOut, ilstOut int, ok bool) {
+	panic("netlib: Strexc requires cgo")
+}
+
+// Strtri panics because it requires cgo.
+func (Float32Implementation) Strtri(uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int) (ok bool) {
+	panic("netlib: Strtri requires cgo")
+}
+
+// Strtrs panics because it requires cgo.
+func (Float32Implementation) Strtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float32, lda int, b []float32, ldb int) (ok bool) {
+	panic("netlib: Strtrs requires cgo")
+}
+
+// Shseqr panics because it requires cgo.
+func (Float32Implementation) Shseqr(job lapack.SchurJob, compz lapack.SchurComp, n, ilo, ihi int, h []float32, ldh int, wr, wi []float32, z []float32, ldz int, work []float32, lwork int) (unconverged int) {
+	panic("netlib: Shseqr requires cgo")
+}
+
+// Sgeev panics because it requires cgo.
+func (Float32Implementation) Sgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float32, lda int, wr, wi []float32, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) (first int) {
+	panic("netlib: Sgeev requires cgo")
+}
+
+// Stgsja panics because it requires cgo.
+func (Float32Implementation) Stgsja(jobU, jobV, jobQ lapack.GSVDJob, m, p, n, k, l int, a []float32, lda int, b []float32, ldb int, tola, tolb float32, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32) (cycles int, ok bool) {
+	panic("netlib: Stgsja requires cgo")
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !cgo
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+func TestNoCgo(t *testing.T) {
+	impl := Implementation{}
+
+	// Routines provided by gonum are forwarded.
+	a := []float64{4, 2, 2, 3}
+	if !impl.Dpotrf(blas.Lower, 2, a, 2) {
+		t.Error("unexpected Dpotrf failure")
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"Dgesdd", func() {
+			impl.Dgesdd(lapack.SVDAll, 2, 2, a, 2, nil, nil, 2, nil, 2, nil, -1, nil)
+		}},
+		{"Zgetrf", func() {
+			impl.Zgetrf(2, 2, make([]complex128, 4), 2, make([]int, 2))
+		}},
+		{"Sgetrf", func() {
+			Float32Implementation{}.Sgetrf(2, 2, make([]float32, 4), 2, make([]int, 2))
+		}},
+	} {
+		func() {
+			defer func() {
+				want := "netlib: " + test.name + " requires cgo"
+				if r := recover(); r != want {
+					t.Errorf("unexpected panic: got %v, want %q", r, want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
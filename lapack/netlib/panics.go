@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+// Panic strings for the routines that are not provided by gonum/lapack/gonum.
+const (
+	// Panic strings for bad enumeration values.
+	badEquilibration = "lapack: bad Equilibration"
+	badEVRange       = "lapack: bad EVRange"
+	badFact          = "lapack: bad FactJob"
+	badGenEVType     = "lapack: bad GenEVType"
+
+	// Panic strings for bad numerical and string values.
+	badIl       = "lapack: il out of range"
+	badIu       = "lapack: iu out of range"
+	badInterval = "lapack: vl not less than vu"
+	badLdAF     = "lapack: bad leading dimension of AF"
+	badLIWork   = "lapack: insufficient declared integer workspace length"
+	badLdVS     = "lapack: bad leading dimension of VS"
+	badLdVSL    = "lapack: bad leading dimension of VSL"
+	badLdVSR    = "lapack: bad leading dimension of VSR"
+	nGTMP       = "lapack: n > m+p"
+	pGTN        = "lapack: p > n"
+	badScaling  = "lapack: non-positive scale factor"
+
+	// Panic strings for insufficient slice lengths.
+	shortAF     = "lapack: insufficient length of af"
+	shortAP     = "lapack: insufficient length of ap"
+	shortAlphaI = "lapack: insufficient length of alphai"
+	shortAlphaR = "lapack: insufficient length of alphar"
+	shortBerr   = "lapack: insufficient length of berr"
+	shortBeta   = "lapack: insufficient length of beta"
+	shortDU2    = "lapack: insufficient length of du2"
+	shortFerr   = "lapack: insufficient length of ferr"
+	shortIfail  = "lapack: insufficient length of ifail"
+	shortIsuppz = "lapack: insufficient length of isuppz"
+	shortR      = "lapack: insufficient length of r"
+	shortSWork  = "lapack: insufficient length of swork"
+	shortVS     = "lapack: insufficient length of vs"
+	shortVSL    = "lapack: insufficient length of vsl"
+	shortVSR    = "lapack: insufficient length of vsr"
+)
@@ -0,0 +1,352 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// pivotProblem holds the operands of the routines that pass pivot indices or
+// integer workspace to LAPACKE, sized for the small systems for which the
+// conversion of the indices would dominate the run time if it allocated.
+type pivotProblem struct {
+	n      int
+	a, spd []float64
+	lu     []float64
+	b, rhs []float64
+	x      []float64
+	ipiv   []int
+	jpvt   []int
+	perm   []int
+	tau    []float64
+
+	// af and ipivAf hold the LU factorization of a.
+	af     []float64
+	ipivAf []int
+
+	// ab holds a in band storage with kl sub-diagonals and ku
+	// super-diagonals, and abLU and ipivBand its factorization.
+	kl, ku, ldab int
+	ab, abLU     []float64
+	ipivBand     []int
+
+	// dl, d and du hold a tridiagonal matrix, and tdl, td, tdu, du2 and
+	// ipivTri its factorization.
+	dl, d, du         []float64
+	tdl, td, tdu, du2 []float64
+	ipivTri           []int
+
+	// symLU and ipivSym hold the factorization of the symmetric matrix sym.
+	sym, symLU []float64
+	ipivSym    []int
+
+	ferr, berr []float64
+	r, c       []float64
+	v          []float64
+	isgn       []int
+	isave      [3]int
+	swork      []float32
+	work       []float64
+	iwork      []int
+	workz      []complex128
+
+	geqp3Lw, gelsyLw int
+	sytrfLw, sysvxLw int
+
+	a32, lu32, b32 []float32
+	az, luz, bz    []complex128
+}
+
+func newPivotProblem(n int) *pivotProblem {
+	rnd := rand.New(rand.NewSource(1))
+	const kl, ku = 1, 2
+	ldab := 2*kl + ku + 1
+	_, ab := randomBand(n, n, kl, ku, ldab, rnd)
+	p := &pivotProblem{
+		n:        n,
+		a:        make([]float64, n*n),
+		spd:      dspd(n, n, rnd),
+		lu:       make([]float64, n*n),
+		b:        make([]float64, n),
+		rhs:      make([]float64, n),
+		x:        make([]float64, n),
+		ipiv:     make([]int, n),
+		jpvt:     make([]int, n),
+		perm:     rnd.Perm(n),
+		tau:      make([]float64, n),
+		af:       make([]float64, n*n),
+		ipivAf:   make([]int, n),
+		kl:       kl,
+		ku:       ku,
+		ldab:     ldab,
+		ab:       ab,
+		abLU:     make([]float64, len(ab)),
+		ipivBand: make([]int, n),
+		dl:       make([]float64, n-1),
+		d:        make([]float64, n),
+		du:       make([]float64, n-1),
+		tdl:      make([]float64, n-1),
+		td:       make([]float64, n),
+		tdu:      make([]float64, n-1),
+		du2:      make([]float64, n-2),
+		ipivTri:  make([]int, n),
+		sym:      make([]float64, n*n),
+		symLU:    make([]float64, n*n),
+		ipivSym:  make([]int, n),
+		ferr:     make([]float64, 1),
+		berr:     make([]float64, 1),
+		r:        make([]float64, n),
+		c:        make([]float64, n),
+		v:        make([]float64, n),
+		isgn:     make([]int, n),
+		swork:    make([]float32, n*(n+1)),
+		iwork:    make([]int, n),
+		a32:      make([]float32, n*n),
+		lu32:     make([]float32, n*n),
+		b32:      make([]float32, n),
+		az:       make([]complex128, n*n),
+		luz:      make([]complex128, n*n),
+		bz:       make([]complex128, n),
+	}
+	for i := range p.a {
+		p.a[i] = rnd.NormFloat64()
+		p.a32[i] = float32(p.a[i])
+		p.az[i] = complex(p.a[i], rnd.NormFloat64())
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			p.sym[i*n+j] = p.a[i*n+j] + p.a[j*n+i]
+		}
+	}
+	for i := range p.rhs {
+		p.rhs[i] = rnd.NormFloat64()
+	}
+	for i := range p.d {
+		p.d[i] = rnd.NormFloat64() + 4
+	}
+	for i := range p.dl {
+		p.dl[i] = rnd.NormFloat64()
+		p.du[i] = rnd.NormFloat64()
+	}
+
+	var query [1]float64
+	impl.Dgeqp3(n, n, p.lu, n, p.jpvt, p.tau, query[:], -1)
+	p.geqp3Lw = int(query[0])
+	impl.Dgelsy(n, n, 1, p.lu, n, p.b, 1, p.jpvt, 1e-10, query[:], -1)
+	p.gelsyLw = int(query[0])
+	impl.Dsytrf(blas.Upper, n, p.symLU, n, p.ipivSym, query[:], -1)
+	p.sytrfLw = int(query[0])
+	impl.Dsysvx(FactCompute, blas.Upper, n, 1, p.sym, n, p.symLU, n, p.ipivSym, p.rhs, 1, p.x, 1, p.ferr, p.berr, query[:], -1, p.iwork)
+	p.sysvxLw = max(int(query[0]), 3*n)
+	impl.Dgetri(n, p.lu, n, p.ipiv, query[:], -1)
+	lwork := max(max(p.geqp3Lw, p.gelsyLw), max(p.sytrfLw, p.sysvxLw))
+	p.work = make([]float64, max(lwork, max(int(query[0]), 4*n)))
+	var queryz [1]complex128
+	impl.Zgetri(n, p.luz, n, p.ipiv, queryz[:], -1)
+	p.workz = make([]complex128, max(int(real(queryz[0])), n))
+
+	// Compute the factorizations used by the routines that take them as
+	// input.
+	copy(p.af, p.a)
+	impl.Dgetrf(n, n, p.af, n, p.ipivAf)
+	copy(p.lu, p.a)
+	impl.Dgetrf(n, n, p.lu, n, p.ipiv)
+	copy(p.abLU, p.ab)
+	impl.Dgbtrf(n, n, kl, ku, p.abLU, ldab, p.ipivBand)
+	copy(p.tdl, p.dl)
+	copy(p.td, p.d)
+	copy(p.tdu, p.du)
+	impl.Dgttrf(n, p.tdl, p.td, p.tdu, p.du2, p.ipivTri)
+	copy(p.symLU, p.sym)
+	impl.Dsytrf(blas.Upper, n, p.symLU, n, p.ipivSym, p.work, p.sytrfLw)
+	copy(p.luz, p.az)
+	impl.Zgetrf(n, n, p.luz, n, p.ipiv)
+	copy(p.lu32, p.a32)
+	simpl.Sgetrf(n, n, p.lu32, n, p.ipiv)
+	return p
+}
+
+// routines returns the calls made by TestPivotAllocs and BenchmarkPivots.
+// allocs is the number of allocations made by a call that are not related to
+// the conversion of indices, such as those of the scalar outputs and the
+// band storage passed to LAPACKE, which escape to the heap.
+func (p *pivotProblem) routines() []struct {
+	name   string
+	allocs float64
+	f      func()
+} {
+	n := p.n
+	return []struct {
+		name   string
+		allocs float64
+		f      func()
+	}{
+		{"Dgetrf", 0, func() {
+			copy(p.lu, p.a)
+			impl.Dgetrf(n, n, p.lu, n, p.ipiv)
+		}},
+		{"Dgetf2", 0, func() {
+			copy(p.lu, p.a)
+			impl.Dgetf2(n, n, p.lu, n, p.ipiv)
+		}},
+		{"Dgetrs", 0, func() {
+			copy(p.b, p.rhs)
+			impl.Dgetrs(blas.NoTrans, n, 1, p.lu, n, p.ipiv, p.b, 1)
+		}},
+		{"Dgetri", 0, func() {
+			copy(p.lu, p.a)
+			impl.Dgetrf(n, n, p.lu, n, p.ipiv)
+			impl.Dgetri(n, p.lu, n, p.ipiv, p.work, len(p.work))
+		}},
+		{"Dlaswp", 0, func() {
+			impl.Dlaswp(n, p.lu, n, 0, n-1, p.ipiv, 1)
+		}},
+		{"Dlapmt", 0, func() {
+			impl.Dlapmt(true, n, n, p.lu, n, p.perm)
+			impl.Dlapmt(false, n, n, p.lu, n, p.perm)
+		}},
+		{"Dgeqp3", 0, func() {
+			copy(p.lu, p.a)
+			for i := range p.jpvt {
+				p.jpvt[i] = -1
+			}
+			impl.Dgeqp3(n, n, p.lu, n, p.jpvt, p.tau, p.work, p.geqp3Lw)
+		}},
+		{"Dgelsy", 1, func() {
+			copy(p.lu, p.a)
+			copy(p.b, p.rhs)
+			for i := range p.jpvt {
+				p.jpvt[i] = -1
+			}
+			impl.Dgelsy(n, n, 1, p.lu, n, p.b, 1, p.jpvt, 1e-10, p.work, p.gelsyLw)
+		}},
+		{"Dpstrf", 0, func() {
+			copy(p.lu, p.spd)
+			impl.Dpstrf(blas.Upper, n, p.lu, n, p.ipiv, -1, p.work)
+		}},
+		{"Dgecon", 1, func() {
+			impl.Dgecon(lapack.MaxColumnSum, n, p.af, n, 1, p.work, p.iwork)
+		}},
+		{"Dlacn2", 1, func() {
+			p.isave = [3]int{}
+			impl.Dlacn2(n, p.v, p.x, p.isgn, 0, 0, &p.isave)
+		}},
+		{"Dgerfs", 0, func() {
+			impl.Dgerfs(blas.NoTrans, n, 1, p.a, n, p.af, n, p.ipivAf, p.rhs, 1, p.x, 1, p.ferr, p.berr, p.work, p.iwork)
+		}},
+		{"Dgesvx", 2, func() {
+			impl.Dgesvx(FactSupplied, blas.NoTrans, n, 1, p.a, n, p.af, n, p.ipivAf, EquilibrationNone, p.r, p.c, p.rhs, 1, p.x, 1, p.ferr, p.berr, p.work, p.iwork)
+		}},
+		{"Dsgesv", 0, func() {
+			copy(p.lu, p.a)
+			impl.Dsgesv(n, 1, p.lu, n, p.ipiv, p.rhs, 1, p.x, 1, p.work, p.swork)
+		}},
+		{"Dgbtrf", 1, func() {
+			copy(p.abLU, p.ab)
+			impl.Dgbtrf(n, n, p.kl, p.ku, p.abLU, p.ldab, p.ipivBand)
+		}},
+		{"Dgbtrs", 1, func() {
+			copy(p.b, p.rhs)
+			impl.Dgbtrs(blas.NoTrans, n, p.kl, p.ku, 1, p.abLU, p.ldab, p.ipivBand, p.b, 1)
+		}},
+		{"Dgbcon", 2, func() {
+			impl.Dgbcon(lapack.MaxColumnSum, n, p.kl, p.ku, p.abLU, p.ldab, p.ipivBand, 1, p.work, p.iwork)
+		}},
+		{"Dgttrf", 0, func() {
+			copy(p.tdl, p.dl)
+			copy(p.td, p.d)
+			copy(p.tdu, p.du)
+			impl.Dgttrf(n, p.tdl, p.td, p.tdu, p.du2, p.ipivTri)
+		}},
+		{"Dgttrs", 0, func() {
+			copy(p.b, p.rhs)
+			impl.Dgttrs(blas.NoTrans, n, 1, p.tdl, p.td, p.tdu, p.du2, p.ipivTri, p.b, 1)
+		}},
+		{"Dsytrf", 0, func() {
+			copy(p.symLU, p.sym)
+			impl.Dsytrf(blas.Upper, n, p.symLU, n, p.ipivSym, p.work, p.sytrfLw)
+		}},
+		{"Dsytrs", 0, func() {
+			copy(p.b, p.rhs)
+			impl.Dsytrs(blas.Upper, n, 1, p.symLU, n, p.ipivSym, p.b, 1)
+		}},
+		{"Dsytri", 0, func() {
+			copy(p.lu, p.symLU)
+			impl.Dsytri(blas.Upper, n, p.lu, n, p.ipivSym, p.work)
+		}},
+		{"Dsycon", 1, func() {
+			impl.Dsycon(blas.Upper, n, p.symLU, n, p.ipivSym, 1, p.work, p.iwork)
+		}},
+		{"Dsysvx", 1, func() {
+			impl.Dsysvx(FactSupplied, blas.Upper, n, 1, p.sym, n, p.symLU, n, p.ipivSym, p.rhs, 1, p.x, 1, p.ferr, p.berr, p.work, p.sysvxLw, p.iwork)
+		}},
+		{"Zgetrf", 0, func() {
+			copy(p.luz, p.az)
+			impl.Zgetrf(n, n, p.luz, n, p.ipiv)
+		}},
+		{"Zgetrs", 0, func() {
+			for i, v := range p.rhs {
+				p.bz[i] = complex(v, 0)
+			}
+			impl.Zgetrs(blas.NoTrans, n, 1, p.luz, n, p.ipiv, p.bz, 1)
+		}},
+		{"Zgetri", 0, func() {
+			copy(p.luz, p.az)
+			impl.Zgetrf(n, n, p.luz, n, p.ipiv)
+			impl.Zgetri(n, p.luz, n, p.ipiv, p.workz, len(p.workz))
+		}},
+		{"Sgetrf", 0, func() {
+			copy(p.lu32, p.a32)
+			simpl.Sgetrf(n, n, p.lu32, n, p.ipiv)
+		}},
+		{"Sgetrs", 0, func() {
+			for i, v := range p.rhs {
+				p.b32[i] = float32(v)
+			}
+			simpl.Sgetrs(blas.NoTrans, n, 1, p.lu32, n, p.ipiv, p.b32, 1)
+		}},
+	}
+}
+
+func TestPivotAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("sync.Pool drops items when the race detector is enabled")
+	}
+	p := newPivotProblem(8)
+	for _, r := range p.routines() {
+		allocs := testing.AllocsPerRun(100, r.f)
+		if allocs > r.allocs {
+			t.Errorf("unexpected allocations for %s: got:%v want at most:%v", r.name, allocs, r.allocs)
+		}
+	}
+}
+
+func BenchmarkPivots(b *testing.B) {
+	for _, n := range []int{4, 8, 16} {
+		p := newPivotProblem(n)
+		for _, r := range p.routines() {
+			b.Run(fmt.Sprintf("%s/n=%d", r.name, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					r.f()
+				}
+				b.StopTimer()
+				if !raceEnabled {
+					if allocs := testing.AllocsPerRun(10, r.f); allocs > r.allocs {
+						b.Errorf("unexpected allocations: got:%v want at most:%v", allocs, r.allocs)
+					}
+				}
+			})
+		}
+	}
+}
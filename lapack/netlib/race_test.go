@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build race
+
+package netlib
+
+// raceEnabled is true when the tests are built with the race detector, which
+// makes sync.Pool drop pooled items at random.
+const raceEnabled = true
@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+// EVRange specifies which eigenvalues are computed by routines such as
+// Dsyevr.
+type EVRange byte
+
+const (
+	EVRangeAll   EVRange = 'A' // Compute all eigenvalues.
+	EVRangeValue EVRange = 'V' // Compute the eigenvalues in the half-open interval (vl,vu].
+	EVRangeIndex EVRange = 'I' // Compute the eigenvalues with indices il through iu.
+)
+
+// FactJob specifies whether the expert drivers such as Dgesvx are given the
+// factorization of A or compute it.
+type FactJob byte
+
+const (
+	FactSupplied    FactJob = 'F' // The factorization of A is supplied on entry.
+	FactCompute     FactJob = 'N' // The factorization of A is computed.
+	FactEquilibrate FactJob = 'E' // A is equilibrated if necessary, then the factorization is computed.
+)
+
+// Equilibration specifies the scaling of a system of linear equations done
+// by the expert drivers such as Dgesvx.
+type Equilibration byte
+
+const (
+	EquilibrationNone Equilibration = 'N' // No equilibration.
+	EquilibrationRow  Equilibration = 'R' // Row equilibration, A is replaced by diag(r)*A.
+	EquilibrationCol  Equilibration = 'C' // Column equilibration, A is replaced by A*diag(c).
+	EquilibrationBoth Equilibration = 'B' // Row and column equilibration, A is replaced by diag(r)*A*diag(c).
+	EquilibrationSym  Equilibration = 'Y' // Symmetric equilibration, A is replaced by diag(s)*A*diag(s).
+)
+
+// GenEVType specifies the form of a generalized symmetric-definite
+// eigenproblem solved by routines such as Dsygv.
+type GenEVType int
+
+const (
+	GenEVAxBx GenEVType = 1 // A*x = λ*B*x.
+	GenEVABx  GenEVType = 2 // A*B*x = λ*x.
+	GenEVBAx  GenEVType = 3 // B*A*x = λ*x.
+)
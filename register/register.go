@@ -0,0 +1,42 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package register installs the netlib BLAS and LAPACK implementations as the
+// implementations used by the gonum blas64, blas32, cblas128, cblas64 and
+// lapack64 packages.
+//
+// The package is imported for its side effect:
+//
+//	import _ "gonum.org/v1/netlib/register"
+//
+// This is equivalent to calling
+//
+//	blas64.Use(blasnetlib.Implementation{})
+//	blas32.Use(blasnetlib.Implementation{})
+//	cblas128.Use(blasnetlib.Implementation{})
+//	cblas64.Use(blasnetlib.Implementation{})
+//	lapack64.Use(lapacknetlib.Implementation{})
+//
+// where blasnetlib and lapacknetlib are gonum.org/v1/netlib/blas/netlib and
+// gonum.org/v1/netlib/lapack/netlib respectively.
+package register // import "gonum.org/v1/netlib/register"
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/blas/cblas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+	lapacknetlib "gonum.org/v1/netlib/lapack/netlib"
+)
+
+func init() {
+	blas64.Use(blasnetlib.Implementation{})
+	blas32.Use(blasnetlib.Implementation{})
+	cblas128.Use(blasnetlib.Implementation{})
+	cblas64.Use(blasnetlib.Implementation{})
+	lapack64.Use(lapacknetlib.Implementation{})
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+
+package register
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/blas/cblas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+func TestRegister(t *testing.T) {
+	if !blasnetlib.Native {
+		t.Fatal("netlib BLAS does not call a C library")
+	}
+	if _, ok := blas64.Implementation().(blasnetlib.Implementation); !ok {
+		t.Errorf("unexpected blas64 implementation: %T", blas64.Implementation())
+	}
+	if _, ok := blas32.Implementation().(blasnetlib.Implementation); !ok {
+		t.Errorf("unexpected blas32 implementation: %T", blas32.Implementation())
+	}
+	if _, ok := cblas128.Implementation().(blasnetlib.Implementation); !ok {
+		t.Errorf("unexpected cblas128 implementation: %T", cblas128.Implementation())
+	}
+	if _, ok := cblas64.Implementation().(blasnetlib.Implementation); !ok {
+		t.Errorf("unexpected cblas64 implementation: %T", cblas64.Implementation())
+	}
+}
+
+// The lapack64 package does not provide an accessor for its implementation,
+// so TestRegisterLapack64 makes it panic on an illegal argument and checks
+// that the panic was raised by the netlib implementation.
+func TestRegisterLapack64(t *testing.T) {
+	const want = "gonum.org/v1/netlib/lapack/netlib.Implementation.Dpotrf"
+	defer func() {
+		// The frames of the panicking call are still on the stack
+		// while the deferred call runs.
+		pc := make([]uintptr, 64)
+		frames := runtime.CallersFrames(pc[:runtime.Callers(1, pc)])
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic for illegal uplo")
+		}
+		for {
+			f, more := frames.Next()
+			if f.Function == want {
+				return
+			}
+			if strings.HasSuffix(f.Function, ".Dpotrf") {
+				t.Fatalf("unexpected lapack64 implementation: panic raised by %s", f.Function)
+			}
+			if !more {
+				break
+			}
+		}
+		t.Errorf("%s not found in the stack of the panic %q", want, r)
+	}()
+	lapack64.Potrf(blas64.Symmetric{N: 1, Stride: 1, Data: []float64{1}, Uplo: blas.All})
+}